@@ -6,9 +6,11 @@
 package main
 
 import (
+	"context"
 	_ "erpfinance/docs"
 	"erpfinance/internal/config"
 	"erpfinance/internal/helper"
+	"erpfinance/internal/middleware"
 	"erpfinance/internal/migrations"
 	"erpfinance/internal/routes"
 	"fmt"
@@ -42,6 +44,12 @@ func main() {
 		log.Printf("Peringatan: Gagal memuat file .env dari %s. Error: %v", envPath, err)
 	}
 	
+	// Muat kunci penandatangan JWT
+	config.InitJWTKeys(projectRoot)
+
+	// Muat kunci enkripsi secret di database (seed TOTP)
+	config.InitSecretEncryptionKey(projectRoot)
+
 	// Init DB
 	config.InitDB()
 	db := config.DB
@@ -50,6 +58,11 @@ func main() {
 	err = migrations.Migrate(db)
 	helper.PanicIfError(err)
 
+	// Run MRP yang masih Queued/Running berasal dari proses sebelumnya dan tidak akan selesai
+	mrpService, err := config.InitializeMRPService(db)
+	helper.PanicIfError(err)
+	helper.PanicIfError(mrpService.FailInterruptedRuns(context.Background()))
+
 	// Dapatkan koneksi sql native & defer close
 	sqlDB, err := db.DB()
 	helper.PanicIfError(err)
@@ -64,22 +77,146 @@ func main() {
 
 	// Middleware
 	app.Use(logger.New())
+	app.Use(middleware.RequestInfo())
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     os.Getenv("CORS_PORT"),
 		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
 		AllowCredentials: true,
 	}))
 
+	// Pemeriksa token yang dicabut dipakai AuthMiddleware di semua route
+	tokenRevocationService, err := config.InitializeTokenRevocationService(db)
+	helper.PanicIfError(err)
+	middleware.SetTokenRevocationChecker(tokenRevocationService)
+
 	// Inisialisasi Handlers via Google Wire
 	authHandler, err := config.InitializeAuthHandler(db)
 	helper.PanicIfError(err)
 
+	twoFactorHandler, err := config.InitializeTwoFactorHandler(db)
+	helper.PanicIfError(err)
+
+	sessionHandler, err := config.InitializeSessionHandler(db)
+	helper.PanicIfError(err)
+
 	usersHandler, err := config.InitializeUsersHandler(db)
 	helper.PanicIfError(err)
 
+	ledgerHandler, err := config.InitializeLedgerHandler(db)
+	helper.PanicIfError(err)
+
+	periodHandler, err := config.InitializePeriodHandler(db)
+	helper.PanicIfError(err)
+
+	purchasingHandler, err := config.InitializePurchasingHandler(db)
+	helper.PanicIfError(err)
+
+	supplierHandler, err := config.InitializeSupplierHandler(db)
+	helper.PanicIfError(err)
+
+	inventoryHandler, err := config.InitializeInventoryHandler(db)
+	helper.PanicIfError(err)
+
+	goodsReceiptHandler, err := config.InitializeGoodsReceiptHandler(db)
+	helper.PanicIfError(err)
+
+	payableHandler, err := config.InitializePayableHandler(db)
+	helper.PanicIfError(err)
+
+	paymentRunHandler, err := config.InitializePaymentRunHandler(db)
+	helper.PanicIfError(err)
+
+	customerHandler, err := config.InitializeCustomerHandler(db)
+	helper.PanicIfError(err)
+
+	receivableHandler, err := config.InitializeReceivableHandler(db)
+	helper.PanicIfError(err)
+
+	customerReceiptHandler, err := config.InitializeCustomerReceiptHandler(db)
+	helper.PanicIfError(err)
+
+	ppcHandler, err := config.InitializePPCHandler(db)
+	helper.PanicIfError(err)
+
+	workOrderHandler, err := config.InitializeWorkOrderHandler(db)
+	helper.PanicIfError(err)
+
+	mrpHandler, err := config.InitializeMRPHandler(db)
+	helper.PanicIfError(err)
+
+	carrierHandler, err := config.InitializeCarrierHandler(db)
+	helper.PanicIfError(err)
+
+	shipmentHandler, err := config.InitializeShipmentHandler(db)
+	helper.PanicIfError(err)
+
+	salesOrderHandler, err := config.InitializeSalesOrderHandler(db)
+	helper.PanicIfError(err)
+
+	currencyHandler, err := config.InitializeCurrencyHandler(db)
+	helper.PanicIfError(err)
+
+	fxRevaluationHandler, err := config.InitializeFXRevaluationHandler(db)
+	helper.PanicIfError(err)
+
+	taxHandler, err := config.InitializeTaxHandler(db)
+	helper.PanicIfError(err)
+
+	fixedAssetHandler, err := config.InitializeFixedAssetHandler(db)
+	helper.PanicIfError(err)
+
+	depreciationRunHandler, err := config.InitializeDepreciationRunHandler(db)
+	helper.PanicIfError(err)
+
+	bankAccountHandler, err := config.InitializeBankAccountHandler(db)
+	helper.PanicIfError(err)
+
+	bankReconciliationHandler, err := config.InitializeBankReconciliationHandler(db)
+	helper.PanicIfError(err)
+
+	financialReportHandler, err := config.InitializeFinancialReportHandler(db)
+	helper.PanicIfError(err)
+
+	costCenterHandler, err := config.InitializeCostCenterHandler(db)
+	helper.PanicIfError(err)
+
+	budgetHandler, err := config.InitializeBudgetHandler(db)
+	helper.PanicIfError(err)
+
+	approvalRuleHandler, err := config.InitializeApprovalRuleHandler(db)
+	helper.PanicIfError(err)
+
+	approvalHandler, err := config.InitializeApprovalHandler(db)
+	helper.PanicIfError(err)
+
+	auditLogHandler, err := config.InitializeAuditLogHandler(db)
+	helper.PanicIfError(err)
+
 	// Register routes
-	routes.AuthRouter(app, authHandler)
+	routes.AuthRouter(app, authHandler, twoFactorHandler, sessionHandler)
 	routes.UsersRouter(app, usersHandler)
+	routes.LedgerRouter(app, ledgerHandler)
+	routes.PeriodRouter(app, periodHandler)
+	routes.PurchasingRouter(app, purchasingHandler)
+	routes.SupplierRouter(app, supplierHandler)
+	routes.InventoryRouter(app, inventoryHandler)
+	routes.GoodsReceiptRouter(app, goodsReceiptHandler)
+	routes.PayableRouter(app, payableHandler)
+	routes.PaymentRunRouter(app, paymentRunHandler)
+	routes.CustomerRouter(app, customerHandler)
+	routes.ReceivableRouter(app, receivableHandler)
+	routes.CustomerReceiptRouter(app, customerReceiptHandler)
+	routes.PPCRouter(app, ppcHandler, workOrderHandler, mrpHandler)
+	routes.LogisticsRouter(app, carrierHandler, shipmentHandler)
+	routes.SalesRouter(app, salesOrderHandler)
+	routes.CurrencyRouter(app, currencyHandler, fxRevaluationHandler)
+	routes.TaxRouter(app, taxHandler)
+	routes.AssetRouter(app, fixedAssetHandler, depreciationRunHandler)
+	routes.BankRouter(app, bankAccountHandler, bankReconciliationHandler)
+	routes.ReportRouter(app, financialReportHandler)
+	routes.BudgetRouter(app, costCenterHandler, budgetHandler)
+	routes.ApprovalRouter(app, approvalRuleHandler, approvalHandler)
+	routes.AuditRouter(app, auditLogHandler)
 
 	// Swagger documentation
 	app.Get("/swagger/*", fiberSwagger.HandlerDefault)
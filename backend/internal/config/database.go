@@ -2,6 +2,7 @@ package config
 
 import (
 	"erpfinance/internal/helper"
+	auditRepo "erpfinance/internal/repository/audit"
 	"os"
 	"time"
 
@@ -26,6 +27,10 @@ func InitDB() {
 	})
 	helper.PanicIfError(err)
 
+	// Setiap create, update dan delete melalui gorm dicatat ke audit_logs dalam transaksi yang sama
+	err = db.Use(auditRepo.NewAuditPlugin())
+	helper.PanicIfError(err)
+
 	sqlDB, err := db.DB()
 	helper.PanicIfError(err)
 
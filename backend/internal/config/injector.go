@@ -4,16 +4,76 @@
 package config
 
 import (
+	"erpfinance/internal/handler/approval"
+	"erpfinance/internal/handler/asset"
+	"erpfinance/internal/handler/audit"
 	"erpfinance/internal/handler/auth"
+	"erpfinance/internal/handler/bank"
+	"erpfinance/internal/handler/budget"
+	"erpfinance/internal/handler/currency"
+	"erpfinance/internal/handler/inventory"
+	"erpfinance/internal/handler/ledger"
+	"erpfinance/internal/handler/logistics"
+	"erpfinance/internal/handler/payable"
+	"erpfinance/internal/handler/period"
+	"erpfinance/internal/handler/ppc"
+	"erpfinance/internal/handler/purchasing"
+	"erpfinance/internal/handler/receivable"
+	"erpfinance/internal/handler/receiving"
+	"erpfinance/internal/handler/report"
+	"erpfinance/internal/handler/sales"
+	"erpfinance/internal/handler/supplier"
+	"erpfinance/internal/handler/tax"
 	"erpfinance/internal/handler/users"
+	approvalRepo "erpfinance/internal/repository/approval"
+	assetRepo "erpfinance/internal/repository/asset"
+	auditRepo "erpfinance/internal/repository/audit"
 	authRepo "erpfinance/internal/repository/auth"
+	bankRepo "erpfinance/internal/repository/bank"
+	budgetRepo "erpfinance/internal/repository/budget"
+	currencyRepo "erpfinance/internal/repository/currency"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	ledgerRepo "erpfinance/internal/repository/ledger"
+	logisticsRepo "erpfinance/internal/repository/logistics"
+	payableRepo "erpfinance/internal/repository/payable"
+	periodRepo "erpfinance/internal/repository/period"
+	ppcRepo "erpfinance/internal/repository/ppc"
+	purchasingRepo "erpfinance/internal/repository/purchasing"
+	receivableRepo "erpfinance/internal/repository/receivable"
+	receivingRepo "erpfinance/internal/repository/receiving"
+	reportRepo "erpfinance/internal/repository/report"
+	salesRepo "erpfinance/internal/repository/sales"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	supplierRepo "erpfinance/internal/repository/supplier"
+	taxRepo "erpfinance/internal/repository/tax"
 	tokenRepo "erpfinance/internal/repository/token"
 	usersRepo "erpfinance/internal/repository/users"
+	approvalService "erpfinance/internal/service/approval"
+	assetService "erpfinance/internal/service/asset"
+	auditService "erpfinance/internal/service/audit"
 	authService "erpfinance/internal/service/auth"
+	bankService "erpfinance/internal/service/bank"
+	budgetService "erpfinance/internal/service/budget"
+	currencyService "erpfinance/internal/service/currency"
+	inventoryService "erpfinance/internal/service/inventory"
+	ledgerService "erpfinance/internal/service/ledger"
+	logisticsService "erpfinance/internal/service/logistics"
+	payableService "erpfinance/internal/service/payable"
+	periodService "erpfinance/internal/service/period"
+	ppcService "erpfinance/internal/service/ppc"
+	purchasingService "erpfinance/internal/service/purchasing"
+	receivableService "erpfinance/internal/service/receivable"
+	receivingService "erpfinance/internal/service/receiving"
+	reportService "erpfinance/internal/service/report"
+	salesService "erpfinance/internal/service/sales"
+	supplierService "erpfinance/internal/service/supplier"
+	taxService "erpfinance/internal/service/tax"
 	usersService "erpfinance/internal/service/users"
+	"reflect"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/wire"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -23,22 +83,156 @@ var ProviderSet = wire.NewSet(
 	authRepo.NewAuthRepository,
 	tokenRepo.NewTokenRepository,
 	usersRepo.NewUsersRepository,
+	sequenceRepo.NewSequenceRepository,
+	ledgerRepo.NewAccountRepository,
+	ledgerRepo.NewJournalRepository,
+	periodRepo.NewPeriodRepository,
+	purchasingRepo.NewRequisitionRepository,
+	purchasingRepo.NewPurchaseOrderRepository,
+	supplierRepo.NewSupplierRepository,
+	inventoryRepo.NewItemRepository,
+	inventoryRepo.NewWarehouseRepository,
+	inventoryRepo.NewStockMovementRepository,
+	receivingRepo.NewGoodsReceiptRepository,
+	payableRepo.NewSupplierInvoiceRepository,
+	payableRepo.NewMatchToleranceRepository,
+	payableRepo.NewPayableSettingRepository,
+	payableRepo.NewPaymentRunRepository,
+	receivableRepo.NewCustomerRepository,
+	receivableRepo.NewSalesInvoiceRepository,
+	receivableRepo.NewCustomerReceiptRepository,
+	receivableRepo.NewReceivableSettingRepository,
+	ppcRepo.NewWorkCenterRepository,
+	ppcRepo.NewBillOfMaterialRepository,
+	ppcRepo.NewRoutingRepository,
+	ppcRepo.NewWorkOrderRepository,
+	ppcRepo.NewMRPRunRepository,
+	logisticsRepo.NewCarrierRepository,
+	logisticsRepo.NewShipmentRepository,
+	salesRepo.NewSalesOrderRepository,
+	currencyRepo.NewCurrencyRepository,
+	currencyRepo.NewExchangeRateRepository,
+	currencyRepo.NewCurrencySettingRepository,
+	currencyRepo.NewFXRevaluationRepository,
+	taxRepo.NewTaxCodeRepository,
+	taxRepo.NewTaxInvoiceRangeRepository,
+	taxRepo.NewTaxReportRepository,
+	assetRepo.NewAssetCategoryRepository,
+	assetRepo.NewFixedAssetRepository,
+	assetRepo.NewDepreciationRunRepository,
+	bankRepo.NewBankAccountRepository,
+	bankRepo.NewBankStatementRepository,
+	bankRepo.NewBankBookRepository,
+	reportRepo.NewFinancialReportRepository,
+	budgetRepo.NewCostCenterRepository,
+	budgetRepo.NewBudgetRepository,
+	approvalRepo.NewApprovalRuleRepository,
+	approvalRepo.NewApprovalRequestRepository,
+	approvalRepo.NewApprovalDelegationRepository,
+	auditRepo.NewAuditLogRepository,
+	authRepo.NewTwoFactorRepository,
+	authRepo.NewSecurityEventRepository,
+	tokenRepo.NewRevokedTokenRepository,
 
 	// Service providers
 	authService.NewAuthService,
 	usersService.NewUsersService,
+	ledgerService.NewLedgerService,
+	periodService.NewPeriodService,
+	periodService.NewPeriodCheckService,
+	purchasingService.NewPurchasingService,
+	supplierService.NewSupplierService,
+	supplierService.NewSupplierCheckService,
+	inventoryService.NewInventoryService,
+	receivingService.NewGoodsReceiptService,
+	payableService.NewPayableService,
+	payableService.NewPaymentRunService,
+	receivableService.NewCustomerService,
+	receivableService.NewReceivableService,
+	receivableService.NewCustomerReceiptService,
+	ppcService.NewPPCService,
+	ppcService.NewWorkOrderService,
+	ppcService.NewMRPService,
+	logisticsService.NewCarrierService,
+	logisticsService.NewShipmentService,
+	salesService.NewSalesOrderService,
+	currencyService.NewCurrencyService,
+	currencyService.NewFXRevaluationService,
+	taxService.NewTaxService,
+	assetService.NewFixedAssetService,
+	assetService.NewDepreciationRunService,
+	bankService.NewBankAccountService,
+	bankService.NewBankReconciliationService,
+	reportService.NewFinancialReportService,
+	budgetService.NewCostCenterService,
+	budgetService.NewBudgetService,
+	approvalService.NewApprovalRuleService,
+	approvalService.NewApprovalService,
+	purchasingService.NewRequisitionApprovalListener,
+	auditService.NewAuditLogService,
+	authService.NewTwoFactorService,
+	authService.NewSessionService,
+	authService.NewTokenRevocationService,
 
 	// Handler providers
 	auth.NewAuthHandler,
 	users.NewUsersHandler,
+	ledger.NewLedgerHandler,
+	period.NewPeriodHandler,
+	purchasing.NewPurchasingHandler,
+	supplier.NewSupplierHandler,
+	inventory.NewInventoryHandler,
+	receiving.NewGoodsReceiptHandler,
+	payable.NewPayableHandler,
+	payable.NewPaymentRunHandler,
+	receivable.NewCustomerHandler,
+	receivable.NewReceivableHandler,
+	receivable.NewCustomerReceiptHandler,
+	ppc.NewPPCHandler,
+	ppc.NewWorkOrderHandler,
+	ppc.NewMRPHandler,
+	logistics.NewCarrierHandler,
+	logistics.NewShipmentHandler,
+	sales.NewSalesOrderHandler,
+	currency.NewCurrencyHandler,
+	currency.NewFXRevaluationHandler,
+	tax.NewTaxHandler,
+	asset.NewFixedAssetHandler,
+	asset.NewDepreciationRunHandler,
+	bank.NewBankAccountHandler,
+	bank.NewBankReconciliationHandler,
+	report.NewFinancialReportHandler,
+	budget.NewCostCenterHandler,
+	budget.NewBudgetHandler,
+	approval.NewApprovalRuleHandler,
+	approval.NewApprovalHandler,
+	audit.NewAuditLogHandler,
+	auth.NewTwoFactorHandler,
+	auth.NewSessionHandler,
 
 	// Validator provider
 	ProvideValidator,
+
+	// Approval listener provider
+	ProvideApprovalListeners,
 )
 
-// ProvideValidator menyediakan instance validator
+// ProvideValidator menyediakan instance validator. Nominal decimal.Decimal divalidasi sebagai
+// angka sehingga tag seperti gt=0 dan gte=0 tetap berlaku.
 func ProvideValidator() *validator.Validate {
-	return validator.New()
+	validate := validator.New()
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		if amount, ok := field.Interface().(decimal.Decimal); ok {
+			return amount.InexactFloat64()
+		}
+		return nil
+	}, decimal.Decimal{})
+	return validate
+}
+
+// ProvideApprovalListeners mengumpulkan modul yang menerima hasil akhir workflow approval
+func ProvideApprovalListeners(requisitionListener *purchasingService.RequisitionApprovalListener) []approvalService.ApprovalListener {
+	return []approvalService.ApprovalListener{requisitionListener}
 }
 
 // InitializeAuthHandler menginisialisasi auth handler dengan semua dependensinya
@@ -52,3 +246,207 @@ func InitializeUsersHandler(db *gorm.DB) (users.UsersHandler, error) {
 	wire.Build(ProviderSet)
 	return &users.UsersHandlerImpl{}, nil
 }
+
+// InitializeLedgerHandler menginisialisasi ledger handler dengan semua dependensinya
+func InitializeLedgerHandler(db *gorm.DB) (ledger.LedgerHandler, error) {
+	wire.Build(ProviderSet)
+	return &ledger.LedgerHandlerImpl{}, nil
+}
+
+// InitializePeriodHandler menginisialisasi period handler dengan semua dependensinya
+func InitializePeriodHandler(db *gorm.DB) (period.PeriodHandler, error) {
+	wire.Build(ProviderSet)
+	return &period.PeriodHandlerImpl{}, nil
+}
+
+// InitializePurchasingHandler menginisialisasi purchasing handler dengan semua dependensinya
+func InitializePurchasingHandler(db *gorm.DB) (purchasing.PurchasingHandler, error) {
+	wire.Build(ProviderSet)
+	return &purchasing.PurchasingHandlerImpl{}, nil
+}
+
+// InitializeSupplierHandler menginisialisasi supplier handler dengan semua dependensinya
+func InitializeSupplierHandler(db *gorm.DB) (supplier.SupplierHandler, error) {
+	wire.Build(ProviderSet)
+	return &supplier.SupplierHandlerImpl{}, nil
+}
+
+// InitializeInventoryHandler menginisialisasi inventory handler dengan semua dependensinya
+func InitializeInventoryHandler(db *gorm.DB) (inventory.InventoryHandler, error) {
+	wire.Build(ProviderSet)
+	return &inventory.InventoryHandlerImpl{}, nil
+}
+
+// InitializeGoodsReceiptHandler menginisialisasi goods receipt handler dengan semua dependensinya
+func InitializeGoodsReceiptHandler(db *gorm.DB) (receiving.GoodsReceiptHandler, error) {
+	wire.Build(ProviderSet)
+	return &receiving.GoodsReceiptHandlerImpl{}, nil
+}
+
+// InitializePayableHandler menginisialisasi payable handler dengan semua dependensinya
+func InitializePayableHandler(db *gorm.DB) (payable.PayableHandler, error) {
+	wire.Build(ProviderSet)
+	return &payable.PayableHandlerImpl{}, nil
+}
+
+// InitializePaymentRunHandler menginisialisasi payment run handler dengan semua dependensinya
+func InitializePaymentRunHandler(db *gorm.DB) (payable.PaymentRunHandler, error) {
+	wire.Build(ProviderSet)
+	return &payable.PaymentRunHandlerImpl{}, nil
+}
+
+// InitializeCustomerHandler menginisialisasi customer handler dengan semua dependensinya
+func InitializeCustomerHandler(db *gorm.DB) (receivable.CustomerHandler, error) {
+	wire.Build(ProviderSet)
+	return &receivable.CustomerHandlerImpl{}, nil
+}
+
+// InitializeReceivableHandler menginisialisasi receivable handler dengan semua dependensinya
+func InitializeReceivableHandler(db *gorm.DB) (receivable.ReceivableHandler, error) {
+	wire.Build(ProviderSet)
+	return &receivable.ReceivableHandlerImpl{}, nil
+}
+
+// InitializeCustomerReceiptHandler menginisialisasi customer receipt handler dengan semua dependensinya
+func InitializeCustomerReceiptHandler(db *gorm.DB) (receivable.CustomerReceiptHandler, error) {
+	wire.Build(ProviderSet)
+	return &receivable.CustomerReceiptHandlerImpl{}, nil
+}
+
+// InitializePPCHandler menginisialisasi PPC handler dengan semua dependensinya
+func InitializePPCHandler(db *gorm.DB) (ppc.PPCHandler, error) {
+	wire.Build(ProviderSet)
+	return &ppc.PPCHandlerImpl{}, nil
+}
+
+// InitializeWorkOrderHandler menginisialisasi work order handler dengan semua dependensinya
+func InitializeWorkOrderHandler(db *gorm.DB) (ppc.WorkOrderHandler, error) {
+	wire.Build(ProviderSet)
+	return &ppc.WorkOrderHandlerImpl{}, nil
+}
+
+// InitializeMRPHandler menginisialisasi MRP handler dengan semua dependensinya
+func InitializeMRPHandler(db *gorm.DB) (ppc.MRPHandler, error) {
+	wire.Build(ProviderSet)
+	return &ppc.MRPHandlerImpl{}, nil
+}
+
+// InitializeCarrierHandler menginisialisasi carrier handler dengan semua dependensinya
+func InitializeCarrierHandler(db *gorm.DB) (logistics.CarrierHandler, error) {
+	wire.Build(ProviderSet)
+	return &logistics.CarrierHandlerImpl{}, nil
+}
+
+// InitializeShipmentHandler menginisialisasi shipment handler dengan semua dependensinya
+func InitializeShipmentHandler(db *gorm.DB) (logistics.ShipmentHandler, error) {
+	wire.Build(ProviderSet)
+	return &logistics.ShipmentHandlerImpl{}, nil
+}
+
+// InitializeSalesOrderHandler menginisialisasi sales order handler dengan semua dependensinya
+func InitializeSalesOrderHandler(db *gorm.DB) (sales.SalesOrderHandler, error) {
+	wire.Build(ProviderSet)
+	return &sales.SalesOrderHandlerImpl{}, nil
+}
+
+// InitializeCurrencyHandler menginisialisasi currency handler dengan semua dependensinya
+func InitializeCurrencyHandler(db *gorm.DB) (currency.CurrencyHandler, error) {
+	wire.Build(ProviderSet)
+	return &currency.CurrencyHandlerImpl{}, nil
+}
+
+// InitializeFXRevaluationHandler menginisialisasi FX revaluation handler dengan semua dependensinya
+func InitializeFXRevaluationHandler(db *gorm.DB) (currency.FXRevaluationHandler, error) {
+	wire.Build(ProviderSet)
+	return &currency.FXRevaluationHandlerImpl{}, nil
+}
+
+// InitializeTaxHandler menginisialisasi tax handler dengan semua dependensinya
+func InitializeTaxHandler(db *gorm.DB) (tax.TaxHandler, error) {
+	wire.Build(ProviderSet)
+	return &tax.TaxHandlerImpl{}, nil
+}
+
+// InitializeFixedAssetHandler menginisialisasi fixed asset handler dengan semua dependensinya
+func InitializeFixedAssetHandler(db *gorm.DB) (asset.FixedAssetHandler, error) {
+	wire.Build(ProviderSet)
+	return &asset.FixedAssetHandlerImpl{}, nil
+}
+
+// InitializeDepreciationRunHandler menginisialisasi depreciation run handler dengan semua dependensinya
+func InitializeDepreciationRunHandler(db *gorm.DB) (asset.DepreciationRunHandler, error) {
+	wire.Build(ProviderSet)
+	return &asset.DepreciationRunHandlerImpl{}, nil
+}
+
+// InitializeBankAccountHandler menginisialisasi bank account handler dengan semua dependensinya
+func InitializeBankAccountHandler(db *gorm.DB) (bank.BankAccountHandler, error) {
+	wire.Build(ProviderSet)
+	return &bank.BankAccountHandlerImpl{}, nil
+}
+
+// InitializeBankReconciliationHandler menginisialisasi bank reconciliation handler dengan semua dependensinya
+func InitializeBankReconciliationHandler(db *gorm.DB) (bank.BankReconciliationHandler, error) {
+	wire.Build(ProviderSet)
+	return &bank.BankReconciliationHandlerImpl{}, nil
+}
+
+// InitializeFinancialReportHandler menginisialisasi financial report handler dengan semua dependensinya
+func InitializeFinancialReportHandler(db *gorm.DB) (report.FinancialReportHandler, error) {
+	wire.Build(ProviderSet)
+	return &report.FinancialReportHandlerImpl{}, nil
+}
+
+// InitializeCostCenterHandler menginisialisasi cost center handler dengan semua dependensinya
+func InitializeCostCenterHandler(db *gorm.DB) (budget.CostCenterHandler, error) {
+	wire.Build(ProviderSet)
+	return &budget.CostCenterHandlerImpl{}, nil
+}
+
+// InitializeBudgetHandler menginisialisasi budget handler dengan semua dependensinya
+func InitializeBudgetHandler(db *gorm.DB) (budget.BudgetHandler, error) {
+	wire.Build(ProviderSet)
+	return &budget.BudgetHandlerImpl{}, nil
+}
+
+// InitializeApprovalRuleHandler menginisialisasi approval rule handler dengan semua dependensinya
+func InitializeApprovalRuleHandler(db *gorm.DB) (approval.ApprovalRuleHandler, error) {
+	wire.Build(ProviderSet)
+	return &approval.ApprovalRuleHandlerImpl{}, nil
+}
+
+// InitializeApprovalHandler menginisialisasi approval handler dengan semua dependensinya
+func InitializeApprovalHandler(db *gorm.DB) (approval.ApprovalHandler, error) {
+	wire.Build(ProviderSet)
+	return &approval.ApprovalHandlerImpl{}, nil
+}
+
+// InitializeAuditLogHandler menginisialisasi audit log handler dengan semua dependensinya
+func InitializeAuditLogHandler(db *gorm.DB) (audit.AuditLogHandler, error) {
+	wire.Build(ProviderSet)
+	return &audit.AuditLogHandlerImpl{}, nil
+}
+
+// InitializeTwoFactorHandler menginisialisasi two-factor handler dengan semua dependensinya
+func InitializeTwoFactorHandler(db *gorm.DB) (auth.TwoFactorHandler, error) {
+	wire.Build(ProviderSet)
+	return &auth.TwoFactorHandlerImpl{}, nil
+}
+
+// InitializeSessionHandler menginisialisasi session handler dengan semua dependensinya
+func InitializeSessionHandler(db *gorm.DB) (auth.SessionHandler, error) {
+	wire.Build(ProviderSet)
+	return &auth.SessionHandlerImpl{}, nil
+}
+
+// InitializeTokenRevocationService menginisialisasi pemeriksa pencabutan token untuk AuthMiddleware
+func InitializeTokenRevocationService(db *gorm.DB) (authService.TokenRevocationService, error) {
+	wire.Build(ProviderSet)
+	return &authService.TokenRevocationServiceImpl{}, nil
+}
+
+// InitializeMRPService menginisialisasi MRP service untuk membereskan run yang terputus saat start
+func InitializeMRPService(db *gorm.DB) (ppcService.MRPService, error) {
+	wire.Build(ProviderSet)
+	return &ppcService.MRPServiceImpl{}, nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"erpfinance/internal/helper"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// InitJWTKeys memuat kunci penandatangan JWT dari JWT_KEYS_DIR (relatif terhadap root backend,
+// default "keys"). Setiap file <kid>.pem berisi private key RSA minimal 2048 bit (RS256) atau
+// Ed25519 (EdDSA), atau hanya public key untuk kunci lama yang tinggal dipakai verifikasi.
+// JWT_ACTIVE_KID menentukan kunci untuk menandatangani token baru.
+//
+// Prosedur rotasi kunci:
+//  1. Buat kunci baru, mis. `openssl genpkey -algorithm ed25519 -out keys/2026-11.pem`
+//  2. Deploy dengan file kunci baru tanpa mengubah JWT_ACTIVE_KID, sehingga service lain sempat
+//     mengambil kunci baru dari /.well-known/jwks.json sebelum dipakai
+//  3. Ubah JWT_ACTIVE_KID ke kid baru lalu restart; token lama tetap lolos verifikasi dengan kunci lama
+//  4. Setelah masa berlaku refresh token (7 hari) lewat, hapus file kunci lama
+//
+// Tanpa file kunci sama sekali, server membuat kunci Ed25519 sementara (khusus development) sehingga
+// semua token tidak berlaku lagi setelah restart.
+func InitJWTKeys(projectRoot string) {
+	keysDir := os.Getenv("JWT_KEYS_DIR")
+	if keysDir == "" {
+		keysDir = "keys"
+	}
+	if !filepath.IsAbs(keysDir) {
+		keysDir = filepath.Join(projectRoot, keysDir)
+	}
+
+	keys, err := loadJWTKeys(keysDir)
+	helper.PanicIfError(err)
+
+	activeKeyID := os.Getenv("JWT_ACTIVE_KID")
+	if len(keys) == 0 {
+		log.Printf("Peringatan: tidak ada kunci JWT di %s, memakai kunci sementara. Token tidak berlaku lagi setelah restart.", keysDir)
+		key, err := helper.GenerateEphemeralJWTKey()
+		helper.PanicIfError(err)
+		keys = append(keys, key)
+		activeKeyID = key.KeyID
+	}
+
+	keySet, err := helper.NewJWTKeySet(keys, activeKeyID)
+	helper.PanicIfError(err)
+	helper.SetJWTKeySet(keySet)
+}
+
+// loadJWTKeys membaca semua file .pem di dir; folder yang tidak ada dianggap kosong
+func loadJWTKeys(dir string) ([]*helper.JWTKey, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT keys directory: %w", err)
+	}
+
+	var keys []*helper.JWTKey
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		key, err := helper.ParseJWTKeyPEM(strings.TrimSuffix(entry.Name(), ".pem"), data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"erpfinance/internal/helper"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// InitSecretEncryptionKey memuat kunci AES-256 untuk mengenkripsi secret di database (seed TOTP)
+// dari SECRET_ENCRYPTION_KEY_FILE (relatif terhadap root backend, default "keys/secret.key").
+// File berisi 32 byte acak dalam base64, mis. hasil `openssl rand -base64 32`.
+//
+// Bila file belum ada, kunci baru dibuat dan disimpan di lokasi tersebut. Simpan dan backup file
+// ini terpisah dari database: tanpa kunci yang sama, semua user harus mendaftarkan ulang 2FA.
+func InitSecretEncryptionKey(projectRoot string) {
+	keyFile := os.Getenv("SECRET_ENCRYPTION_KEY_FILE")
+	if keyFile == "" {
+		keyFile = filepath.Join("keys", "secret.key")
+	}
+	if !filepath.IsAbs(keyFile) {
+		keyFile = filepath.Join(projectRoot, keyFile)
+	}
+
+	key, err := loadSecretEncryptionKey(keyFile)
+	if os.IsNotExist(err) {
+		log.Printf("Peringatan: kunci enkripsi secret tidak ditemukan, membuat kunci baru di %s", keyFile)
+		key, err = createSecretEncryptionKey(keyFile)
+	}
+	helper.PanicIfError(err)
+	helper.PanicIfError(helper.SetSecretEncryptionKey(key))
+}
+
+func loadSecretEncryptionKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("secret encryption key %s is not valid base64", path)
+	}
+	return key, nil
+}
+
+func createSecretEncryptionKey(path string) ([]byte, error) {
+	key := make([]byte, helper.SecretEncryptionKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+	if err := os.WriteFile(path, []byte(encoded), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write secret encryption key: %w", err)
+	}
+	return key, nil
+}
@@ -7,16 +7,76 @@
 package config
 
 import (
+	approval3 "erpfinance/internal/handler/approval"
+	"erpfinance/internal/handler/asset"
+	"erpfinance/internal/handler/audit"
 	"erpfinance/internal/handler/auth"
+	"erpfinance/internal/handler/bank"
+	budget2 "erpfinance/internal/handler/budget"
+	currency3 "erpfinance/internal/handler/currency"
+	inventory3 "erpfinance/internal/handler/inventory"
+	"erpfinance/internal/handler/ledger"
+	"erpfinance/internal/handler/logistics"
+	"erpfinance/internal/handler/payable"
+	period3 "erpfinance/internal/handler/period"
+	"erpfinance/internal/handler/ppc"
+	"erpfinance/internal/handler/purchasing"
+	"erpfinance/internal/handler/receivable"
+	receiving3 "erpfinance/internal/handler/receiving"
+	"erpfinance/internal/handler/report"
+	sales3 "erpfinance/internal/handler/sales"
+	supplier3 "erpfinance/internal/handler/supplier"
+	tax3 "erpfinance/internal/handler/tax"
 	"erpfinance/internal/handler/users"
+	"erpfinance/internal/repository/approval"
+	asset2 "erpfinance/internal/repository/asset"
+	audit2 "erpfinance/internal/repository/audit"
 	auth2 "erpfinance/internal/repository/auth"
+	bank2 "erpfinance/internal/repository/bank"
+	"erpfinance/internal/repository/budget"
+	"erpfinance/internal/repository/currency"
+	"erpfinance/internal/repository/inventory"
+	ledger2 "erpfinance/internal/repository/ledger"
+	logistics2 "erpfinance/internal/repository/logistics"
+	payable2 "erpfinance/internal/repository/payable"
+	"erpfinance/internal/repository/period"
+	ppc2 "erpfinance/internal/repository/ppc"
+	purchasing2 "erpfinance/internal/repository/purchasing"
+	receivable2 "erpfinance/internal/repository/receivable"
+	"erpfinance/internal/repository/receiving"
+	report2 "erpfinance/internal/repository/report"
+	"erpfinance/internal/repository/sales"
+	"erpfinance/internal/repository/sequence"
+	"erpfinance/internal/repository/supplier"
+	"erpfinance/internal/repository/tax"
 	"erpfinance/internal/repository/token"
 	users2 "erpfinance/internal/repository/users"
+	approval2 "erpfinance/internal/service/approval"
+	asset3 "erpfinance/internal/service/asset"
+	audit3 "erpfinance/internal/service/audit"
 	auth3 "erpfinance/internal/service/auth"
+	bank3 "erpfinance/internal/service/bank"
+	budget3 "erpfinance/internal/service/budget"
+	currency2 "erpfinance/internal/service/currency"
+	inventory2 "erpfinance/internal/service/inventory"
+	ledger3 "erpfinance/internal/service/ledger"
+	logistics3 "erpfinance/internal/service/logistics"
+	payable3 "erpfinance/internal/service/payable"
+	period2 "erpfinance/internal/service/period"
+	ppc3 "erpfinance/internal/service/ppc"
+	purchasing3 "erpfinance/internal/service/purchasing"
+	receivable3 "erpfinance/internal/service/receivable"
+	receiving2 "erpfinance/internal/service/receiving"
+	report3 "erpfinance/internal/service/report"
+	sales2 "erpfinance/internal/service/sales"
+	supplier2 "erpfinance/internal/service/supplier"
+	tax2 "erpfinance/internal/service/tax"
 	users3 "erpfinance/internal/service/users"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/wire"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"reflect"
 )
 
 // Injectors from injector.go:
@@ -25,8 +85,12 @@ import (
 func InitializeAuthHandler(db *gorm.DB) (auth.AuthHandler, error) {
 	authRepository := auth2.NewAuthRepository()
 	tokenRepository := token.NewTokenRepository()
+	twoFactorRepository := auth2.NewTwoFactorRepository()
+	securityEventRepository := auth2.NewSecurityEventRepository()
+	revokedTokenRepository := token.NewRevokedTokenRepository()
+	tokenRevocationService := auth3.NewTokenRevocationService(revokedTokenRepository, db)
 	validate := ProvideValidator()
-	authService := auth3.NewAuthService(authRepository, tokenRepository, db, validate)
+	authService := auth3.NewAuthService(authRepository, tokenRepository, twoFactorRepository, securityEventRepository, tokenRevocationService, db, validate)
 	authHandler := auth.NewAuthHandler(authService)
 	return authHandler, nil
 }
@@ -34,18 +98,595 @@ func InitializeAuthHandler(db *gorm.DB) (auth.AuthHandler, error) {
 // InitializeUsersHandler menginisialisasi users handler dengan semua dependensinya
 func InitializeUsersHandler(db *gorm.DB) (users.UsersHandler, error) {
 	usersRepository := users2.NewUsersRepository()
+	tokenRepository := token.NewTokenRepository()
+	revokedTokenRepository := token.NewRevokedTokenRepository()
+	tokenRevocationService := auth3.NewTokenRevocationService(revokedTokenRepository, db)
 	validate := ProvideValidator()
-	usersService := users3.NewUsersService(usersRepository, db, validate)
+	usersService := users3.NewUsersService(usersRepository, tokenRepository, tokenRevocationService, db, validate)
 	usersHandler := users.NewUsersHandler(usersService)
 	return usersHandler, nil
 }
 
+// InitializeLedgerHandler menginisialisasi ledger handler dengan semua dependensinya
+func InitializeLedgerHandler(db *gorm.DB) (ledger.LedgerHandler, error) {
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	ledgerHandler := ledger.NewLedgerHandler(ledgerService)
+	return ledgerHandler, nil
+}
+
+// InitializePeriodHandler menginisialisasi period handler dengan semua dependensinya
+func InitializePeriodHandler(db *gorm.DB) (period3.PeriodHandler, error) {
+	periodRepository := period.NewPeriodRepository()
+	validate := ProvideValidator()
+	periodService := period2.NewPeriodService(periodRepository, db, validate)
+	periodHandler := period3.NewPeriodHandler(periodService)
+	return periodHandler, nil
+}
+
+// InitializePurchasingHandler menginisialisasi purchasing handler dengan semua dependensinya
+func InitializePurchasingHandler(db *gorm.DB) (purchasing.PurchasingHandler, error) {
+	requisitionRepository := purchasing2.NewRequisitionRepository()
+	purchaseOrderRepository := purchasing2.NewPurchaseOrderRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	supplierCheckService := supplier2.NewSupplierCheckService(supplierRepository)
+	itemRepository := inventory.NewItemRepository()
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	approvalRequestRepository := approval.NewApprovalRequestRepository()
+	approvalDelegationRepository := approval.NewApprovalDelegationRepository()
+	usersRepository := users2.NewUsersRepository()
+	requisitionApprovalListener := purchasing3.NewRequisitionApprovalListener(requisitionRepository)
+	v := ProvideApprovalListeners(requisitionApprovalListener)
+	validate := ProvideValidator()
+	approvalService := approval2.NewApprovalService(approvalRuleRepository, approvalRequestRepository, approvalDelegationRepository, usersRepository, v, db, validate)
+	purchasingService := purchasing3.NewPurchasingService(requisitionRepository, purchaseOrderRepository, sequenceRepository, supplierCheckService, itemRepository, approvalService, db, validate)
+	goodsReceiptRepository := receiving.NewGoodsReceiptRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	goodsReceiptService := receiving2.NewGoodsReceiptService(goodsReceiptRepository, warehouseRepository, sequenceRepository, purchasingService, inventoryService, db, validate)
+	purchasingHandler := purchasing.NewPurchasingHandler(purchasingService, goodsReceiptService)
+	return purchasingHandler, nil
+}
+
+// InitializeSupplierHandler menginisialisasi supplier handler dengan semua dependensinya
+func InitializeSupplierHandler(db *gorm.DB) (supplier3.SupplierHandler, error) {
+	supplierRepository := supplier.NewSupplierRepository()
+	validate := ProvideValidator()
+	supplierService := supplier2.NewSupplierService(supplierRepository, db, validate)
+	supplierHandler := supplier3.NewSupplierHandler(supplierService)
+	return supplierHandler, nil
+}
+
+// InitializeInventoryHandler menginisialisasi inventory handler dengan semua dependensinya
+func InitializeInventoryHandler(db *gorm.DB) (inventory3.InventoryHandler, error) {
+	itemRepository := inventory.NewItemRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	validate := ProvideValidator()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	inventoryHandler := inventory3.NewInventoryHandler(inventoryService)
+	return inventoryHandler, nil
+}
+
+// InitializeGoodsReceiptHandler menginisialisasi goods receipt handler dengan semua dependensinya
+func InitializeGoodsReceiptHandler(db *gorm.DB) (receiving3.GoodsReceiptHandler, error) {
+	goodsReceiptRepository := receiving.NewGoodsReceiptRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	requisitionRepository := purchasing2.NewRequisitionRepository()
+	purchaseOrderRepository := purchasing2.NewPurchaseOrderRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	supplierCheckService := supplier2.NewSupplierCheckService(supplierRepository)
+	itemRepository := inventory.NewItemRepository()
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	approvalRequestRepository := approval.NewApprovalRequestRepository()
+	approvalDelegationRepository := approval.NewApprovalDelegationRepository()
+	usersRepository := users2.NewUsersRepository()
+	requisitionApprovalListener := purchasing3.NewRequisitionApprovalListener(requisitionRepository)
+	v := ProvideApprovalListeners(requisitionApprovalListener)
+	validate := ProvideValidator()
+	approvalService := approval2.NewApprovalService(approvalRuleRepository, approvalRequestRepository, approvalDelegationRepository, usersRepository, v, db, validate)
+	purchasingService := purchasing3.NewPurchasingService(requisitionRepository, purchaseOrderRepository, sequenceRepository, supplierCheckService, itemRepository, approvalService, db, validate)
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	goodsReceiptService := receiving2.NewGoodsReceiptService(goodsReceiptRepository, warehouseRepository, sequenceRepository, purchasingService, inventoryService, db, validate)
+	goodsReceiptHandler := receiving3.NewGoodsReceiptHandler(goodsReceiptService)
+	return goodsReceiptHandler, nil
+}
+
+// InitializePayableHandler menginisialisasi payable handler dengan semua dependensinya
+func InitializePayableHandler(db *gorm.DB) (payable.PayableHandler, error) {
+	supplierInvoiceRepository := payable2.NewSupplierInvoiceRepository()
+	matchToleranceRepository := payable2.NewMatchToleranceRepository()
+	payableSettingRepository := payable2.NewPayableSettingRepository()
+	purchaseOrderRepository := purchasing2.NewPurchaseOrderRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	taxCodeRepository := tax.NewTaxCodeRepository()
+	taxInvoiceRangeRepository := tax.NewTaxInvoiceRangeRepository()
+	taxReportRepository := tax.NewTaxReportRepository()
+	itemRepository := inventory.NewItemRepository()
+	taxService := tax2.NewTaxService(taxCodeRepository, taxInvoiceRangeRepository, taxReportRepository, itemRepository, ledgerService, db, validate)
+	payableService := payable3.NewPayableService(supplierInvoiceRepository, matchToleranceRepository, payableSettingRepository, purchaseOrderRepository, supplierRepository, sequenceRepository, currencyService, ledgerService, taxService, db, validate)
+	payableHandler := payable.NewPayableHandler(payableService)
+	return payableHandler, nil
+}
+
+// InitializePaymentRunHandler menginisialisasi payment run handler dengan semua dependensinya
+func InitializePaymentRunHandler(db *gorm.DB) (payable.PaymentRunHandler, error) {
+	paymentRunRepository := payable2.NewPaymentRunRepository()
+	supplierInvoiceRepository := payable2.NewSupplierInvoiceRepository()
+	payableSettingRepository := payable2.NewPayableSettingRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	paymentRunService := payable3.NewPaymentRunService(paymentRunRepository, supplierInvoiceRepository, payableSettingRepository, supplierRepository, sequenceRepository, currencyService, ledgerService, db, validate)
+	paymentRunHandler := payable.NewPaymentRunHandler(paymentRunService)
+	return paymentRunHandler, nil
+}
+
+// InitializeCustomerHandler menginisialisasi customer handler dengan semua dependensinya
+func InitializeCustomerHandler(db *gorm.DB) (receivable.CustomerHandler, error) {
+	customerRepository := receivable2.NewCustomerRepository()
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	validate := ProvideValidator()
+	customerService := receivable3.NewCustomerService(customerRepository, salesInvoiceRepository, customerReceiptRepository, db, validate)
+	customerHandler := receivable.NewCustomerHandler(customerService)
+	return customerHandler, nil
+}
+
+// InitializeReceivableHandler menginisialisasi receivable handler dengan semua dependensinya
+func InitializeReceivableHandler(db *gorm.DB) (receivable.ReceivableHandler, error) {
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	customerRepository := receivable2.NewCustomerRepository()
+	receivableSettingRepository := receivable2.NewReceivableSettingRepository()
+	itemRepository := inventory.NewItemRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	taxCodeRepository := tax.NewTaxCodeRepository()
+	taxInvoiceRangeRepository := tax.NewTaxInvoiceRangeRepository()
+	taxReportRepository := tax.NewTaxReportRepository()
+	taxService := tax2.NewTaxService(taxCodeRepository, taxInvoiceRangeRepository, taxReportRepository, itemRepository, ledgerService, db, validate)
+	receivableService := receivable3.NewReceivableService(salesInvoiceRepository, customerReceiptRepository, customerRepository, receivableSettingRepository, itemRepository, sequenceRepository, currencyService, ledgerService, taxService, db, validate)
+	receivableHandler := receivable.NewReceivableHandler(receivableService)
+	return receivableHandler, nil
+}
+
+// InitializeCustomerReceiptHandler menginisialisasi customer receipt handler dengan semua dependensinya
+func InitializeCustomerReceiptHandler(db *gorm.DB) (receivable.CustomerReceiptHandler, error) {
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerRepository := receivable2.NewCustomerRepository()
+	receivableSettingRepository := receivable2.NewReceivableSettingRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	customerReceiptService := receivable3.NewCustomerReceiptService(customerReceiptRepository, salesInvoiceRepository, customerRepository, receivableSettingRepository, sequenceRepository, currencyService, ledgerService, db, validate)
+	customerReceiptHandler := receivable.NewCustomerReceiptHandler(customerReceiptService)
+	return customerReceiptHandler, nil
+}
+
+// InitializePPCHandler menginisialisasi PPC handler dengan semua dependensinya
+func InitializePPCHandler(db *gorm.DB) (ppc.PPCHandler, error) {
+	workCenterRepository := ppc2.NewWorkCenterRepository()
+	billOfMaterialRepository := ppc2.NewBillOfMaterialRepository()
+	routingRepository := ppc2.NewRoutingRepository()
+	itemRepository := inventory.NewItemRepository()
+	validate := ProvideValidator()
+	ppcService := ppc3.NewPPCService(workCenterRepository, billOfMaterialRepository, routingRepository, itemRepository, db, validate)
+	ppcHandler := ppc.NewPPCHandler(ppcService)
+	return ppcHandler, nil
+}
+
+// InitializeWorkOrderHandler menginisialisasi work order handler dengan semua dependensinya
+func InitializeWorkOrderHandler(db *gorm.DB) (ppc.WorkOrderHandler, error) {
+	workOrderRepository := ppc2.NewWorkOrderRepository()
+	billOfMaterialRepository := ppc2.NewBillOfMaterialRepository()
+	routingRepository := ppc2.NewRoutingRepository()
+	itemRepository := inventory.NewItemRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	validate := ProvideValidator()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	workOrderService := ppc3.NewWorkOrderService(workOrderRepository, billOfMaterialRepository, routingRepository, itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, inventoryService, db, validate)
+	workOrderHandler := ppc.NewWorkOrderHandler(workOrderService)
+	return workOrderHandler, nil
+}
+
+// InitializeMRPHandler menginisialisasi MRP handler dengan semua dependensinya
+func InitializeMRPHandler(db *gorm.DB) (ppc.MRPHandler, error) {
+	mrpRunRepository := ppc2.NewMRPRunRepository()
+	billOfMaterialRepository := ppc2.NewBillOfMaterialRepository()
+	workOrderRepository := ppc2.NewWorkOrderRepository()
+	itemRepository := inventory.NewItemRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	requisitionRepository := purchasing2.NewRequisitionRepository()
+	purchaseOrderRepository := purchasing2.NewPurchaseOrderRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	supplierCheckService := supplier2.NewSupplierCheckService(supplierRepository)
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	approvalRequestRepository := approval.NewApprovalRequestRepository()
+	approvalDelegationRepository := approval.NewApprovalDelegationRepository()
+	usersRepository := users2.NewUsersRepository()
+	requisitionApprovalListener := purchasing3.NewRequisitionApprovalListener(requisitionRepository)
+	v := ProvideApprovalListeners(requisitionApprovalListener)
+	validate := ProvideValidator()
+	approvalService := approval2.NewApprovalService(approvalRuleRepository, approvalRequestRepository, approvalDelegationRepository, usersRepository, v, db, validate)
+	purchasingService := purchasing3.NewPurchasingService(requisitionRepository, purchaseOrderRepository, sequenceRepository, supplierCheckService, itemRepository, approvalService, db, validate)
+	routingRepository := ppc2.NewRoutingRepository()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	workOrderService := ppc3.NewWorkOrderService(workOrderRepository, billOfMaterialRepository, routingRepository, itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, inventoryService, db, validate)
+	mrpService := ppc3.NewMRPService(mrpRunRepository, billOfMaterialRepository, workOrderRepository, itemRepository, warehouseRepository, stockMovementRepository, requisitionRepository, purchaseOrderRepository, sequenceRepository, purchasingService, workOrderService, db, validate)
+	mrpHandler := ppc.NewMRPHandler(mrpService)
+	return mrpHandler, nil
+}
+
+// InitializeCarrierHandler menginisialisasi carrier handler dengan semua dependensinya
+func InitializeCarrierHandler(db *gorm.DB) (logistics.CarrierHandler, error) {
+	carrierRepository := logistics2.NewCarrierRepository()
+	validate := ProvideValidator()
+	carrierService := logistics3.NewCarrierService(carrierRepository, db, validate)
+	carrierHandler := logistics.NewCarrierHandler(carrierService)
+	return carrierHandler, nil
+}
+
+// InitializeShipmentHandler menginisialisasi shipment handler dengan semua dependensinya
+func InitializeShipmentHandler(db *gorm.DB) (logistics.ShipmentHandler, error) {
+	shipmentRepository := logistics2.NewShipmentRepository()
+	carrierRepository := logistics2.NewCarrierRepository()
+	customerRepository := receivable2.NewCustomerRepository()
+	itemRepository := inventory.NewItemRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	validate := ProvideValidator()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	salesOrderRepository := sales.NewSalesOrderRepository()
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	salesOrderService := sales2.NewSalesOrderService(salesOrderRepository, customerRepository, salesInvoiceRepository, customerReceiptRepository, itemRepository, sequenceRepository, db, validate)
+	shipmentService := logistics3.NewShipmentService(shipmentRepository, carrierRepository, customerRepository, itemRepository, warehouseRepository, sequenceRepository, inventoryService, salesOrderService, db, validate)
+	shipmentHandler := logistics.NewShipmentHandler(shipmentService)
+	return shipmentHandler, nil
+}
+
+// InitializeSalesOrderHandler menginisialisasi sales order handler dengan semua dependensinya
+func InitializeSalesOrderHandler(db *gorm.DB) (sales3.SalesOrderHandler, error) {
+	salesOrderRepository := sales.NewSalesOrderRepository()
+	customerRepository := receivable2.NewCustomerRepository()
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	itemRepository := inventory.NewItemRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	validate := ProvideValidator()
+	salesOrderService := sales2.NewSalesOrderService(salesOrderRepository, customerRepository, salesInvoiceRepository, customerReceiptRepository, itemRepository, sequenceRepository, db, validate)
+	salesOrderHandler := sales3.NewSalesOrderHandler(salesOrderService)
+	return salesOrderHandler, nil
+}
+
+// InitializeCurrencyHandler menginisialisasi currency handler dengan semua dependensinya
+func InitializeCurrencyHandler(db *gorm.DB) (currency3.CurrencyHandler, error) {
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	currencyHandler := currency3.NewCurrencyHandler(currencyService)
+	return currencyHandler, nil
+}
+
+// InitializeFXRevaluationHandler menginisialisasi FX revaluation handler dengan semua dependensinya
+func InitializeFXRevaluationHandler(db *gorm.DB) (currency3.FXRevaluationHandler, error) {
+	fxRevaluationRepository := currency.NewFXRevaluationRepository()
+	currencySettingRepository := currency.NewCurrencySettingRepository()
+	salesInvoiceRepository := receivable2.NewSalesInvoiceRepository()
+	customerReceiptRepository := receivable2.NewCustomerReceiptRepository()
+	receivableSettingRepository := receivable2.NewReceivableSettingRepository()
+	supplierInvoiceRepository := payable2.NewSupplierInvoiceRepository()
+	payableSettingRepository := payable2.NewPayableSettingRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	currencyRepository := currency.NewCurrencyRepository()
+	exchangeRateRepository := currency.NewExchangeRateRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	currencyService := currency2.NewCurrencyService(currencyRepository, exchangeRateRepository, currencySettingRepository, ledgerService, db, validate)
+	fxRevaluationService := currency2.NewFXRevaluationService(fxRevaluationRepository, currencySettingRepository, salesInvoiceRepository, customerReceiptRepository, receivableSettingRepository, supplierInvoiceRepository, payableSettingRepository, sequenceRepository, currencyService, ledgerService, db, validate)
+	fxRevaluationHandler := currency3.NewFXRevaluationHandler(fxRevaluationService)
+	return fxRevaluationHandler, nil
+}
+
+// InitializeTaxHandler menginisialisasi tax handler dengan semua dependensinya
+func InitializeTaxHandler(db *gorm.DB) (tax3.TaxHandler, error) {
+	taxCodeRepository := tax.NewTaxCodeRepository()
+	taxInvoiceRangeRepository := tax.NewTaxInvoiceRangeRepository()
+	taxReportRepository := tax.NewTaxReportRepository()
+	itemRepository := inventory.NewItemRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	taxService := tax2.NewTaxService(taxCodeRepository, taxInvoiceRangeRepository, taxReportRepository, itemRepository, ledgerService, db, validate)
+	taxHandler := tax3.NewTaxHandler(taxService)
+	return taxHandler, nil
+}
+
+// InitializeFixedAssetHandler menginisialisasi fixed asset handler dengan semua dependensinya
+func InitializeFixedAssetHandler(db *gorm.DB) (asset.FixedAssetHandler, error) {
+	assetCategoryRepository := asset2.NewAssetCategoryRepository()
+	fixedAssetRepository := asset2.NewFixedAssetRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	fixedAssetService := asset3.NewFixedAssetService(assetCategoryRepository, fixedAssetRepository, sequenceRepository, ledgerService, db, validate)
+	fixedAssetHandler := asset.NewFixedAssetHandler(fixedAssetService)
+	return fixedAssetHandler, nil
+}
+
+// InitializeDepreciationRunHandler menginisialisasi depreciation run handler dengan semua dependensinya
+func InitializeDepreciationRunHandler(db *gorm.DB) (asset.DepreciationRunHandler, error) {
+	depreciationRunRepository := asset2.NewDepreciationRunRepository()
+	fixedAssetRepository := asset2.NewFixedAssetRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	depreciationRunService := asset3.NewDepreciationRunService(depreciationRunRepository, fixedAssetRepository, sequenceRepository, ledgerService, db, validate)
+	depreciationRunHandler := asset.NewDepreciationRunHandler(depreciationRunService)
+	return depreciationRunHandler, nil
+}
+
+// InitializeBankAccountHandler menginisialisasi bank account handler dengan semua dependensinya
+func InitializeBankAccountHandler(db *gorm.DB) (bank.BankAccountHandler, error) {
+	bankAccountRepository := bank2.NewBankAccountRepository()
+	bankStatementRepository := bank2.NewBankStatementRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	bankAccountService := bank3.NewBankAccountService(bankAccountRepository, bankStatementRepository, ledgerService, db, validate)
+	bankAccountHandler := bank.NewBankAccountHandler(bankAccountService)
+	return bankAccountHandler, nil
+}
+
+// InitializeBankReconciliationHandler menginisialisasi bank reconciliation handler dengan semua dependensinya
+func InitializeBankReconciliationHandler(db *gorm.DB) (bank.BankReconciliationHandler, error) {
+	bankAccountRepository := bank2.NewBankAccountRepository()
+	bankStatementRepository := bank2.NewBankStatementRepository()
+	bankBookRepository := bank2.NewBankBookRepository()
+	validate := ProvideValidator()
+	bankReconciliationService := bank3.NewBankReconciliationService(bankAccountRepository, bankStatementRepository, bankBookRepository, db, validate)
+	bankReconciliationHandler := bank.NewBankReconciliationHandler(bankReconciliationService)
+	return bankReconciliationHandler, nil
+}
+
+// InitializeFinancialReportHandler menginisialisasi financial report handler dengan semua dependensinya
+func InitializeFinancialReportHandler(db *gorm.DB) (report.FinancialReportHandler, error) {
+	financialReportRepository := report2.NewFinancialReportRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	validate := ProvideValidator()
+	financialReportService := report3.NewFinancialReportService(financialReportRepository, accountRepository, db, validate)
+	financialReportHandler := report.NewFinancialReportHandler(financialReportService)
+	return financialReportHandler, nil
+}
+
+// InitializeCostCenterHandler menginisialisasi cost center handler dengan semua dependensinya
+func InitializeCostCenterHandler(db *gorm.DB) (budget2.CostCenterHandler, error) {
+	costCenterRepository := budget.NewCostCenterRepository()
+	validate := ProvideValidator()
+	costCenterService := budget3.NewCostCenterService(costCenterRepository, db, validate)
+	costCenterHandler := budget2.NewCostCenterHandler(costCenterService)
+	return costCenterHandler, nil
+}
+
+// InitializeBudgetHandler menginisialisasi budget handler dengan semua dependensinya
+func InitializeBudgetHandler(db *gorm.DB) (budget2.BudgetHandler, error) {
+	budgetRepository := budget.NewBudgetRepository()
+	costCenterRepository := budget.NewCostCenterRepository()
+	accountRepository := ledger2.NewAccountRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	journalRepository := ledger2.NewJournalRepository()
+	periodRepository := period.NewPeriodRepository()
+	periodCheckService := period2.NewPeriodCheckService(periodRepository)
+	validate := ProvideValidator()
+	ledgerService := ledger3.NewLedgerService(accountRepository, journalRepository, costCenterRepository, sequenceRepository, periodCheckService, db, validate)
+	budgetService := budget3.NewBudgetService(budgetRepository, costCenterRepository, accountRepository, sequenceRepository, ledgerService, db, validate)
+	budgetHandler := budget2.NewBudgetHandler(budgetService)
+	return budgetHandler, nil
+}
+
+// InitializeApprovalRuleHandler menginisialisasi approval rule handler dengan semua dependensinya
+func InitializeApprovalRuleHandler(db *gorm.DB) (approval3.ApprovalRuleHandler, error) {
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	usersRepository := users2.NewUsersRepository()
+	validate := ProvideValidator()
+	approvalRuleService := approval2.NewApprovalRuleService(approvalRuleRepository, usersRepository, db, validate)
+	approvalRuleHandler := approval3.NewApprovalRuleHandler(approvalRuleService)
+	return approvalRuleHandler, nil
+}
+
+// InitializeApprovalHandler menginisialisasi approval handler dengan semua dependensinya
+func InitializeApprovalHandler(db *gorm.DB) (approval3.ApprovalHandler, error) {
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	approvalRequestRepository := approval.NewApprovalRequestRepository()
+	approvalDelegationRepository := approval.NewApprovalDelegationRepository()
+	usersRepository := users2.NewUsersRepository()
+	requisitionRepository := purchasing2.NewRequisitionRepository()
+	requisitionApprovalListener := purchasing3.NewRequisitionApprovalListener(requisitionRepository)
+	v := ProvideApprovalListeners(requisitionApprovalListener)
+	validate := ProvideValidator()
+	approvalService := approval2.NewApprovalService(approvalRuleRepository, approvalRequestRepository, approvalDelegationRepository, usersRepository, v, db, validate)
+	approvalHandler := approval3.NewApprovalHandler(approvalService)
+	return approvalHandler, nil
+}
+
+// InitializeAuditLogHandler menginisialisasi audit log handler dengan semua dependensinya
+func InitializeAuditLogHandler(db *gorm.DB) (audit.AuditLogHandler, error) {
+	auditLogRepository := audit2.NewAuditLogRepository()
+	auditLogService := audit3.NewAuditLogService(auditLogRepository, db)
+	auditLogHandler := audit.NewAuditLogHandler(auditLogService)
+	return auditLogHandler, nil
+}
+
+// InitializeTwoFactorHandler menginisialisasi two-factor handler dengan semua dependensinya
+func InitializeTwoFactorHandler(db *gorm.DB) (auth.TwoFactorHandler, error) {
+	twoFactorRepository := auth2.NewTwoFactorRepository()
+	validate := ProvideValidator()
+	twoFactorService := auth3.NewTwoFactorService(twoFactorRepository, db, validate)
+	twoFactorHandler := auth.NewTwoFactorHandler(twoFactorService)
+	return twoFactorHandler, nil
+}
+
+// InitializeSessionHandler menginisialisasi session handler dengan semua dependensinya
+func InitializeSessionHandler(db *gorm.DB) (auth.SessionHandler, error) {
+	tokenRepository := token.NewTokenRepository()
+	revokedTokenRepository := token.NewRevokedTokenRepository()
+	tokenRevocationService := auth3.NewTokenRevocationService(revokedTokenRepository, db)
+	sessionService := auth3.NewSessionService(tokenRepository, tokenRevocationService, db)
+	sessionHandler := auth.NewSessionHandler(sessionService)
+	return sessionHandler, nil
+}
+
+// InitializeTokenRevocationService menginisialisasi pemeriksa pencabutan token untuk AuthMiddleware
+func InitializeTokenRevocationService(db *gorm.DB) (auth3.TokenRevocationService, error) {
+	revokedTokenRepository := token.NewRevokedTokenRepository()
+	tokenRevocationService := auth3.NewTokenRevocationService(revokedTokenRepository, db)
+	return tokenRevocationService, nil
+}
+
+// InitializeMRPService menginisialisasi MRP service untuk membereskan run yang terputus saat start
+func InitializeMRPService(db *gorm.DB) (ppc3.MRPService, error) {
+	mrpRunRepository := ppc2.NewMRPRunRepository()
+	billOfMaterialRepository := ppc2.NewBillOfMaterialRepository()
+	workOrderRepository := ppc2.NewWorkOrderRepository()
+	itemRepository := inventory.NewItemRepository()
+	warehouseRepository := inventory.NewWarehouseRepository()
+	stockMovementRepository := inventory.NewStockMovementRepository()
+	requisitionRepository := purchasing2.NewRequisitionRepository()
+	purchaseOrderRepository := purchasing2.NewPurchaseOrderRepository()
+	sequenceRepository := sequence.NewSequenceRepository()
+	supplierRepository := supplier.NewSupplierRepository()
+	supplierCheckService := supplier2.NewSupplierCheckService(supplierRepository)
+	approvalRuleRepository := approval.NewApprovalRuleRepository()
+	approvalRequestRepository := approval.NewApprovalRequestRepository()
+	approvalDelegationRepository := approval.NewApprovalDelegationRepository()
+	usersRepository := users2.NewUsersRepository()
+	requisitionApprovalListener := purchasing3.NewRequisitionApprovalListener(requisitionRepository)
+	v := ProvideApprovalListeners(requisitionApprovalListener)
+	validate := ProvideValidator()
+	approvalService := approval2.NewApprovalService(approvalRuleRepository, approvalRequestRepository, approvalDelegationRepository, usersRepository, v, db, validate)
+	purchasingService := purchasing3.NewPurchasingService(requisitionRepository, purchaseOrderRepository, sequenceRepository, supplierCheckService, itemRepository, approvalService, db, validate)
+	routingRepository := ppc2.NewRoutingRepository()
+	inventoryService := inventory2.NewInventoryService(itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, db, validate)
+	workOrderService := ppc3.NewWorkOrderService(workOrderRepository, billOfMaterialRepository, routingRepository, itemRepository, warehouseRepository, stockMovementRepository, sequenceRepository, inventoryService, db, validate)
+	mrpService := ppc3.NewMRPService(mrpRunRepository, billOfMaterialRepository, workOrderRepository, itemRepository, warehouseRepository, stockMovementRepository, requisitionRepository, purchaseOrderRepository, sequenceRepository, purchasingService, workOrderService, db, validate)
+	return mrpService, nil
+}
+
 // injector.go:
 
 // ProviderSet adalah kumpulan provider untuk dependency injection
-var ProviderSet = wire.NewSet(auth2.NewAuthRepository, token.NewTokenRepository, users2.NewUsersRepository, auth3.NewAuthService, users3.NewUsersService, auth.NewAuthHandler, users.NewUsersHandler, ProvideValidator)
+var ProviderSet = wire.NewSet(auth2.NewAuthRepository, token.NewTokenRepository, users2.NewUsersRepository, sequence.NewSequenceRepository, ledger2.NewAccountRepository, ledger2.NewJournalRepository, period.NewPeriodRepository, purchasing2.NewRequisitionRepository, purchasing2.NewPurchaseOrderRepository, supplier.NewSupplierRepository, inventory.NewItemRepository, inventory.NewWarehouseRepository, inventory.NewStockMovementRepository, receiving.NewGoodsReceiptRepository, payable2.NewSupplierInvoiceRepository, payable2.NewMatchToleranceRepository, payable2.NewPayableSettingRepository, payable2.NewPaymentRunRepository, receivable2.NewCustomerRepository, receivable2.NewSalesInvoiceRepository, receivable2.NewCustomerReceiptRepository, receivable2.NewReceivableSettingRepository, ppc2.NewWorkCenterRepository, ppc2.NewBillOfMaterialRepository, ppc2.NewRoutingRepository, ppc2.NewWorkOrderRepository, ppc2.NewMRPRunRepository, logistics2.NewCarrierRepository, logistics2.NewShipmentRepository, sales.NewSalesOrderRepository, currency.NewCurrencyRepository, currency.NewExchangeRateRepository, currency.NewCurrencySettingRepository, currency.NewFXRevaluationRepository, tax.NewTaxCodeRepository, tax.NewTaxInvoiceRangeRepository, tax.NewTaxReportRepository, asset2.NewAssetCategoryRepository, asset2.NewFixedAssetRepository, asset2.NewDepreciationRunRepository, bank2.NewBankAccountRepository, bank2.NewBankStatementRepository, bank2.NewBankBookRepository, report2.NewFinancialReportRepository, budget.NewCostCenterRepository, budget.NewBudgetRepository, approval.NewApprovalRuleRepository, approval.NewApprovalRequestRepository, approval.NewApprovalDelegationRepository, audit2.NewAuditLogRepository, auth2.NewTwoFactorRepository, auth2.NewSecurityEventRepository, token.NewRevokedTokenRepository, auth3.NewAuthService, users3.NewUsersService, ledger3.NewLedgerService, period2.NewPeriodService, period2.NewPeriodCheckService, purchasing3.NewPurchasingService, supplier2.NewSupplierService, supplier2.NewSupplierCheckService, inventory2.NewInventoryService, receiving2.NewGoodsReceiptService, payable3.NewPayableService, payable3.NewPaymentRunService, receivable3.NewCustomerService, receivable3.NewReceivableService, receivable3.NewCustomerReceiptService, ppc3.NewPPCService, ppc3.NewWorkOrderService, ppc3.NewMRPService, logistics3.NewCarrierService, logistics3.NewShipmentService, sales2.NewSalesOrderService, currency2.NewCurrencyService, currency2.NewFXRevaluationService, tax2.NewTaxService, asset3.NewFixedAssetService, asset3.NewDepreciationRunService, bank3.NewBankAccountService, bank3.NewBankReconciliationService, report3.NewFinancialReportService, budget3.NewCostCenterService, budget3.NewBudgetService, approval2.NewApprovalRuleService, approval2.NewApprovalService, purchasing3.NewRequisitionApprovalListener, audit3.NewAuditLogService, auth3.NewTwoFactorService, auth3.NewSessionService, auth3.NewTokenRevocationService, auth.NewAuthHandler, users.NewUsersHandler, ledger.NewLedgerHandler, period3.NewPeriodHandler, purchasing.NewPurchasingHandler, supplier3.NewSupplierHandler, inventory3.NewInventoryHandler, receiving3.NewGoodsReceiptHandler, payable.NewPayableHandler, payable.NewPaymentRunHandler, receivable.NewCustomerHandler, receivable.NewReceivableHandler, receivable.NewCustomerReceiptHandler, ppc.NewPPCHandler, ppc.NewWorkOrderHandler, ppc.NewMRPHandler, logistics.NewCarrierHandler, logistics.NewShipmentHandler, sales3.NewSalesOrderHandler, currency3.NewCurrencyHandler, currency3.NewFXRevaluationHandler, tax3.NewTaxHandler, asset.NewFixedAssetHandler, asset.NewDepreciationRunHandler, bank.NewBankAccountHandler, bank.NewBankReconciliationHandler, report.NewFinancialReportHandler, budget2.NewCostCenterHandler, budget2.NewBudgetHandler, approval3.NewApprovalRuleHandler, approval3.NewApprovalHandler, audit.NewAuditLogHandler, auth.NewTwoFactorHandler, auth.NewSessionHandler, ProvideValidator,
+
+	ProvideApprovalListeners,
+)
 
-// ProvideValidator menyediakan instance validator
+// ProvideValidator menyediakan instance validator. Nominal decimal.Decimal divalidasi sebagai
+// angka sehingga tag seperti gt=0 dan gte=0 tetap berlaku.
 func ProvideValidator() *validator.Validate {
-	return validator.New()
+	validate := validator.New()
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		if amount, ok := field.Interface().(decimal.Decimal); ok {
+			return amount.InexactFloat64()
+		}
+		return nil
+	}, decimal.Decimal{})
+	return validate
+}
+
+// ProvideApprovalListeners mengumpulkan modul yang menerima hasil akhir workflow approval
+func ProvideApprovalListeners(requisitionListener *purchasing3.RequisitionApprovalListener) []approval2.ApprovalListener {
+	return []approval2.ApprovalListener{requisitionListener}
 }
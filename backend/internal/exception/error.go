@@ -11,3 +11,16 @@ func NewError(message string) *Error {
 func (e *Error) Error() string {
 	return e.Message
 }
+
+// NotFoundError dipakai ketika data yang diminta tidak ditemukan
+type NotFoundError struct {
+	Message string
+}
+
+func NewNotFoundError(message string) *NotFoundError {
+	return &NotFoundError{Message: message}
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Message
+}
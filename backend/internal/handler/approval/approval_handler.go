@@ -0,0 +1,14 @@
+package approval
+
+import "github.com/gofiber/fiber/v2"
+
+type ApprovalHandler interface {
+	Inbox(ctx *fiber.Ctx) error
+	FindAllRequests(ctx *fiber.Ctx) error
+	FindRequestById(ctx *fiber.Ctx) error
+	Approve(ctx *fiber.Ctx) error
+	Reject(ctx *fiber.Ctx) error
+	CreateDelegation(ctx *fiber.Ctx) error
+	RevokeDelegation(ctx *fiber.Ctx) error
+	FindAllDelegations(ctx *fiber.Ctx) error
+}
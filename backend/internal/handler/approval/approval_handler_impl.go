@@ -0,0 +1,297 @@
+package approval
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+	service "erpfinance/internal/service/approval"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ApprovalHandlerImpl struct {
+	ApprovalService service.ApprovalService
+}
+
+func NewApprovalHandler(approvalService service.ApprovalService) ApprovalHandler {
+	return &ApprovalHandlerImpl{
+		ApprovalService: approvalService,
+	}
+}
+
+// Inbox godoc
+// @Summary Get approval inbox
+// @Description Get pending approval tasks for the logged-in user, including tasks delegated to them
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param document_type query string false "Document type"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/approvals/inbox [get]
+func (handler *ApprovalHandlerImpl) Inbox(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter approval.ApprovalInboxFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ApprovalService.Inbox(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval inbox retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindAllRequests godoc
+// @Summary Get all approval requests with pagination
+// @Description Get approval requests with optional document type, status, document and requester filters
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param document_type query string false "Document type"
+// @Param status query string false "Status (Pending, Approved, Rejected)"
+// @Param document_id query string false "Document ID"
+// @Param mine query bool false "Only requests submitted by the logged-in user"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/approvals/requests [get]
+func (handler *ApprovalHandlerImpl) FindAllRequests(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter approval.ApprovalRequestFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ApprovalService.FindAllRequests(ctx.Context(), helper.CurrentUserID(ctx), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval requests retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindRequestById godoc
+// @Summary Get approval request by ID
+// @Description Get approval request details with its tasks and decision history
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval request ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/requests/{id} [get]
+func (handler *ApprovalHandlerImpl) FindRequestById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	approvalRequest, err := handler.ApprovalService.FindRequestById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval request retrieved successfully",
+		Data:    approvalRequest,
+	})
+}
+
+// Approve godoc
+// @Summary Approve request
+// @Description Approve the current step of an approval request as approver or delegate
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval request ID (UUID)"
+// @Param request body approval.ApprovalDecisionRequest false "Decision comment"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/requests/{id}/approve [post]
+func (handler *ApprovalHandlerImpl) Approve(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	// Body opsional: approve tanpa komentar boleh dikirim tanpa body
+	var request approval.ApprovalDecisionRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&request); err != nil {
+			return helper.BadRequestResponse(ctx, "Invalid request body format.")
+		}
+	}
+
+	approvalRequest, err := handler.ApprovalService.Approve(ctx.Context(), id, helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval request successfully approved",
+		Data:    approvalRequest,
+	})
+}
+
+// Reject godoc
+// @Summary Reject request
+// @Description Reject an approval request; remaining tasks are cancelled
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval request ID (UUID)"
+// @Param request body approval.ApprovalRejectRequest true "Reject reason"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/requests/{id}/reject [post]
+func (handler *ApprovalHandlerImpl) Reject(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request approval.ApprovalRejectRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	approvalRequest, err := handler.ApprovalService.Reject(ctx.Context(), id, helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval request successfully rejected",
+		Data:    approvalRequest,
+	})
+}
+
+// CreateDelegation godoc
+// @Summary Create approval delegation
+// @Description Delegate approval authority to another user for a date range and optional document type
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body approval.ApprovalDelegationRequest true "Delegation request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/approvals/delegations [post]
+func (handler *ApprovalHandlerImpl) CreateDelegation(ctx *fiber.Ctx) error {
+	var request approval.ApprovalDelegationRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	delegation, err := handler.ApprovalService.CreateDelegation(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Approval delegation successfully created",
+		Data:    delegation,
+	})
+}
+
+// RevokeDelegation godoc
+// @Summary Revoke approval delegation
+// @Description Revoke an active approval delegation
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval delegation ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/delegations/{id}/revoke [post]
+func (handler *ApprovalHandlerImpl) RevokeDelegation(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	delegation, err := handler.ApprovalService.RevokeDelegation(ctx.Context(), id, helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval delegation successfully revoked",
+		Data:    delegation,
+	})
+}
+
+// FindAllDelegations godoc
+// @Summary Get all approval delegations with pagination
+// @Description Get delegations given or received by the logged-in user; admin sees all delegations
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param active_only query bool false "Only active delegations"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/approvals/delegations [get]
+func (handler *ApprovalHandlerImpl) FindAllDelegations(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter approval.ApprovalDelegationFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ApprovalService.FindAllDelegations(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentUserRole(ctx), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval delegations retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
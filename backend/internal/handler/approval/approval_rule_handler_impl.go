@@ -0,0 +1,155 @@
+package approval
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+	service "erpfinance/internal/service/approval"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ApprovalRuleHandlerImpl struct {
+	ApprovalRuleService service.ApprovalRuleService
+}
+
+func NewApprovalRuleHandler(approvalRuleService service.ApprovalRuleService) ApprovalRuleHandler {
+	return &ApprovalRuleHandlerImpl{
+		ApprovalRuleService: approvalRuleService,
+	}
+}
+
+// Create godoc
+// @Summary Create approval rule
+// @Description Create an approval rule with sequential or parallel steps for a document type and amount range
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body approval.ApprovalRuleRequest true "Approval rule request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/approvals/rules [post]
+func (handler *ApprovalRuleHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request approval.ApprovalRuleRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	rule, err := handler.ApprovalRuleService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Approval rule successfully created",
+		Data:    rule,
+	})
+}
+
+// Update godoc
+// @Summary Update approval rule
+// @Description Update an approval rule and replace its steps; running requests keep their current tasks
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval rule ID (UUID)"
+// @Param request body approval.ApprovalRuleUpdateRequest true "Approval rule request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/rules/{id} [put]
+func (handler *ApprovalRuleHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request approval.ApprovalRuleUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	rule, err := handler.ApprovalRuleService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval rule successfully updated",
+		Data:    rule,
+	})
+}
+
+// FindById godoc
+// @Summary Get approval rule by ID
+// @Description Get approval rule details with its steps
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Approval rule ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/approvals/rules/{id} [get]
+func (handler *ApprovalRuleHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	rule, err := handler.ApprovalRuleService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval rule retrieved successfully",
+		Data:    rule,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all approval rules with pagination
+// @Description Get approval rules with optional document type, active and search filters
+// @Tags approvals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param document_type query string false "Document type"
+// @Param active_only query bool false "Only active rules"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/approvals/rules [get]
+func (handler *ApprovalRuleHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter approval.ApprovalRuleFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ApprovalRuleService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Approval rules retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package asset
+
+import "github.com/gofiber/fiber/v2"
+
+type DepreciationRunHandler interface {
+	Run(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+}
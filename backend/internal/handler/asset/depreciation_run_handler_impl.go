@@ -0,0 +1,118 @@
+package asset
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+	service "erpfinance/internal/service/asset"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DepreciationRunHandlerImpl struct {
+	DepreciationRunService service.DepreciationRunService
+}
+
+func NewDepreciationRunHandler(depreciationRunService service.DepreciationRunService) DepreciationRunHandler {
+	return &DepreciationRunHandlerImpl{
+		DepreciationRunService: depreciationRunService,
+	}
+}
+
+// Run godoc
+// @Summary Run monthly depreciation
+// @Description Depreciate all active fixed assets through the month end, catching up missed months, and post one journal entry
+// @Tags depreciation-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body asset.DepreciationRunRequest true "Depreciation run request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/depreciation-runs [post]
+func (handler *DepreciationRunHandlerImpl) Run(ctx *fiber.Ctx) error {
+	var request asset.DepreciationRunRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	run, err := handler.DepreciationRunService.Run(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Depreciation successfully posted",
+		Data:    run,
+	})
+}
+
+// FindById godoc
+// @Summary Get depreciation run by ID
+// @Description Get depreciation run with its asset lines
+// @Tags depreciation-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Depreciation run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/depreciation-runs/{id} [get]
+func (handler *DepreciationRunHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	run, err := handler.DepreciationRunService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Depreciation run retrieved successfully",
+		Data:    run,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all depreciation runs with pagination
+// @Description Get depreciation runs with optional period range
+// @Tags depreciation-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/depreciation-runs [get]
+func (handler *DepreciationRunHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter asset.DepreciationRunFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.DepreciationRunService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Depreciation runs retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
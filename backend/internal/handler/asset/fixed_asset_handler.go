@@ -0,0 +1,18 @@
+package asset
+
+import "github.com/gofiber/fiber/v2"
+
+type FixedAssetHandler interface {
+	CreateCategory(ctx *fiber.Ctx) error
+	UpdateCategory(ctx *fiber.Ctx) error
+	FindCategoryById(ctx *fiber.Ctx) error
+	FindAllCategories(ctx *fiber.Ctx) error
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Schedule(ctx *fiber.Ctx) error
+	Activate(ctx *fiber.Ctx) error
+	Transfer(ctx *fiber.Ctx) error
+	Dispose(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,433 @@
+package asset
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+	service "erpfinance/internal/service/asset"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FixedAssetHandlerImpl struct {
+	FixedAssetService service.FixedAssetService
+}
+
+func NewFixedAssetHandler(fixedAssetService service.FixedAssetService) FixedAssetHandler {
+	return &FixedAssetHandlerImpl{
+		FixedAssetService: fixedAssetService,
+	}
+}
+
+// CreateCategory godoc
+// @Summary Create asset category
+// @Description Create an asset category with its default depreciation method, useful life and accounts
+// @Tags asset-categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body asset.AssetCategoryRequest true "Asset category request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/categories [post]
+func (handler *FixedAssetHandlerImpl) CreateCategory(ctx *fiber.Ctx) error {
+	var request asset.AssetCategoryRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	category, err := handler.FixedAssetService.CreateCategory(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Asset category successfully created",
+		Data:    category,
+	})
+}
+
+// UpdateCategory godoc
+// @Summary Update asset category
+// @Description Update an asset category; existing assets keep their own method and useful life
+// @Tags asset-categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Asset category ID (UUID)"
+// @Param request body asset.AssetCategoryUpdateRequest true "Asset category request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/categories/{id} [put]
+func (handler *FixedAssetHandlerImpl) UpdateCategory(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request asset.AssetCategoryUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	category, err := handler.FixedAssetService.UpdateCategory(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Asset category successfully updated",
+		Data:    category,
+	})
+}
+
+// FindCategoryById godoc
+// @Summary Get asset category by ID
+// @Description Get asset category details
+// @Tags asset-categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Asset category ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/categories/{id} [get]
+func (handler *FixedAssetHandlerImpl) FindCategoryById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	category, err := handler.FixedAssetService.FindCategoryById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Asset category retrieved successfully",
+		Data:    category,
+	})
+}
+
+// FindAllCategories godoc
+// @Summary Get all asset categories with pagination
+// @Description Get asset categories with optional active filter and search
+// @Tags asset-categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param active_only query bool false "Only active categories"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/categories [get]
+func (handler *FixedAssetHandlerImpl) FindAllCategories(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter asset.AssetCategoryFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.FixedAssetService.FindAllCategories(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Asset categories retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Create godoc
+// @Summary Create fixed asset
+// @Description Register a fixed asset as draft; method, useful life and declining factor default to the category
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body asset.FixedAssetRequest true "Fixed asset request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets [post]
+func (handler *FixedAssetHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request asset.FixedAssetRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fixedAsset, err := handler.FixedAssetService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Fixed asset successfully created",
+		Data:    fixedAsset,
+	})
+}
+
+// Update godoc
+// @Summary Update fixed asset
+// @Description Update a draft fixed asset
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Param request body asset.FixedAssetRequest true "Fixed asset request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id} [put]
+func (handler *FixedAssetHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request asset.FixedAssetRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fixedAsset, err := handler.FixedAssetService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed asset successfully updated",
+		Data:    fixedAsset,
+	})
+}
+
+// FindById godoc
+// @Summary Get fixed asset by ID
+// @Description Get fixed asset details with transfer history
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id} [get]
+func (handler *FixedAssetHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	fixedAsset, err := handler.FixedAssetService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed asset retrieved successfully",
+		Data:    fixedAsset,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all fixed assets with pagination
+// @Description Get the fixed asset register with optional status, category, location and search filters
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Status (Draft, Active, FullyDepreciated, Disposed)"
+// @Param category_id query string false "Asset category ID"
+// @Param location query string false "Location"
+// @Param search query string false "Search by number, name or serial number"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets [get]
+func (handler *FixedAssetHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter asset.FixedAssetFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.FixedAssetService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed assets retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Schedule godoc
+// @Summary Get depreciation schedule
+// @Description Get the monthly depreciation schedule over the useful life, marking months already posted
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id}/schedule [get]
+func (handler *FixedAssetHandlerImpl) Schedule(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	schedule, err := handler.FixedAssetService.Schedule(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Depreciation schedule retrieved successfully",
+		Data:    schedule,
+	})
+}
+
+// Activate godoc
+// @Summary Activate fixed asset
+// @Description Post the acquisition journal (debit asset account, credit offset account) and start depreciation
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Param request body asset.FixedAssetActivateRequest true "Activate request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id}/activate [post]
+func (handler *FixedAssetHandlerImpl) Activate(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request asset.FixedAssetActivateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fixedAsset, err := handler.FixedAssetService.Activate(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed asset successfully activated",
+		Data:    fixedAsset,
+	})
+}
+
+// Transfer godoc
+// @Summary Transfer fixed asset
+// @Description Move a fixed asset to another location and record it in the transfer history
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Param request body asset.FixedAssetTransferRequest true "Transfer request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id}/transfer [post]
+func (handler *FixedAssetHandlerImpl) Transfer(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request asset.FixedAssetTransferRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fixedAsset, err := handler.FixedAssetService.Transfer(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed asset successfully transferred",
+		Data:    fixedAsset,
+	})
+}
+
+// Dispose godoc
+// @Summary Dispose fixed asset
+// @Description Derecognise a fixed asset and post the gain or loss (proceeds less book value); depreciation must be run through the month before disposal
+// @Tags fixed-assets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fixed asset ID (UUID)"
+// @Param request body asset.FixedAssetDisposalRequest true "Disposal request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/fixed-assets/{id}/dispose [post]
+func (handler *FixedAssetHandlerImpl) Dispose(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request asset.FixedAssetDisposalRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fixedAsset, err := handler.FixedAssetService.Dispose(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fixed asset successfully disposed",
+		Data:    fixedAsset,
+	})
+}
@@ -0,0 +1,8 @@
+package audit
+
+import "github.com/gofiber/fiber/v2"
+
+type AuditLogHandler interface {
+	FindAll(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+}
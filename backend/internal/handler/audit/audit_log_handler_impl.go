@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/audit"
+	service "erpfinance/internal/service/audit"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditLogHandlerImpl struct {
+	AuditLogService service.AuditLogService
+}
+
+func NewAuditLogHandler(auditLogService service.AuditLogService) AuditLogHandler {
+	return &AuditLogHandlerImpl{
+		AuditLogService: auditLogService,
+	}
+}
+
+// FindAll godoc
+// @Summary Get all audit logs with pagination
+// @Description Get audit logs filtered by entity, user, action and date range (inclusive, Asia/Jakarta)
+// @Tags audit-logs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param entity_type query string false "Entity type (table name, e.g. users)"
+// @Param entity_id query string false "Entity ID"
+// @Param user_id query string false "Actor user ID"
+// @Param action query string false "Action (CREATE, UPDATE, DELETE)"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/audit-logs [get]
+func (handler *AuditLogHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter audit.AuditLogFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.AuditLogService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Audit logs retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindById godoc
+// @Summary Get audit log by ID
+// @Description Get audit log details with before and after values
+// @Tags audit-logs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Audit log ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/audit-logs/{id} [get]
+func (handler *AuditLogHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	auditLog, err := handler.AuditLogService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Audit log retrieved successfully",
+		Data:    auditLog,
+	})
+}
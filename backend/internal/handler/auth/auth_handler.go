@@ -5,7 +5,10 @@ import "github.com/gofiber/fiber/v2"
 type AuthHandler interface {
 	Register(context *fiber.Ctx) error
 	Login(context *fiber.Ctx) error
+	LoginTwoFactor(context *fiber.Ctx) error
+	LoginTwoFactorSetup(context *fiber.Ctx) error
 	RefreshToken(context *fiber.Ctx) error
 	Logout(context *fiber.Ctx) error
 	ChangePassword(context *fiber.Ctx) error
+	JWKS(context *fiber.Ctx) error
 }
@@ -1,9 +1,11 @@
 package auth
 
 import (
+	"erpfinance/internal/helper"
 	"erpfinance/internal/model/dto"
 	"erpfinance/internal/model/dto/auth"
 	service "erpfinance/internal/service/auth"
+	"log"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -86,6 +88,21 @@ func (handler *AuthHandlerImpl) Login(context *fiber.Ctx) error {
 		})
 	}
 
+	// Token belum diterbitkan sampai login tahap kedua selesai
+	if response.TwoFactorRequired {
+		return context.Status(fiber.StatusOK).JSON(dto.WebResponse{
+			Code:    fiber.StatusOK,
+			Status:  "OK",
+			Message: "Two-factor authentication required",
+			Data: fiber.Map{
+				"two_factor_required":  true,
+				"enrollment_required":  response.EnrollmentRequired,
+				"challenge_token":      response.ChallengeToken,
+				"challenge_expires_in": response.ChallengeExpiresIn,
+			},
+		})
+	}
+
 	// Set refresh token in HTTP-only cookie with security flags
 	context.Cookie(&fiber.Cookie{
 		Name:     "refresh_token",
@@ -109,6 +126,100 @@ func (handler *AuthHandlerImpl) Login(context *fiber.Ctx) error {
 	})
 }
 
+// LoginTwoFactor godoc
+// @Summary Complete two-factor login
+// @Description Complete login with the challenge token and a TOTP code or recovery code. Users enrolling during login receive their recovery codes once.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body auth.TwoFactorLoginRequest true "Two-factor login request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/auth/login/2fa [post]
+func (handler *AuthHandlerImpl) LoginTwoFactor(context *fiber.Ctx) error {
+	var request auth.TwoFactorLoginRequest
+	if err := context.BodyParser(&request); err != nil {
+		return context.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+			Code:    fiber.StatusBadRequest,
+			Status:  "BAD REQUEST",
+			Data:    err.Error(),
+			Message: "Failed to read two-factor login request",
+		})
+	}
+
+	response, err := handler.AuthService.LoginTwoFactor(context.Context(), request)
+	if err != nil {
+		return context.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+			Code:    fiber.StatusBadRequest,
+			Status:  "BAD REQUEST",
+			Data:    err.Error(),
+			Message: "Two-factor login failed.",
+		})
+	}
+
+	context.Cookie(&fiber.Cookie{
+		Name:     "refresh_token",
+		Value:    response.RefreshToken,
+		MaxAge:   7 * 24 * 60 * 60,
+		Path:     "/",
+		Domain:   "localhost",
+		Secure:   false,
+		HTTPOnly: true,
+		SameSite: "Strict",
+	})
+
+	data := fiber.Map{
+		"access_token": response.AccessToken,
+	}
+	if len(response.RecoveryCodes) > 0 {
+		data["recovery_codes"] = response.RecoveryCodes
+	}
+
+	return context.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Login successful",
+		Data:    data,
+	})
+}
+
+// LoginTwoFactorSetup godoc
+// @Summary Set up an authenticator during login
+// @Description Generate a TOTP secret for a user whose role requires two-factor authentication but who has not enrolled yet. Complete the login with /auth/login/2fa.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body auth.TwoFactorChallengeRequest true "Challenge request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/auth/login/2fa/setup [post]
+func (handler *AuthHandlerImpl) LoginTwoFactorSetup(context *fiber.Ctx) error {
+	var request auth.TwoFactorChallengeRequest
+	if err := context.BodyParser(&request); err != nil {
+		return context.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+			Code:    fiber.StatusBadRequest,
+			Status:  "BAD REQUEST",
+			Message: "Invalid request format",
+		})
+	}
+
+	response, err := handler.AuthService.LoginTwoFactorSetup(context.Context(), request)
+	if err != nil {
+		return context.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+			Code:    fiber.StatusBadRequest,
+			Status:  "BAD REQUEST",
+			Message: err.Error(),
+		})
+	}
+
+	return context.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Scan the provisioning URI with your authenticator app",
+		Data:    response,
+	})
+}
+
 // RefreshToken godoc
 // @Summary Refresh access token
 // @Description Refresh access token using refresh token from cookie or request body
@@ -152,6 +263,21 @@ func (handler *AuthHandlerImpl) RefreshToken(context *fiber.Ctx) error {
 		RefreshToken: refreshToken,
 	})
 	if err != nil {
+		// Detail penolakan (mis. reuse terdeteksi) hanya dicatat di server, tidak dikirim ke client
+		log.Printf("Refresh token rejected: %v", err)
+
+		// Hapus cookie agar client tidak terus memakai token yang sudah dicabut
+		context.Cookie(&fiber.Cookie{
+			Name:     "refresh_token",
+			Value:    "",
+			MaxAge:   -1,
+			Path:     "/",
+			Domain:   "localhost",
+			Secure:   false,
+			HTTPOnly: true,
+			SameSite: "Strict",
+		})
+
 		return context.Status(fiber.StatusUnauthorized).JSON(dto.WebResponse{
 			Code:    fiber.StatusUnauthorized,
 			Status:  "UNAUTHORIZED",
@@ -288,3 +414,16 @@ func (handler *AuthHandlerImpl) ChangePassword(context *fiber.Ctx) error {
 		Message: "Password successfully changed",
 	})
 }
+
+// JWKS godoc
+// @Summary Get JSON Web Key Set
+// @Description Public keys (including retired keys that still verify unexpired tokens) for verifying access tokens by kid. Returned as a plain JWKS document, not wrapped in WebResponse.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} auth.JWKSResponse
+// @Router /.well-known/jwks.json [get]
+func (handler *AuthHandlerImpl) JWKS(context *fiber.Ctx) error {
+	// Service lain boleh meng-cache sebentar; kunci baru dipublikasikan sebelum dipakai menandatangani
+	context.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return context.Status(fiber.StatusOK).JSON(helper.JWKS())
+}
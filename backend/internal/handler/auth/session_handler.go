@@ -0,0 +1,9 @@
+package auth
+
+import "github.com/gofiber/fiber/v2"
+
+type SessionHandler interface {
+	FindAll(ctx *fiber.Ctx) error
+	Revoke(ctx *fiber.Ctx) error
+	RevokeOthers(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	service "erpfinance/internal/service/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SessionHandlerImpl struct {
+	SessionService service.SessionService
+}
+
+func NewSessionHandler(sessionService service.SessionService) SessionHandler {
+	return &SessionHandlerImpl{
+		SessionService: sessionService,
+	}
+}
+
+// FindAll godoc
+// @Summary Get active sessions
+// @Description Get the active login sessions (one per device) of the logged-in user; current marks the session of this access token
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/auth/sessions [get]
+func (handler *SessionHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	sessions, err := handler.SessionService.FindAll(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentSessionID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sessions retrieved successfully",
+		Data:    sessions,
+	})
+}
+
+// Revoke godoc
+// @Summary Revoke session
+// @Description Sign out one of the other devices of the logged-in user. The current session cannot be revoked here; use logout instead.
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Session ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/sessions/{id}/revoke [post]
+func (handler *SessionHandlerImpl) Revoke(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	if err := handler.SessionService.Revoke(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentSessionID(ctx), id); err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Session successfully revoked",
+	})
+}
+
+// RevokeOthers godoc
+// @Summary Revoke all other sessions
+// @Description Sign out every device of the logged-in user except the current session
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/auth/sessions/revoke-others [post]
+func (handler *SessionHandlerImpl) RevokeOthers(ctx *fiber.Ctx) error {
+	result, err := handler.SessionService.RevokeOthers(ctx.Context(), helper.CurrentUserID(ctx), helper.CurrentSessionID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Other sessions successfully revoked",
+		Data:    result,
+	})
+}
@@ -0,0 +1,14 @@
+package auth
+
+import "github.com/gofiber/fiber/v2"
+
+type TwoFactorHandler interface {
+	Status(ctx *fiber.Ctx) error
+	Setup(ctx *fiber.Ctx) error
+	Enable(ctx *fiber.Ctx) error
+	Disable(ctx *fiber.Ctx) error
+	RegenerateRecoveryCodes(ctx *fiber.Ctx) error
+	FindAllPolicies(ctx *fiber.Ctx) error
+	UpdatePolicy(ctx *fiber.Ctx) error
+	Reset(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/auth"
+	service "erpfinance/internal/service/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TwoFactorHandlerImpl struct {
+	TwoFactorService service.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService service.TwoFactorService) TwoFactorHandler {
+	return &TwoFactorHandlerImpl{
+		TwoFactorService: twoFactorService,
+	}
+}
+
+// Status godoc
+// @Summary Get two-factor status
+// @Description Get whether two-factor authentication is enabled for the logged-in user, whether the role requires it and the remaining recovery codes
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa [get]
+func (handler *TwoFactorHandlerImpl) Status(ctx *fiber.Ctx) error {
+	status, err := handler.TwoFactorService.Status(ctx.Context(), helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor status retrieved successfully",
+		Data:    status,
+	})
+}
+
+// Setup godoc
+// @Summary Set up an authenticator app
+// @Description Generate a new TOTP secret and provisioning URI. Two-factor authentication is enabled after confirming a code with /auth/2fa/enable.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/setup [post]
+func (handler *TwoFactorHandlerImpl) Setup(ctx *fiber.Ctx) error {
+	setup, err := handler.TwoFactorService.Setup(ctx.Context(), helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Scan the provisioning URI with your authenticator app",
+		Data:    setup,
+	})
+}
+
+// Enable godoc
+// @Summary Enable two-factor authentication
+// @Description Confirm the authenticator with a TOTP code. The recovery codes are returned only once.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body auth.TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/enable [post]
+func (handler *TwoFactorHandlerImpl) Enable(ctx *fiber.Ctx) error {
+	var request auth.TwoFactorCodeRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	recoveryCodes, err := handler.TwoFactorService.Enable(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor authentication successfully enabled",
+		Data:    recoveryCodes,
+	})
+}
+
+// Disable godoc
+// @Summary Disable two-factor authentication
+// @Description Disable two-factor authentication with the current password and a TOTP code. Not allowed when the role requires two-factor authentication.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body auth.TwoFactorDisableRequest true "Password and TOTP code"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/disable [post]
+func (handler *TwoFactorHandlerImpl) Disable(ctx *fiber.Ctx) error {
+	var request auth.TwoFactorDisableRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	if err := handler.TwoFactorService.Disable(ctx.Context(), helper.CurrentUserID(ctx), request); err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor authentication successfully disabled",
+	})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace all recovery codes after confirming a TOTP code. The new codes are returned only once.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body auth.TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/recovery-codes [post]
+func (handler *TwoFactorHandlerImpl) RegenerateRecoveryCodes(ctx *fiber.Ctx) error {
+	var request auth.TwoFactorCodeRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	recoveryCodes, err := handler.TwoFactorService.RegenerateRecoveryCodes(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Recovery codes successfully regenerated",
+		Data:    recoveryCodes,
+	})
+}
+
+// FindAllPolicies godoc
+// @Summary Get two-factor policies
+// @Description Get whether two-factor authentication is required for each role
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/policies [get]
+func (handler *TwoFactorHandlerImpl) FindAllPolicies(ctx *fiber.Ctx) error {
+	policies, err := handler.TwoFactorService.FindAllPolicies(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor policies retrieved successfully",
+		Data:    policies,
+	})
+}
+
+// UpdatePolicy godoc
+// @Summary Update two-factor policy
+// @Description Set whether two-factor authentication is required for a role. Users of that role without two-factor authentication must enrol at their next login.
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param role path string true "Role (Admin, Finance, Purchasing, PPC, Logistics, Warehouse, Sales)"
+// @Param request body auth.TwoFactorPolicyRequest true "Two-factor policy"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/policies/{role} [put]
+func (handler *TwoFactorHandlerImpl) UpdatePolicy(ctx *fiber.Ctx) error {
+	role := ctx.Params("role")
+
+	var request auth.TwoFactorPolicyRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	policy, err := handler.TwoFactorService.UpdatePolicy(ctx.Context(), role, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor policy successfully updated",
+		Data:    policy,
+	})
+}
+
+// Reset godoc
+// @Summary Reset user two-factor authentication
+// @Description Disable two-factor authentication and remove the recovery codes of a user who lost their authenticator
+// @Tags two-factor
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/auth/2fa/users/{id}/reset [post]
+func (handler *TwoFactorHandlerImpl) Reset(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	if err := handler.TwoFactorService.Reset(ctx.Context(), id); err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Two-factor authentication successfully reset",
+	})
+}
@@ -0,0 +1,10 @@
+package bank
+
+import "github.com/gofiber/fiber/v2"
+
+type BankAccountHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+}
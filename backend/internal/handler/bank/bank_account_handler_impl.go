@@ -0,0 +1,155 @@
+package bank
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+	service "erpfinance/internal/service/bank"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BankAccountHandlerImpl struct {
+	BankAccountService service.BankAccountService
+}
+
+func NewBankAccountHandler(bankAccountService service.BankAccountService) BankAccountHandler {
+	return &BankAccountHandlerImpl{
+		BankAccountService: bankAccountService,
+	}
+}
+
+// Create godoc
+// @Summary Create bank account
+// @Description Create a bank or cash account linked to an asset GL account
+// @Tags bank-accounts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body bank.BankAccountRequest true "Bank account request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts [post]
+func (handler *BankAccountHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request bank.BankAccountRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	account, err := handler.BankAccountService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Bank account successfully created",
+		Data:    account,
+	})
+}
+
+// Update godoc
+// @Summary Update bank account
+// @Description Update a bank account; currency and GL account are locked once statements have been imported
+// @Tags bank-accounts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank account ID (UUID)"
+// @Param request body bank.BankAccountUpdateRequest true "Bank account request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts/{id} [put]
+func (handler *BankAccountHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request bank.BankAccountUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	account, err := handler.BankAccountService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bank account successfully updated",
+		Data:    account,
+	})
+}
+
+// FindById godoc
+// @Summary Get bank account by ID
+// @Description Get bank account details
+// @Tags bank-accounts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank account ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts/{id} [get]
+func (handler *BankAccountHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	account, err := handler.BankAccountService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bank account retrieved successfully",
+		Data:    account,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all bank accounts with pagination
+// @Description Get bank and cash accounts with optional type, active and search filters
+// @Tags bank-accounts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param type query string false "Type (BANK, CASH)"
+// @Param active_only query bool false "Only active accounts"
+// @Param search query string false "Search by code, name or account number"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts [get]
+func (handler *BankAccountHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter bank.BankAccountFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.BankAccountService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bank accounts retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
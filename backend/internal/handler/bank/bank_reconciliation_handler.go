@@ -0,0 +1,13 @@
+package bank
+
+import "github.com/gofiber/fiber/v2"
+
+type BankReconciliationHandler interface {
+	ImportStatement(ctx *fiber.Ctx) error
+	FindStatementById(ctx *fiber.Ctx) error
+	FindAllStatements(ctx *fiber.Ctx) error
+	Workspace(ctx *fiber.Ctx) error
+	AutoMatch(ctx *fiber.Ctx) error
+	Match(ctx *fiber.Ctx) error
+	Unmatch(ctx *fiber.Ctx) error
+}
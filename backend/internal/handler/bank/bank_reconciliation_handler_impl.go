@@ -0,0 +1,272 @@
+package bank
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+	service "erpfinance/internal/service/bank"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BankReconciliationHandlerImpl struct {
+	BankReconciliationService service.BankReconciliationService
+}
+
+func NewBankReconciliationHandler(bankReconciliationService service.BankReconciliationService) BankReconciliationHandler {
+	return &BankReconciliationHandlerImpl{
+		BankReconciliationService: bankReconciliationService,
+	}
+}
+
+// ImportStatement godoc
+// @Summary Import bank statement
+// @Description Import a CSV (date, description, reference, amount or debit/credit) or MT940 statement; lines already imported for the account are skipped
+// @Tags bank-statements
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param file formData file true "Statement file"
+// @Param bank_account_id formData string true "Bank account ID"
+// @Param format formData string false "Format (CSV, MT940); detected from the file when empty"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-statements/import [post]
+func (handler *BankReconciliationHandlerImpl) ImportStatement(ctx *fiber.Ctx) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.BadRequestResponse(ctx, "Statement file is required in the file field.")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return helper.BadRequestResponse(ctx, "Unable to read the uploaded file.")
+	}
+	defer file.Close()
+
+	var request bank.BankStatementImportRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	statement, err := handler.BankReconciliationService.ImportStatement(ctx.Context(), helper.CurrentUserID(ctx), request, fileHeader.Filename, file)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Bank statement successfully imported",
+		Data:    statement,
+	})
+}
+
+// FindStatementById godoc
+// @Summary Get bank statement by ID
+// @Description Get an imported bank statement with its lines and match status
+// @Tags bank-statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank statement ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-statements/{id} [get]
+func (handler *BankReconciliationHandlerImpl) FindStatementById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	statement, err := handler.BankReconciliationService.FindStatementById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bank statement retrieved successfully",
+		Data:    statement,
+	})
+}
+
+// FindAllStatements godoc
+// @Summary Get all bank statements with pagination
+// @Description Get imported bank statements with optional bank account filter
+// @Tags bank-statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param bank_account_id query string false "Bank account ID"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/bank-statements [get]
+func (handler *BankReconciliationHandlerImpl) FindAllStatements(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter bank.BankStatementFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.BankReconciliationService.FindAllStatements(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bank statements retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Workspace godoc
+// @Summary Get reconciliation workspace
+// @Description Get all statement lines of a bank account in the period with their match status, and posted receipts and payments not yet matched
+// @Tags bank-reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank account ID (UUID)"
+// @Param date_from query string true "Start date (YYYY-MM-DD)"
+// @Param date_to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts/{id}/reconciliation [get]
+func (handler *BankReconciliationHandlerImpl) Workspace(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var filter bank.BankReconciliationRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	workspace, err := handler.BankReconciliationService.Workspace(ctx.Context(), id, filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Reconciliation workspace retrieved successfully",
+		Data:    workspace,
+	})
+}
+
+// AutoMatch godoc
+// @Summary Auto-match statement lines
+// @Description Match unmatched statement lines to posted customer receipts and supplier payment batches with the same amount within the date tolerance, using references to break ties; ambiguous lines are left for manual matching
+// @Tags bank-reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank account ID (UUID)"
+// @Param request body bank.BankAutoMatchRequest true "Auto-match request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-accounts/{id}/reconciliation/auto-match [post]
+func (handler *BankReconciliationHandlerImpl) AutoMatch(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request bank.BankAutoMatchRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	result, err := handler.BankReconciliationService.AutoMatch(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Statement lines successfully auto-matched",
+		Data:    result,
+	})
+}
+
+// Match godoc
+// @Summary Match statement line
+// @Description Manually match a statement line to a posted customer receipt or supplier payment batch with the same amount
+// @Tags bank-reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank statement line ID (UUID)"
+// @Param request body bank.BankManualMatchRequest true "Match request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-statements/lines/{id}/match [post]
+func (handler *BankReconciliationHandlerImpl) Match(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request bank.BankManualMatchRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	line, err := handler.BankReconciliationService.Match(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Statement line successfully matched",
+		Data:    line,
+	})
+}
+
+// Unmatch godoc
+// @Summary Unmatch statement line
+// @Description Remove the match of a statement line so it can be matched again
+// @Tags bank-reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bank statement line ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/bank-statements/lines/{id}/unmatch [post]
+func (handler *BankReconciliationHandlerImpl) Unmatch(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	line, err := handler.BankReconciliationService.Unmatch(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Statement line successfully unmatched",
+		Data:    line,
+	})
+}
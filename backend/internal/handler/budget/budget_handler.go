@@ -0,0 +1,14 @@
+package budget
+
+import "github.com/gofiber/fiber/v2"
+
+type BudgetHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Activate(ctx *fiber.Ctx) error
+	Close(ctx *fiber.Ctx) error
+	BudgetVsActual(ctx *fiber.Ctx) error
+	Check(ctx *fiber.Ctx) error
+}
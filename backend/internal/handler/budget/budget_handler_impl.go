@@ -0,0 +1,282 @@
+package budget
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+	service "erpfinance/internal/service/budget"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BudgetHandlerImpl struct {
+	BudgetService service.BudgetService
+}
+
+func NewBudgetHandler(budgetService service.BudgetService) BudgetHandler {
+	return &BudgetHandlerImpl{
+		BudgetService: budgetService,
+	}
+}
+
+// Create godoc
+// @Summary Create budget
+// @Description Create a draft annual budget for a cost center with monthly amounts per account
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body budget.BudgetRequest true "Budget request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/budgets [post]
+func (handler *BudgetHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request budget.BudgetRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	budgetEntity, err := handler.BudgetService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Budget successfully created",
+		Data:    budgetEntity,
+	})
+}
+
+// Update godoc
+// @Summary Update budget
+// @Description Replace the header and lines of a draft budget
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Budget ID (UUID)"
+// @Param request body budget.BudgetRequest true "Budget request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/budgets/{id} [put]
+func (handler *BudgetHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request budget.BudgetRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	budgetEntity, err := handler.BudgetService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget successfully updated",
+		Data:    budgetEntity,
+	})
+}
+
+// FindById godoc
+// @Summary Get budget by ID
+// @Description Get budget details with monthly amounts per account
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Budget ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/budgets/{id} [get]
+func (handler *BudgetHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	budgetEntity, err := handler.BudgetService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget retrieved successfully",
+		Data:    budgetEntity,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all budgets with pagination
+// @Description Get budgets with optional fiscal year, cost center and status filters
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param fiscal_year query int false "Fiscal year"
+// @Param cost_center_id query string false "Cost center ID"
+// @Param status query string false "Status (Draft, Active, Closed)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/budgets [get]
+func (handler *BudgetHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter budget.BudgetFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.BudgetService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budgets retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Activate godoc
+// @Summary Activate budget
+// @Description Activate a draft budget so it is used by budget checks and reports
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Budget ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/budgets/{id}/activate [post]
+func (handler *BudgetHandlerImpl) Activate(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	budgetEntity, err := handler.BudgetService.Activate(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget successfully activated",
+		Data:    budgetEntity,
+	})
+}
+
+// Close godoc
+// @Summary Close budget
+// @Description Close an active budget; it stays in reports but is no longer used by budget checks
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Budget ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/budgets/{id}/close [post]
+func (handler *BudgetHandlerImpl) Close(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	budgetEntity, err := handler.BudgetService.Close(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget successfully closed",
+		Data:    budgetEntity,
+	})
+}
+
+// BudgetVsActual godoc
+// @Summary Budget vs actual report
+// @Description Compare active and closed budgets with posted journal lines per cost center and account, month by month up to month_to
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param fiscal_year query int true "Fiscal year"
+// @Param month_to query int false "Last month included (1-12, default: 12)"
+// @Param cost_center_id query string false "Cost center ID"
+// @Param account_id query string false "Account ID"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/budgets/report/budget-vs-actual [get]
+func (handler *BudgetHandlerImpl) BudgetVsActual(ctx *fiber.Ctx) error {
+	var filter budget.BudgetVsActualRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	report, err := handler.BudgetService.BudgetVsActual(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget vs actual report retrieved successfully",
+		Data:    report,
+	})
+}
+
+// Check godoc
+// @Summary Check proposed spend
+// @Description Check a proposed spend against the remaining budget of the cost center and account; returns an OK, WARN or BLOCK verdict
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body budget.BudgetCheckRequest true "Budget check request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/budgets/check [post]
+func (handler *BudgetHandlerImpl) Check(ctx *fiber.Ctx) error {
+	var request budget.BudgetCheckRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	result, err := handler.BudgetService.Check(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Budget check completed",
+		Data:    result,
+	})
+}
@@ -0,0 +1,10 @@
+package budget
+
+import "github.com/gofiber/fiber/v2"
+
+type CostCenterHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,155 @@
+package budget
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+	service "erpfinance/internal/service/budget"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CostCenterHandlerImpl struct {
+	CostCenterService service.CostCenterService
+}
+
+func NewCostCenterHandler(costCenterService service.CostCenterService) CostCenterHandler {
+	return &CostCenterHandlerImpl{
+		CostCenterService: costCenterService,
+	}
+}
+
+// Create godoc
+// @Summary Create cost center
+// @Description Create a cost center or department, optionally under a parent
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body budget.CostCenterRequest true "Cost center request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/cost-centers [post]
+func (handler *CostCenterHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request budget.CostCenterRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	costCenter, err := handler.CostCenterService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Cost center successfully created",
+		Data:    costCenter,
+	})
+}
+
+// Update godoc
+// @Summary Update cost center
+// @Description Update a cost center; it cannot be deactivated while it has active children
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Cost center ID (UUID)"
+// @Param request body budget.CostCenterUpdateRequest true "Cost center request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/cost-centers/{id} [put]
+func (handler *CostCenterHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request budget.CostCenterUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	costCenter, err := handler.CostCenterService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Cost center successfully updated",
+		Data:    costCenter,
+	})
+}
+
+// FindById godoc
+// @Summary Get cost center by ID
+// @Description Get cost center details
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Cost center ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/cost-centers/{id} [get]
+func (handler *CostCenterHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	costCenter, err := handler.CostCenterService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Cost center retrieved successfully",
+		Data:    costCenter,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all cost centers with pagination
+// @Description Get cost centers with optional parent, active and search filters
+// @Tags cost-centers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param parent_id query string false "Parent cost center ID"
+// @Param active_only query bool false "Only active cost centers"
+// @Param search query string false "Search by code, name or manager"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/cost-centers [get]
+func (handler *CostCenterHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter budget.CostCenterFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CostCenterService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Cost centers retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
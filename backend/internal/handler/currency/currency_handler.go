@@ -0,0 +1,16 @@
+package currency
+
+import "github.com/gofiber/fiber/v2"
+
+type CurrencyHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindByCode(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	SaveRate(ctx *fiber.Ctx) error
+	ImportRates(ctx *fiber.Ctx) error
+	FindAllRates(ctx *fiber.Ctx) error
+	LookupRate(ctx *fiber.Ctx) error
+	GetSetting(ctx *fiber.Ctx) error
+	UpdateSetting(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,339 @@
+package currency
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+	service "erpfinance/internal/service/currency"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CurrencyHandlerImpl struct {
+	CurrencyService service.CurrencyService
+}
+
+func NewCurrencyHandler(currencyService service.CurrencyService) CurrencyHandler {
+	return &CurrencyHandlerImpl{
+		CurrencyService: currencyService,
+	}
+}
+
+// Create godoc
+// @Summary Create currency
+// @Description Register a currency by its ISO 4217 code
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body currency.CurrencyCreateRequest true "Currency request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/currencies [post]
+func (handler *CurrencyHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request currency.CurrencyCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	currencyResponse, err := handler.CurrencyService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Currency successfully created",
+		Data:    currencyResponse,
+	})
+}
+
+// Update godoc
+// @Summary Update currency
+// @Description Update currency details and status; the base currency cannot be deactivated
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param code path string true "Currency code (ISO 4217)"
+// @Param request body currency.CurrencyUpdateRequest true "Currency request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/{code} [put]
+func (handler *CurrencyHandlerImpl) Update(ctx *fiber.Ctx) error {
+	code := ctx.Params("code")
+
+	var request currency.CurrencyUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	currencyResponse, err := handler.CurrencyService.Update(ctx.Context(), code, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Currency successfully updated",
+		Data:    currencyResponse,
+	})
+}
+
+// FindByCode godoc
+// @Summary Get currency by code
+// @Description Get currency details
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param code path string true "Currency code (ISO 4217)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/{code} [get]
+func (handler *CurrencyHandlerImpl) FindByCode(ctx *fiber.Ctx) error {
+	code := ctx.Params("code")
+
+	currencyResponse, err := handler.CurrencyService.FindByCode(ctx.Context(), code)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Currency retrieved successfully",
+		Data:    currencyResponse,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all currencies with pagination
+// @Description Get currencies with optional active filter and search
+// @Tags currencies
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param active_only query bool false "Only active currencies"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/currencies [get]
+func (handler *CurrencyHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter currency.CurrencyFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CurrencyService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Currencies retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// SaveRate godoc
+// @Summary Save exchange rate
+// @Description Record the daily rate of a currency against the base currency; an existing rate for the same date is replaced
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body currency.ExchangeRateRequest true "Exchange rate request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/rates [post]
+func (handler *CurrencyHandlerImpl) SaveRate(ctx *fiber.Ctx) error {
+	var request currency.ExchangeRateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	rate, err := handler.CurrencyService.SaveRate(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Exchange rate successfully saved",
+		Data:    rate,
+	})
+}
+
+// ImportRates godoc
+// @Summary Import exchange rates
+// @Description Import daily rates from a CSV file with header currency_code,rate_date,rate; all rows are validated before any rate is saved
+// @Tags exchange-rates
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/rates/import [post]
+func (handler *CurrencyHandlerImpl) ImportRates(ctx *fiber.Ctx) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.BadRequestResponse(ctx, "CSV file is required in the file field.")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return helper.BadRequestResponse(ctx, "Unable to read the uploaded file.")
+	}
+	defer file.Close()
+
+	result, err := handler.CurrencyService.ImportRates(ctx.Context(), helper.CurrentUserID(ctx), file)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Exchange rates successfully imported",
+		Data:    result,
+	})
+}
+
+// FindAllRates godoc
+// @Summary Get exchange rates with pagination
+// @Description Get exchange rates with optional currency and date range filters
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param currency_code query string false "Currency code"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/currencies/rates [get]
+func (handler *CurrencyHandlerImpl) FindAllRates(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter currency.ExchangeRateFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CurrencyService.FindAllRates(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Exchange rates retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// LookupRate godoc
+// @Summary Lookup exchange rate
+// @Description Get the rate effective on a date, i.e. the latest rate on or before that date
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param currency_code query string true "Currency code"
+// @Param date query string false "Date (YYYY-MM-DD), default today"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/rates/lookup [get]
+func (handler *CurrencyHandlerImpl) LookupRate(ctx *fiber.Ctx) error {
+	var filter currency.ExchangeRateLookupRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	rate, err := handler.CurrencyService.LookupRate(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Exchange rate retrieved successfully",
+		Data:    rate,
+	})
+}
+
+// GetSetting godoc
+// @Summary Get currency settings
+// @Description Get the base currency and the realised and unrealised FX accounts
+// @Tags currency-settings
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/currencies/settings [get]
+func (handler *CurrencyHandlerImpl) GetSetting(ctx *fiber.Ctx) error {
+	setting, err := handler.CurrencyService.GetSetting(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Currency settings retrieved successfully",
+		Data:    setting,
+	})
+}
+
+// UpdateSetting godoc
+// @Summary Update currency settings
+// @Description Set the realised and unrealised FX gain/loss accounts
+// @Tags currency-settings
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body currency.CurrencySettingRequest true "Currency setting request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/settings [put]
+func (handler *CurrencyHandlerImpl) UpdateSetting(ctx *fiber.Ctx) error {
+	var request currency.CurrencySettingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	setting, err := handler.CurrencyService.UpdateSetting(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Currency settings successfully updated",
+		Data:    setting,
+	})
+}
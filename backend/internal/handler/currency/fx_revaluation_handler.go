@@ -0,0 +1,9 @@
+package currency
+
+import "github.com/gofiber/fiber/v2"
+
+type FXRevaluationHandler interface {
+	Run(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,118 @@
+package currency
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+	service "erpfinance/internal/service/currency"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FXRevaluationHandlerImpl struct {
+	FXRevaluationService service.FXRevaluationService
+}
+
+func NewFXRevaluationHandler(fXRevaluationService service.FXRevaluationService) FXRevaluationHandler {
+	return &FXRevaluationHandlerImpl{
+		FXRevaluationService: fXRevaluationService,
+	}
+}
+
+// Run godoc
+// @Summary Run FX revaluation
+// @Description Revalue open foreign currency receivables, customer credits and payables at the month-end closing rate; the adjusting journal is reversed on the next day
+// @Tags fx-revaluations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body currency.FXRevaluationRequest true "FX revaluation request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/revaluations [post]
+func (handler *FXRevaluationHandlerImpl) Run(ctx *fiber.Ctx) error {
+	var request currency.FXRevaluationRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	revaluation, err := handler.FXRevaluationService.Run(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "FX revaluation successfully posted",
+		Data:    revaluation,
+	})
+}
+
+// FindById godoc
+// @Summary Get FX revaluation by ID
+// @Description Get FX revaluation with its document lines
+// @Tags fx-revaluations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "FX revaluation ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/currencies/revaluations/{id} [get]
+func (handler *FXRevaluationHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	revaluation, err := handler.FXRevaluationService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "FX revaluation retrieved successfully",
+		Data:    revaluation,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all FX revaluations with pagination
+// @Description Get FX revaluations with optional date range
+// @Tags fx-revaluations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/currencies/revaluations [get]
+func (handler *FXRevaluationHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter currency.FXRevaluationFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.FXRevaluationService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "FX revaluations retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
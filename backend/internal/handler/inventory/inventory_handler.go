@@ -0,0 +1,20 @@
+package inventory
+
+import "github.com/gofiber/fiber/v2"
+
+type InventoryHandler interface {
+	CreateItem(ctx *fiber.Ctx) error
+	UpdateItem(ctx *fiber.Ctx) error
+	FindItemById(ctx *fiber.Ctx) error
+	FindAllItems(ctx *fiber.Ctx) error
+
+	CreateWarehouse(ctx *fiber.Ctx) error
+	UpdateWarehouse(ctx *fiber.Ctx) error
+	FindWarehouseById(ctx *fiber.Ctx) error
+	FindAllWarehouses(ctx *fiber.Ctx) error
+	CreateBin(ctx *fiber.Ctx) error
+
+	CreateMovement(ctx *fiber.Ctx) error
+	FindAllMovements(ctx *fiber.Ctx) error
+	FindBalances(ctx *fiber.Ctx) error
+}
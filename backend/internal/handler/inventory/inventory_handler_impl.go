@@ -0,0 +1,420 @@
+package inventory
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/inventory"
+	service "erpfinance/internal/service/inventory"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type InventoryHandlerImpl struct {
+	InventoryService service.InventoryService
+}
+
+func NewInventoryHandler(inventoryService service.InventoryService) InventoryHandler {
+	return &InventoryHandlerImpl{
+		InventoryService: inventoryService,
+	}
+}
+
+// CreateItem godoc
+// @Summary Create item
+// @Description Add a new item to the item master
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body inventory.ItemCreateRequest true "Create item request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/inventory/items [post]
+func (handler *InventoryHandlerImpl) CreateItem(ctx *fiber.Ctx) error {
+	var request inventory.ItemCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	item, err := handler.InventoryService.CreateItem(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Item successfully created",
+		Data:    item,
+	})
+}
+
+// UpdateItem godoc
+// @Summary Update item
+// @Description Update item name, description, category, unit of measure and active flag
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Item ID (UUID)"
+// @Param request body inventory.ItemUpdateRequest true "Update item request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/inventory/items/{id} [put]
+func (handler *InventoryHandlerImpl) UpdateItem(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request inventory.ItemUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	item, err := handler.InventoryService.UpdateItem(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Item successfully updated",
+		Data:    item,
+	})
+}
+
+// FindItemById godoc
+// @Summary Get item by ID
+// @Description Get item details by item ID
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/inventory/items/{id} [get]
+func (handler *InventoryHandlerImpl) FindItemById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	item, err := handler.InventoryService.FindItemById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Item retrieved successfully",
+		Data:    item,
+	})
+}
+
+// FindAllItems godoc
+// @Summary Get all items with pagination
+// @Description Get item master with optional search
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/inventory/items [get]
+func (handler *InventoryHandlerImpl) FindAllItems(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter inventory.MasterFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.InventoryService.FindAllItems(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Items retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// CreateWarehouse godoc
+// @Summary Create warehouse
+// @Description Add a new warehouse
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body inventory.WarehouseCreateRequest true "Create warehouse request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/inventory/warehouses [post]
+func (handler *InventoryHandlerImpl) CreateWarehouse(ctx *fiber.Ctx) error {
+	var request inventory.WarehouseCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	warehouse, err := handler.InventoryService.CreateWarehouse(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Warehouse successfully created",
+		Data:    warehouse,
+	})
+}
+
+// UpdateWarehouse godoc
+// @Summary Update warehouse
+// @Description Update warehouse name, address and active flag
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Warehouse ID (UUID)"
+// @Param request body inventory.WarehouseUpdateRequest true "Update warehouse request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/inventory/warehouses/{id} [put]
+func (handler *InventoryHandlerImpl) UpdateWarehouse(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request inventory.WarehouseUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	warehouse, err := handler.InventoryService.UpdateWarehouse(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Warehouse successfully updated",
+		Data:    warehouse,
+	})
+}
+
+// FindWarehouseById godoc
+// @Summary Get warehouse by ID
+// @Description Get warehouse with its bins
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Warehouse ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/inventory/warehouses/{id} [get]
+func (handler *InventoryHandlerImpl) FindWarehouseById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	warehouse, err := handler.InventoryService.FindWarehouseById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Warehouse retrieved successfully",
+		Data:    warehouse,
+	})
+}
+
+// FindAllWarehouses godoc
+// @Summary Get all warehouses with pagination
+// @Description Get warehouses with optional search
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/inventory/warehouses [get]
+func (handler *InventoryHandlerImpl) FindAllWarehouses(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter inventory.MasterFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.InventoryService.FindAllWarehouses(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Warehouses retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// CreateBin godoc
+// @Summary Create warehouse bin
+// @Description Add a storage bin to a warehouse
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Warehouse ID (UUID)"
+// @Param request body inventory.BinCreateRequest true "Create bin request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/inventory/warehouses/{id}/bins [post]
+func (handler *InventoryHandlerImpl) CreateBin(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request inventory.BinCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	warehouse, err := handler.InventoryService.CreateBin(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Bin successfully created",
+		Data:    warehouse,
+	})
+}
+
+// CreateMovement godoc
+// @Summary Create stock movement
+// @Description Record a receipt, issue, transfer or adjustment. Movements that would make stock negative are rejected.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body inventory.StockMovementRequest true "Stock movement request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/inventory/movements [post]
+func (handler *InventoryHandlerImpl) CreateMovement(ctx *fiber.Ctx) error {
+	var request inventory.StockMovementRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	movements, err := handler.InventoryService.CreateMovement(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Stock movement successfully recorded",
+		Data:    movements,
+	})
+}
+
+// FindAllMovements godoc
+// @Summary Get all stock movements with pagination
+// @Description Get stock movements with optional item, warehouse, type and date range filter
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param item_id query string false "Item ID (UUID)"
+// @Param warehouse_id query string false "Warehouse ID (UUID)"
+// @Param movement_type query string false "Movement type (Receipt, Issue, Transfer, Adjustment)"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/inventory/movements [get]
+func (handler *InventoryHandlerImpl) FindAllMovements(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter inventory.StockMovementFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.InventoryService.FindAllMovements(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Stock movements retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindBalances godoc
+// @Summary Get on-hand stock balances
+// @Description Get on-hand quantity per item and location derived from stock movements
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param item_id query string false "Item ID (UUID)"
+// @Param warehouse_id query string false "Warehouse ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/inventory/balances [get]
+func (handler *InventoryHandlerImpl) FindBalances(ctx *fiber.Ctx) error {
+	var filter inventory.StockBalanceFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	balances, err := handler.InventoryService.FindBalances(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Stock balances retrieved successfully",
+		Data:    balances,
+	})
+}
@@ -0,0 +1,17 @@
+package ledger
+
+import "github.com/gofiber/fiber/v2"
+
+type LedgerHandler interface {
+	CreateAccount(ctx *fiber.Ctx) error
+	UpdateAccount(ctx *fiber.Ctx) error
+	FindAccountById(ctx *fiber.Ctx) error
+	FindAllAccounts(ctx *fiber.Ctx) error
+	FindAccountTree(ctx *fiber.Ctx) error
+
+	CreateJournal(ctx *fiber.Ctx) error
+	FindJournalById(ctx *fiber.Ctx) error
+	FindAllJournals(ctx *fiber.Ctx) error
+	PostJournal(ctx *fiber.Ctx) error
+	ReverseJournal(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,342 @@
+package ledger
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ledger"
+	service "erpfinance/internal/service/ledger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LedgerHandlerImpl struct {
+	LedgerService service.LedgerService
+}
+
+func NewLedgerHandler(ledgerService service.LedgerService) LedgerHandler {
+	return &LedgerHandlerImpl{
+		LedgerService: ledgerService,
+	}
+}
+
+// CreateAccount godoc
+// @Summary Create account
+// @Description Add a new account to the chart of accounts
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ledger.AccountCreateRequest true "Create account request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/ledger/accounts [post]
+func (handler *LedgerHandlerImpl) CreateAccount(ctx *fiber.Ctx) error {
+	var request ledger.AccountCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	account, err := handler.LedgerService.CreateAccount(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Account successfully created",
+		Data:    account,
+	})
+}
+
+// UpdateAccount godoc
+// @Summary Update account
+// @Description Update name, parent, postable and active flags of an account
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Account ID (UUID)"
+// @Param request body ledger.AccountUpdateRequest true "Update account request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ledger/accounts/{id} [put]
+func (handler *LedgerHandlerImpl) UpdateAccount(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ledger.AccountUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	account, err := handler.LedgerService.UpdateAccount(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Account successfully updated",
+		Data:    account,
+	})
+}
+
+// FindAccountById godoc
+// @Summary Get account by ID
+// @Description Get account details by account ID
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Account ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ledger/accounts/{id} [get]
+func (handler *LedgerHandlerImpl) FindAccountById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	account, err := handler.LedgerService.FindAccountById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Account retrieved successfully",
+		Data:    account,
+	})
+}
+
+// FindAllAccounts godoc
+// @Summary Get all accounts with pagination
+// @Description Get chart of accounts with optional search and type filter
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code or name"
+// @Param type query string false "Account type (Asset, Liability, Equity, Revenue, Expense)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ledger/accounts [get]
+func (handler *LedgerHandlerImpl) FindAllAccounts(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ledger.AccountFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.LedgerService.FindAllAccounts(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Accounts retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindAccountTree godoc
+// @Summary Get chart of accounts tree
+// @Description Get all accounts arranged by parent-child hierarchy
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ledger/accounts/tree [get]
+func (handler *LedgerHandlerImpl) FindAccountTree(ctx *fiber.Ctx) error {
+	tree, err := handler.LedgerService.FindAccountTree(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Account tree retrieved successfully",
+		Data:    tree,
+	})
+}
+
+// CreateJournal godoc
+// @Summary Create journal entry
+// @Description Create a balanced draft journal entry
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ledger.JournalCreateRequest true "Create journal request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/ledger/journals [post]
+func (handler *LedgerHandlerImpl) CreateJournal(ctx *fiber.Ctx) error {
+	var request ledger.JournalCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	journal, err := handler.LedgerService.CreateJournal(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Journal entry successfully created",
+		Data:    journal,
+	})
+}
+
+// FindJournalById godoc
+// @Summary Get journal entry by ID
+// @Description Get journal entry with its lines
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Journal entry ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ledger/journals/{id} [get]
+func (handler *LedgerHandlerImpl) FindJournalById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	journal, err := handler.LedgerService.FindJournalById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Journal entry retrieved successfully",
+		Data:    journal,
+	})
+}
+
+// FindAllJournals godoc
+// @Summary Get all journal entries with pagination
+// @Description Get journal entries with optional status and date range filter
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Journal status (Draft, Posted, Reversed)"
+// @Param date_from query string false "Start date (YYYY-MM-DD)"
+// @Param date_to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/ledger/journals [get]
+func (handler *LedgerHandlerImpl) FindAllJournals(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ledger.JournalFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.LedgerService.FindAllJournals(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Journal entries retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// PostJournal godoc
+// @Summary Post journal entry
+// @Description Post a draft journal entry to the ledger. Unbalanced entries are rejected.
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Journal entry ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ledger/journals/{id}/post [post]
+func (handler *LedgerHandlerImpl) PostJournal(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	journal, err := handler.LedgerService.PostJournal(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Journal entry successfully posted",
+		Data:    journal,
+	})
+}
+
+// ReverseJournal godoc
+// @Summary Reverse journal entry
+// @Description Create and post a reversing entry for a posted journal entry
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Journal entry ID (UUID)"
+// @Param request body ledger.JournalReverseRequest true "Reverse journal request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ledger/journals/{id}/reverse [post]
+func (handler *LedgerHandlerImpl) ReverseJournal(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ledger.JournalReverseRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	journal, err := handler.LedgerService.ReverseJournal(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Journal entry successfully reversed",
+		Data:    journal,
+	})
+}
@@ -0,0 +1,153 @@
+package logistics
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+	service "erpfinance/internal/service/logistics"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CarrierHandlerImpl struct {
+	CarrierService service.CarrierService
+}
+
+func NewCarrierHandler(carrierService service.CarrierService) CarrierHandler {
+	return &CarrierHandlerImpl{
+		CarrierService: carrierService,
+	}
+}
+
+// Create godoc
+// @Summary Create carrier
+// @Description Create a carrier; tracking_url may contain the {tracking_number} placeholder
+// @Tags carriers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body logistics.CarrierCreateRequest true "Carrier request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/logistics/carriers [post]
+func (handler *CarrierHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request logistics.CarrierCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	carrier, err := handler.CarrierService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Carrier successfully created",
+		Data:    carrier,
+	})
+}
+
+// Update godoc
+// @Summary Update carrier
+// @Description Update carrier details and status
+// @Tags carriers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Carrier ID (UUID)"
+// @Param request body logistics.CarrierUpdateRequest true "Carrier request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/carriers/{id} [put]
+func (handler *CarrierHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.CarrierUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	carrier, err := handler.CarrierService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Carrier successfully updated",
+		Data:    carrier,
+	})
+}
+
+// FindById godoc
+// @Summary Get carrier by ID
+// @Description Get carrier details
+// @Tags carriers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Carrier ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/carriers/{id} [get]
+func (handler *CarrierHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	carrier, err := handler.CarrierService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Carrier retrieved successfully",
+		Data:    carrier,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all carriers with pagination
+// @Description Get carriers with optional search
+// @Tags carriers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/logistics/carriers [get]
+func (handler *CarrierHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter logistics.CarrierFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CarrierService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Carriers retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
@@ -0,0 +1,18 @@
+package logistics
+
+import "github.com/gofiber/fiber/v2"
+
+type ShipmentHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	PackingList(ctx *fiber.Ctx) error
+	UpdatePacking(ctx *fiber.Ctx) error
+	Pick(ctx *fiber.Ctx) error
+	Ship(ctx *fiber.Ctx) error
+	Deliver(ctx *fiber.Ctx) error
+	Return(ctx *fiber.Ctx) error
+	AddTracking(ctx *fiber.Ctx) error
+	AddAttachment(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,441 @@
+package logistics
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+	service "erpfinance/internal/service/logistics"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShipmentHandlerImpl struct {
+	ShipmentService service.ShipmentService
+}
+
+func NewShipmentHandler(shipmentService service.ShipmentService) ShipmentHandler {
+	return &ShipmentHandlerImpl{
+		ShipmentService: shipmentService,
+	}
+}
+
+// Create godoc
+// @Summary Create shipment
+// @Description Create a planned outbound shipment with lines and packages
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body logistics.ShipmentRequest true "Shipment request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments [post]
+func (handler *ShipmentHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request logistics.ShipmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Shipment successfully created",
+		Data:    shipment,
+	})
+}
+
+// Update godoc
+// @Summary Update shipment
+// @Description Update a planned shipment; lines and packages are replaced
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentRequest true "Shipment request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id} [put]
+func (handler *ShipmentHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment successfully updated",
+		Data:    shipment,
+	})
+}
+
+// FindById godoc
+// @Summary Get shipment by ID
+// @Description Get shipment with lines, packages, tracking events and attachments
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id} [get]
+func (handler *ShipmentHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	shipment, err := handler.ShipmentService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment retrieved successfully",
+		Data:    shipment,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all shipments with pagination
+// @Description Get shipments with optional status, carrier, customer and search filters
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Shipment status (Planned, Picked, Shipped, Delivered, Returned)"
+// @Param carrier_id query string false "Carrier ID (UUID)"
+// @Param customer_id query string false "Customer ID (UUID)"
+// @Param search query string false "Search by number, tracking number, recipient or reference"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments [get]
+func (handler *ShipmentHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter logistics.ShipmentFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ShipmentService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipments retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// PackingList godoc
+// @Summary Get packing list
+// @Description Get shipment lines grouped per package
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/packing-list [get]
+func (handler *ShipmentHandlerImpl) PackingList(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	packingList, err := handler.ShipmentService.PackingList(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Packing list retrieved successfully",
+		Data:    packingList,
+	})
+}
+
+// UpdatePacking godoc
+// @Summary Update packing
+// @Description Replace packages and assign lines to packages before the shipment is shipped
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentPackingRequest true "Packing request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/packing [put]
+func (handler *ShipmentHandlerImpl) UpdatePacking(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentPackingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.UpdatePacking(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment packing successfully updated",
+		Data:    shipment,
+	})
+}
+
+// Pick godoc
+// @Summary Pick shipment
+// @Description Mark a planned shipment as picked
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/pick [post]
+func (handler *ShipmentHandlerImpl) Pick(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	shipment, err := handler.ShipmentService.Pick(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment successfully picked",
+		Data:    shipment,
+	})
+}
+
+// Ship godoc
+// @Summary Ship shipment
+// @Description Hand the shipment over to the carrier and issue its stock
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentShipRequest true "Ship request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/ship [post]
+func (handler *ShipmentHandlerImpl) Ship(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentShipRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.Ship(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment successfully shipped",
+		Data:    shipment,
+	})
+}
+
+// Deliver godoc
+// @Summary Deliver shipment
+// @Description Mark the shipment as delivered with optional proof of delivery
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentDeliverRequest true "Deliver request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/deliver [post]
+func (handler *ShipmentHandlerImpl) Deliver(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentDeliverRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.Deliver(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment successfully delivered",
+		Data:    shipment,
+	})
+}
+
+// Return godoc
+// @Summary Return shipment
+// @Description Mark the shipment as returned and receive its stock back
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentReturnRequest true "Return request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/return [post]
+func (handler *ShipmentHandlerImpl) Return(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentReturnRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.Return(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Shipment successfully returned",
+		Data:    shipment,
+	})
+}
+
+// AddTracking godoc
+// @Summary Add tracking event
+// @Description Record a carrier checkpoint for a shipment in transit
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentTrackingRequest true "Tracking request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/tracking [post]
+func (handler *ShipmentHandlerImpl) AddTracking(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentTrackingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.AddTracking(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tracking event successfully added",
+		Data:    shipment,
+	})
+}
+
+// AddAttachment godoc
+// @Summary Add proof of delivery
+// @Description Attach proof-of-delivery file metadata to a shipped shipment
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Shipment ID (UUID)"
+// @Param request body logistics.ShipmentAttachmentRequest true "Attachment request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/logistics/shipments/{id}/attachments [post]
+func (handler *ShipmentHandlerImpl) AddAttachment(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request logistics.ShipmentAttachmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	shipment, err := handler.ShipmentService.AddAttachment(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Attachment successfully added",
+		Data:    shipment,
+	})
+}
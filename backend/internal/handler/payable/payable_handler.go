@@ -0,0 +1,19 @@
+package payable
+
+import "github.com/gofiber/fiber/v2"
+
+type PayableHandler interface {
+	CreateInvoice(ctx *fiber.Ctx) error
+	UpdateInvoice(ctx *fiber.Ctx) error
+	FindInvoiceById(ctx *fiber.Ctx) error
+	FindAllInvoices(ctx *fiber.Ctx) error
+	MatchInvoice(ctx *fiber.Ctx) error
+	ApproveInvoice(ctx *fiber.Ctx) error
+	CancelInvoice(ctx *fiber.Ctx) error
+
+	GetTolerance(ctx *fiber.Ctx) error
+	UpdateTolerance(ctx *fiber.Ctx) error
+	GetSetting(ctx *fiber.Ctx) error
+	UpdateSetting(ctx *fiber.Ctx) error
+	AgingReport(ctx *fiber.Ctx) error
+}
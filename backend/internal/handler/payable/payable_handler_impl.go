@@ -0,0 +1,388 @@
+package payable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+	service "erpfinance/internal/service/payable"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PayableHandlerImpl struct {
+	PayableService service.PayableService
+}
+
+func NewPayableHandler(payableService service.PayableService) PayableHandler {
+	return &PayableHandlerImpl{
+		PayableService: payableService,
+	}
+}
+
+// CreateInvoice godoc
+// @Summary Create supplier invoice
+// @Description Capture a draft supplier invoice against a purchase order. The three-way match status is calculated on save.
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body payable.SupplierInvoiceRequest true "Supplier invoice request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices [post]
+func (handler *PayableHandlerImpl) CreateInvoice(ctx *fiber.Ctx) error {
+	var request payable.SupplierInvoiceRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoice, err := handler.PayableService.CreateInvoice(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Supplier invoice successfully created",
+		Data:    invoice,
+	})
+}
+
+// UpdateInvoice godoc
+// @Summary Update supplier invoice
+// @Description Update header and lines of a draft supplier invoice
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier invoice ID (UUID)"
+// @Param request body payable.SupplierInvoiceRequest true "Supplier invoice request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/{id} [put]
+func (handler *PayableHandlerImpl) UpdateInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request payable.SupplierInvoiceRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoice, err := handler.PayableService.UpdateInvoice(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier invoice successfully updated",
+		Data:    invoice,
+	})
+}
+
+// FindInvoiceById godoc
+// @Summary Get supplier invoice by ID
+// @Description Get supplier invoice with its lines
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/{id} [get]
+func (handler *PayableHandlerImpl) FindInvoiceById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.PayableService.FindInvoiceById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier invoice retrieved successfully",
+		Data:    invoice,
+	})
+}
+
+// FindAllInvoices godoc
+// @Summary Get all supplier invoices with pagination
+// @Description Get supplier invoices with optional status, match status and search filter
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Invoice status (Draft, Approved, Cancelled)"
+// @Param match_status query string false "Match status (Matched, Variance)"
+// @Param search query string false "Search by number, supplier invoice number or supplier name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices [get]
+func (handler *PayableHandlerImpl) FindAllInvoices(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter payable.SupplierInvoiceFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PayableService.FindAllInvoices(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier invoices retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// MatchInvoice godoc
+// @Summary Get three-way match variance report
+// @Description Compare invoice lines against purchase order prices and received quantities using the configured tolerances
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/{id}/match [get]
+func (handler *PayableHandlerImpl) MatchInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	report, err := handler.PayableService.MatchInvoice(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Variance report retrieved successfully",
+		Data:    report,
+	})
+}
+
+// ApproveInvoice godoc
+// @Summary Approve supplier invoice
+// @Description Approve a draft supplier invoice. Approval is rejected when the three-way match has variances outside tolerance.
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/{id}/approve [post]
+func (handler *PayableHandlerImpl) ApproveInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.PayableService.ApproveInvoice(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier invoice successfully approved",
+		Data:    invoice,
+	})
+}
+
+// CancelInvoice godoc
+// @Summary Cancel supplier invoice
+// @Description Cancel a draft supplier invoice
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/{id}/cancel [post]
+func (handler *PayableHandlerImpl) CancelInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.PayableService.CancelInvoice(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier invoice successfully cancelled",
+		Data:    invoice,
+	})
+}
+
+// GetTolerance godoc
+// @Summary Get three-way match tolerances
+// @Description Get quantity, price and amount tolerances used by the three-way match
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/tolerances [get]
+func (handler *PayableHandlerImpl) GetTolerance(ctx *fiber.Ctx) error {
+	tolerance, err := handler.PayableService.GetTolerance(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Match tolerances retrieved successfully",
+		Data:    tolerance,
+	})
+}
+
+// UpdateTolerance godoc
+// @Summary Update three-way match tolerances
+// @Description Set quantity and price tolerances in percent and the absolute amount tolerance
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body payable.MatchToleranceRequest true "Match tolerance request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/tolerances [put]
+func (handler *PayableHandlerImpl) UpdateTolerance(ctx *fiber.Ctx) error {
+	var request payable.MatchToleranceRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	tolerance, err := handler.PayableService.UpdateTolerance(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Match tolerances successfully updated",
+		Data:    tolerance,
+	})
+}
+
+// GetSetting godoc
+// @Summary Get payable account settings
+// @Description Get the payable control account and purchase account used for automatic journals
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/settings [get]
+func (handler *PayableHandlerImpl) GetSetting(ctx *fiber.Ctx) error {
+	setting, err := handler.PayableService.GetSetting(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payable settings retrieved successfully",
+		Data:    setting,
+	})
+}
+
+// UpdateSetting godoc
+// @Summary Update payable account settings
+// @Description Set the payable control account (liability) and the purchase account debited on invoice approval
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body payable.PayableSettingRequest true "Payable setting request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/settings [put]
+func (handler *PayableHandlerImpl) UpdateSetting(ctx *fiber.Ctx) error {
+	var request payable.PayableSettingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	setting, err := handler.PayableService.UpdateSetting(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payable settings successfully updated",
+		Data:    setting,
+	})
+}
+
+// AgingReport godoc
+// @Summary Get accounts payable aging
+// @Description Get outstanding supplier invoices grouped into current, 1-30, 31-60, 61-90 and over 90 days overdue buckets
+// @Tags supplier-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param as_of query string false "As of date (YYYY-MM-DD, default: today)"
+// @Param supplier_id query string false "Supplier ID (UUID)"
+// @Param currency query string false "Currency code"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/supplier-invoices/aging [get]
+func (handler *PayableHandlerImpl) AgingReport(ctx *fiber.Ctx) error {
+	var filter payable.AgingFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	report, err := handler.PayableService.AgingReport(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payable aging retrieved successfully",
+		Data:    report,
+	})
+}
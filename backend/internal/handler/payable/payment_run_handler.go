@@ -0,0 +1,11 @@
+package payable
+
+import "github.com/gofiber/fiber/v2"
+
+type PaymentRunHandler interface {
+	Create(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Post(ctx *fiber.Ctx) error
+	Cancel(ctx *fiber.Ctx) error
+}
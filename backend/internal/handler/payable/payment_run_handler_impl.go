@@ -0,0 +1,177 @@
+package payable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+	service "erpfinance/internal/service/payable"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PaymentRunHandlerImpl struct {
+	PaymentRunService service.PaymentRunService
+}
+
+func NewPaymentRunHandler(paymentRunService service.PaymentRunService) PaymentRunHandler {
+	return &PaymentRunHandlerImpl{
+		PaymentRunService: paymentRunService,
+	}
+}
+
+// Create godoc
+// @Summary Create payment run
+// @Description Select approved supplier invoices due on or before due_until and group them into one payment batch per supplier
+// @Tags payment-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body payable.PaymentRunRequest true "Payment run request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/payment-runs [post]
+func (handler *PaymentRunHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request payable.PaymentRunRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	run, err := handler.PaymentRunService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Payment run successfully created",
+		Data:    run,
+	})
+}
+
+// FindById godoc
+// @Summary Get payment run by ID
+// @Description Get payment run with its batches and invoices
+// @Tags payment-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Payment run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/payment-runs/{id} [get]
+func (handler *PaymentRunHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	run, err := handler.PaymentRunService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payment run retrieved successfully",
+		Data:    run,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all payment runs with pagination
+// @Description Get payment runs with optional status filter
+// @Tags payment-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Payment run status (Draft, Posted, Cancelled)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/payment-runs [get]
+func (handler *PaymentRunHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter payable.PaymentRunFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PaymentRunService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payment runs retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Post godoc
+// @Summary Post payment run
+// @Description Post one payment journal per batch and mark the paid invoices
+// @Tags payment-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Payment run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/payment-runs/{id}/post [post]
+func (handler *PaymentRunHandlerImpl) Post(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	run, err := handler.PaymentRunService.Post(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payment run successfully posted",
+		Data:    run,
+	})
+}
+
+// Cancel godoc
+// @Summary Cancel payment run
+// @Description Cancel a draft payment run and release its invoices
+// @Tags payment-runs
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Payment run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/payment-runs/{id}/cancel [post]
+func (handler *PaymentRunHandlerImpl) Cancel(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	run, err := handler.PaymentRunService.Cancel(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Payment run successfully cancelled",
+		Data:    run,
+	})
+}
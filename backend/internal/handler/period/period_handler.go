@@ -0,0 +1,11 @@
+package period
+
+import "github.com/gofiber/fiber/v2"
+
+type PeriodHandler interface {
+	CreateFiscalYear(ctx *fiber.Ctx) error
+	FindFiscalYearById(ctx *fiber.Ctx) error
+	FindAllFiscalYears(ctx *fiber.Ctx) error
+	ChangeStatus(ctx *fiber.Ctx) error
+	FindStatusHistory(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,177 @@
+package period
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/period"
+	service "erpfinance/internal/service/period"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PeriodHandlerImpl struct {
+	PeriodService service.PeriodService
+}
+
+func NewPeriodHandler(periodService service.PeriodService) PeriodHandler {
+	return &PeriodHandlerImpl{
+		PeriodService: periodService,
+	}
+}
+
+// CreateFiscalYear godoc
+// @Summary Create fiscal year
+// @Description Create a fiscal year with twelve open monthly periods
+// @Tags periods
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body period.FiscalYearCreateRequest true "Create fiscal year request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/periods/fiscal-years [post]
+func (handler *PeriodHandlerImpl) CreateFiscalYear(ctx *fiber.Ctx) error {
+	var request period.FiscalYearCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	fiscalYear, err := handler.PeriodService.CreateFiscalYear(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Fiscal year successfully created",
+		Data:    fiscalYear,
+	})
+}
+
+// FindFiscalYearById godoc
+// @Summary Get fiscal year by ID
+// @Description Get fiscal year with its monthly periods
+// @Tags periods
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Fiscal year ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/periods/fiscal-years/{id} [get]
+func (handler *PeriodHandlerImpl) FindFiscalYearById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	fiscalYear, err := handler.PeriodService.FindFiscalYearById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fiscal year retrieved successfully",
+		Data:    fiscalYear,
+	})
+}
+
+// FindAllFiscalYears godoc
+// @Summary Get all fiscal years with pagination
+// @Description Get fiscal years with their monthly periods
+// @Tags periods
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/periods/fiscal-years [get]
+func (handler *PeriodHandlerImpl) FindAllFiscalYears(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	paginationResponse, err := handler.PeriodService.FindAllFiscalYears(ctx.Context(), pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Fiscal years retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// ChangeStatus godoc
+// @Summary Change accounting period status
+// @Description Open, soft-close or lock an accounting period. A reason is required and recorded in the period history.
+// @Tags periods
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Accounting period ID (UUID)"
+// @Param request body period.PeriodStatusRequest true "Change status request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/periods/{id}/status [patch]
+func (handler *PeriodHandlerImpl) ChangeStatus(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request period.PeriodStatusRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	updated, err := handler.PeriodService.ChangeStatus(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Accounting period status successfully changed",
+		Data:    updated,
+	})
+}
+
+// FindStatusHistory godoc
+// @Summary Get accounting period status history
+// @Description Get every status change of an accounting period with its reason
+// @Tags periods
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Accounting period ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/periods/{id}/history [get]
+func (handler *PeriodHandlerImpl) FindStatusHistory(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	history, err := handler.PeriodService.FindStatusHistory(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Accounting period history retrieved successfully",
+		Data:    history,
+	})
+}
@@ -0,0 +1,12 @@
+package ppc
+
+import "github.com/gofiber/fiber/v2"
+
+type MRPHandler interface {
+	StartRun(ctx *fiber.Ctx) error
+	FindRunById(ctx *fiber.Ctx) error
+	FindAllRuns(ctx *fiber.Ctx) error
+	FindRunResults(ctx *fiber.Ctx) error
+	FindRunSuggestions(ctx *fiber.Ctx) error
+	ConvertSuggestions(ctx *fiber.Ctx) error
+}
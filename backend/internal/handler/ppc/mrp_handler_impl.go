@@ -0,0 +1,223 @@
+package ppc
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	service "erpfinance/internal/service/ppc"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type MRPHandlerImpl struct {
+	MRPService service.MRPService
+}
+
+func NewMRPHandler(mrpService service.MRPService) MRPHandler {
+	return &MRPHandlerImpl{
+		MRPService: mrpService,
+	}
+}
+
+// StartRun godoc
+// @Summary Start MRP run
+// @Description Queue an MRP run for a warehouse and planning horizon; the calculation runs in the background
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ppc.MRPRunRequest true "MRP run request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs [post]
+func (handler *MRPHandlerImpl) StartRun(ctx *fiber.Ctx) error {
+	var request ppc.MRPRunRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	run, err := handler.MRPService.StartRun(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "MRP run successfully queued",
+		Data:    run,
+	})
+}
+
+// FindRunById godoc
+// @Summary Get MRP run by ID
+// @Description Get MRP run status, progress and demands
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "MRP run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs/{id} [get]
+func (handler *MRPHandlerImpl) FindRunById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	run, err := handler.MRPService.FindRunById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "MRP run retrieved successfully",
+		Data:    run,
+	})
+}
+
+// FindAllRuns godoc
+// @Summary Get all MRP runs with pagination
+// @Description Get MRP runs with optional status filter
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Run status (Queued, Running, Completed, Failed)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs [get]
+func (handler *MRPHandlerImpl) FindAllRuns(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ppc.MRPRunFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.MRPService.FindAllRuns(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "MRP runs retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindRunResults godoc
+// @Summary Get MRP run results
+// @Description Get netting summary per item ordered by low level code
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "MRP run ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs/{id}/results [get]
+func (handler *MRPHandlerImpl) FindRunResults(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	results, err := handler.MRPService.FindRunResults(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "MRP run results retrieved successfully",
+		Data:    results,
+	})
+}
+
+// FindRunSuggestions godoc
+// @Summary Get MRP run suggestions
+// @Description Get suggested purchase requisitions and work orders of a run
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "MRP run ID (UUID)"
+// @Param type query string false "Suggestion type (Purchase, Production)"
+// @Param status query string false "Suggestion status (Open, Converted)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs/{id}/suggestions [get]
+func (handler *MRPHandlerImpl) FindRunSuggestions(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var filter ppc.MRPSuggestionFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	suggestions, err := handler.MRPService.FindRunSuggestions(ctx.Context(), id, filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "MRP suggestions retrieved successfully",
+		Data:    suggestions,
+	})
+}
+
+// ConvertSuggestions godoc
+// @Summary Convert MRP suggestions
+// @Description Convert open suggestions into a draft purchase requisition and draft work orders
+// @Tags mrp
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "MRP run ID (UUID)"
+// @Param request body ppc.MRPConvertRequest true "Convert request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/mrp/runs/{id}/convert [post]
+func (handler *MRPHandlerImpl) ConvertSuggestions(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.MRPConvertRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	conversion, err := handler.MRPService.ConvertSuggestions(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "MRP suggestions successfully converted",
+		Data:    conversion,
+	})
+}
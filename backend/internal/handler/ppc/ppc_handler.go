@@ -0,0 +1,19 @@
+package ppc
+
+import "github.com/gofiber/fiber/v2"
+
+type PPCHandler interface {
+	CreateWorkCenter(ctx *fiber.Ctx) error
+	UpdateWorkCenter(ctx *fiber.Ctx) error
+	FindWorkCenterById(ctx *fiber.Ctx) error
+	FindAllWorkCenters(ctx *fiber.Ctx) error
+	CreateBOM(ctx *fiber.Ctx) error
+	UpdateBOM(ctx *fiber.Ctx) error
+	FindBOMById(ctx *fiber.Ctx) error
+	FindAllBOMs(ctx *fiber.Ctx) error
+	ExplodeBOM(ctx *fiber.Ctx) error
+	CreateRouting(ctx *fiber.Ctx) error
+	UpdateRouting(ctx *fiber.Ctx) error
+	FindRoutingById(ctx *fiber.Ctx) error
+	FindAllRoutings(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,460 @@
+package ppc
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	service "erpfinance/internal/service/ppc"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PPCHandlerImpl struct {
+	PPCService service.PPCService
+}
+
+func NewPPCHandler(ppcService service.PPCService) PPCHandler {
+	return &PPCHandlerImpl{
+		PPCService: ppcService,
+	}
+}
+
+// CreateWorkCenter godoc
+// @Summary Create work center
+// @Description Create a work center with its hourly rate
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ppc.WorkCenterCreateRequest true "Work center request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-centers [post]
+func (handler *PPCHandlerImpl) CreateWorkCenter(ctx *fiber.Ctx) error {
+	var request ppc.WorkCenterCreateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	workCenter, err := handler.PPCService.CreateWorkCenter(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Work center successfully created",
+		Data:    workCenter,
+	})
+}
+
+// UpdateWorkCenter godoc
+// @Summary Update work center
+// @Description Update work center name, hourly rate and status
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work center ID (UUID)"
+// @Param request body ppc.WorkCenterUpdateRequest true "Work center request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-centers/{id} [put]
+func (handler *PPCHandlerImpl) UpdateWorkCenter(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.WorkCenterUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	workCenter, err := handler.PPCService.UpdateWorkCenter(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work center successfully updated",
+		Data:    workCenter,
+	})
+}
+
+// FindWorkCenterById godoc
+// @Summary Get work center by ID
+// @Description Get work center details
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work center ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-centers/{id} [get]
+func (handler *PPCHandlerImpl) FindWorkCenterById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	workCenter, err := handler.PPCService.FindWorkCenterById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work center retrieved successfully",
+		Data:    workCenter,
+	})
+}
+
+// FindAllWorkCenters godoc
+// @Summary Get all work centers with pagination
+// @Description Get work centers with optional search
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-centers [get]
+func (handler *PPCHandlerImpl) FindAllWorkCenters(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ppc.PPCFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PPCService.FindAllWorkCenters(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work centers retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// CreateBOM godoc
+// @Summary Create bill of material
+// @Description Create a new bill of material version for an item; components with their own default BOM become sub-assemblies
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ppc.BillOfMaterialRequest true "Bill of material request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/boms [post]
+func (handler *PPCHandlerImpl) CreateBOM(ctx *fiber.Ctx) error {
+	var request ppc.BillOfMaterialRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	bom, err := handler.PPCService.CreateBOM(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Bill of material successfully created",
+		Data:    bom,
+	})
+}
+
+// UpdateBOM godoc
+// @Summary Update bill of material
+// @Description Update bill of material header and components
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bill of material ID (UUID)"
+// @Param request body ppc.BillOfMaterialRequest true "Bill of material request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/boms/{id} [put]
+func (handler *PPCHandlerImpl) UpdateBOM(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.BillOfMaterialRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	bom, err := handler.PPCService.UpdateBOM(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bill of material successfully updated",
+		Data:    bom,
+	})
+}
+
+// FindBOMById godoc
+// @Summary Get bill of material by ID
+// @Description Get bill of material with its components
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bill of material ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/boms/{id} [get]
+func (handler *PPCHandlerImpl) FindBOMById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	bom, err := handler.PPCService.FindBOMById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bill of material retrieved successfully",
+		Data:    bom,
+	})
+}
+
+// FindAllBOMs godoc
+// @Summary Get all bills of material with pagination
+// @Description Get bills of material with optional item and search filters
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param item_id query string false "Item ID (UUID)"
+// @Param search query string false "Search by item code, item name or description"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ppc/boms [get]
+func (handler *PPCHandlerImpl) FindAllBOMs(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ppc.PPCFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PPCService.FindAllBOMs(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bills of material retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// ExplodeBOM godoc
+// @Summary Explode bill of material
+// @Description Get the multi-level component tree for a quantity with rolled-up standard material cost
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Bill of material ID (UUID)"
+// @Param quantity query number false "Output quantity (default: BOM base quantity)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/boms/{id}/explode [get]
+func (handler *PPCHandlerImpl) ExplodeBOM(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.BOMExplodeRequest
+	if err := ctx.QueryParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	explosion, err := handler.PPCService.ExplodeBOM(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Bill of material exploded successfully",
+		Data:    explosion,
+	})
+}
+
+// CreateRouting godoc
+// @Summary Create routing
+// @Description Create a new routing version for an item
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ppc.RoutingRequest true "Routing request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/routings [post]
+func (handler *PPCHandlerImpl) CreateRouting(ctx *fiber.Ctx) error {
+	var request ppc.RoutingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	routing, err := handler.PPCService.CreateRouting(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Routing successfully created",
+		Data:    routing,
+	})
+}
+
+// UpdateRouting godoc
+// @Summary Update routing
+// @Description Update routing header and operations
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Routing ID (UUID)"
+// @Param request body ppc.RoutingRequest true "Routing request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/routings/{id} [put]
+func (handler *PPCHandlerImpl) UpdateRouting(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.RoutingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	routing, err := handler.PPCService.UpdateRouting(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Routing successfully updated",
+		Data:    routing,
+	})
+}
+
+// FindRoutingById godoc
+// @Summary Get routing by ID
+// @Description Get routing with its operations
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Routing ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/routings/{id} [get]
+func (handler *PPCHandlerImpl) FindRoutingById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	routing, err := handler.PPCService.FindRoutingById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Routing retrieved successfully",
+		Data:    routing,
+	})
+}
+
+// FindAllRoutings godoc
+// @Summary Get all routings with pagination
+// @Description Get routings with optional item and search filters
+// @Tags ppc
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param item_id query string false "Item ID (UUID)"
+// @Param search query string false "Search by item code, item name or description"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ppc/routings [get]
+func (handler *PPCHandlerImpl) FindAllRoutings(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ppc.PPCFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PPCService.FindAllRoutings(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Routings retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
@@ -0,0 +1,15 @@
+package ppc
+
+import "github.com/gofiber/fiber/v2"
+
+type WorkOrderHandler interface {
+	Create(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Release(ctx *fiber.Ctx) error
+	Issue(ctx *fiber.Ctx) error
+	RecordOutput(ctx *fiber.Ctx) error
+	Complete(ctx *fiber.Ctx) error
+	Cancel(ctx *fiber.Ctx) error
+	Cost(ctx *fiber.Ctx) error
+}
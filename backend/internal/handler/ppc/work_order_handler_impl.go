@@ -0,0 +1,317 @@
+package ppc
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	service "erpfinance/internal/service/ppc"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorkOrderHandlerImpl struct {
+	WorkOrderService service.WorkOrderService
+}
+
+func NewWorkOrderHandler(workOrderService service.WorkOrderService) WorkOrderHandler {
+	return &WorkOrderHandlerImpl{
+		WorkOrderService: workOrderService,
+	}
+}
+
+// Create godoc
+// @Summary Create work order
+// @Description Create a draft work order; components and operations are copied from the BOM and routing
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body ppc.WorkOrderRequest true "Work order request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders [post]
+func (handler *WorkOrderHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request ppc.WorkOrderRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	workOrder, err := handler.WorkOrderService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Work order successfully created",
+		Data:    workOrder,
+	})
+}
+
+// FindById godoc
+// @Summary Get work order by ID
+// @Description Get work order with materials, operations and outputs
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id} [get]
+func (handler *WorkOrderHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	workOrder, err := handler.WorkOrderService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work order retrieved successfully",
+		Data:    workOrder,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all work orders with pagination
+// @Description Get work orders with optional status, item and search filters
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Work order status (Draft, Released, InProgress, Completed, Cancelled)"
+// @Param item_id query string false "Item ID (UUID)"
+// @Param search query string false "Search by number or notes"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders [get]
+func (handler *WorkOrderHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter ppc.WorkOrderFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.WorkOrderService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work orders retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Release godoc
+// @Summary Release work order
+// @Description Reserve all required components from the work order warehouse
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/release [post]
+func (handler *WorkOrderHandlerImpl) Release(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	workOrder, err := handler.WorkOrderService.Release(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work order successfully released",
+		Data:    workOrder,
+	})
+}
+
+// Issue godoc
+// @Summary Issue materials
+// @Description Issue components from the warehouse to the work order
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Param request body ppc.WorkOrderIssueRequest true "Issue request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/issue [post]
+func (handler *WorkOrderHandlerImpl) Issue(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.WorkOrderIssueRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	workOrder, err := handler.WorkOrderService.Issue(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Materials successfully issued",
+		Data:    workOrder,
+	})
+}
+
+// RecordOutput godoc
+// @Summary Record production output
+// @Description Record good output into stock, scrap and actual operation time
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Param request body ppc.WorkOrderOutputRequest true "Output request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/output [post]
+func (handler *WorkOrderHandlerImpl) RecordOutput(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request ppc.WorkOrderOutputRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	workOrder, err := handler.WorkOrderService.RecordOutput(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Production output successfully recorded",
+		Data:    workOrder,
+	})
+}
+
+// Complete godoc
+// @Summary Complete work order
+// @Description Complete the work order and release unused reservations
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/complete [post]
+func (handler *WorkOrderHandlerImpl) Complete(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	workOrder, err := handler.WorkOrderService.Complete(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work order successfully completed",
+		Data:    workOrder,
+	})
+}
+
+// Cancel godoc
+// @Summary Cancel work order
+// @Description Cancel a draft or released work order without issued materials or output
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/cancel [post]
+func (handler *WorkOrderHandlerImpl) Cancel(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	workOrder, err := handler.WorkOrderService.Cancel(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work order successfully cancelled",
+		Data:    workOrder,
+	})
+}
+
+// Cost godoc
+// @Summary Get work order cost
+// @Description Compare actual material and labor cost with standard cost
+// @Tags work-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Work order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/ppc/work-orders/{id}/cost [get]
+func (handler *WorkOrderHandlerImpl) Cost(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	cost, err := handler.WorkOrderService.Cost(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Work order cost retrieved successfully",
+		Data:    cost,
+	})
+}
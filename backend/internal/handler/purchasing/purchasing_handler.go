@@ -0,0 +1,24 @@
+package purchasing
+
+import "github.com/gofiber/fiber/v2"
+
+type PurchasingHandler interface {
+	CreateRequisition(ctx *fiber.Ctx) error
+	UpdateRequisition(ctx *fiber.Ctx) error
+	FindRequisitionById(ctx *fiber.Ctx) error
+	FindAllRequisitions(ctx *fiber.Ctx) error
+	SubmitRequisition(ctx *fiber.Ctx) error
+	ApproveRequisition(ctx *fiber.Ctx) error
+	RejectRequisition(ctx *fiber.Ctx) error
+	ConvertRequisition(ctx *fiber.Ctx) error
+
+	CreatePurchaseOrder(ctx *fiber.Ctx) error
+	UpdatePurchaseOrder(ctx *fiber.Ctx) error
+	FindPurchaseOrderById(ctx *fiber.Ctx) error
+	FindAllPurchaseOrders(ctx *fiber.Ctx) error
+	ApprovePurchaseOrder(ctx *fiber.Ctx) error
+	SendPurchaseOrder(ctx *fiber.Ctx) error
+	ReceivePurchaseOrder(ctx *fiber.Ctx) error
+	ClosePurchaseOrder(ctx *fiber.Ctx) error
+	CancelPurchaseOrder(ctx *fiber.Ctx) error
+}
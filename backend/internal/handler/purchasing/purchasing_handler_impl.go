@@ -0,0 +1,586 @@
+package purchasing
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/purchasing"
+	service "erpfinance/internal/service/purchasing"
+	receivingService "erpfinance/internal/service/receiving"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PurchasingHandlerImpl struct {
+	PurchasingService   service.PurchasingService
+	GoodsReceiptService receivingService.GoodsReceiptService
+}
+
+func NewPurchasingHandler(purchasingService service.PurchasingService, goodsReceiptService receivingService.GoodsReceiptService) PurchasingHandler {
+	return &PurchasingHandlerImpl{
+		PurchasingService:   purchasingService,
+		GoodsReceiptService: goodsReceiptService,
+	}
+}
+
+// CreateRequisition godoc
+// @Summary Create purchase requisition
+// @Description Raise a new draft purchase requisition
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body purchasing.RequisitionRequest true "Requisition request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions [post]
+func (handler *PurchasingHandlerImpl) CreateRequisition(ctx *fiber.Ctx) error {
+	var request purchasing.RequisitionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	requisition, err := handler.PurchasingService.CreateRequisition(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Purchase requisition successfully created",
+		Data:    requisition,
+	})
+}
+
+// UpdateRequisition godoc
+// @Summary Update purchase requisition
+// @Description Update header and lines of a draft purchase requisition
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Param request body purchasing.RequisitionRequest true "Requisition request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id} [put]
+func (handler *PurchasingHandlerImpl) UpdateRequisition(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request purchasing.RequisitionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	requisition, err := handler.PurchasingService.UpdateRequisition(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisition successfully updated",
+		Data:    requisition,
+	})
+}
+
+// FindRequisitionById godoc
+// @Summary Get purchase requisition by ID
+// @Description Get purchase requisition with its lines
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id} [get]
+func (handler *PurchasingHandlerImpl) FindRequisitionById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	requisition, err := handler.PurchasingService.FindRequisitionById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisition retrieved successfully",
+		Data:    requisition,
+	})
+}
+
+// FindAllRequisitions godoc
+// @Summary Get all purchase requisitions with pagination
+// @Description Get purchase requisitions with optional status filter and search
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Requisition status (Draft, Submitted, Approved, Rejected, Converted)"
+// @Param search query string false "Search by number or notes"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions [get]
+func (handler *PurchasingHandlerImpl) FindAllRequisitions(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter purchasing.PurchasingFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PurchasingService.FindAllRequisitions(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisitions retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// SubmitRequisition godoc
+// @Summary Submit purchase requisition
+// @Description Submit a draft purchase requisition for approval
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id}/submit [post]
+func (handler *PurchasingHandlerImpl) SubmitRequisition(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	requisition, err := handler.PurchasingService.SubmitRequisition(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisition successfully submitted",
+		Data:    requisition,
+	})
+}
+
+// ApproveRequisition godoc
+// @Summary Approve purchase requisition
+// @Description Approve a submitted purchase requisition
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id}/approve [post]
+func (handler *PurchasingHandlerImpl) ApproveRequisition(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	requisition, err := handler.PurchasingService.ApproveRequisition(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisition successfully approved",
+		Data:    requisition,
+	})
+}
+
+// RejectRequisition godoc
+// @Summary Reject purchase requisition
+// @Description Reject a submitted purchase requisition with a reason
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Param request body purchasing.RequisitionRejectRequest true "Reject requisition request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id}/reject [post]
+func (handler *PurchasingHandlerImpl) RejectRequisition(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request purchasing.RequisitionRejectRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	requisition, err := handler.PurchasingService.RejectRequisition(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase requisition successfully rejected",
+		Data:    requisition,
+	})
+}
+
+// ConvertRequisition godoc
+// @Summary Convert purchase requisition to purchase order
+// @Description Create a draft purchase order from an approved purchase requisition
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Requisition ID (UUID)"
+// @Param request body purchasing.RequisitionConvertRequest true "Convert requisition request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/requisitions/{id}/convert [post]
+func (handler *PurchasingHandlerImpl) ConvertRequisition(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request purchasing.RequisitionConvertRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.PurchasingService.ConvertRequisition(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Purchase requisition successfully converted to purchase order",
+		Data:    order,
+	})
+}
+
+// CreatePurchaseOrder godoc
+// @Summary Create purchase order
+// @Description Create a new draft purchase order
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body purchasing.PurchaseOrderRequest true "Purchase order request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders [post]
+func (handler *PurchasingHandlerImpl) CreatePurchaseOrder(ctx *fiber.Ctx) error {
+	var request purchasing.PurchaseOrderRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.PurchasingService.CreatePurchaseOrder(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Purchase order successfully created",
+		Data:    order,
+	})
+}
+
+// UpdatePurchaseOrder godoc
+// @Summary Update purchase order
+// @Description Update header and lines of a draft purchase order
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Param request body purchasing.PurchaseOrderRequest true "Purchase order request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id} [put]
+func (handler *PurchasingHandlerImpl) UpdatePurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request purchasing.PurchaseOrderRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.PurchasingService.UpdatePurchaseOrder(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order successfully updated",
+		Data:    order,
+	})
+}
+
+// FindPurchaseOrderById godoc
+// @Summary Get purchase order by ID
+// @Description Get purchase order with its lines and received quantities
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id} [get]
+func (handler *PurchasingHandlerImpl) FindPurchaseOrderById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.PurchasingService.FindPurchaseOrderById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order retrieved successfully",
+		Data:    order,
+	})
+}
+
+// FindAllPurchaseOrders godoc
+// @Summary Get all purchase orders with pagination
+// @Description Get purchase orders with optional status filter and search
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Purchase order status (Draft, Approved, Sent, PartiallyReceived, Closed, Cancelled)"
+// @Param search query string false "Search by number or supplier name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders [get]
+func (handler *PurchasingHandlerImpl) FindAllPurchaseOrders(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter purchasing.PurchasingFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.PurchasingService.FindAllPurchaseOrders(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase orders retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// ApprovePurchaseOrder godoc
+// @Summary Approve purchase order
+// @Description Approve a draft purchase order
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id}/approve [post]
+func (handler *PurchasingHandlerImpl) ApprovePurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.PurchasingService.ApprovePurchaseOrder(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order successfully approved",
+		Data:    order,
+	})
+}
+
+// SendPurchaseOrder godoc
+// @Summary Send purchase order
+// @Description Mark an approved purchase order as sent to the supplier
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id}/send [post]
+func (handler *PurchasingHandlerImpl) SendPurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.PurchasingService.SendPurchaseOrder(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order successfully sent",
+		Data:    order,
+	})
+}
+
+// ReceivePurchaseOrder godoc
+// @Summary Receive purchase order
+// @Description Record received quantities as a goods receipt into the given warehouse. The order is closed once every line is fully received. Prefer POST /api/v1/goods-receipts for new integrations.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Param request body purchasing.PurchaseOrderReceiveRequest true "Receive purchase order request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id}/receive [post]
+func (handler *PurchasingHandlerImpl) ReceivePurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request purchasing.PurchaseOrderReceiveRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.GoodsReceiptService.ReceivePurchaseOrder(ctx.Context(), helper.CurrentUserID(ctx), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order receipt successfully recorded",
+		Data:    order,
+	})
+}
+
+// ClosePurchaseOrder godoc
+// @Summary Close purchase order
+// @Description Close a partially received purchase order without receiving the remaining quantity
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id}/close [post]
+func (handler *PurchasingHandlerImpl) ClosePurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.PurchasingService.ClosePurchaseOrder(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order successfully closed",
+		Data:    order,
+	})
+}
+
+// CancelPurchaseOrder godoc
+// @Summary Cancel purchase order
+// @Description Cancel a draft or approved purchase order
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/purchasing/orders/{id}/cancel [post]
+func (handler *PurchasingHandlerImpl) CancelPurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.PurchasingService.CancelPurchaseOrder(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order successfully cancelled",
+		Data:    order,
+	})
+}
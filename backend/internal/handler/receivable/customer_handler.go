@@ -0,0 +1,11 @@
+package receivable
+
+import "github.com/gofiber/fiber/v2"
+
+type CustomerHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Statement(ctx *fiber.Ctx) error
+}
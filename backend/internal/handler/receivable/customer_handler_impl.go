@@ -0,0 +1,192 @@
+package receivable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	service "erpfinance/internal/service/receivable"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CustomerHandlerImpl struct {
+	CustomerService service.CustomerService
+}
+
+func NewCustomerHandler(customerService service.CustomerService) CustomerHandler {
+	return &CustomerHandlerImpl{
+		CustomerService: customerService,
+	}
+}
+
+// Create godoc
+// @Summary Create customer
+// @Description Create a new customer
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body receivable.CustomerRequest true "Customer request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/customers [post]
+func (handler *CustomerHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request receivable.CustomerRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	customer, err := handler.CustomerService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Customer successfully created",
+		Data:    customer,
+	})
+}
+
+// Update godoc
+// @Summary Update customer
+// @Description Update customer master data
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer ID (UUID)"
+// @Param request body receivable.CustomerRequest true "Customer request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customers/{id} [put]
+func (handler *CustomerHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request receivable.CustomerRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	customer, err := handler.CustomerService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer successfully updated",
+		Data:    customer,
+	})
+}
+
+// FindById godoc
+// @Summary Get customer by ID
+// @Description Get customer master data
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customers/{id} [get]
+func (handler *CustomerHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	customer, err := handler.CustomerService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer retrieved successfully",
+		Data:    customer,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all customers with pagination
+// @Description Get customers with optional search and active filter
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code, name or NPWP"
+// @Param is_active query bool false "Filter by active flag"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/customers [get]
+func (handler *CustomerHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter receivable.CustomerFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CustomerService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customers retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Statement godoc
+// @Summary Get customer statement
+// @Description Get opening balance, posted invoices and receipts with running balance, closing balance and open invoices for a date range
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer ID (UUID)"
+// @Param date_from query string false "Start date (YYYY-MM-DD, default: first day of the date_to month)"
+// @Param date_to query string false "End date (YYYY-MM-DD, default: today)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customers/{id}/statement [get]
+func (handler *CustomerHandlerImpl) Statement(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request receivable.CustomerStatementRequest
+	if err := ctx.QueryParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	statement, err := handler.CustomerService.Statement(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer statement retrieved successfully",
+		Data:    statement,
+	})
+}
@@ -0,0 +1,12 @@
+package receivable
+
+import "github.com/gofiber/fiber/v2"
+
+type CustomerReceiptHandler interface {
+	Create(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Post(ctx *fiber.Ctx) error
+	Cancel(ctx *fiber.Ctx) error
+	Allocate(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,219 @@
+package receivable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	service "erpfinance/internal/service/receivable"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CustomerReceiptHandlerImpl struct {
+	CustomerReceiptService service.CustomerReceiptService
+}
+
+func NewCustomerReceiptHandler(customerReceiptService service.CustomerReceiptService) CustomerReceiptHandler {
+	return &CustomerReceiptHandlerImpl{
+		CustomerReceiptService: customerReceiptService,
+	}
+}
+
+// Create godoc
+// @Summary Create customer receipt
+// @Description Create a draft customer receipt, optionally allocated across several sales invoices
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body receivable.CustomerReceiptRequest true "Customer receipt request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts [post]
+func (handler *CustomerReceiptHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request receivable.CustomerReceiptRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	receipt, err := handler.CustomerReceiptService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Customer receipt successfully created",
+		Data:    receipt,
+	})
+}
+
+// FindById godoc
+// @Summary Get customer receipt by ID
+// @Description Get customer receipt with its allocations
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer receipt ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts/{id} [get]
+func (handler *CustomerReceiptHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	receipt, err := handler.CustomerReceiptService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer receipt retrieved successfully",
+		Data:    receipt,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all customer receipts with pagination
+// @Description Get customer receipts with optional status, customer, unapplied and search filters
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Receipt status (Draft, Posted, Cancelled)"
+// @Param customer_id query string false "Customer ID (UUID)"
+// @Param unapplied query bool false "Only posted receipts with unapplied credit"
+// @Param search query string false "Search by number, reference or customer name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts [get]
+func (handler *CustomerReceiptHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter receivable.CustomerReceiptFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.CustomerReceiptService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer receipts retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Post godoc
+// @Summary Post customer receipt
+// @Description Post the receipt journal and settle the allocated sales invoices; any unallocated amount becomes customer credit
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer receipt ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts/{id}/post [post]
+func (handler *CustomerReceiptHandlerImpl) Post(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	receipt, err := handler.CustomerReceiptService.Post(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer receipt successfully posted",
+		Data:    receipt,
+	})
+}
+
+// Cancel godoc
+// @Summary Cancel customer receipt
+// @Description Cancel a draft customer receipt
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer receipt ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts/{id}/cancel [post]
+func (handler *CustomerReceiptHandlerImpl) Cancel(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	receipt, err := handler.CustomerReceiptService.Cancel(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer receipt successfully cancelled",
+		Data:    receipt,
+	})
+}
+
+// Allocate godoc
+// @Summary Allocate customer credit
+// @Description Allocate the unapplied credit of a posted receipt to open sales invoices
+// @Tags customer-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer receipt ID (UUID)"
+// @Param request body receivable.ReceiptAllocateRequest true "Receipt allocation request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/customer-receipts/{id}/allocate [post]
+func (handler *CustomerReceiptHandlerImpl) Allocate(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request receivable.ReceiptAllocateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	receipt, err := handler.CustomerReceiptService.Allocate(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer receipt successfully allocated",
+		Data:    receipt,
+	})
+}
@@ -0,0 +1,15 @@
+package receivable
+
+import "github.com/gofiber/fiber/v2"
+
+type ReceivableHandler interface {
+	CreateInvoice(ctx *fiber.Ctx) error
+	UpdateInvoice(ctx *fiber.Ctx) error
+	FindInvoiceById(ctx *fiber.Ctx) error
+	FindAllInvoices(ctx *fiber.Ctx) error
+	PostInvoice(ctx *fiber.Ctx) error
+	CancelInvoice(ctx *fiber.Ctx) error
+	GetSetting(ctx *fiber.Ctx) error
+	UpdateSetting(ctx *fiber.Ctx) error
+	AgingReport(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,304 @@
+package receivable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	service "erpfinance/internal/service/receivable"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReceivableHandlerImpl struct {
+	ReceivableService service.ReceivableService
+}
+
+func NewReceivableHandler(receivableService service.ReceivableService) ReceivableHandler {
+	return &ReceivableHandlerImpl{
+		ReceivableService: receivableService,
+	}
+}
+
+// CreateInvoice godoc
+// @Summary Create sales invoice
+// @Description Create a draft sales invoice with line items and taxes
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body receivable.SalesInvoiceRequest true "Sales invoice request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices [post]
+func (handler *ReceivableHandlerImpl) CreateInvoice(ctx *fiber.Ctx) error {
+	var request receivable.SalesInvoiceRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoice, err := handler.ReceivableService.CreateInvoice(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Sales invoice successfully created",
+		Data:    invoice,
+	})
+}
+
+// UpdateInvoice godoc
+// @Summary Update sales invoice
+// @Description Update header, lines and taxes of a draft sales invoice
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales invoice ID (UUID)"
+// @Param request body receivable.SalesInvoiceRequest true "Sales invoice request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/{id} [put]
+func (handler *ReceivableHandlerImpl) UpdateInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request receivable.SalesInvoiceRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoice, err := handler.ReceivableService.UpdateInvoice(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales invoice successfully updated",
+		Data:    invoice,
+	})
+}
+
+// FindInvoiceById godoc
+// @Summary Get sales invoice by ID
+// @Description Get sales invoice with its lines and taxes
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/{id} [get]
+func (handler *ReceivableHandlerImpl) FindInvoiceById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.ReceivableService.FindInvoiceById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales invoice retrieved successfully",
+		Data:    invoice,
+	})
+}
+
+// FindAllInvoices godoc
+// @Summary Get all sales invoices with pagination
+// @Description Get sales invoices with optional status, customer and search filters
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Invoice status (Draft, Posted, Paid, Cancelled)"
+// @Param customer_id query string false "Customer ID (UUID)"
+// @Param search query string false "Search by number, reference or customer name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices [get]
+func (handler *ReceivableHandlerImpl) FindAllInvoices(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter receivable.SalesInvoiceFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.ReceivableService.FindAllInvoices(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales invoices retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// PostInvoice godoc
+// @Summary Post sales invoice
+// @Description Post the receivable journal of a draft sales invoice
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/{id}/post [post]
+func (handler *ReceivableHandlerImpl) PostInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.ReceivableService.PostInvoice(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales invoice successfully posted",
+		Data:    invoice,
+	})
+}
+
+// CancelInvoice godoc
+// @Summary Cancel sales invoice
+// @Description Cancel a draft sales invoice
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales invoice ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/{id}/cancel [post]
+func (handler *ReceivableHandlerImpl) CancelInvoice(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	invoice, err := handler.ReceivableService.CancelInvoice(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales invoice successfully cancelled",
+		Data:    invoice,
+	})
+}
+
+// GetSetting godoc
+// @Summary Get receivable account settings
+// @Description Get the receivable control account and default revenue account used for automatic journals
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/settings [get]
+func (handler *ReceivableHandlerImpl) GetSetting(ctx *fiber.Ctx) error {
+	setting, err := handler.ReceivableService.GetSetting(ctx.Context())
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Receivable settings retrieved successfully",
+		Data:    setting,
+	})
+}
+
+// UpdateSetting godoc
+// @Summary Update receivable account settings
+// @Description Set the receivable control account (asset) and the default revenue account credited on invoice posting
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body receivable.ReceivableSettingRequest true "Receivable setting request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/settings [put]
+func (handler *ReceivableHandlerImpl) UpdateSetting(ctx *fiber.Ctx) error {
+	var request receivable.ReceivableSettingRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	setting, err := handler.ReceivableService.UpdateSetting(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Receivable settings successfully updated",
+		Data:    setting,
+	})
+}
+
+// AgingReport godoc
+// @Summary Get accounts receivable aging
+// @Description Get outstanding sales invoices grouped into current, 1-30, 31-60, 61-90 and over 90 days overdue buckets, net of unapplied customer credits
+// @Tags sales-invoices
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param as_of query string false "As of date (YYYY-MM-DD, default: today)"
+// @Param customer_id query string false "Customer ID (UUID)"
+// @Param currency query string false "Currency code"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/sales-invoices/aging [get]
+func (handler *ReceivableHandlerImpl) AgingReport(ctx *fiber.Ctx) error {
+	var filter receivable.AgingFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	report, err := handler.ReceivableService.AgingReport(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Receivable aging retrieved successfully",
+		Data:    report,
+	})
+}
@@ -0,0 +1,10 @@
+package receiving
+
+import "github.com/gofiber/fiber/v2"
+
+type GoodsReceiptHandler interface {
+	Create(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	FindPurchaseOrder(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,148 @@
+package receiving
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receiving"
+	service "erpfinance/internal/service/receiving"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GoodsReceiptHandlerImpl struct {
+	GoodsReceiptService service.GoodsReceiptService
+}
+
+func NewGoodsReceiptHandler(goodsReceiptService service.GoodsReceiptService) GoodsReceiptHandler {
+	return &GoodsReceiptHandlerImpl{
+		GoodsReceiptService: goodsReceiptService,
+	}
+}
+
+// Create godoc
+// @Summary Create goods receipt
+// @Description Record goods received against a sent purchase order. Stock lines are posted to inventory.
+// @Tags goods-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body receiving.GoodsReceiptRequest true "Goods receipt request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/goods-receipts [post]
+func (handler *GoodsReceiptHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request receiving.GoodsReceiptRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	receipt, err := handler.GoodsReceiptService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Goods receipt successfully recorded",
+		Data:    receipt,
+	})
+}
+
+// FindById godoc
+// @Summary Get goods receipt by ID
+// @Description Get goods receipt with ordered vs received quantity per line
+// @Tags goods-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Goods receipt ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/goods-receipts/{id} [get]
+func (handler *GoodsReceiptHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	receipt, err := handler.GoodsReceiptService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Goods receipt retrieved successfully",
+		Data:    receipt,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all goods receipts with pagination
+// @Description Get goods receipts with optional purchase order filter and search
+// @Tags goods-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param purchase_order_id query string false "Purchase order ID (UUID)"
+// @Param search query string false "Search by number or delivery note"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/goods-receipts [get]
+func (handler *GoodsReceiptHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter receiving.GoodsReceiptFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.GoodsReceiptService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Goods receipts retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// FindPurchaseOrder godoc
+// @Summary Get purchase order to receive
+// @Description Get purchase order lines with received and outstanding quantities
+// @Tags goods-receipts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/goods-receipts/purchase-orders/{id} [get]
+func (handler *GoodsReceiptHandlerImpl) FindPurchaseOrder(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.GoodsReceiptService.FindPurchaseOrder(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Purchase order retrieved successfully",
+		Data:    order,
+	})
+}
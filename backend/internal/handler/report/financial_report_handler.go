@@ -0,0 +1,10 @@
+package report
+
+import "github.com/gofiber/fiber/v2"
+
+type FinancialReportHandler interface {
+	TrialBalance(ctx *fiber.Ctx) error
+	BalanceSheet(ctx *fiber.Ctx) error
+	IncomeStatement(ctx *fiber.Ctx) error
+	CashFlow(ctx *fiber.Ctx) error
+}
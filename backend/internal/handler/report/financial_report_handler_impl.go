@@ -0,0 +1,162 @@
+package report
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/report"
+	service "erpfinance/internal/service/report"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FinancialReportHandlerImpl struct {
+	FinancialReportService service.FinancialReportService
+}
+
+func NewFinancialReportHandler(financialReportService service.FinancialReportService) FinancialReportHandler {
+	return &FinancialReportHandlerImpl{
+		FinancialReportService: financialReportService,
+	}
+}
+
+// TrialBalance godoc
+// @Summary Get trial balance
+// @Description Get opening balance, period debit and credit and closing balance per account with header accounts rolled up, optionally with a comparative period
+// @Tags financial-reports
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param date_from query string true "Period start date (YYYY-MM-DD)"
+// @Param date_to query string true "Period end date (YYYY-MM-DD)"
+// @Param compare_date_from query string false "Comparative period start date (YYYY-MM-DD)"
+// @Param compare_date_to query string false "Comparative period end date (YYYY-MM-DD)"
+// @Param max_level query int false "Deepest account level to show, sub-accounts roll up into their parent (0 = all levels)"
+// @Param include_zero query bool false "Include accounts without balance"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/reports/financial/trial-balance [get]
+func (handler *FinancialReportHandlerImpl) TrialBalance(ctx *fiber.Ctx) error {
+	var filter report.FinancialReportRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	trialBalance, err := handler.FinancialReportService.TrialBalance(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Trial balance retrieved successfully",
+		Data:    trialBalance,
+	})
+}
+
+// BalanceSheet godoc
+// @Summary Get balance sheet
+// @Description Get assets, liabilities and equity as of a date, including profit and loss not yet closed to retained earnings, optionally with a comparative date
+// @Tags financial-reports
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param as_of query string true "Balance sheet date (YYYY-MM-DD)"
+// @Param compare_as_of query string false "Comparative date (YYYY-MM-DD)"
+// @Param max_level query int false "Deepest account level to show, sub-accounts roll up into their parent (0 = all levels)"
+// @Param include_zero query bool false "Include accounts without balance"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/reports/financial/balance-sheet [get]
+func (handler *FinancialReportHandlerImpl) BalanceSheet(ctx *fiber.Ctx) error {
+	var filter report.BalanceSheetRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	balanceSheet, err := handler.FinancialReportService.BalanceSheet(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Balance sheet retrieved successfully",
+		Data:    balanceSheet,
+	})
+}
+
+// IncomeStatement godoc
+// @Summary Get income statement
+// @Description Get revenue, expenses and net income for a period, optionally with a comparative period
+// @Tags financial-reports
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param date_from query string true "Period start date (YYYY-MM-DD)"
+// @Param date_to query string true "Period end date (YYYY-MM-DD)"
+// @Param compare_date_from query string false "Comparative period start date (YYYY-MM-DD)"
+// @Param compare_date_to query string false "Comparative period end date (YYYY-MM-DD)"
+// @Param max_level query int false "Deepest account level to show, sub-accounts roll up into their parent (0 = all levels)"
+// @Param include_zero query bool false "Include accounts without balance"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/reports/financial/income-statement [get]
+func (handler *FinancialReportHandlerImpl) IncomeStatement(ctx *fiber.Ctx) error {
+	var filter report.FinancialReportRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	incomeStatement, err := handler.FinancialReportService.IncomeStatement(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Income statement retrieved successfully",
+		Data:    incomeStatement,
+	})
+}
+
+// CashFlow godoc
+// @Summary Get cash flow statement
+// @Description Get an indirect-method cash flow statement: net income adjusted by changes in non-cash balance sheet accounts grouped by their cash flow category
+// @Tags financial-reports
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param date_from query string true "Period start date (YYYY-MM-DD)"
+// @Param date_to query string true "Period end date (YYYY-MM-DD)"
+// @Param compare_date_from query string false "Comparative period start date (YYYY-MM-DD)"
+// @Param compare_date_to query string false "Comparative period end date (YYYY-MM-DD)"
+// @Param max_level query int false "Deepest account level to show, sub-accounts roll up into their parent (0 = all levels)"
+// @Param include_zero query bool false "Include accounts without balance"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/reports/financial/cash-flow [get]
+func (handler *FinancialReportHandlerImpl) CashFlow(ctx *fiber.Ctx) error {
+	var filter report.FinancialReportRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	cashFlow, err := handler.FinancialReportService.CashFlow(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Cash flow statement retrieved successfully",
+		Data:    cashFlow,
+	})
+}
@@ -0,0 +1,14 @@
+package sales
+
+import "github.com/gofiber/fiber/v2"
+
+type SalesOrderHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	Confirm(ctx *fiber.Ctx) error
+	OverrideCredit(ctx *fiber.Ctx) error
+	Cancel(ctx *fiber.Ctx) error
+	CustomerCredit(ctx *fiber.Ctx) error
+}
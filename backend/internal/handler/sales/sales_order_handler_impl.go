@@ -0,0 +1,286 @@
+package sales
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/sales"
+	service "erpfinance/internal/service/sales"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SalesOrderHandlerImpl struct {
+	SalesOrderService service.SalesOrderService
+}
+
+func NewSalesOrderHandler(salesOrderService service.SalesOrderService) SalesOrderHandler {
+	return &SalesOrderHandlerImpl{
+		SalesOrderService: salesOrderService,
+	}
+}
+
+// Create godoc
+// @Summary Create sales order
+// @Description Create a draft sales order with priced, discounted and taxed lines
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body sales.SalesOrderRequest true "Sales order request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders [post]
+func (handler *SalesOrderHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request sales.SalesOrderRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.SalesOrderService.Create(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Sales order successfully created",
+		Data:    order,
+	})
+}
+
+// Update godoc
+// @Summary Update sales order
+// @Description Update a draft or credit hold sales order; lines are replaced and the order returns to draft
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales order ID (UUID)"
+// @Param request body sales.SalesOrderRequest true "Sales order request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders/{id} [put]
+func (handler *SalesOrderHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request sales.SalesOrderRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.SalesOrderService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales order successfully updated",
+		Data:    order,
+	})
+}
+
+// FindById godoc
+// @Summary Get sales order by ID
+// @Description Get sales order with lines and fulfilment status
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders/{id} [get]
+func (handler *SalesOrderHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.SalesOrderService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales order retrieved successfully",
+		Data:    order,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all sales orders with pagination
+// @Description Get sales orders with optional status, customer and search filters
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param status query string false "Order status (Draft, CreditHold, Confirmed, PartiallyFulfilled, Fulfilled, Cancelled)"
+// @Param customer_id query string false "Customer ID (UUID)"
+// @Param search query string false "Search by number, customer name or reference"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/sales/orders [get]
+func (handler *SalesOrderHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter sales.SalesOrderFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.SalesOrderService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales orders retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// Confirm godoc
+// @Summary Confirm sales order
+// @Description Check the customer credit limit; the order is confirmed or placed on credit hold when the limit is exceeded
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders/{id}/confirm [post]
+func (handler *SalesOrderHandlerImpl) Confirm(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.SalesOrderService.Confirm(ctx.Context(), id, helper.CurrentUserID(ctx))
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales order confirmation processed",
+		Data:    order,
+	})
+}
+
+// OverrideCredit godoc
+// @Summary Override credit hold
+// @Description Approve a sales order on credit hold (Finance only)
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales order ID (UUID)"
+// @Param request body sales.CreditOverrideRequest true "Credit override request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders/{id}/credit-override [post]
+func (handler *SalesOrderHandlerImpl) OverrideCredit(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request sales.CreditOverrideRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	order, err := handler.SalesOrderService.OverrideCredit(ctx.Context(), id, helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales order credit hold successfully overridden",
+		Data:    order,
+	})
+}
+
+// Cancel godoc
+// @Summary Cancel sales order
+// @Description Cancel a sales order that has not been shipped yet
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/orders/{id}/cancel [post]
+func (handler *SalesOrderHandlerImpl) Cancel(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	order, err := handler.SalesOrderService.Cancel(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Sales order successfully cancelled",
+		Data:    order,
+	})
+}
+
+// CustomerCredit godoc
+// @Summary Get customer credit status
+// @Description Get credit limit, open receivable, open orders and available credit of a customer
+// @Tags sales-customers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Customer ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/sales/customers/{id}/credit [get]
+func (handler *SalesOrderHandlerImpl) CustomerCredit(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	credit, err := handler.SalesOrderService.CustomerCredit(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Customer credit retrieved successfully",
+		Data:    credit,
+	})
+}
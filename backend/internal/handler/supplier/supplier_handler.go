@@ -0,0 +1,11 @@
+package supplier
+
+import "github.com/gofiber/fiber/v2"
+
+type SupplierHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Update(ctx *fiber.Ctx) error
+	FindById(ctx *fiber.Ctx) error
+	FindAll(ctx *fiber.Ctx) error
+	ChangeStatus(ctx *fiber.Ctx) error
+}
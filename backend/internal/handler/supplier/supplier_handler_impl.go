@@ -0,0 +1,190 @@
+package supplier
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/supplier"
+	service "erpfinance/internal/service/supplier"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SupplierHandlerImpl struct {
+	SupplierService service.SupplierService
+}
+
+func NewSupplierHandler(supplierService service.SupplierService) SupplierHandler {
+	return &SupplierHandlerImpl{
+		SupplierService: supplierService,
+	}
+}
+
+// Create godoc
+// @Summary Create supplier
+// @Description Register a new supplier with its addresses, contacts and bank accounts
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body supplier.SupplierRequest true "Supplier request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/suppliers [post]
+func (handler *SupplierHandlerImpl) Create(ctx *fiber.Ctx) error {
+	var request supplier.SupplierRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	created, err := handler.SupplierService.Create(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Supplier successfully created",
+		Data:    created,
+	})
+}
+
+// Update godoc
+// @Summary Update supplier
+// @Description Update supplier data. Addresses, contacts and bank accounts are replaced with the ones sent.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier ID (UUID)"
+// @Param request body supplier.SupplierRequest true "Supplier request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/suppliers/{id} [put]
+func (handler *SupplierHandlerImpl) Update(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request supplier.SupplierRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	updated, err := handler.SupplierService.Update(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier successfully updated",
+		Data:    updated,
+	})
+}
+
+// FindById godoc
+// @Summary Get supplier by ID
+// @Description Get supplier with its addresses, contacts and bank accounts
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/suppliers/{id} [get]
+func (handler *SupplierHandlerImpl) FindById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	result, err := handler.SupplierService.FindById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier retrieved successfully",
+		Data:    result,
+	})
+}
+
+// FindAll godoc
+// @Summary Get all suppliers with pagination
+// @Description Get suppliers with optional search and status filter
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param search query string false "Search by code, name or NPWP"
+// @Param status query string false "Supplier status (Active, Blocked)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/suppliers [get]
+func (handler *SupplierHandlerImpl) FindAll(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter supplier.SupplierFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.SupplierService.FindAll(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Suppliers retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// ChangeStatus godoc
+// @Summary Change supplier status
+// @Description Activate or block a supplier. A reason is required when blocking.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Supplier ID (UUID)"
+// @Param request body supplier.SupplierStatusRequest true "Change status request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/suppliers/{id}/status [patch]
+func (handler *SupplierHandlerImpl) ChangeStatus(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request supplier.SupplierStatusRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	updated, err := handler.SupplierService.ChangeStatus(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Supplier status successfully changed",
+		Data:    updated,
+	})
+}
@@ -0,0 +1,15 @@
+package tax
+
+import "github.com/gofiber/fiber/v2"
+
+type TaxHandler interface {
+	CreateTaxCode(ctx *fiber.Ctx) error
+	UpdateTaxCode(ctx *fiber.Ctx) error
+	FindTaxCodeById(ctx *fiber.Ctx) error
+	FindAllTaxCodes(ctx *fiber.Ctx) error
+	CreateInvoiceRange(ctx *fiber.Ctx) error
+	UpdateInvoiceRange(ctx *fiber.Ctx) error
+	FindAllInvoiceRanges(ctx *fiber.Ctx) error
+	WithholdingReport(ctx *fiber.Ctx) error
+	ExportEFaktur(ctx *fiber.Ctx) error
+}
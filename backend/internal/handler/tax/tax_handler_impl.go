@@ -0,0 +1,319 @@
+package tax
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/tax"
+	service "erpfinance/internal/service/tax"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TaxHandlerImpl struct {
+	TaxService service.TaxService
+}
+
+func NewTaxHandler(taxService service.TaxService) TaxHandler {
+	return &TaxHandlerImpl{
+		TaxService: taxService,
+	}
+}
+
+// CreateTaxCode godoc
+// @Summary Create tax code
+// @Description Register a tax code such as PPN or PPh 23/4(2) with its rate, DPP factor and tax accounts
+// @Tags tax-codes
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body tax.TaxCodeRequest true "Tax code request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/taxes/codes [post]
+func (handler *TaxHandlerImpl) CreateTaxCode(ctx *fiber.Ctx) error {
+	var request tax.TaxCodeRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	taxCode, err := handler.TaxService.CreateTaxCode(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Tax code successfully created",
+		Data:    taxCode,
+	})
+}
+
+// UpdateTaxCode godoc
+// @Summary Update tax code
+// @Description Update a tax code; posted invoices keep the rate they were posted with
+// @Tags tax-codes
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Tax code ID (UUID)"
+// @Param request body tax.TaxCodeUpdateRequest true "Tax code request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/taxes/codes/{id} [put]
+func (handler *TaxHandlerImpl) UpdateTaxCode(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request tax.TaxCodeUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	taxCode, err := handler.TaxService.UpdateTaxCode(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tax code successfully updated",
+		Data:    taxCode,
+	})
+}
+
+// FindTaxCodeById godoc
+// @Summary Get tax code by ID
+// @Description Get tax code details
+// @Tags tax-codes
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Tax code ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/taxes/codes/{id} [get]
+func (handler *TaxHandlerImpl) FindTaxCodeById(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	taxCode, err := handler.TaxService.FindTaxCodeById(ctx.Context(), id)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tax code retrieved successfully",
+		Data:    taxCode,
+	})
+}
+
+// FindAllTaxCodes godoc
+// @Summary Get all tax codes with pagination
+// @Description Get tax codes with optional type, active and search filters
+// @Tags tax-codes
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param type query string false "Tax type (PPN, PPH23, PPH4_2, OTHER)"
+// @Param active_only query bool false "Only active tax codes"
+// @Param search query string false "Search by code or name"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/taxes/codes [get]
+func (handler *TaxHandlerImpl) FindAllTaxCodes(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter tax.TaxCodeFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.TaxService.FindAllTaxCodes(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tax codes retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// CreateInvoiceRange godoc
+// @Summary Create tax invoice number range
+// @Description Register a tax invoice serial number range (NSFP) allocated by the tax office for a tax year
+// @Tags tax-invoice-ranges
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body tax.TaxInvoiceRangeRequest true "Tax invoice range request"
+// @Success 201 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Router /api/v1/taxes/invoice-ranges [post]
+func (handler *TaxHandlerImpl) CreateInvoiceRange(ctx *fiber.Ctx) error {
+	var request tax.TaxInvoiceRangeRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoiceRange, err := handler.TaxService.CreateInvoiceRange(ctx.Context(), helper.CurrentUserID(ctx), request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dto.WebResponse{
+		Code:    fiber.StatusCreated,
+		Status:  "CREATED",
+		Message: "Tax invoice range successfully created",
+		Data:    invoiceRange,
+	})
+}
+
+// UpdateInvoiceRange godoc
+// @Summary Update tax invoice number range
+// @Description Activate or deactivate a tax invoice number range
+// @Tags tax-invoice-ranges
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Tax invoice range ID (UUID)"
+// @Param request body tax.TaxInvoiceRangeUpdateRequest true "Tax invoice range request"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/taxes/invoice-ranges/{id} [put]
+func (handler *TaxHandlerImpl) UpdateInvoiceRange(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	var request tax.TaxInvoiceRangeUpdateRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid request body format.")
+	}
+
+	invoiceRange, err := handler.TaxService.UpdateInvoiceRange(ctx.Context(), id, request)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tax invoice range successfully updated",
+		Data:    invoiceRange,
+	})
+}
+
+// FindAllInvoiceRanges godoc
+// @Summary Get all tax invoice number ranges with pagination
+// @Description Get tax invoice number ranges with remaining numbers
+// @Tags tax-invoice-ranges
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 20, max: 100)"
+// @Param year query int false "Tax year"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/taxes/invoice-ranges [get]
+func (handler *TaxHandlerImpl) FindAllInvoiceRanges(ctx *fiber.Ctx) error {
+	pagination := helper.PaginationFromQuery(ctx)
+
+	var filter tax.TaxInvoiceRangeFilterRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	paginationResponse, err := handler.TaxService.FindAllInvoiceRanges(ctx.Context(), filter, pagination)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Tax invoice ranges retrieved successfully",
+		Data:    paginationResponse,
+	})
+}
+
+// WithholdingReport godoc
+// @Summary Withholding tax report
+// @Description List PPh withheld on approved supplier invoices with totals per tax type in IDR
+// @Tags tax-reports
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param date_from query string true "Start date (YYYY-MM-DD)"
+// @Param date_to query string true "End date (YYYY-MM-DD)"
+// @Param tax_type query string false "Tax type (PPH23 or PPH4_2)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/taxes/withholdings [get]
+func (handler *TaxHandlerImpl) WithholdingReport(ctx *fiber.Ctx) error {
+	var filter tax.TaxPeriodRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	report, err := handler.TaxService.WithholdingReport(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "Withholding tax report retrieved successfully",
+		Data:    report,
+	})
+}
+
+// ExportEFaktur godoc
+// @Summary Export e-Faktur CSV
+// @Description Download output VAT invoices posted in the date range in the e-Faktur CSV import layout (FK, LT and OF rows); amounts are converted to IDR at the invoice rate
+// @Tags tax-reports
+// @Accept json
+// @Produce text/csv
+// @Param Authorization header string true "Bearer token"
+// @Param date_from query string true "Start date (YYYY-MM-DD)"
+// @Param date_to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.WebResponse
+// @Failure 500 {object} dto.WebResponse
+// @Router /api/v1/taxes/e-faktur/export [get]
+func (handler *TaxHandlerImpl) ExportEFaktur(ctx *fiber.Ctx) error {
+	var filter tax.TaxPeriodRequest
+	if err := ctx.QueryParser(&filter); err != nil {
+		return helper.BadRequestResponse(ctx, "Invalid query parameters.")
+	}
+
+	content, filename, err := handler.TaxService.ExportEFaktur(ctx.Context(), filter)
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Attachment(filename)
+	return ctx.Status(fiber.StatusOK).Send(content)
+}
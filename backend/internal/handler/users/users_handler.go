@@ -7,4 +7,6 @@ type UsersHandler interface {
 	FindById(ctx *fiber.Ctx) error
 	Update(ctx *fiber.Ctx) error
 	Delete(ctx *fiber.Ctx) error
+	Deactivate(ctx *fiber.Ctx) error
+	Activate(ctx *fiber.Ctx) error
 }
@@ -1,6 +1,7 @@
 package users
 
 import (
+	"erpfinance/internal/helper"
 	"erpfinance/internal/model/dto"
 	"erpfinance/internal/model/dto/users"
 	service "erpfinance/internal/service/users"
@@ -225,4 +226,62 @@ func (handler *UsersHandlerImpl) Delete(ctx *fiber.Ctx) error {
 		Status:  "OK",
 		Message: "User successfully deleted",
 	})
-}
\ No newline at end of file
+}
+
+// Deactivate godoc
+// @Summary Deactivate user
+// @Description Block login for a user and immediately reject all of their access and refresh tokens
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/users/{id}/deactivate [post]
+func (handler *UsersHandlerImpl) Deactivate(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	if err := handler.UsersService.Deactivate(ctx.Context(), id, helper.CurrentUserID(ctx)); err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "User successfully deactivated",
+	})
+}
+
+// Activate godoc
+// @Summary Activate user
+// @Description Allow a deactivated user to login again
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} dto.WebResponse
+// @Failure 400 {object} dto.WebResponse
+// @Failure 404 {object} dto.WebResponse
+// @Router /api/v1/users/{id}/activate [post]
+func (handler *UsersHandlerImpl) Activate(ctx *fiber.Ctx) error {
+	id, err := helper.ParamUUID(ctx, "id")
+	if err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	if err := handler.UsersService.Activate(ctx.Context(), id); err != nil {
+		return helper.ErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(dto.WebResponse{
+		Code:    fiber.StatusOK,
+		Status:  "OK",
+		Message: "User successfully activated",
+	})
+}
@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+func init() {
+	// Nominal uang tetap diserialisasi sebagai angka JSON, bukan string
+	decimal.MarshalJSONWithoutQuotes = true
+}
+
+// RoundAmount membulatkan nominal uang ke 2 angka desimal
+func RoundAmount(amount decimal.Decimal) decimal.Decimal {
+	return amount.Round(2)
+}
+
+// IsZeroAmount memeriksa apakah nominal bernilai nol setelah dibulatkan
+func IsZeroAmount(amount decimal.Decimal) bool {
+	return RoundAmount(amount).IsZero()
+}
+
+// MultiplyAmount mengalikan nominal dengan faktor non-uang (kuantitas, kurs, jumlah bulan)
+// lalu membulatkannya ke 2 angka desimal
+func MultiplyAmount(amount decimal.Decimal, factor float64) decimal.Decimal {
+	return RoundAmount(amount.Mul(decimal.NewFromFloat(factor)))
+}
+
+// DivideAmount membagi nominal dengan pembagi non-uang lalu membulatkannya ke 2 angka desimal.
+// Pembagi nol menghasilkan nol.
+func DivideAmount(amount decimal.Decimal, divisor float64) decimal.Decimal {
+	if divisor == 0 {
+		return decimal.Zero
+	}
+	return RoundAmount(amount.Div(decimal.NewFromFloat(divisor)))
+}
+
+// PercentOfAmount menghitung persentase dari nominal, dibulatkan ke 2 angka desimal
+func PercentOfAmount(amount decimal.Decimal, percent float64) decimal.Decimal {
+	return RoundAmount(amount.Mul(decimal.NewFromFloat(percent)).Div(hundred))
+}
+
+// RoundMeasure membulatkan nilai non-uang seperti persen, menit, atau dimensi ke 2 angka desimal
+func RoundMeasure(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// RoundQuantity membulatkan kuantitas barang ke 4 angka desimal
+func RoundQuantity(quantity float64) float64 {
+	return math.Round(quantity*10000) / 10000
+}
+
+// RoundRate membulatkan kurs valas ke 8 angka desimal
+func RoundRate(rate float64) float64 {
+	return math.Round(rate*100000000) / 100000000
+}
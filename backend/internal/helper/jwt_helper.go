@@ -2,7 +2,6 @@ package helper
 
 import (
 	"erpfinance/internal/model/domain"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,41 +13,75 @@ type JWTClaim struct {
 	Name  string      `json:"name"`
 	Email string      `json:"email"`
 	Role  domain.Role `json:"role"`
+	// SessionID adalah family refresh token (satu sesi per perangkat) tempat token diterbitkan
+	SessionID uuid.UUID `json:"sid,omitempty"`
+	// TokenUse membedakan access dan refresh token yang ditandatangani dengan kunci yang sama
+	TokenUse string `json:"token_use"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(user domain.Users) (accessToken string, refreshToken string, err error) {
+// AccessTokenTTL adalah masa berlaku access token; entri denylist cukup disimpan selama ini
+const AccessTokenTTL = 15 * time.Minute
+
+// JWTIssuer diisi di klaim iss dan wajib diperiksa service lain yang memverifikasi token lewat JWKS
+const JWTIssuer = "erpfinance"
+
+const (
+	tokenUseAccess  = "access"
+	tokenUseRefresh = "refresh"
+)
+
+// jwtAlgorithms adalah satu-satunya algoritma yang diterima saat verifikasi
+var jwtAlgorithms = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+
+func GenerateJWT(user domain.Users, sessionID uuid.UUID) (accessToken string, refreshToken string, err error) {
+	key, err := signingKey()
+	if err != nil {
+		return "", "", err
+	}
+
 	// Access Token
 	accessClaims := JWTClaim{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Role:  user.Role,
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      user.Role,
+		SessionID: sessionID,
+		TokenUse:  tokenUseAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(15 * time.Minute)),
+			// jti dipakai untuk mencabut satu access token (denylist)
+			ID:        uuid.NewString(),
+			Issuer:    JWTIssuer,
+			Subject:   user.ID.String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
 	// Create Access Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessToken, err = token.SignedString([]byte(os.Getenv("JWT_SECRET_KEY")))
+	accessToken, err = signToken(key, accessClaims)
 	if err != nil {
 		return "", "", err
 	}
 
 	// Refresh Token
 	refreshClaims := JWTClaim{
-		ID: user.ID,
+		ID:        user.ID,
+		SessionID: sessionID,
+		TokenUse:  tokenUseRefresh,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// jti membuat setiap refresh token unik walau diterbitkan pada detik yang sama, karena
+			// token lama tetap disimpan setelah rotasi
+			ID:        uuid.NewString(),
+			Issuer:    JWTIssuer,
+			Subject:   user.ID.String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)), // 7 days
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
 	// Create Refresh Token
-	refreshTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	refreshToken, err = refreshTokenObj.SignedString([]byte(os.Getenv("JWT_REFRESH_KEY")))
+	refreshToken, err = signToken(key, refreshClaims)
 	if err != nil {
 		return "", "", err
 	}
@@ -57,16 +90,11 @@ func GenerateJWT(user domain.Users) (accessToken string, refreshToken string, er
 }
 
 func ValidateToken(tokenString string, isRefreshToken bool) (*JWTClaim, error) {
-	var secretKey string
-	if isRefreshToken {
-		secretKey = os.Getenv("JWT_REFRESH_KEY")
-	} else {
-		secretKey = os.Getenv("JWT_SECRET_KEY")
-	}
-
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaim{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaim{}, verificationKey,
+		jwt.WithValidMethods(jwtAlgorithms),
+		jwt.WithIssuer(JWTIssuer),
+		jwt.WithExpirationRequired(),
+	)
 
 	if err != nil {
 		return nil, err
@@ -77,5 +105,21 @@ func ValidateToken(tokenString string, isRefreshToken bool) (*JWTClaim, error) {
 		return nil, jwt.ErrInvalidKey
 	}
 
+	// Refresh token tidak boleh dipakai sebagai access token, begitu juga sebaliknya
+	expectedUse := tokenUseAccess
+	if isRefreshToken {
+		expectedUse = tokenUseRefresh
+	}
+	if claims.TokenUse != expectedUse {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
 	return claims, nil
 }
+
+// signToken menandatangani klaim dengan kunci aktif dan mencantumkan kid di header
+func signToken(key *JWTKey, claims JWTClaim) (string, error) {
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.KeyID
+	return token.SignedString(key.PrivateKey)
+}
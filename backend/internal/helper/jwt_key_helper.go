@@ -0,0 +1,181 @@
+package helper
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"erpfinance/internal/model/dto/auth"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits adalah ukuran minimum kunci RSA untuk RS256
+const minRSAKeyBits = 2048
+
+// JWTKey adalah satu kunci penandatangan JWT yang dikenali dari KeyID (header kid). PrivateKey nil
+// berarti kunci lama yang hanya dipakai verifikasi sampai semua token yang ditandatanganinya kedaluwarsa.
+type JWTKey struct {
+	KeyID      string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// JWTKeySet berisi semua kunci yang diterima saat verifikasi dan satu kunci aktif untuk menandatangani
+type JWTKeySet struct {
+	active *JWTKey
+	keys   map[string]*JWTKey
+}
+
+var jwtKeySet *JWTKeySet
+
+// SetJWTKeySet dipanggil sekali saat startup sebelum token pertama diterbitkan
+func SetJWTKeySet(keySet *JWTKeySet) {
+	jwtKeySet = keySet
+}
+
+// NewJWTKeySet memastikan kid unik dan kunci aktif memiliki private key
+func NewJWTKeySet(keys []*JWTKey, activeKeyID string) (*JWTKeySet, error) {
+	keySet := &JWTKeySet{keys: make(map[string]*JWTKey, len(keys))}
+	for _, key := range keys {
+		if _, exists := keySet.keys[key.KeyID]; exists {
+			return nil, fmt.Errorf("duplicate JWT key id %q", key.KeyID)
+		}
+		keySet.keys[key.KeyID] = key
+	}
+
+	active, ok := keySet.keys[activeKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active JWT key %q not found", activeKeyID)
+	}
+	if active.PrivateKey == nil {
+		return nil, fmt.Errorf("active JWT key %q has no private key", activeKeyID)
+	}
+	keySet.active = active
+
+	return keySet, nil
+}
+
+// ParseJWTKeyPEM membaca private key RSA/Ed25519 (PKCS#8 atau PKCS#1) atau public key (PKIX)
+func ParseJWTKeyPEM(keyID string, data []byte) (*JWTKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT key %q is not PEM encoded", keyID)
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("JWT key %q has unsupported PEM type %q", keyID, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("JWT key %q: %w", keyID, err)
+	}
+
+	key := &JWTKey{KeyID: keyID}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method, key.PublicKey = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Method, key.PublicKey = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("JWT key %q must be an RSA or Ed25519 key", keyID)
+	}
+
+	if rsaKey, ok := key.PublicKey.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("JWT key %q: RSA keys must be at least %d bits", keyID, minRSAKeyBits)
+	}
+	return key, nil
+}
+
+// GenerateEphemeralJWTKey membuat kunci Ed25519 di memori untuk development tanpa file kunci
+func GenerateEphemeralJWTKey() (*JWTKey, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWTKey{
+		KeyID:      "ephemeral-" + base64.RawURLEncoding.EncodeToString(publicKey[:6]),
+		Method:     jwt.SigningMethodEdDSA,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}, nil
+}
+
+// JWKS mengembalikan public key semua kunci, termasuk kunci lama yang masih dipakai verifikasi
+func JWKS() auth.JWKSResponse {
+	response := auth.JWKSResponse{Keys: []auth.JSONWebKey{}}
+	if jwtKeySet == nil {
+		return response
+	}
+
+	for _, key := range jwtKeySet.keys {
+		jwk := auth.JSONWebKey{
+			Use:       "sig",
+			Algorithm: key.Method.Alg(),
+			KeyID:     key.KeyID,
+		}
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+		response.Keys = append(response.Keys, jwk)
+	}
+
+	sort.Slice(response.Keys, func(i, j int) bool {
+		return response.Keys[i].KeyID < response.Keys[j].KeyID
+	})
+	return response
+}
+
+// signingKey mengembalikan kunci aktif untuk token baru
+func signingKey() (*JWTKey, error) {
+	if jwtKeySet == nil {
+		return nil, errors.New("JWT signing keys are not initialized")
+	}
+	return jwtKeySet.active, nil
+}
+
+// verificationKey mencari kunci berdasarkan header kid dan memastikan header alg sama persis dengan
+// algoritma kunci tersebut, sehingga token dengan alg lain (none, HS256, dll.) selalu ditolak
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	if jwtKeySet == nil {
+		return nil, errors.New("JWT signing keys are not initialized")
+	}
+
+	keyID, _ := token.Header["kid"].(string)
+	key, ok := jwtKeySet.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown JWT key id %q", keyID)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected JWT algorithm %q for key %q", token.Method.Alg(), keyID)
+	}
+	return key.PublicKey, nil
+}
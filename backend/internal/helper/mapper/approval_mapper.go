@@ -0,0 +1,138 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/approval"
+)
+
+func ToApprovalRuleResponse(r domain.ApprovalRule) *approval.ApprovalRuleResponse {
+	response := &approval.ApprovalRuleResponse{
+		ID:           r.ID,
+		Code:         r.Code,
+		Name:         r.Name,
+		DocumentType: r.DocumentType,
+		MinAmount:    r.MinAmount,
+		MaxAmount:    r.MaxAmount,
+		Priority:     r.Priority,
+		IsActive:     r.IsActive,
+		Description:  r.Description,
+		CreatedAt:    helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:    helper.FormatTimeIndonesia(r.UpdatedAt),
+		Steps:        make([]approval.ApprovalRuleStepResponse, 0, len(r.Steps)),
+	}
+	for _, step := range r.Steps {
+		response.Steps = append(response.Steps, approval.ApprovalRuleStepResponse{
+			ID:             step.ID,
+			Sequence:       step.Sequence,
+			Name:           step.Name,
+			ApproverRole:   step.ApproverRole,
+			ApproverUserID: step.ApproverUserID,
+			MinAmount:      step.MinAmount,
+		})
+	}
+	return response
+}
+
+func ToApprovalRuleResponses(r []domain.ApprovalRule) []approval.ApprovalRuleResponse {
+	var ruleResponses []approval.ApprovalRuleResponse
+	for _, rule := range r {
+		ruleResponses = append(ruleResponses, *ToApprovalRuleResponse(rule))
+	}
+	return ruleResponses
+}
+
+func ToApprovalRequestResponse(r domain.ApprovalRequest) *approval.ApprovalRequestResponse {
+	response := &approval.ApprovalRequestResponse{
+		ID:              r.ID,
+		DocumentType:    r.DocumentType,
+		DocumentID:      r.DocumentID,
+		DocumentNumber:  r.DocumentNumber,
+		Amount:          r.Amount,
+		RuleID:          r.RuleID,
+		Status:          r.Status,
+		CurrentSequence: r.CurrentSequence,
+		RequestedBy:     r.RequestedBy,
+		DecidedBy:       r.DecidedBy,
+		CompletedAt:     formatOptionalTime(r.CompletedAt),
+		Comment:         r.Comment,
+		CreatedAt:       helper.FormatTimeIndonesia(r.CreatedAt),
+	}
+	if r.Rule != nil {
+		response.RuleCode = r.Rule.Code
+		response.RuleName = r.Rule.Name
+	}
+	for _, task := range r.Tasks {
+		response.Tasks = append(response.Tasks, approval.ApprovalTaskResponse{
+			ID:             task.ID,
+			Sequence:       task.Sequence,
+			Name:           task.Name,
+			ApproverRole:   task.ApproverRole,
+			ApproverUserID: task.ApproverUserID,
+			Status:         task.Status,
+			ActedBy:        task.ActedBy,
+			OnBehalfOf:     task.OnBehalfOf,
+			ActedAt:        formatOptionalTime(task.ActedAt),
+			Comment:        task.Comment,
+		})
+	}
+	return response
+}
+
+// ToApprovalRequestResponses dipakai untuk daftar request: task tidak ikut dikirim
+func ToApprovalRequestResponses(r []domain.ApprovalRequest) []approval.ApprovalRequestResponse {
+	var requestResponses []approval.ApprovalRequestResponse
+	for _, request := range r {
+		request.Tasks = nil
+		requestResponses = append(requestResponses, *ToApprovalRequestResponse(request))
+	}
+	return requestResponses
+}
+
+func ToApprovalInboxResponses(t []domain.ApprovalTask) []approval.ApprovalInboxItemResponse {
+	var inboxResponses []approval.ApprovalInboxItemResponse
+	for _, task := range t {
+		item := approval.ApprovalInboxItemResponse{
+			TaskID:         task.ID,
+			TaskName:       task.Name,
+			Sequence:       task.Sequence,
+			ApproverRole:   task.ApproverRole,
+			ApproverUserID: task.ApproverUserID,
+			RequestID:      task.RequestID,
+		}
+		if task.Request != nil {
+			item.DocumentType = task.Request.DocumentType
+			item.DocumentID = task.Request.DocumentID
+			item.DocumentNumber = task.Request.DocumentNumber
+			item.Amount = task.Request.Amount
+			item.RequestedBy = task.Request.RequestedBy
+			item.RequestedAt = helper.FormatTimeIndonesia(task.Request.CreatedAt)
+		}
+		inboxResponses = append(inboxResponses, item)
+	}
+	return inboxResponses
+}
+
+func ToApprovalDelegationResponse(d domain.ApprovalDelegation) *approval.ApprovalDelegationResponse {
+	return &approval.ApprovalDelegationResponse{
+		ID:            d.ID,
+		DelegatorID:   d.DelegatorID,
+		DelegatorName: d.Delegator.Name,
+		DelegateID:    d.DelegateID,
+		DelegateName:  d.Delegate.Name,
+		DocumentType:  d.DocumentType,
+		StartDate:     helper.FormatDate(d.StartDate),
+		EndDate:       helper.FormatDate(d.EndDate),
+		Reason:        d.Reason,
+		IsActive:      d.IsActive,
+		CreatedAt:     helper.FormatTimeIndonesia(d.CreatedAt),
+	}
+}
+
+func ToApprovalDelegationResponses(d []domain.ApprovalDelegation) []approval.ApprovalDelegationResponse {
+	var delegationResponses []approval.ApprovalDelegationResponse
+	for _, delegation := range d {
+		delegationResponses = append(delegationResponses, *ToApprovalDelegationResponse(delegation))
+	}
+	return delegationResponses
+}
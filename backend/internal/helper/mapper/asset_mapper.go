@@ -0,0 +1,126 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/asset"
+)
+
+func ToAssetCategoryResponse(c domain.AssetCategory) *asset.AssetCategoryResponse {
+	return &asset.AssetCategoryResponse{
+		ID:                               c.ID,
+		Code:                             c.Code,
+		Name:                             c.Name,
+		Method:                           c.Method,
+		UsefulLifeMonths:                 c.UsefulLifeMonths,
+		DecliningFactor:                  c.DecliningFactor,
+		AssetAccountID:                   c.AssetAccountID,
+		AccumulatedDepreciationAccountID: c.AccumulatedDepreciationAccountID,
+		DepreciationExpenseAccountID:     c.DepreciationExpenseAccountID,
+		DisposalGainLossAccountID:        c.DisposalGainLossAccountID,
+		IsActive:                         c.IsActive,
+		CreatedAt:                        helper.FormatTimeIndonesia(c.CreatedAt),
+		UpdatedAt:                        helper.FormatTimeIndonesia(c.UpdatedAt),
+	}
+}
+
+func ToAssetCategoryResponses(c []domain.AssetCategory) []asset.AssetCategoryResponse {
+	var categoryResponses []asset.AssetCategoryResponse
+	for _, category := range c {
+		categoryResponses = append(categoryResponses, *ToAssetCategoryResponse(category))
+	}
+	return categoryResponses
+}
+
+func ToFixedAssetResponse(a domain.FixedAsset) *asset.FixedAssetResponse {
+	response := &asset.FixedAssetResponse{
+		ID:                      a.ID,
+		Number:                  a.Number,
+		Name:                    a.Name,
+		Description:             a.Description,
+		SerialNumber:            a.SerialNumber,
+		CategoryID:              a.CategoryID,
+		CategoryCode:            a.Category.Code,
+		CategoryName:            a.Category.Name,
+		Location:                a.Location,
+		AcquisitionDate:         helper.FormatDate(a.AcquisitionDate),
+		DepreciationStartDate:   helper.FormatDate(a.DepreciationStartDate),
+		AcquisitionCost:         a.AcquisitionCost,
+		SalvageValue:            a.SalvageValue,
+		UsefulLifeMonths:        a.UsefulLifeMonths,
+		Method:                  a.Method,
+		DecliningFactor:         a.DecliningFactor,
+		AccumulatedDepreciation: a.AccumulatedDepreciation,
+		BookValue:               helper.RoundAmount(a.BookValue()),
+		DepreciatedMonths:       a.DepreciatedMonths,
+		Status:                  a.Status,
+		AcquisitionEntryID:      a.AcquisitionEntryID,
+		DisposalProceeds:        a.DisposalProceeds,
+		DisposalGainLoss:        a.DisposalGainLoss,
+		DisposalEntryID:         a.DisposalEntryID,
+		Notes:                   a.Notes,
+		CreatedBy:               a.CreatedBy,
+		CreatedAt:               helper.FormatTimeIndonesia(a.CreatedAt),
+		UpdatedAt:               helper.FormatTimeIndonesia(a.UpdatedAt),
+	}
+	if a.LastDepreciationDate != nil {
+		response.LastDepreciationDate = helper.FormatDate(*a.LastDepreciationDate)
+	}
+	if a.DisposalDate != nil {
+		response.DisposalDate = helper.FormatDate(*a.DisposalDate)
+	}
+	for _, transfer := range a.Transfers {
+		response.Transfers = append(response.Transfers, asset.FixedAssetTransferResponse{
+			ID:           transfer.ID,
+			TransferDate: helper.FormatDate(transfer.TransferDate),
+			FromLocation: transfer.FromLocation,
+			ToLocation:   transfer.ToLocation,
+			Notes:        transfer.Notes,
+			CreatedBy:    transfer.CreatedBy,
+			CreatedAt:    helper.FormatTimeIndonesia(transfer.CreatedAt),
+		})
+	}
+	return response
+}
+
+func ToFixedAssetResponses(a []domain.FixedAsset) []asset.FixedAssetResponse {
+	var assetResponses []asset.FixedAssetResponse
+	for _, fixedAsset := range a {
+		assetResponses = append(assetResponses, *ToFixedAssetResponse(fixedAsset))
+	}
+	return assetResponses
+}
+
+func ToDepreciationRunResponse(r domain.DepreciationRun) *asset.DepreciationRunResponse {
+	response := &asset.DepreciationRunResponse{
+		ID:             r.ID,
+		Number:         r.Number,
+		PeriodEnd:      helper.FormatDate(r.PeriodEnd),
+		TotalAmount:    r.TotalAmount,
+		JournalEntryID: r.JournalEntryID,
+		Notes:          r.Notes,
+		CreatedBy:      r.CreatedBy,
+		CreatedAt:      helper.FormatTimeIndonesia(r.CreatedAt),
+	}
+	for _, line := range r.Lines {
+		response.Lines = append(response.Lines, asset.DepreciationRunLineResponse{
+			ID:               line.ID,
+			FixedAssetID:     line.FixedAssetID,
+			AssetNumber:      line.AssetNumber,
+			AssetName:        line.AssetName,
+			Months:           line.Months,
+			Amount:           line.Amount,
+			AccumulatedAfter: line.AccumulatedAfter,
+			BookValueAfter:   line.BookValueAfter,
+		})
+	}
+	return response
+}
+
+func ToDepreciationRunResponses(r []domain.DepreciationRun) []asset.DepreciationRunResponse {
+	var runResponses []asset.DepreciationRunResponse
+	for _, run := range r {
+		runResponses = append(runResponses, *ToDepreciationRunResponse(run))
+	}
+	return runResponses
+}
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"encoding/json"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/audit"
+	"time"
+)
+
+func ToAuditLogResponse(l domain.AuditLog) *audit.AuditLogResponse {
+	return &audit.AuditLogResponse{
+		ID:         l.ID,
+		Action:     l.Action,
+		EntityType: l.EntityType,
+		EntityID:   l.EntityID,
+		ActorID:    l.ActorID,
+		ActorEmail: l.ActorEmail,
+		ActorRole:  l.ActorRole,
+		IPAddress:  l.IPAddress,
+		OldValues:  rawJSON(l.OldValues),
+		NewValues:  rawJSON(l.NewValues),
+		// Audit log butuh jam lengkap, bukan hanya tanggal seperti FormatTimeIndonesia
+		CreatedAt: l.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToAuditLogResponses(l []domain.AuditLog) []audit.AuditLogResponse {
+	var auditLogResponses []audit.AuditLogResponse
+	for _, auditLog := range l {
+		auditLogResponses = append(auditLogResponses, *ToAuditLogResponse(auditLog))
+	}
+	return auditLogResponses
+}
+
+func rawJSON(value *string) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	return json.RawMessage(*value)
+}
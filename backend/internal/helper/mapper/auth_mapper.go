@@ -4,6 +4,9 @@ import (
 	"erpfinance/internal/helper"
 	"erpfinance/internal/model/domain"
 	"erpfinance/internal/model/dto/auth"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func ToAuthResponse(user domain.Users) *auth.AuthResponse {
@@ -16,3 +19,37 @@ func ToAuthResponse(user domain.Users) *auth.AuthResponse {
 		UpdatedAt: helper.FormatTimeIndonesia(user.UpdatedAt),
 	}
 }
+
+func ToTwoFactorPolicyResponse(policy domain.TwoFactorPolicy) auth.TwoFactorPolicyResponse {
+	response := auth.TwoFactorPolicyResponse{
+		Role:      policy.Role,
+		Required:  policy.Required,
+		UpdatedBy: policy.UpdatedBy,
+	}
+	if !policy.UpdatedAt.IsZero() {
+		response.UpdatedAt = helper.FormatTimeIndonesia(policy.UpdatedAt)
+	}
+	return response
+}
+
+func ToSessionResponse(token domain.RefreshToken, currentSessionID uuid.UUID) auth.SessionResponse {
+	return auth.SessionResponse{
+		ID:         token.FamilyID,
+		DeviceName: token.DeviceName,
+		UserAgent:  token.UserAgent,
+		IPAddress:  token.IPAddress,
+		// Sesi butuh jam lengkap, bukan hanya tanggal seperti FormatTimeIndonesia
+		SignedInAt: token.SignedInAt.Format(time.RFC3339),
+		LastUsedAt: token.LastUsedAt.Format(time.RFC3339),
+		ExpiresAt:  token.ExpiresAt.Format(time.RFC3339),
+		Current:    token.FamilyID == currentSessionID,
+	}
+}
+
+func ToSessionResponses(tokens []domain.RefreshToken, currentSessionID uuid.UUID) []auth.SessionResponse {
+	responses := make([]auth.SessionResponse, 0, len(tokens))
+	for _, token := range tokens {
+		responses = append(responses, ToSessionResponse(token, currentSessionID))
+	}
+	return responses
+}
@@ -0,0 +1,115 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/bank"
+)
+
+func ToBankAccountResponse(a domain.BankAccount) *bank.BankAccountResponse {
+	return &bank.BankAccountResponse{
+		ID:            a.ID,
+		Code:          a.Code,
+		Name:          a.Name,
+		Type:          a.Type,
+		BankName:      a.BankName,
+		AccountNumber: a.AccountNumber,
+		AccountHolder: a.AccountHolder,
+		Currency:      a.Currency,
+		GLAccountID:   a.GLAccountID,
+		GLAccountCode: a.GLAccount.Code,
+		GLAccountName: a.GLAccount.Name,
+		IsActive:      a.IsActive,
+		Notes:         a.Notes,
+		CreatedAt:     helper.FormatTimeIndonesia(a.CreatedAt),
+		UpdatedAt:     helper.FormatTimeIndonesia(a.UpdatedAt),
+	}
+}
+
+func ToBankAccountResponses(a []domain.BankAccount) []bank.BankAccountResponse {
+	var accountResponses []bank.BankAccountResponse
+	for _, account := range a {
+		accountResponses = append(accountResponses, *ToBankAccountResponse(account))
+	}
+	return accountResponses
+}
+
+func ToBankStatementResponse(s domain.BankStatement) *bank.BankStatementResponse {
+	response := &bank.BankStatementResponse{
+		ID:              s.ID,
+		BankAccountID:   s.BankAccountID,
+		BankAccountCode: s.BankAccount.Code,
+		BankAccountName: s.BankAccount.Name,
+		Format:          s.Format,
+		FileName:        s.FileName,
+		StatementNo:     s.StatementNo,
+		PeriodStart:     helper.FormatDate(s.PeriodStart),
+		PeriodEnd:       helper.FormatDate(s.PeriodEnd),
+		OpeningBalance:  s.OpeningBalance,
+		ClosingBalance:  s.ClosingBalance,
+		TotalCredit:     s.TotalCredit,
+		TotalDebit:      s.TotalDebit,
+		LineCount:       s.LineCount,
+		SkippedLines:    s.SkippedLines,
+		ImportedBy:      s.ImportedBy,
+		CreatedAt:       helper.FormatTimeIndonesia(s.CreatedAt),
+	}
+	for _, line := range s.Lines {
+		response.Lines = append(response.Lines, *ToBankStatementLineResponse(line))
+	}
+	return response
+}
+
+func ToBankStatementResponses(s []domain.BankStatement) []bank.BankStatementResponse {
+	var statementResponses []bank.BankStatementResponse
+	for _, statement := range s {
+		statementResponses = append(statementResponses, *ToBankStatementResponse(statement))
+	}
+	return statementResponses
+}
+
+func ToBankStatementLineResponse(l domain.BankStatementLine) *bank.BankStatementLineResponse {
+	response := &bank.BankStatementLineResponse{
+		ID:                l.ID,
+		BankStatementID:   l.BankStatementID,
+		LineNo:            l.LineNo,
+		TransactionDate:   helper.FormatDate(l.TransactionDate),
+		Amount:            l.Amount,
+		Reference:         l.Reference,
+		Description:       l.Description,
+		Status:            l.Status,
+		MatchedSourceType: l.MatchedSourceType,
+		MatchedSourceID:   l.MatchedSourceID,
+		MatchMethod:       l.MatchMethod,
+		MatchedBy:         l.MatchedBy,
+	}
+	if l.MatchedAt != nil {
+		response.MatchedAt = helper.FormatTimeIndonesia(*l.MatchedAt)
+	}
+	return response
+}
+
+func ToBankStatementLineResponses(l []domain.BankStatementLine) []bank.BankStatementLineResponse {
+	lineResponses := make([]bank.BankStatementLineResponse, 0, len(l))
+	for _, line := range l {
+		lineResponses = append(lineResponses, *ToBankStatementLineResponse(line))
+	}
+	return lineResponses
+}
+
+func ToBankBookTransactionResponses(t []domain.BankBookTransaction) []bank.BankBookTransactionResponse {
+	transactionResponses := make([]bank.BankBookTransactionResponse, 0, len(t))
+	for _, transaction := range t {
+		transactionResponses = append(transactionResponses, bank.BankBookTransactionResponse{
+			SourceType:      transaction.SourceType,
+			SourceID:        transaction.SourceID,
+			Number:          transaction.Number,
+			TransactionDate: helper.FormatDate(transaction.TransactionDate),
+			Reference:       transaction.Reference,
+			Counterparty:    transaction.Counterparty,
+			Currency:        transaction.Currency,
+			Amount:          transaction.Amount,
+		})
+	}
+	return transactionResponses
+}
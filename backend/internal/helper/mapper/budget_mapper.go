@@ -0,0 +1,98 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/budget"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func ToCostCenterResponse(c domain.CostCenter) *budget.CostCenterResponse {
+	response := &budget.CostCenterResponse{
+		ID:          c.ID,
+		Code:        c.Code,
+		Name:        c.Name,
+		ParentID:    c.ParentID,
+		ManagerName: c.ManagerName,
+		IsActive:    c.IsActive,
+		Notes:       c.Notes,
+		CreatedAt:   helper.FormatTimeIndonesia(c.CreatedAt),
+		UpdatedAt:   helper.FormatTimeIndonesia(c.UpdatedAt),
+	}
+	if c.Parent != nil {
+		response.ParentCode = c.Parent.Code
+		response.ParentName = c.Parent.Name
+	}
+	return response
+}
+
+func ToCostCenterResponses(c []domain.CostCenter) []budget.CostCenterResponse {
+	var costCenterResponses []budget.CostCenterResponse
+	for _, costCenter := range c {
+		costCenterResponses = append(costCenterResponses, *ToCostCenterResponse(costCenter))
+	}
+	return costCenterResponses
+}
+
+// ToBudgetResponse mengelompokkan baris per bulan menjadi satu baris per akun, diurutkan menurut kode akun
+func ToBudgetResponse(b domain.Budget) *budget.BudgetResponse {
+	response := &budget.BudgetResponse{
+		ID:                   b.ID,
+		Number:               b.Number,
+		Name:                 b.Name,
+		FiscalYear:           b.FiscalYear,
+		CostCenterID:         b.CostCenterID,
+		CostCenterCode:       b.CostCenter.Code,
+		CostCenterName:       b.CostCenter.Name,
+		Status:               b.Status,
+		ControlPeriod:        b.ControlPeriod,
+		ControlAction:        b.ControlAction,
+		WarnThresholdPercent: b.WarnThresholdPercent,
+		TotalAmount:          b.TotalAmount,
+		Notes:                b.Notes,
+		CreatedBy:            b.CreatedBy,
+		ActivatedBy:          b.ActivatedBy,
+		ActivatedAt:          formatOptionalTime(b.ActivatedAt),
+		CreatedAt:            helper.FormatTimeIndonesia(b.CreatedAt),
+		UpdatedAt:            helper.FormatTimeIndonesia(b.UpdatedAt),
+	}
+
+	lineIndex := make(map[uuid.UUID]int)
+	for _, line := range b.Lines {
+		index, ok := lineIndex[line.AccountID]
+		if !ok {
+			index = len(response.Lines)
+			lineIndex[line.AccountID] = index
+			response.Lines = append(response.Lines, budget.BudgetLineResponse{
+				AccountID:      line.AccountID,
+				AccountCode:    line.Account.Code,
+				AccountName:    line.Account.Name,
+				MonthlyAmounts: make([]decimal.Decimal, 12),
+			})
+		}
+		if line.Month >= 1 && line.Month <= 12 {
+			response.Lines[index].MonthlyAmounts[line.Month-1] = line.Amount
+		}
+		response.Lines[index].AnnualAmount = response.Lines[index].AnnualAmount.Add(line.Amount)
+	}
+	for i := range response.Lines {
+		response.Lines[i].AnnualAmount = helper.RoundAmount(response.Lines[i].AnnualAmount)
+	}
+	sort.Slice(response.Lines, func(i, j int) bool {
+		return response.Lines[i].AccountCode < response.Lines[j].AccountCode
+	})
+	return response
+}
+
+// ToBudgetResponses dipakai untuk daftar budget: baris anggaran tidak ikut dikirim
+func ToBudgetResponses(b []domain.Budget) []budget.BudgetResponse {
+	var budgetResponses []budget.BudgetResponse
+	for _, item := range b {
+		item.Lines = nil
+		budgetResponses = append(budgetResponses, *ToBudgetResponse(item))
+	}
+	return budgetResponses
+}
@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/currency"
+)
+
+func ToCurrencyResponse(c domain.Currency) *currency.CurrencyResponse {
+	return &currency.CurrencyResponse{
+		Code:          c.Code,
+		Name:          c.Name,
+		Symbol:        c.Symbol,
+		DecimalPlaces: c.DecimalPlaces,
+		IsBase:        c.Code == domain.BaseCurrency,
+		IsActive:      c.IsActive,
+		CreatedAt:     helper.FormatTimeIndonesia(c.CreatedAt),
+		UpdatedAt:     helper.FormatTimeIndonesia(c.UpdatedAt),
+	}
+}
+
+func ToCurrencyResponses(c []domain.Currency) []currency.CurrencyResponse {
+	var currencyResponses []currency.CurrencyResponse
+	for _, item := range c {
+		currencyResponses = append(currencyResponses, *ToCurrencyResponse(item))
+	}
+	return currencyResponses
+}
+
+func ToExchangeRateResponse(r domain.ExchangeRate) *currency.ExchangeRateResponse {
+	return &currency.ExchangeRateResponse{
+		ID:           r.ID,
+		CurrencyCode: r.CurrencyCode,
+		RateDate:     helper.FormatDate(r.RateDate),
+		Rate:         r.Rate,
+		Source:       r.Source,
+		CreatedBy:    r.CreatedBy,
+		UpdatedAt:    helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+}
+
+func ToExchangeRateResponses(r []domain.ExchangeRate) []currency.ExchangeRateResponse {
+	var rateResponses []currency.ExchangeRateResponse
+	for _, rate := range r {
+		rateResponses = append(rateResponses, *ToExchangeRateResponse(rate))
+	}
+	return rateResponses
+}
+
+func ToCurrencySettingResponse(s domain.CurrencySetting) *currency.CurrencySettingResponse {
+	response := &currency.CurrencySettingResponse{
+		BaseCurrency:          domain.BaseCurrency,
+		RealisedFXAccountID:   s.RealisedFXAccountID,
+		UnrealisedFXAccountID: s.UnrealisedFXAccountID,
+		UpdatedBy:             s.UpdatedBy,
+	}
+	if !s.UpdatedAt.IsZero() {
+		response.UpdatedAt = helper.FormatTimeIndonesia(s.UpdatedAt)
+	}
+	return response
+}
+
+func ToFXRevaluationResponse(r domain.FXRevaluation) *currency.FXRevaluationResponse {
+	response := &currency.FXRevaluationResponse{
+		ID:              r.ID,
+		Number:          r.Number,
+		RevaluationDate: helper.FormatDate(r.RevaluationDate),
+		ReversalDate:    helper.FormatDate(r.ReversalDate),
+		TotalAdjustment: r.TotalAdjustment,
+		JournalEntryID:  r.JournalEntryID,
+		ReversalEntryID: r.ReversalEntryID,
+		Notes:           r.Notes,
+		CreatedBy:       r.CreatedBy,
+		CreatedAt:       helper.FormatTimeIndonesia(r.CreatedAt),
+	}
+	for _, line := range r.Lines {
+		response.Lines = append(response.Lines, currency.FXRevaluationLineResponse{
+			SourceType:   line.SourceType,
+			SourceID:     line.SourceID,
+			Number:       line.Number,
+			PartyName:    line.PartyName,
+			Currency:     line.Currency,
+			OpenAmount:   line.OpenAmount,
+			BookedRate:   line.BookedRate,
+			ClosingRate:  line.ClosingRate,
+			BookedBase:   line.BookedBase,
+			RevaluedBase: line.RevaluedBase,
+			Adjustment:   line.Adjustment,
+		})
+	}
+	return response
+}
+
+func ToFXRevaluationResponses(r []domain.FXRevaluation) []currency.FXRevaluationResponse {
+	var revaluationResponses []currency.FXRevaluationResponse
+	for _, revaluation := range r {
+		revaluationResponses = append(revaluationResponses, *ToFXRevaluationResponse(revaluation))
+	}
+	return revaluationResponses
+}
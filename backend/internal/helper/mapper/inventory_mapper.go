@@ -0,0 +1,117 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/inventory"
+)
+
+func ToItemResponse(i domain.Item) *inventory.ItemResponse {
+	return &inventory.ItemResponse{
+		ID:           i.ID,
+		Code:         i.Code,
+		Name:         i.Name,
+		Description:  i.Description,
+		Category:     i.Category,
+		UOM:          i.UOM,
+		StandardCost: i.StandardCost,
+		LeadTimeDays: i.LeadTimeDays,
+		MinOrderQty:  i.MinOrderQty,
+		IsActive:     i.IsActive,
+		CreatedAt:    helper.FormatTimeIndonesia(i.CreatedAt),
+		UpdatedAt:    helper.FormatTimeIndonesia(i.UpdatedAt),
+	}
+}
+
+func ToItemResponses(i []domain.Item) []inventory.ItemResponse {
+	var itemResponses []inventory.ItemResponse
+	for _, item := range i {
+		itemResponses = append(itemResponses, *ToItemResponse(item))
+	}
+	return itemResponses
+}
+
+func ToWarehouseResponse(w domain.Warehouse) *inventory.WarehouseResponse {
+	response := &inventory.WarehouseResponse{
+		ID:        w.ID,
+		Code:      w.Code,
+		Name:      w.Name,
+		Address:   w.Address,
+		IsActive:  w.IsActive,
+		CreatedAt: helper.FormatTimeIndonesia(w.CreatedAt),
+		UpdatedAt: helper.FormatTimeIndonesia(w.UpdatedAt),
+	}
+	for _, bin := range w.Bins {
+		response.Bins = append(response.Bins, inventory.BinResponse{
+			ID:       bin.ID,
+			Code:     bin.Code,
+			Name:     bin.Name,
+			IsActive: bin.IsActive,
+		})
+	}
+	return response
+}
+
+func ToWarehouseResponses(w []domain.Warehouse) []inventory.WarehouseResponse {
+	var warehouseResponses []inventory.WarehouseResponse
+	for _, warehouse := range w {
+		warehouseResponses = append(warehouseResponses, *ToWarehouseResponse(warehouse))
+	}
+	return warehouseResponses
+}
+
+func ToStockMovementResponse(m domain.StockMovement) inventory.StockMovementResponse {
+	response := inventory.StockMovementResponse{
+		ID:           m.ID,
+		Number:       m.Number,
+		MovementType: m.MovementType,
+		MovementDate: helper.FormatDate(m.MovementDate),
+		ItemID:       m.ItemID,
+		WarehouseID:  m.WarehouseID,
+		BinID:        m.BinID,
+		Quantity:     m.Quantity,
+		Reference:    m.Reference,
+		SourceType:   m.SourceType,
+		SourceID:     m.SourceID,
+		Notes:        m.Notes,
+		CreatedBy:    m.CreatedBy,
+		CreatedAt:    helper.FormatTimeIndonesia(m.CreatedAt),
+	}
+	if m.Item != nil {
+		response.ItemCode = m.Item.Code
+		response.ItemName = m.Item.Name
+	}
+	if m.Warehouse != nil {
+		response.WarehouseCode = m.Warehouse.Code
+	}
+	if m.Bin != nil {
+		response.BinCode = m.Bin.Code
+	}
+	return response
+}
+
+func ToStockMovementResponses(m []domain.StockMovement) []inventory.StockMovementResponse {
+	var movementResponses []inventory.StockMovementResponse
+	for _, movement := range m {
+		movementResponses = append(movementResponses, ToStockMovementResponse(movement))
+	}
+	return movementResponses
+}
+
+func ToStockBalanceResponses(b []domain.StockBalance) []inventory.StockBalanceResponse {
+	balanceResponses := make([]inventory.StockBalanceResponse, 0, len(b))
+	for _, balance := range b {
+		balanceResponses = append(balanceResponses, inventory.StockBalanceResponse{
+			ItemID:        balance.ItemID,
+			ItemCode:      balance.ItemCode,
+			ItemName:      balance.ItemName,
+			UOM:           balance.UOM,
+			WarehouseID:   balance.WarehouseID,
+			WarehouseCode: balance.WarehouseCode,
+			BinID:         balance.BinID,
+			BinCode:       balance.BinCode,
+			Quantity:      helper.RoundQuantity(balance.Quantity),
+		})
+	}
+	return balanceResponses
+}
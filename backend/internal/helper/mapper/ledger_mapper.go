@@ -0,0 +1,114 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/ledger"
+
+	"github.com/google/uuid"
+)
+
+func ToAccountResponse(a domain.Account) *ledger.AccountResponse {
+	return &ledger.AccountResponse{
+		ID:               a.ID,
+		Code:             a.Code,
+		Name:             a.Name,
+		Type:             a.Type,
+		ParentID:         a.ParentID,
+		IsPostable:       a.IsPostable,
+		IsActive:         a.IsActive,
+		CashFlowCategory: a.CashFlowCategory,
+		CreatedAt:        helper.FormatTimeIndonesia(a.CreatedAt),
+		UpdatedAt:        helper.FormatTimeIndonesia(a.UpdatedAt),
+	}
+}
+
+func ToAccountResponses(a []domain.Account) []ledger.AccountResponse {
+	var accountResponses []ledger.AccountResponse
+	for _, account := range a {
+		accountResponses = append(accountResponses, *ToAccountResponse(account))
+	}
+	return accountResponses
+}
+
+// ToAccountTreeResponses menyusun daftar akun (urut kode) menjadi pohon berdasarkan ParentID
+func ToAccountTreeResponses(a []domain.Account) []ledger.AccountTreeResponse {
+	childrenOf := make(map[uuid.UUID][]domain.Account)
+	var roots []domain.Account
+	for _, account := range a {
+		if account.ParentID == nil {
+			roots = append(roots, account)
+			continue
+		}
+		childrenOf[*account.ParentID] = append(childrenOf[*account.ParentID], account)
+	}
+
+	var build func(accounts []domain.Account) []ledger.AccountTreeResponse
+	build = func(accounts []domain.Account) []ledger.AccountTreeResponse {
+		nodes := make([]ledger.AccountTreeResponse, 0, len(accounts))
+		for _, account := range accounts {
+			nodes = append(nodes, ledger.AccountTreeResponse{
+				AccountResponse: *ToAccountResponse(account),
+				Children:        build(childrenOf[account.ID]),
+			})
+		}
+		return nodes
+	}
+	return build(roots)
+}
+
+func ToJournalResponse(e domain.JournalEntry) *ledger.JournalResponse {
+	response := &ledger.JournalResponse{
+		ID:           e.ID,
+		EntryNumber:  e.EntryNumber,
+		EntryDate:    helper.FormatDate(e.EntryDate),
+		Description:  e.Description,
+		Reference:    e.Reference,
+		SourceType:   e.SourceType,
+		SourceID:     e.SourceID,
+		Status:       e.Status,
+		ReversalOfID: e.ReversalOfID,
+		CreatedBy:    e.CreatedBy,
+		PostedBy:     e.PostedBy,
+		PostedAt:     formatOptionalTime(e.PostedAt),
+		CreatedAt:    helper.FormatTimeIndonesia(e.CreatedAt),
+	}
+
+	for _, line := range e.Lines {
+		response.TotalDebit = response.TotalDebit.Add(line.Debit)
+		response.TotalCredit = response.TotalCredit.Add(line.Credit)
+		lineResponse := ledger.JournalLineResponse{
+			ID:            line.ID,
+			LineNo:        line.LineNo,
+			AccountID:     line.AccountID,
+			AccountCode:   line.Account.Code,
+			AccountName:   line.Account.Name,
+			CostCenterID:  line.CostCenterID,
+			Description:   line.Description,
+			Debit:         line.Debit,
+			Credit:        line.Credit,
+			Currency:      line.Currency,
+			ExchangeRate:  line.ExchangeRate,
+			ForeignDebit:  line.ForeignDebit,
+			ForeignCredit: line.ForeignCredit,
+		}
+		if line.CostCenter != nil {
+			lineResponse.CostCenterCode = line.CostCenter.Code
+		}
+		response.Lines = append(response.Lines, lineResponse)
+	}
+	response.TotalDebit = helper.RoundAmount(response.TotalDebit)
+	response.TotalCredit = helper.RoundAmount(response.TotalCredit)
+	return response
+}
+
+// ToJournalSummaryResponses dipakai untuk daftar jurnal: total dihitung, baris tidak ikut dikirim
+func ToJournalSummaryResponses(e []domain.JournalEntry) []ledger.JournalResponse {
+	var journalResponses []ledger.JournalResponse
+	for _, entry := range e {
+		response := ToJournalResponse(entry)
+		response.Lines = nil
+		journalResponses = append(journalResponses, *response)
+	}
+	return journalResponses
+}
@@ -0,0 +1,192 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/logistics"
+)
+
+func ToCarrierResponse(c domain.Carrier) *logistics.CarrierResponse {
+	return &logistics.CarrierResponse{
+		ID:          c.ID,
+		Code:        c.Code,
+		Name:        c.Name,
+		ServiceType: c.ServiceType,
+		ContactName: c.ContactName,
+		Phone:       c.Phone,
+		Email:       c.Email,
+		TrackingURL: c.TrackingURL,
+		IsActive:    c.IsActive,
+		Notes:       c.Notes,
+		CreatedAt:   helper.FormatTimeIndonesia(c.CreatedAt),
+		UpdatedAt:   helper.FormatTimeIndonesia(c.UpdatedAt),
+	}
+}
+
+func ToCarrierResponses(c []domain.Carrier) []logistics.CarrierResponse {
+	var carrierResponses []logistics.CarrierResponse
+	for _, carrier := range c {
+		carrierResponses = append(carrierResponses, *ToCarrierResponse(carrier))
+	}
+	return carrierResponses
+}
+
+func ToShipmentResponse(s domain.Shipment) *logistics.ShipmentResponse {
+	response := &logistics.ShipmentResponse{
+		ID:              s.ID,
+		Number:          s.Number,
+		ShipmentDate:    helper.FormatDate(s.ShipmentDate),
+		WarehouseID:     s.WarehouseID,
+		CarrierID:       s.CarrierID,
+		CustomerID:      s.CustomerID,
+		SalesOrderID:    s.SalesOrderID,
+		RecipientName:   s.RecipientName,
+		RecipientPhone:  s.RecipientPhone,
+		ShippingAddress: s.ShippingAddress,
+		TrackingNumber:  s.TrackingNumber,
+		Reference:       s.Reference,
+		Notes:           s.Notes,
+		Status:          string(s.Status),
+		ReceivedBy:      s.ReceivedBy,
+		ReturnReason:    s.ReturnReason,
+		TotalPackages:   len(s.Packages),
+		CreatedBy:       s.CreatedBy,
+		PickedAt:        formatOptionalTime(s.PickedAt),
+		ShippedAt:       formatOptionalTime(s.ShippedAt),
+		DeliveredAt:     formatOptionalTime(s.DeliveredAt),
+		ReturnedAt:      formatOptionalTime(s.ReturnedAt),
+		CreatedAt:       helper.FormatTimeIndonesia(s.CreatedAt),
+		UpdatedAt:       helper.FormatTimeIndonesia(s.UpdatedAt),
+	}
+	if s.Warehouse != nil {
+		response.WarehouseCode = s.Warehouse.Code
+	}
+	if s.Carrier != nil {
+		response.CarrierCode = s.Carrier.Code
+		response.CarrierName = s.Carrier.Name
+		response.TrackingLink = s.Carrier.TrackingLink(s.TrackingNumber)
+	}
+	if s.Customer != nil {
+		response.CustomerCode = s.Customer.Code
+		response.CustomerName = s.Customer.Name
+	}
+	if s.SalesOrder != nil {
+		response.SalesOrderNumber = s.SalesOrder.Number
+	}
+
+	for _, line := range s.Lines {
+		response.Lines = append(response.Lines, toShipmentLineResponse(line))
+	}
+	for _, shipmentPackage := range s.Packages {
+		response.TotalWeight = helper.RoundQuantity(response.TotalWeight + shipmentPackage.Weight)
+		response.Packages = append(response.Packages, toShipmentPackageResponse(shipmentPackage))
+	}
+	for _, event := range s.Events {
+		response.Events = append(response.Events, logistics.ShipmentEventResponse{
+			ID:          event.ID,
+			Status:      string(event.Status),
+			EventAt:     helper.FormatTimeIndonesia(event.EventAt),
+			Location:    event.Location,
+			Description: event.Description,
+			CreatedBy:   event.CreatedBy,
+		})
+	}
+	for _, attachment := range s.Attachments {
+		response.Attachments = append(response.Attachments, logistics.ShipmentAttachmentResponse{
+			ID:          attachment.ID,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			FileSize:    attachment.FileSize,
+			StorageURL:  attachment.StorageURL,
+			Description: attachment.Description,
+			UploadedBy:  attachment.UploadedBy,
+			CreatedAt:   helper.FormatTimeIndonesia(attachment.CreatedAt),
+		})
+	}
+
+	return response
+}
+
+func ToShipmentResponses(s []domain.Shipment) []logistics.ShipmentResponse {
+	var shipmentResponses []logistics.ShipmentResponse
+	for _, shipment := range s {
+		shipmentResponses = append(shipmentResponses, *ToShipmentResponse(shipment))
+	}
+	return shipmentResponses
+}
+
+// ToPackingListResponse mengelompokkan baris pengiriman per koli; baris dengan nomor koli
+// yang tidak ada dianggap belum dikemas
+func ToPackingListResponse(s domain.Shipment) *logistics.PackingListResponse {
+	response := &logistics.PackingListResponse{
+		ShipmentID:      s.ID,
+		Number:          s.Number,
+		ShipmentDate:    helper.FormatDate(s.ShipmentDate),
+		Status:          string(s.Status),
+		TrackingNumber:  s.TrackingNumber,
+		RecipientName:   s.RecipientName,
+		RecipientPhone:  s.RecipientPhone,
+		ShippingAddress: s.ShippingAddress,
+		Packages:        []logistics.PackingListPackageResponse{},
+		UnpackedLines:   []logistics.ShipmentLineResponse{},
+		TotalPackages:   len(s.Packages),
+	}
+	if s.Warehouse != nil {
+		response.WarehouseCode = s.Warehouse.Code
+	}
+	if s.Carrier != nil {
+		response.CarrierName = s.Carrier.Name
+	}
+
+	packageIndex := make(map[int]int, len(s.Packages))
+	for i, shipmentPackage := range s.Packages {
+		packageIndex[shipmentPackage.PackageNo] = i
+		response.TotalWeight = helper.RoundQuantity(response.TotalWeight + shipmentPackage.Weight)
+		response.Packages = append(response.Packages, logistics.PackingListPackageResponse{
+			ShipmentPackageResponse: toShipmentPackageResponse(shipmentPackage),
+			Lines:                   []logistics.ShipmentLineResponse{},
+		})
+	}
+
+	for _, line := range s.Lines {
+		response.TotalQuantity = helper.RoundQuantity(response.TotalQuantity + line.Quantity)
+		if index, ok := packageIndex[line.PackageNo]; ok {
+			response.Packages[index].Lines = append(response.Packages[index].Lines, toShipmentLineResponse(line))
+			continue
+		}
+		response.UnpackedLines = append(response.UnpackedLines, toShipmentLineResponse(line))
+	}
+
+	return response
+}
+
+func toShipmentLineResponse(line domain.ShipmentLine) logistics.ShipmentLineResponse {
+	lineResponse := logistics.ShipmentLineResponse{
+		ID:               line.ID,
+		LineNo:           line.LineNo,
+		ItemID:           line.ItemID,
+		BinID:            line.BinID,
+		SalesOrderLineID: line.SalesOrderLineID,
+		Description:      line.Description,
+		UOM:              line.UOM,
+		Quantity:         line.Quantity,
+		PackageNo:        line.PackageNo,
+	}
+	if line.Item != nil {
+		lineResponse.ItemCode = line.Item.Code
+	}
+	return lineResponse
+}
+
+func toShipmentPackageResponse(p domain.ShipmentPackage) logistics.ShipmentPackageResponse {
+	return logistics.ShipmentPackageResponse{
+		ID:          p.ID,
+		PackageNo:   p.PackageNo,
+		PackageType: p.PackageType,
+		Weight:      p.Weight,
+		Length:      p.Length,
+		Width:       p.Width,
+		Height:      p.Height,
+		Notes:       p.Notes,
+	}
+}
@@ -0,0 +1,155 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/payable"
+)
+
+func ToSupplierInvoiceResponse(i domain.SupplierInvoice) *payable.SupplierInvoiceResponse {
+	response := &payable.SupplierInvoiceResponse{
+		ID:                i.ID,
+		Number:            i.Number,
+		SupplierInvoiceNo: i.SupplierInvoiceNo,
+		SupplierID:        i.SupplierID,
+		SupplierName:      i.SupplierName,
+		PurchaseOrderID:   i.PurchaseOrderID,
+		InvoiceDate:       helper.FormatDate(i.InvoiceDate),
+		DueDate:           helper.FormatDate(i.DueDate),
+		Currency:          i.Currency,
+		ExchangeRate:      i.ExchangeRate,
+		Notes:             i.Notes,
+		Status:            i.Status,
+		MatchStatus:       i.MatchStatus,
+		SubtotalAmount:    i.SubtotalAmount,
+		TaxAmount:         i.TaxAmount,
+		TotalAmount:       i.TotalAmount,
+		PaidAmount:        i.PaidAmount,
+		OutstandingAmount: helper.RoundAmount(i.OutstandingAmount()),
+		JournalEntryID:    i.JournalEntryID,
+		CreatedBy:         i.CreatedBy,
+		ApprovedBy:        i.ApprovedBy,
+		ApprovedAt:        formatOptionalTime(i.ApprovedAt),
+		CreatedAt:         helper.FormatTimeIndonesia(i.CreatedAt),
+		UpdatedAt:         helper.FormatTimeIndonesia(i.UpdatedAt),
+	}
+	if i.PurchaseOrder != nil {
+		response.PurchaseOrderNumber = i.PurchaseOrder.Number
+	}
+	for _, line := range i.Lines {
+		response.Lines = append(response.Lines, payable.SupplierInvoiceLineResponse{
+			ID:                  line.ID,
+			PurchaseOrderLineID: line.PurchaseOrderLineID,
+			LineNo:              line.LineNo,
+			Description:         line.Description,
+			Quantity:            line.Quantity,
+			UnitPrice:           line.UnitPrice,
+			LineTotal:           line.LineTotal,
+		})
+	}
+	for _, tax := range i.Taxes {
+		response.Taxes = append(response.Taxes, payable.SupplierInvoiceTaxResponse{
+			ID:            tax.ID,
+			LineNo:        tax.LineNo,
+			TaxCode:       tax.TaxCode,
+			TaxType:       tax.TaxType,
+			Description:   tax.Description,
+			AccountID:     tax.AccountID,
+			BaseAmount:    tax.BaseAmount,
+			Rate:          tax.Rate,
+			Amount:        tax.Amount,
+			IsWithholding: tax.IsWithholding,
+		})
+	}
+	return response
+}
+
+// ToSupplierInvoiceSummaryResponses dipakai untuk daftar supplier invoice tanpa detail baris
+func ToSupplierInvoiceSummaryResponses(i []domain.SupplierInvoice) []payable.SupplierInvoiceResponse {
+	var invoiceResponses []payable.SupplierInvoiceResponse
+	for _, invoice := range i {
+		response := ToSupplierInvoiceResponse(invoice)
+		response.Lines = nil
+		response.Taxes = nil
+		invoiceResponses = append(invoiceResponses, *response)
+	}
+	return invoiceResponses
+}
+
+func ToMatchToleranceResponse(t domain.MatchTolerance) *payable.MatchToleranceResponse {
+	response := &payable.MatchToleranceResponse{
+		QuantityPercent: t.QuantityPercent,
+		PricePercent:    t.PricePercent,
+		AmountAbsolute:  t.AmountAbsolute,
+		UpdatedBy:       t.UpdatedBy,
+	}
+	if !t.UpdatedAt.IsZero() {
+		response.UpdatedAt = helper.FormatTimeIndonesia(t.UpdatedAt)
+	}
+	return response
+}
+
+func ToPayableSettingResponse(s domain.PayableSetting) *payable.PayableSettingResponse {
+	response := &payable.PayableSettingResponse{
+		PayableAccountID:  s.PayableAccountID,
+		PurchaseAccountID: s.PurchaseAccountID,
+		UpdatedBy:         s.UpdatedBy,
+	}
+	if !s.UpdatedAt.IsZero() {
+		response.UpdatedAt = helper.FormatTimeIndonesia(s.UpdatedAt)
+	}
+	return response
+}
+
+func ToPaymentRunResponse(r domain.PaymentRun) *payable.PaymentRunResponse {
+	response := &payable.PaymentRunResponse{
+		ID:               r.ID,
+		Number:           r.Number,
+		PaymentDate:      helper.FormatDate(r.PaymentDate),
+		DueUntil:         helper.FormatDate(r.DueUntil),
+		Currency:         r.Currency,
+		ExchangeRate:     r.ExchangeRate,
+		PaymentAccountID: r.PaymentAccountID,
+		Notes:            r.Notes,
+		Status:           r.Status,
+		TotalAmount:      r.TotalAmount,
+		CreatedBy:        r.CreatedBy,
+		PostedBy:         r.PostedBy,
+		PostedAt:         formatOptionalTime(r.PostedAt),
+		CreatedAt:        helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:        helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	for _, batch := range r.Batches {
+		batchResponse := payable.PaymentBatchResponse{
+			ID:             batch.ID,
+			BatchNo:        batch.BatchNo,
+			SupplierID:     batch.SupplierID,
+			SupplierName:   batch.SupplierName,
+			BankName:       batch.BankName,
+			AccountNumber:  batch.AccountNumber,
+			AccountName:    batch.AccountName,
+			TotalAmount:    batch.TotalAmount,
+			JournalEntryID: batch.JournalEntryID,
+		}
+		for _, line := range batch.Lines {
+			batchResponse.Lines = append(batchResponse.Lines, payable.PaymentBatchLineResponse{
+				ID:                line.ID,
+				SupplierInvoiceID: line.SupplierInvoiceID,
+				InvoiceNumber:     line.InvoiceNumber,
+				SupplierInvoiceNo: line.SupplierInvoiceNo,
+				DueDate:           helper.FormatDate(line.DueDate),
+				Amount:            line.Amount,
+			})
+		}
+		response.Batches = append(response.Batches, batchResponse)
+	}
+	return response
+}
+
+func ToPaymentRunResponses(r []domain.PaymentRun) []payable.PaymentRunResponse {
+	var runResponses []payable.PaymentRunResponse
+	for _, run := range r {
+		runResponses = append(runResponses, *ToPaymentRunResponse(run))
+	}
+	return runResponses
+}
@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/period"
+)
+
+func ToPeriodResponse(p domain.AccountingPeriod) *period.PeriodResponse {
+	return &period.PeriodResponse{
+		ID:           p.ID,
+		FiscalYearID: p.FiscalYearID,
+		PeriodNo:     p.PeriodNo,
+		Name:         p.Name,
+		StartDate:    helper.FormatDate(p.StartDate),
+		EndDate:      helper.FormatDate(p.EndDate),
+		Status:       p.Status,
+		UpdatedAt:    helper.FormatTimeIndonesia(p.UpdatedAt),
+	}
+}
+
+func ToFiscalYearResponse(f domain.FiscalYear) *period.FiscalYearResponse {
+	response := &period.FiscalYearResponse{
+		ID:        f.ID,
+		Code:      f.Code,
+		StartDate: helper.FormatDate(f.StartDate),
+		EndDate:   helper.FormatDate(f.EndDate),
+		CreatedAt: helper.FormatTimeIndonesia(f.CreatedAt),
+	}
+	for _, p := range f.Periods {
+		response.Periods = append(response.Periods, *ToPeriodResponse(p))
+	}
+	return response
+}
+
+func ToFiscalYearResponses(f []domain.FiscalYear) []period.FiscalYearResponse {
+	var fiscalYearResponses []period.FiscalYearResponse
+	for _, fiscalYear := range f {
+		fiscalYearResponses = append(fiscalYearResponses, *ToFiscalYearResponse(fiscalYear))
+	}
+	return fiscalYearResponses
+}
+
+func ToPeriodStatusLogResponses(l []domain.PeriodStatusLog) []period.PeriodStatusLogResponse {
+	var logResponses []period.PeriodStatusLogResponse
+	for _, log := range l {
+		logResponses = append(logResponses, period.PeriodStatusLogResponse{
+			ID:         log.ID,
+			PeriodID:   log.PeriodID,
+			FromStatus: log.FromStatus,
+			ToStatus:   log.ToStatus,
+			Reason:     log.Reason,
+			ChangedBy:  log.ChangedBy,
+			CreatedAt:  helper.FormatTimeIndonesia(log.CreatedAt),
+		})
+	}
+	return logResponses
+}
@@ -0,0 +1,288 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/ppc"
+)
+
+func ToWorkCenterResponse(w domain.WorkCenter) *ppc.WorkCenterResponse {
+	return &ppc.WorkCenterResponse{
+		ID:         w.ID,
+		Code:       w.Code,
+		Name:       w.Name,
+		HourlyRate: w.HourlyRate,
+		IsActive:   w.IsActive,
+		CreatedAt:  helper.FormatTimeIndonesia(w.CreatedAt),
+		UpdatedAt:  helper.FormatTimeIndonesia(w.UpdatedAt),
+	}
+}
+
+func ToWorkCenterResponses(w []domain.WorkCenter) []ppc.WorkCenterResponse {
+	var workCenterResponses []ppc.WorkCenterResponse
+	for _, workCenter := range w {
+		workCenterResponses = append(workCenterResponses, *ToWorkCenterResponse(workCenter))
+	}
+	return workCenterResponses
+}
+
+func ToBillOfMaterialResponse(b domain.BillOfMaterial) *ppc.BillOfMaterialResponse {
+	response := &ppc.BillOfMaterialResponse{
+		ID:          b.ID,
+		ItemID:      b.ItemID,
+		Version:     b.Version,
+		Description: b.Description,
+		Quantity:    b.Quantity,
+		IsDefault:   b.IsDefault,
+		IsActive:    b.IsActive,
+		CreatedAt:   helper.FormatTimeIndonesia(b.CreatedAt),
+		UpdatedAt:   helper.FormatTimeIndonesia(b.UpdatedAt),
+	}
+	if b.Item != nil {
+		response.ItemCode = b.Item.Code
+		response.ItemName = b.Item.Name
+	}
+	for _, line := range b.Lines {
+		lineResponse := ppc.BillOfMaterialLineResponse{
+			ID:              line.ID,
+			LineNo:          line.LineNo,
+			ComponentItemID: line.ComponentItemID,
+			Quantity:        line.Quantity,
+			ScrapPercent:    line.ScrapPercent,
+			Notes:           line.Notes,
+		}
+		if line.ComponentItem != nil {
+			lineResponse.ComponentCode = line.ComponentItem.Code
+			lineResponse.ComponentName = line.ComponentItem.Name
+			lineResponse.UOM = line.ComponentItem.UOM
+		}
+		response.Lines = append(response.Lines, lineResponse)
+	}
+	return response
+}
+
+func ToBillOfMaterialResponses(b []domain.BillOfMaterial) []ppc.BillOfMaterialResponse {
+	var bomResponses []ppc.BillOfMaterialResponse
+	for _, bom := range b {
+		bomResponses = append(bomResponses, *ToBillOfMaterialResponse(bom))
+	}
+	return bomResponses
+}
+
+func ToRoutingResponse(r domain.Routing) *ppc.RoutingResponse {
+	response := &ppc.RoutingResponse{
+		ID:          r.ID,
+		ItemID:      r.ItemID,
+		Version:     r.Version,
+		Description: r.Description,
+		IsDefault:   r.IsDefault,
+		IsActive:    r.IsActive,
+		CreatedAt:   helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:   helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	if r.Item != nil {
+		response.ItemCode = r.Item.Code
+		response.ItemName = r.Item.Name
+	}
+	for _, operation := range r.Operations {
+		operationResponse := ppc.RoutingOperationResponse{
+			ID:                operation.ID,
+			Sequence:          operation.Sequence,
+			WorkCenterID:      operation.WorkCenterID,
+			Description:       operation.Description,
+			SetupMinutes:      operation.SetupMinutes,
+			RunMinutesPerUnit: operation.RunMinutesPerUnit,
+		}
+		if operation.WorkCenter != nil {
+			operationResponse.WorkCenterCode = operation.WorkCenter.Code
+		}
+		response.Operations = append(response.Operations, operationResponse)
+	}
+	return response
+}
+
+func ToRoutingResponses(r []domain.Routing) []ppc.RoutingResponse {
+	var routingResponses []ppc.RoutingResponse
+	for _, routing := range r {
+		routingResponses = append(routingResponses, *ToRoutingResponse(routing))
+	}
+	return routingResponses
+}
+
+func ToWorkOrderResponse(w domain.WorkOrder) *ppc.WorkOrderResponse {
+	response := &ppc.WorkOrderResponse{
+		ID:                w.ID,
+		Number:            w.Number,
+		ItemID:            w.ItemID,
+		BillOfMaterialID:  w.BillOfMaterialID,
+		RoutingID:         w.RoutingID,
+		WarehouseID:       w.WarehouseID,
+		PlannedQuantity:   w.PlannedQuantity,
+		CompletedQuantity: w.CompletedQuantity,
+		ScrapQuantity:     w.ScrapQuantity,
+		PlannedStartDate:  helper.FormatDate(w.PlannedStartDate),
+		PlannedEndDate:    helper.FormatDate(w.PlannedEndDate),
+		Status:            string(w.Status),
+		Notes:             w.Notes,
+		CreatedBy:         w.CreatedBy,
+		ReleasedAt:        formatOptionalTime(w.ReleasedAt),
+		CompletedAt:       formatOptionalTime(w.CompletedAt),
+		CreatedAt:         helper.FormatTimeIndonesia(w.CreatedAt),
+		UpdatedAt:         helper.FormatTimeIndonesia(w.UpdatedAt),
+	}
+	if w.Item != nil {
+		response.ItemCode = w.Item.Code
+		response.ItemName = w.Item.Name
+	}
+	if w.Warehouse != nil {
+		response.WarehouseCode = w.Warehouse.Code
+	}
+	for _, material := range w.Materials {
+		materialResponse := ppc.WorkOrderMaterialResponse{
+			ID:               material.ID,
+			LineNo:           material.LineNo,
+			ItemID:           material.ItemID,
+			RequiredQuantity: material.RequiredQuantity,
+			ReservedQuantity: material.ReservedQuantity,
+			IssuedQuantity:   material.IssuedQuantity,
+			StandardUnitCost: material.StandardUnitCost,
+		}
+		if material.Item != nil {
+			materialResponse.ItemCode = material.Item.Code
+			materialResponse.ItemName = material.Item.Name
+			materialResponse.UOM = material.Item.UOM
+		}
+		response.Materials = append(response.Materials, materialResponse)
+	}
+	for _, operation := range w.Operations {
+		response.Operations = append(response.Operations, ppc.WorkOrderOperationResponse{
+			ID:                operation.ID,
+			Sequence:          operation.Sequence,
+			WorkCenterID:      operation.WorkCenterID,
+			WorkCenterCode:    operation.WorkCenterCode,
+			Description:       operation.Description,
+			SetupMinutes:      operation.SetupMinutes,
+			RunMinutesPerUnit: operation.RunMinutesPerUnit,
+			HourlyRate:        operation.HourlyRate,
+			ActualMinutes:     operation.ActualMinutes,
+		})
+	}
+	for _, output := range w.Outputs {
+		response.Outputs = append(response.Outputs, ppc.WorkOrderOutputResponse{
+			ID:             output.ID,
+			OutputDate:     helper.FormatDate(output.OutputDate),
+			GoodQuantity:   output.GoodQuantity,
+			ScrapQuantity:  output.ScrapQuantity,
+			MovementNumber: output.MovementNumber,
+			Notes:          output.Notes,
+			CreatedBy:      output.CreatedBy,
+			CreatedAt:      helper.FormatTimeIndonesia(output.CreatedAt),
+		})
+	}
+	return response
+}
+
+func ToWorkOrderResponses(w []domain.WorkOrder) []ppc.WorkOrderResponse {
+	var workOrderResponses []ppc.WorkOrderResponse
+	for _, workOrder := range w {
+		workOrderResponses = append(workOrderResponses, *ToWorkOrderResponse(workOrder))
+	}
+	return workOrderResponses
+}
+
+func ToMRPRunResponse(r domain.MRPRun) *ppc.MRPRunResponse {
+	response := &ppc.MRPRunResponse{
+		ID:           r.ID,
+		Number:       r.Number,
+		WarehouseID:  r.WarehouseID,
+		HorizonStart: helper.FormatDate(r.HorizonStart),
+		HorizonEnd:   helper.FormatDate(r.HorizonEnd),
+		Status:       string(r.Status),
+		Progress:     r.Progress,
+		Message:      r.Message,
+		Notes:        r.Notes,
+		CreatedBy:    r.CreatedBy,
+		StartedAt:    formatOptionalTime(r.StartedAt),
+		FinishedAt:   formatOptionalTime(r.FinishedAt),
+		CreatedAt:    helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:    helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	if r.Warehouse != nil {
+		response.WarehouseCode = r.Warehouse.Code
+	}
+
+	for _, demand := range r.Demands {
+		demandResponse := ppc.MRPDemandResponse{
+			ID:        demand.ID,
+			LineNo:    demand.LineNo,
+			ItemID:    demand.ItemID,
+			Quantity:  demand.Quantity,
+			DueDate:   helper.FormatDate(demand.DueDate),
+			Reference: demand.Reference,
+		}
+		if demand.Item != nil {
+			demandResponse.ItemCode = demand.Item.Code
+			demandResponse.ItemName = demand.Item.Name
+		}
+		response.Demands = append(response.Demands, demandResponse)
+	}
+
+	return response
+}
+
+func ToMRPRunResponses(r []domain.MRPRun) []ppc.MRPRunResponse {
+	var runResponses []ppc.MRPRunResponse
+	for _, run := range r {
+		runResponses = append(runResponses, *ToMRPRunResponse(run))
+	}
+	return runResponses
+}
+
+func ToMRPItemResultResponses(r []domain.MRPItemResult) []ppc.MRPItemResultResponse {
+	var resultResponses []ppc.MRPItemResultResponse
+	for _, result := range r {
+		resultResponse := ppc.MRPItemResultResponse{
+			ItemID:            result.ItemID,
+			LowLevelCode:      result.LowLevelCode,
+			OnHand:            result.OnHand,
+			ScheduledReceipts: result.ScheduledReceipts,
+			GrossRequirement:  result.GrossRequirement,
+			NetRequirement:    result.NetRequirement,
+			PlannedQuantity:   result.PlannedQuantity,
+			EndingBalance:     result.EndingBalance,
+		}
+		if result.Item != nil {
+			resultResponse.ItemCode = result.Item.Code
+			resultResponse.ItemName = result.Item.Name
+			resultResponse.UOM = result.Item.UOM
+		}
+		resultResponses = append(resultResponses, resultResponse)
+	}
+	return resultResponses
+}
+
+func ToMRPSuggestionResponses(s []domain.MRPSuggestion) []ppc.MRPSuggestionResponse {
+	var suggestionResponses []ppc.MRPSuggestionResponse
+	for _, suggestion := range s {
+		suggestionResponse := ppc.MRPSuggestionResponse{
+			ID:             suggestion.ID,
+			ItemID:         suggestion.ItemID,
+			SuggestionType: string(suggestion.SuggestionType),
+			NetRequirement: suggestion.NetRequirement,
+			Quantity:       suggestion.Quantity,
+			ReleaseDate:    helper.FormatDate(suggestion.ReleaseDate),
+			DueDate:        helper.FormatDate(suggestion.DueDate),
+			IsPastDue:      suggestion.IsPastDue,
+			Status:         string(suggestion.Status),
+			DocumentID:     suggestion.DocumentID,
+			DocumentNumber: suggestion.DocumentNumber,
+		}
+		if suggestion.Item != nil {
+			suggestionResponse.ItemCode = suggestion.Item.Code
+			suggestionResponse.ItemName = suggestion.Item.Name
+			suggestionResponse.UOM = suggestion.Item.UOM
+		}
+		suggestionResponses = append(suggestionResponses, suggestionResponse)
+	}
+	return suggestionResponses
+}
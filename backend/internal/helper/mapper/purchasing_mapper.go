@@ -0,0 +1,112 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/purchasing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func ToRequisitionResponse(r domain.PurchaseRequisition) *purchasing.RequisitionResponse {
+	response := &purchasing.RequisitionResponse{
+		ID:              r.ID,
+		Number:          r.Number,
+		RequestDate:     helper.FormatDate(r.RequestDate),
+		RequiredDate:    helper.FormatDate(r.RequiredDate),
+		Notes:           r.Notes,
+		Status:          r.Status,
+		RequestedBy:     r.RequestedBy,
+		ApprovedBy:      r.ApprovedBy,
+		ApprovedAt:      formatOptionalTime(r.ApprovedAt),
+		RejectReason:    r.RejectReason,
+		PurchaseOrderID: r.PurchaseOrderID,
+		CreatedAt:       helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:       helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	for _, line := range r.Lines {
+		response.EstimatedTotal = response.EstimatedTotal.Add(line.EstimatedUnitPrice.Mul(decimal.NewFromFloat(line.Quantity)))
+		response.Lines = append(response.Lines, purchasing.RequisitionLineResponse{
+			ID:                 line.ID,
+			LineNo:             line.LineNo,
+			ItemID:             line.ItemID,
+			Description:        line.Description,
+			Quantity:           line.Quantity,
+			UOM:                line.UOM,
+			EstimatedUnitPrice: line.EstimatedUnitPrice,
+		})
+	}
+	response.EstimatedTotal = helper.RoundAmount(response.EstimatedTotal)
+	return response
+}
+
+// ToRequisitionSummaryResponses dipakai untuk daftar requisition tanpa detail baris
+func ToRequisitionSummaryResponses(r []domain.PurchaseRequisition) []purchasing.RequisitionResponse {
+	var requisitionResponses []purchasing.RequisitionResponse
+	for _, requisition := range r {
+		response := ToRequisitionResponse(requisition)
+		response.Lines = nil
+		requisitionResponses = append(requisitionResponses, *response)
+	}
+	return requisitionResponses
+}
+
+func ToPurchaseOrderResponse(o domain.PurchaseOrder) *purchasing.PurchaseOrderResponse {
+	response := &purchasing.PurchaseOrderResponse{
+		ID:              o.ID,
+		Number:          o.Number,
+		RequisitionID:   o.RequisitionID,
+		SupplierID:      o.SupplierID,
+		SupplierName:    o.SupplierName,
+		Currency:        o.Currency,
+		PaymentTermDays: o.PaymentTermDays,
+		OrderDate:       helper.FormatDate(o.OrderDate),
+		ExpectedDate:    helper.FormatDate(o.ExpectedDate),
+		Notes:           o.Notes,
+		Status:          o.Status,
+		TotalAmount:     o.TotalAmount,
+		CreatedBy:       o.CreatedBy,
+		ApprovedBy:      o.ApprovedBy,
+		ApprovedAt:      formatOptionalTime(o.ApprovedAt),
+		SentAt:          formatOptionalTime(o.SentAt),
+		ClosedAt:        formatOptionalTime(o.ClosedAt),
+		CreatedAt:       helper.FormatTimeIndonesia(o.CreatedAt),
+		UpdatedAt:       helper.FormatTimeIndonesia(o.UpdatedAt),
+	}
+	for _, line := range o.Lines {
+		lineResponse := purchasing.PurchaseOrderLineResponse{
+			ID:                  line.ID,
+			LineNo:              line.LineNo,
+			ItemID:              line.ItemID,
+			Description:         line.Description,
+			Quantity:            line.Quantity,
+			UOM:                 line.UOM,
+			UnitPrice:           line.UnitPrice,
+			LineTotal:           line.LineTotal,
+			ReceivedQuantity:    line.ReceivedQuantity,
+			OutstandingQuantity: helper.RoundQuantity(line.OutstandingQuantity()),
+		}
+		if line.ExpectedDate != nil {
+			lineResponse.ExpectedDate = helper.FormatDate(*line.ExpectedDate)
+		}
+		response.Lines = append(response.Lines, lineResponse)
+	}
+	return response
+}
+
+func ToPurchaseOrderResponses(o []domain.PurchaseOrder) []purchasing.PurchaseOrderResponse {
+	var orderResponses []purchasing.PurchaseOrderResponse
+	for _, order := range o {
+		orderResponses = append(orderResponses, *ToPurchaseOrderResponse(order))
+	}
+	return orderResponses
+}
+
+// formatOptionalTime memformat waktu opsional, string kosong jika nil
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return helper.FormatTimeIndonesia(*t)
+}
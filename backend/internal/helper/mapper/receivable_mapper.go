@@ -0,0 +1,158 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/receivable"
+)
+
+func ToCustomerResponse(c domain.Customer) *receivable.CustomerResponse {
+	return &receivable.CustomerResponse{
+		ID:              c.ID,
+		Code:            c.Code,
+		Name:            c.Name,
+		NPWP:            c.NPWP,
+		Email:           c.Email,
+		Phone:           c.Phone,
+		BillingAddress:  c.BillingAddress,
+		PaymentTermDays: c.PaymentTermDays,
+		Currency:        c.Currency,
+		CreditLimit:     c.CreditLimit,
+		IsActive:        c.IsActive,
+		Notes:           c.Notes,
+		CreatedAt:       helper.FormatTimeIndonesia(c.CreatedAt),
+		UpdatedAt:       helper.FormatTimeIndonesia(c.UpdatedAt),
+	}
+}
+
+func ToCustomerResponses(c []domain.Customer) []receivable.CustomerResponse {
+	var customerResponses []receivable.CustomerResponse
+	for _, customer := range c {
+		customerResponses = append(customerResponses, *ToCustomerResponse(customer))
+	}
+	return customerResponses
+}
+
+func ToSalesInvoiceResponse(i domain.SalesInvoice) *receivable.SalesInvoiceResponse {
+	response := &receivable.SalesInvoiceResponse{
+		ID:                i.ID,
+		Number:            i.Number,
+		CustomerID:        i.CustomerID,
+		CustomerName:      i.CustomerName,
+		InvoiceDate:       helper.FormatDate(i.InvoiceDate),
+		DueDate:           helper.FormatDate(i.DueDate),
+		Currency:          i.Currency,
+		ExchangeRate:      i.ExchangeRate,
+		Reference:         i.Reference,
+		TaxInvoiceNo:      i.TaxInvoiceNo,
+		Notes:             i.Notes,
+		Status:            i.Status,
+		SubtotalAmount:    i.SubtotalAmount,
+		TaxAmount:         i.TaxAmount,
+		TotalAmount:       i.TotalAmount,
+		PaidAmount:        i.PaidAmount,
+		OutstandingAmount: helper.RoundAmount(i.OutstandingAmount()),
+		JournalEntryID:    i.JournalEntryID,
+		CreatedBy:         i.CreatedBy,
+		PostedBy:          i.PostedBy,
+		PostedAt:          formatOptionalTime(i.PostedAt),
+		CreatedAt:         helper.FormatTimeIndonesia(i.CreatedAt),
+		UpdatedAt:         helper.FormatTimeIndonesia(i.UpdatedAt),
+	}
+	for _, line := range i.Lines {
+		response.Lines = append(response.Lines, receivable.SalesInvoiceLineResponse{
+			ID:               line.ID,
+			LineNo:           line.LineNo,
+			ItemID:           line.ItemID,
+			Description:      line.Description,
+			Quantity:         line.Quantity,
+			UOM:              line.UOM,
+			UnitPrice:        line.UnitPrice,
+			LineTotal:        line.LineTotal,
+			RevenueAccountID: line.RevenueAccountID,
+		})
+	}
+	for _, tax := range i.Taxes {
+		response.Taxes = append(response.Taxes, receivable.SalesInvoiceTaxResponse{
+			ID:            tax.ID,
+			LineNo:        tax.LineNo,
+			TaxCode:       tax.TaxCode,
+			TaxType:       tax.TaxType,
+			Description:   tax.Description,
+			AccountID:     tax.AccountID,
+			BaseAmount:    tax.BaseAmount,
+			Rate:          tax.Rate,
+			Amount:        tax.Amount,
+			IsWithholding: tax.IsWithholding,
+		})
+	}
+	return response
+}
+
+// ToSalesInvoiceSummaryResponses dipakai untuk daftar sales invoice tanpa detail baris
+func ToSalesInvoiceSummaryResponses(i []domain.SalesInvoice) []receivable.SalesInvoiceResponse {
+	var invoiceResponses []receivable.SalesInvoiceResponse
+	for _, invoice := range i {
+		response := ToSalesInvoiceResponse(invoice)
+		response.Lines = nil
+		response.Taxes = nil
+		invoiceResponses = append(invoiceResponses, *response)
+	}
+	return invoiceResponses
+}
+
+func ToCustomerReceiptResponse(r domain.CustomerReceipt) *receivable.CustomerReceiptResponse {
+	response := &receivable.CustomerReceiptResponse{
+		ID:               r.ID,
+		Number:           r.Number,
+		CustomerID:       r.CustomerID,
+		CustomerName:     r.CustomerName,
+		ReceiptDate:      helper.FormatDate(r.ReceiptDate),
+		Currency:         r.Currency,
+		ExchangeRate:     r.ExchangeRate,
+		Amount:           r.Amount,
+		AllocatedAmount:  r.AllocatedAmount,
+		UnappliedAmount:  helper.RoundAmount(r.UnappliedAmount()),
+		DepositAccountID: r.DepositAccountID,
+		Reference:        r.Reference,
+		Notes:            r.Notes,
+		Status:           r.Status,
+		JournalEntryID:   r.JournalEntryID,
+		CreatedBy:        r.CreatedBy,
+		PostedBy:         r.PostedBy,
+		PostedAt:         formatOptionalTime(r.PostedAt),
+		CreatedAt:        helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:        helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	for _, allocation := range r.Allocations {
+		response.Allocations = append(response.Allocations, receivable.ReceiptAllocationResponse{
+			ID:             allocation.ID,
+			SalesInvoiceID: allocation.SalesInvoiceID,
+			InvoiceNumber:  allocation.InvoiceNumber,
+			AllocationDate: helper.FormatDate(allocation.AllocationDate),
+			Amount:         allocation.Amount,
+			CreatedBy:      allocation.CreatedBy,
+		})
+	}
+	return response
+}
+
+func ToCustomerReceiptResponses(r []domain.CustomerReceipt) []receivable.CustomerReceiptResponse {
+	var receiptResponses []receivable.CustomerReceiptResponse
+	for _, receipt := range r {
+		receiptResponses = append(receiptResponses, *ToCustomerReceiptResponse(receipt))
+	}
+	return receiptResponses
+}
+
+func ToReceivableSettingResponse(s domain.ReceivableSetting) *receivable.ReceivableSettingResponse {
+	response := &receivable.ReceivableSettingResponse{
+		ReceivableAccountID: s.ReceivableAccountID,
+		RevenueAccountID:    s.RevenueAccountID,
+		UpdatedBy:           s.UpdatedBy,
+	}
+	if !s.UpdatedAt.IsZero() {
+		response.UpdatedAt = helper.FormatTimeIndonesia(s.UpdatedAt)
+	}
+	return response
+}
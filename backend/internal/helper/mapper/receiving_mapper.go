@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/receiving"
+)
+
+func ToGoodsReceiptResponse(r domain.GoodsReceipt) *receiving.GoodsReceiptResponse {
+	response := &receiving.GoodsReceiptResponse{
+		ID:              r.ID,
+		Number:          r.Number,
+		PurchaseOrderID: r.PurchaseOrderID,
+		SupplierID:      r.SupplierID,
+		WarehouseID:     r.WarehouseID,
+		ReceiptDate:     helper.FormatDate(r.ReceiptDate),
+		DeliveryNote:    r.DeliveryNote,
+		Notes:           r.Notes,
+		ReceivedBy:      r.ReceivedBy,
+		CreatedAt:       helper.FormatTimeIndonesia(r.CreatedAt),
+	}
+	if r.PurchaseOrder != nil {
+		response.PurchaseOrderNumber = r.PurchaseOrder.Number
+		response.SupplierName = r.PurchaseOrder.SupplierName
+	}
+	if r.Warehouse != nil {
+		response.WarehouseCode = r.Warehouse.Code
+	}
+	for _, line := range r.Lines {
+		response.Lines = append(response.Lines, receiving.GoodsReceiptLineResponse{
+			ID:                  line.ID,
+			PurchaseOrderLineID: line.PurchaseOrderLineID,
+			LineNo:              line.LineNo,
+			ItemID:              line.ItemID,
+			BinID:               line.BinID,
+			Description:         line.Description,
+			UOM:                 line.UOM,
+			OrderedQuantity:     line.OrderedQuantity,
+			PreviouslyReceived:  line.PreviouslyReceived,
+			ReceivedQuantity:    line.ReceivedQuantity,
+			OutstandingQuantity: helper.RoundQuantity(line.OrderedQuantity - line.PreviouslyReceived - line.ReceivedQuantity),
+		})
+	}
+	return response
+}
+
+func ToGoodsReceiptResponses(r []domain.GoodsReceipt) []receiving.GoodsReceiptResponse {
+	var receiptResponses []receiving.GoodsReceiptResponse
+	for _, receipt := range r {
+		receiptResponses = append(receiptResponses, *ToGoodsReceiptResponse(receipt))
+	}
+	return receiptResponses
+}
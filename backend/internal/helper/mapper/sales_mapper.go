@@ -0,0 +1,74 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/sales"
+)
+
+func ToSalesOrderResponse(o domain.SalesOrder) *sales.SalesOrderResponse {
+	response := &sales.SalesOrderResponse{
+		ID:                   o.ID,
+		Number:               o.Number,
+		CustomerID:           o.CustomerID,
+		CustomerName:         o.CustomerName,
+		OrderDate:            helper.FormatDate(o.OrderDate),
+		Currency:             o.Currency,
+		Reference:            o.Reference,
+		ShippingAddress:      o.ShippingAddress,
+		Notes:                o.Notes,
+		Status:               o.Status,
+		SubtotalAmount:       o.SubtotalAmount,
+		DiscountAmount:       o.DiscountAmount,
+		TaxAmount:            o.TaxAmount,
+		TotalAmount:          o.TotalAmount,
+		CreditLimit:          o.CreditLimit,
+		CreditExposure:       o.CreditExposure,
+		CreditOverrideBy:     o.CreditOverrideBy,
+		CreditOverrideAt:     formatOptionalTime(o.CreditOverrideAt),
+		CreditOverrideReason: o.CreditOverrideReason,
+		CreatedBy:            o.CreatedBy,
+		ConfirmedBy:          o.ConfirmedBy,
+		ConfirmedAt:          formatOptionalTime(o.ConfirmedAt),
+		CancelledAt:          formatOptionalTime(o.CancelledAt),
+		CreatedAt:            helper.FormatTimeIndonesia(o.CreatedAt),
+		UpdatedAt:            helper.FormatTimeIndonesia(o.UpdatedAt),
+	}
+	if o.RequestedDate != nil {
+		response.RequestedDate = helper.FormatDate(*o.RequestedDate)
+	}
+
+	for _, line := range o.Lines {
+		lineResponse := sales.SalesOrderLineResponse{
+			ID:                  line.ID,
+			LineNo:              line.LineNo,
+			ItemID:              line.ItemID,
+			Description:         line.Description,
+			UOM:                 line.UOM,
+			Quantity:            line.Quantity,
+			UnitPrice:           line.UnitPrice,
+			DiscountPercent:     line.DiscountPercent,
+			DiscountAmount:      line.DiscountAmount,
+			NetAmount:           line.NetAmount,
+			TaxPercent:          line.TaxPercent,
+			TaxAmount:           line.TaxAmount,
+			LineTotal:           line.LineTotal,
+			ShippedQuantity:     line.ShippedQuantity,
+			OutstandingQuantity: helper.RoundQuantity(line.OutstandingQuantity()),
+			FulfilmentStatus:    line.FulfilmentStatus,
+		}
+		if line.Item != nil {
+			lineResponse.ItemCode = line.Item.Code
+		}
+		response.Lines = append(response.Lines, lineResponse)
+	}
+	return response
+}
+
+func ToSalesOrderResponses(o []domain.SalesOrder) []sales.SalesOrderResponse {
+	var orderResponses []sales.SalesOrderResponse
+	for _, order := range o {
+		orderResponses = append(orderResponses, *ToSalesOrderResponse(order))
+	}
+	return orderResponses
+}
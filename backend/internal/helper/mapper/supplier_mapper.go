@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/supplier"
+)
+
+func ToSupplierResponse(s domain.Supplier) *supplier.SupplierResponse {
+	response := &supplier.SupplierResponse{
+		ID:              s.ID,
+		Code:            s.Code,
+		Name:            s.Name,
+		NPWP:            s.NPWP,
+		Email:           s.Email,
+		Phone:           s.Phone,
+		PaymentTermDays: s.PaymentTermDays,
+		Currency:        s.Currency,
+		Status:          s.Status,
+		BlockReason:     s.BlockReason,
+		Notes:           s.Notes,
+		CreatedAt:       helper.FormatTimeIndonesia(s.CreatedAt),
+		UpdatedAt:       helper.FormatTimeIndonesia(s.UpdatedAt),
+	}
+	for _, a := range s.Addresses {
+		response.Addresses = append(response.Addresses, supplier.SupplierAddressResponse{
+			ID:         a.ID,
+			Label:      a.Label,
+			Address:    a.Address,
+			City:       a.City,
+			Province:   a.Province,
+			PostalCode: a.PostalCode,
+			IsPrimary:  a.IsPrimary,
+		})
+	}
+	for _, c := range s.Contacts {
+		response.Contacts = append(response.Contacts, supplier.SupplierContactResponse{
+			ID:        c.ID,
+			Name:      c.Name,
+			Position:  c.Position,
+			Email:     c.Email,
+			Phone:     c.Phone,
+			IsPrimary: c.IsPrimary,
+		})
+	}
+	for _, b := range s.BankAccounts {
+		response.BankAccounts = append(response.BankAccounts, supplier.SupplierBankAccountResponse{
+			ID:            b.ID,
+			BankName:      b.BankName,
+			AccountNumber: b.AccountNumber,
+			AccountName:   b.AccountName,
+			IsPrimary:     b.IsPrimary,
+		})
+	}
+	return response
+}
+
+func ToSupplierResponses(s []domain.Supplier) []supplier.SupplierResponse {
+	var supplierResponses []supplier.SupplierResponse
+	for _, item := range s {
+		supplierResponses = append(supplierResponses, *ToSupplierResponse(item))
+	}
+	return supplierResponses
+}
@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/tax"
+)
+
+func ToTaxCodeResponse(t domain.TaxCode) *tax.TaxCodeResponse {
+	return &tax.TaxCodeResponse{
+		ID:                     t.ID,
+		Code:                   t.Code,
+		Name:                   t.Name,
+		Type:                   t.Type,
+		Rate:                   t.Rate,
+		BaseFactor:             t.BaseFactor,
+		EffectiveRate:          helper.RoundRate(t.EffectiveRate()),
+		NoNPWPSurchargePercent: t.NoNPWPSurchargePercent,
+		SalesAccountID:         t.SalesAccountID,
+		PurchaseAccountID:      t.PurchaseAccountID,
+		IsWithholding:          t.IsWithholding(),
+		IsActive:               t.IsActive,
+		Notes:                  t.Notes,
+		CreatedAt:              helper.FormatTimeIndonesia(t.CreatedAt),
+		UpdatedAt:              helper.FormatTimeIndonesia(t.UpdatedAt),
+	}
+}
+
+func ToTaxCodeResponses(t []domain.TaxCode) []tax.TaxCodeResponse {
+	var taxCodeResponses []tax.TaxCodeResponse
+	for _, taxCode := range t {
+		taxCodeResponses = append(taxCodeResponses, *ToTaxCodeResponse(taxCode))
+	}
+	return taxCodeResponses
+}
+
+func ToTaxInvoiceRangeResponse(r domain.TaxInvoiceRange) *tax.TaxInvoiceRangeResponse {
+	response := &tax.TaxInvoiceRangeResponse{
+		ID:               r.ID,
+		Year:             r.Year,
+		Prefix:           r.Prefix,
+		StartSerial:      r.StartSerial,
+		EndSerial:        r.EndSerial,
+		NextSerial:       r.NextSerial,
+		RemainingNumbers: r.RemainingNumbers(),
+		IsActive:         r.IsActive,
+		Notes:            r.Notes,
+		CreatedBy:        r.CreatedBy,
+		CreatedAt:        helper.FormatTimeIndonesia(r.CreatedAt),
+		UpdatedAt:        helper.FormatTimeIndonesia(r.UpdatedAt),
+	}
+	if r.RemainingNumbers() > 0 {
+		response.NextNumber = domain.FormatTaxInvoiceNumber(domain.TaxTransactionCodeNormal, false, r.Prefix, r.Year, r.NextSerial)
+	}
+	return response
+}
+
+func ToTaxInvoiceRangeResponses(r []domain.TaxInvoiceRange) []tax.TaxInvoiceRangeResponse {
+	var rangeResponses []tax.TaxInvoiceRangeResponse
+	for _, invoiceRange := range r {
+		rangeResponses = append(rangeResponses, *ToTaxInvoiceRangeResponse(invoiceRange))
+	}
+	return rangeResponses
+}
@@ -12,6 +12,7 @@ func ToUsersResponse(u domain.Users) *users.UsersResponse {
 		Name:      u.Name,
 		Email:     u.Email,
 		Role:      u.Role,
+		IsActive:  u.IsActive,
 		CreatedAt: helper.FormatTimeIndonesia(u.CreatedAt),
 		UpdatedAt: helper.FormatTimeIndonesia(u.UpdatedAt),
 	}
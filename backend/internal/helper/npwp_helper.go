@@ -0,0 +1,19 @@
+package helper
+
+import "strings"
+
+// NormalizeNPWP membuang tanda baca dari NPWP, contoh: "01.234.567.8-901.000" menjadi "012345678901000"
+func NormalizeNPWP(npwp string) string {
+	var digits strings.Builder
+	for _, r := range npwp {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	return digits.String()
+}
+
+// IsValidNPWP memeriksa NPWP yang sudah dinormalisasi: 15 digit (format lama) atau 16 digit (NIK/format baru)
+func IsValidNPWP(npwp string) bool {
+	return len(npwp) == 15 || len(npwp) == 16
+}
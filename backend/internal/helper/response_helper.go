@@ -0,0 +1,119 @@
+package helper
+
+import (
+	"erpfinance/internal/exception"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// ErrorResponse memetakan error dari service ke response HTTP yang sesuai
+func ErrorResponse(ctx *fiber.Ctx, err error) error {
+	var notFoundErr *exception.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return ctx.Status(fiber.StatusNotFound).JSON(dto.WebResponse{
+			Code:    fiber.StatusNotFound,
+			Status:  "NOT FOUND",
+			Message: err.Error(),
+		})
+	}
+
+	var businessErr *exception.Error
+	if errors.As(err, &businessErr) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+			Code:    fiber.StatusBadRequest,
+			Status:  "BAD REQUEST",
+			Message: err.Error(),
+		})
+	}
+
+	// Error yang tidak terduga cukup di-log, jangan bocorkan detailnya ke client
+	log.Printf("ERROR: %s %s: %v", ctx.Method(), ctx.Path(), err)
+	return ctx.Status(fiber.StatusInternalServerError).JSON(dto.WebResponse{
+		Code:    fiber.StatusInternalServerError,
+		Status:  "INTERNAL SERVER ERROR",
+		Message: "An unexpected error occurred while processing the request.",
+	})
+}
+
+// BadRequestResponse mengirim response 400 dengan pesan tertentu
+func BadRequestResponse(ctx *fiber.Ctx, message string) error {
+	return ctx.Status(fiber.StatusBadRequest).JSON(dto.WebResponse{
+		Code:    fiber.StatusBadRequest,
+		Status:  "BAD REQUEST",
+		Message: message,
+	})
+}
+
+// PaginationFromQuery membaca parameter page & limit dari query string
+func PaginationFromQuery(ctx *fiber.Ctx) dto.PaginationRequest {
+	pagination := dto.PaginationRequest{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if err := ctx.QueryParser(&pagination); err != nil {
+		log.Printf("Warning: Could not parse pagination query: %v. Using default values.", err)
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit <= 0 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+	return pagination
+}
+
+// ParamUUID membaca parameter path berformat UUID
+func ParamUUID(ctx *fiber.Ctx, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Params(name))
+	if err != nil {
+		return uuid.Nil, exception.NewError("Invalid " + name + " format. Please provide a valid UUID.")
+	}
+	return id, nil
+}
+
+// ParseOptionalUUID membaca UUID opsional dari query/body; string kosong menghasilkan nil
+func ParseOptionalUUID(value string, name string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, exception.NewError(name + " must be a valid UUID")
+	}
+	return &parsed, nil
+}
+
+// CurrentUserID mengambil ID user yang login (di-set oleh AuthMiddleware)
+func CurrentUserID(ctx *fiber.Ctx) uuid.UUID {
+	userID, ok := ctx.Locals("userID").(uuid.UUID)
+	if !ok {
+		return uuid.Nil
+	}
+	return userID
+}
+
+// CurrentSessionID mengambil sesi (family refresh token) dari access token yang dipakai. Token lama
+// tanpa klaim sid menghasilkan uuid.Nil.
+func CurrentSessionID(ctx *fiber.Ctx) uuid.UUID {
+	claims, ok := ctx.Locals("userClaims").(*JWTClaim)
+	if !ok {
+		return uuid.Nil
+	}
+	return claims.SessionID
+}
+
+// CurrentUserRole mengambil role user yang login (di-set oleh AuthMiddleware)
+func CurrentUserRole(ctx *fiber.Ctx) domain.Role {
+	role, ok := ctx.Locals("userRole").(domain.Role)
+	if !ok {
+		return ""
+	}
+	return role
+}
@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// encryptedSecretPrefix menandai nilai yang dienkripsi EncryptSecret (AES-256-GCM, versi 1)
+const encryptedSecretPrefix = "enc:v1:"
+
+// SecretEncryptionKeySize adalah panjang kunci AES-256 dalam byte
+const SecretEncryptionKeySize = 32
+
+var secretCipher cipher.AEAD
+
+// SetSecretEncryptionKey dipanggil sekali saat startup dengan kunci aplikasi untuk mengenkripsi
+// secret yang disimpan di database (mis. seed TOTP)
+func SetSecretEncryptionKey(key []byte) error {
+	if len(key) != SecretEncryptionKeySize {
+		return fmt.Errorf("secret encryption key must be %d bytes", SecretEncryptionKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	secretCipher = aead
+	return nil
+}
+
+// EncryptSecret mengenkripsi plaintext dengan nonce acak. associatedData (mis. ID user) ikut
+// diautentikasi sehingga ciphertext tidak bisa dipindahkan ke row lain.
+func EncryptSecret(plaintext string, associatedData string) (string, error) {
+	if secretCipher == nil {
+		return "", errors.New("secret encryption key is not configured")
+	}
+
+	nonce := make([]byte, secretCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := secretCipher.Seal(nonce, nonce, []byte(plaintext), []byte(associatedData))
+	return encryptedSecretPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret membuka nilai hasil EncryptSecret dengan associatedData yang sama
+func DecryptSecret(value string, associatedData string) (string, error) {
+	if secretCipher == nil {
+		return "", errors.New("secret encryption key is not configured")
+	}
+	if !IsEncryptedSecret(value) {
+		return "", errors.New("secret is not encrypted")
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, encryptedSecretPrefix))
+	if err != nil || len(sealed) < secretCipher.NonceSize() {
+		return "", errors.New("malformed encrypted secret")
+	}
+	nonce, ciphertext := sealed[:secretCipher.NonceSize()], sealed[secretCipher.NonceSize():]
+	plaintext, err := secretCipher.Open(nil, nonce, ciphertext, []byte(associatedData))
+	if err != nil {
+		return "", errors.New("failed to decrypt secret")
+	}
+	return string(plaintext), nil
+}
+
+// IsEncryptedSecret memeriksa apakah nilai sudah dalam format EncryptSecret
+func IsEncryptedSecret(value string) bool {
+	return strings.HasPrefix(value, encryptedSecretPrefix)
+}
@@ -43,3 +43,36 @@ func FormatTimeIndonesia(t time.Time) string {
 
 	return dayName + ", " + t.Format("02") + " " + monthName + " " + t.Format("2006")
 }
+
+// DateLayout adalah format tanggal dokumen yang dipakai di request dan response
+const DateLayout = "2006-01-02"
+
+// ParseDate mengubah string "YYYY-MM-DD" menjadi time.Time (UTC)
+func ParseDate(value string) (time.Time, error) {
+	return time.Parse(DateLayout, value)
+}
+
+// FormatDate mengubah time.Time menjadi string "YYYY-MM-DD"
+func FormatDate(t time.Time) string {
+	return t.Format(DateLayout)
+}
+
+// Today mengembalikan tanggal hari ini (zona Asia/Jakarta) dalam bentuk yang sama dengan ParseDate
+func Today() time.Time {
+	location, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		location = time.UTC
+	}
+	now := time.Now().In(location)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StartOfDayJakarta mengubah tanggal hasil ParseDate menjadi pukul 00:00 zona Asia/Jakarta, dipakai
+// untuk memfilter kolom timestamp menurut tanggal lokal
+func StartOfDayJakarta(date time.Time) time.Time {
+	location, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		location = time.UTC
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+}
@@ -0,0 +1,148 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPPeriod dan TOTPDigits mengikuti default RFC 6238 yang didukung semua aplikasi authenticator
+	TOTPPeriod = 30
+	TOTPDigits = 6
+
+	// totpSkew adalah jumlah step sebelum/sesudah yang masih diterima untuk selisih jam perangkat
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret membuat secret 160-bit dalam format base32 tanpa padding
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPProvisioningURI membuat URI otpauth:// yang di-encode menjadi QR code oleh frontend
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(TOTPPeriod))
+	// Sebagian aplikasi authenticator tidak mengenali "+" sebagai spasi pada issuer
+	return "otpauth://totp/" + label + "?" + strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// TOTPStep mengembalikan nomor step waktu untuk t
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / TOTPPeriod
+}
+
+// TOTPCode menghitung kode TOTP untuk secret pada step tertentu
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", err
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1000000), nil
+}
+
+// ValidateTOTP memeriksa kode terhadap step now ±1 dan mengembalikan step yang cocok. Pemanggil
+// menyimpan step tersebut agar kode yang sama tidak bisa dipakai ulang.
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := TOTPStep(now)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// recoveryCodeBytes memberi 80 bit entropi per kode pemulihan sehingga hash SHA-256-nya tidak bisa
+// di-brute-force secara offline walaupun isi tabel bocor
+const recoveryCodeBytes = 10
+
+// GenerateRecoveryCodes membuat kode pemulihan sekali pakai berformat base32 XXXX-XXXX-XXXX-XXXX
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		random := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+		codes = append(codes, groupRecoveryCode(totpEncoding.EncodeToString(random)))
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode menyamakan format input user (huruf kecil, tanpa tanda hubung atau dengan
+// spasi) sebelum di-hash
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code))
+	return groupRecoveryCode(code)
+}
+
+// groupRecoveryCode menyisipkan tanda hubung setiap 4 karakter agar kode mudah dibaca
+func groupRecoveryCode(code string) string {
+	var builder strings.Builder
+	for i, char := range code {
+		if i > 0 && i%4 == 0 {
+			builder.WriteByte('-')
+		}
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
+
+// GenerateOpaqueToken membuat token acak yang aman untuk URL
+func GenerateOpaqueToken() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// HashToken menghitung SHA-256 dari token berentropi tinggi (challenge token 256 bit, recovery code
+// 80 bit) untuk disimpan di database. Hanya untuk nilai acak dengan entropi minimal 80 bit; input
+// yang lebih lemah harus memakai HashPassword (bcrypt).
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
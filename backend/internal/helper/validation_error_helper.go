@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"erpfinance/internal/exception"
 	"fmt"
 	"strings"
 
@@ -75,6 +76,8 @@ func TranslateError(err error) []ValidationError {
 			message = fmt.Sprintf("%s must contain only alphanumeric characters", formatFieldName(field))
 		case "len":
 			message = fmt.Sprintf("%s must be exactly %s characters long", formatFieldName(field), param)
+		case "datetime":
+			message = fmt.Sprintf("%s must be a valid date in format %s", formatFieldName(field), param)
 		default:
 			message = fmt.Sprintf("%s failed validation for '%s'", formatFieldName(field), tag)
 		}
@@ -111,5 +114,5 @@ func FormatValidationError(err error) error {
 	for _, e := range TranslateError(err) {
 		messages = append(messages, e.Message)
 	}
-	return fmt.Errorf("%s", strings.Join(messages, "; "))
+	return exception.NewError(strings.Join(messages, "; "))
 }
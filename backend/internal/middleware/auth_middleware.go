@@ -1,12 +1,27 @@
 package middleware
 
 import (
+	"context"
 	"erpfinance/internal/helper"
 	"erpfinance/internal/model/dto"
+	"log"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// TokenRevocationChecker memeriksa denylist jti/sesi dan watermark token per user
+type TokenRevocationChecker interface {
+	IsRevoked(ctx context.Context, claims *helper.JWTClaim) (bool, error)
+}
+
+var tokenRevocationChecker TokenRevocationChecker
+
+// SetTokenRevocationChecker wajib dipanggil sekali saat startup, sebelum route didaftarkan;
+// AuthMiddleware menolak semua request selama checker belum di-set
+func SetTokenRevocationChecker(checker TokenRevocationChecker) {
+	tokenRevocationChecker = checker
+}
+
 func AuthMiddleware() fiber.Handler {
 	return func(context *fiber.Ctx) error {
 		token := context.Get("Authorization")
@@ -35,9 +50,41 @@ func AuthMiddleware() fiber.Handler {
 			})
 		}
 
+		// Tolak token yang sudah dicabut (logout, ganti password, user dinonaktifkan). Tanpa checker
+		// status pencabutan tidak bisa dipastikan, jadi request ditolak (fail closed).
+		if tokenRevocationChecker == nil {
+			log.Printf("ERROR: token revocation checker is not configured")
+			return context.Status(fiber.StatusInternalServerError).JSON(dto.WebResponse{
+				Code:    fiber.StatusInternalServerError,
+				Status:  "INTERNAL SERVER ERROR",
+				Message: "Unable to verify token",
+				Data:    nil,
+			})
+		}
+		revoked, err := tokenRevocationChecker.IsRevoked(context.Context(), claims)
+		if err != nil {
+			log.Printf("ERROR: failed to check token revocation: %v", err)
+			return context.Status(fiber.StatusInternalServerError).JSON(dto.WebResponse{
+				Code:    fiber.StatusInternalServerError,
+				Status:  "INTERNAL SERVER ERROR",
+				Message: "Unable to verify token",
+				Data:    nil,
+			})
+		}
+		if revoked {
+			return context.Status(fiber.StatusUnauthorized).JSON(dto.WebResponse{
+				Code:    fiber.StatusUnauthorized,
+				Status:  "UNAUTHORIZED",
+				Message: "Token has been revoked",
+				Data:    nil,
+			})
+		}
+
 		// Set user information in context
 		context.Locals("userID", claims.ID)
 		context.Locals("userRole", claims.Role)
+		// Claims lengkap dipakai audit log untuk mencatat actor
+		context.Locals("userClaims", claims)
 
 		return context.Next()
 	}
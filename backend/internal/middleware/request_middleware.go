@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestInfo menyimpan IP dan user agent client di context sehingga bisa dibaca di luar handler
+// (audit log, sesi login)
+func RequestInfo() fiber.Handler {
+	return func(context *fiber.Ctx) error {
+		context.Locals("requestIP", context.IP())
+		context.Locals("requestUserAgent", context.Get(fiber.HeaderUserAgent))
+		return context.Next()
+	}
+}
@@ -11,12 +11,121 @@ func Migrate(db *gorm.DB) error {
 	err := db.AutoMigrate(
 		&domain.Users{},
 		&domain.RefreshToken{},
+		&domain.DocumentSequence{},
+		&domain.Account{},
+		&domain.JournalEntry{},
+		&domain.JournalLine{},
+		&domain.FiscalYear{},
+		&domain.AccountingPeriod{},
+		&domain.PeriodStatusLog{},
+		&domain.Supplier{},
+		&domain.SupplierAddress{},
+		&domain.SupplierContact{},
+		&domain.SupplierBankAccount{},
+		&domain.PurchaseRequisition{},
+		&domain.PurchaseRequisitionLine{},
+		&domain.PurchaseOrder{},
+		&domain.PurchaseOrderLine{},
+		&domain.Item{},
+		&domain.Warehouse{},
+		&domain.WarehouseBin{},
+		&domain.StockMovement{},
+		&domain.GoodsReceipt{},
+		&domain.GoodsReceiptLine{},
+		&domain.SupplierInvoice{},
+		&domain.SupplierInvoiceLine{},
+		&domain.MatchTolerance{},
+		&domain.SupplierInvoiceTax{},
+		&domain.PayableSetting{},
+		&domain.PaymentRun{},
+		&domain.PaymentBatch{},
+		&domain.PaymentBatchLine{},
+		&domain.Customer{},
+		&domain.SalesInvoice{},
+		&domain.SalesInvoiceLine{},
+		&domain.SalesInvoiceTax{},
+		&domain.CustomerReceipt{},
+		&domain.ReceiptAllocation{},
+		&domain.ReceivableSetting{},
+		&domain.WorkCenter{},
+		&domain.BillOfMaterial{},
+		&domain.BillOfMaterialLine{},
+		&domain.Routing{},
+		&domain.RoutingOperation{},
+		&domain.WorkOrder{},
+		&domain.WorkOrderMaterial{},
+		&domain.WorkOrderOperation{},
+		&domain.WorkOrderOutput{},
+		&domain.MRPRun{},
+		&domain.MRPDemand{},
+		&domain.MRPItemResult{},
+		&domain.MRPSuggestion{},
+		&domain.SalesOrder{},
+		&domain.SalesOrderLine{},
+		&domain.Carrier{},
+		&domain.Shipment{},
+		&domain.ShipmentLine{},
+		&domain.ShipmentPackage{},
+		&domain.ShipmentEvent{},
+		&domain.ShipmentAttachment{},
+		&domain.Currency{},
+		&domain.ExchangeRate{},
+		&domain.CurrencySetting{},
+		&domain.FXRevaluation{},
+		&domain.FXRevaluationLine{},
+		&domain.TaxCode{},
+		&domain.TaxInvoiceRange{},
+		&domain.AssetCategory{},
+		&domain.FixedAsset{},
+		&domain.FixedAssetTransfer{},
+		&domain.DepreciationRun{},
+		&domain.DepreciationRunLine{},
+		&domain.BankAccount{},
+		&domain.BankStatement{},
+		&domain.BankStatementLine{},
+		&domain.CostCenter{},
+		&domain.Budget{},
+		&domain.BudgetLine{},
+		&domain.ApprovalRule{},
+		&domain.ApprovalRuleStep{},
+		&domain.ApprovalRequest{},
+		&domain.ApprovalTask{},
+		&domain.ApprovalDelegation{},
+		&domain.AuditLog{},
+		&domain.UserRecoveryCode{},
+		&domain.LoginChallenge{},
+		&domain.TwoFactorPolicy{},
+		&domain.SecurityEvent{},
+		&domain.RevokedToken{},
 	)
 	if err != nil {
 		log.Println("Migration failed:", err)
 		return err
 	}
 
+	// Audit log hanya boleh ditambah: UPDATE, DELETE dan TRUNCATE ditolak di level database
+	err = db.Exec(auditLogImmutableSQL).Error
+	if err != nil {
+		log.Println("Migration failed:", err)
+		return err
+	}
+
 	log.Println("Database migration successful.")
 	return nil
 }
+
+const auditLogImmutableSQL = `
+CREATE OR REPLACE FUNCTION prevent_audit_log_change() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_logs_immutable ON audit_logs;
+CREATE TRIGGER audit_logs_immutable BEFORE UPDATE OR DELETE ON audit_logs
+	FOR EACH ROW EXECUTE FUNCTION prevent_audit_log_change();
+
+DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs;
+CREATE TRIGGER audit_logs_no_truncate BEFORE TRUNCATE ON audit_logs
+	FOR EACH STATEMENT EXECUTE FUNCTION prevent_audit_log_change();
+`
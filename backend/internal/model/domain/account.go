@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccountType string
+
+const (
+	AccountTypeAsset     AccountType = "Asset"
+	AccountTypeLiability AccountType = "Liability"
+	AccountTypeEquity    AccountType = "Equity"
+	AccountTypeRevenue   AccountType = "Revenue"
+	AccountTypeExpense   AccountType = "Expense"
+)
+
+// CashFlowCategory mengelompokkan akun neraca pada laporan arus kas metode tidak langsung
+type CashFlowCategory string
+
+const (
+	CashFlowCategoryCash      CashFlowCategory = "CASH"
+	CashFlowCategoryOperating CashFlowCategory = "OPERATING"
+	CashFlowCategoryInvesting CashFlowCategory = "INVESTING"
+	CashFlowCategoryFinancing CashFlowCategory = "FINANCING"
+)
+
+// IsDebitNormal mengembalikan true untuk tipe akun yang saldo normalnya di debit
+func (t AccountType) IsDebitNormal() bool {
+	return t == AccountTypeAsset || t == AccountTypeExpense
+}
+
+// Account adalah satu baris pada chart of accounts. Akun header (IsPostable = false)
+// hanya dipakai untuk pengelompokan dan tidak boleh dipakai di jurnal.
+type Account struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code       string      `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name       string      `gorm:"not null;" json:"name"`
+	Type       AccountType `gorm:"type:varchar(20);not null;index;" json:"type"`
+	ParentID   *uuid.UUID  `gorm:"type:uuid;index;" json:"parent_id"`
+	IsPostable bool        `gorm:"not null;" json:"is_postable"`
+	IsActive   bool        `gorm:"not null;" json:"is_active"`
+	// CashFlowCategory hanya untuk akun neraca; kosong berarti mengikuti EffectiveCashFlowCategory
+	CashFlowCategory CashFlowCategory `gorm:"type:varchar(20);" json:"cash_flow_category"`
+	CreatedAt        time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+
+	// Self reference untuk hirarki akun
+	Parent *Account `gorm:"foreignKey:ParentID;references:ID;constraint:OnDelete:RESTRICT;" json:"parent,omitempty"`
+}
+
+// TableName sets the table name for Account model
+func (Account) TableName() string {
+	return "accounts"
+}
+
+// IsBalanceSheet mengembalikan true untuk akun aset, kewajiban dan ekuitas
+func (a Account) IsBalanceSheet() bool {
+	return a.Type == AccountTypeAsset || a.Type == AccountTypeLiability || a.Type == AccountTypeEquity
+}
+
+// EffectiveCashFlowCategory mengembalikan kategori arus kas akun neraca: kategori yang diisi,
+// atau FINANCING untuk ekuitas dan OPERATING untuk aset/kewajiban lainnya. Akun laba rugi
+// mengembalikan string kosong karena sudah tercakup dalam laba bersih.
+func (a Account) EffectiveCashFlowCategory() CashFlowCategory {
+	if !a.IsBalanceSheet() {
+		return ""
+	}
+	if a.CashFlowCategory != "" {
+		return a.CashFlowCategory
+	}
+	if a.Type == AccountTypeEquity {
+		return CashFlowCategoryFinancing
+	}
+	return CashFlowCategoryOperating
+}
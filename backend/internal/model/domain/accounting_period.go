@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PeriodStatus string
+
+const (
+	// PeriodStatusOpen: transaksi boleh diposting
+	PeriodStatusOpen PeriodStatus = "Open"
+	// PeriodStatusSoftClosed: posting diblokir, tetapi periode masih bisa dibuka kembali
+	PeriodStatusSoftClosed PeriodStatus = "SoftClosed"
+	// PeriodStatusLocked: posting diblokir permanen, tidak bisa dibuka kembali
+	PeriodStatusLocked PeriodStatus = "Locked"
+)
+
+type FiscalYear struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code      string    `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	StartDate time.Time `gorm:"type:date;not null;" json:"start_date"`
+	EndDate   time.Time `gorm:"type:date;not null;" json:"end_date"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Periods []AccountingPeriod `gorm:"foreignKey:FiscalYearID;references:ID;constraint:OnDelete:CASCADE;" json:"periods,omitempty"`
+}
+
+// TableName sets the table name for FiscalYear model
+func (FiscalYear) TableName() string {
+	return "fiscal_years"
+}
+
+// AccountingPeriod adalah periode bulanan di dalam satu tahun buku
+type AccountingPeriod struct {
+	ID           uuid.UUID    `gorm:"type:uuid;primaryKey;" json:"id"`
+	FiscalYearID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_period_fiscal_year_no;" json:"fiscal_year_id"`
+	PeriodNo     int          `gorm:"not null;uniqueIndex:idx_period_fiscal_year_no;" json:"period_no"`
+	Name         string       `gorm:"type:varchar(20);not null;" json:"name"`
+	StartDate    time.Time    `gorm:"type:date;not null;index;" json:"start_date"`
+	EndDate      time.Time    `gorm:"type:date;not null;index;" json:"end_date"`
+	Status       PeriodStatus `gorm:"type:varchar(20);not null;" json:"status"`
+	CreatedAt    time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time    `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for AccountingPeriod model
+func (AccountingPeriod) TableName() string {
+	return "accounting_periods"
+}
+
+// PeriodStatusLog mencatat setiap perubahan status periode beserta alasannya
+type PeriodStatusLog struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primaryKey;" json:"id"`
+	PeriodID   uuid.UUID    `gorm:"type:uuid;not null;index;" json:"period_id"`
+	FromStatus PeriodStatus `gorm:"type:varchar(20);not null;" json:"from_status"`
+	ToStatus   PeriodStatus `gorm:"type:varchar(20);not null;" json:"to_status"`
+	Reason     string       `gorm:"type:text;not null;" json:"reason"`
+	ChangedBy  uuid.UUID    `gorm:"type:uuid;not null;" json:"changed_by"`
+	CreatedAt  time.Time    `gorm:"autoCreateTime" json:"created_at"`
+
+	Period AccountingPeriod `gorm:"foreignKey:PeriodID;references:ID;constraint:OnDelete:CASCADE;" json:"-"`
+}
+
+// TableName sets the table name for PeriodStatusLog model
+func (PeriodStatusLog) TableName() string {
+	return "period_status_logs"
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Jenis dokumen yang bisa diberi aturan approval
+const (
+	ApprovalDocumentPurchaseRequisition = "PURCHASE_REQUISITION"
+	ApprovalDocumentPurchaseOrder       = "PURCHASE_ORDER"
+	ApprovalDocumentSupplierInvoice     = "SUPPLIER_INVOICE"
+	ApprovalDocumentPaymentRun          = "PAYMENT_RUN"
+	ApprovalDocumentSalesOrder          = "SALES_ORDER"
+	ApprovalDocumentJournalEntry        = "JOURNAL_ENTRY"
+	ApprovalDocumentBudget              = "BUDGET"
+)
+
+type ApprovalRequestStatus string
+
+const (
+	ApprovalRequestStatusPending  ApprovalRequestStatus = "Pending"
+	ApprovalRequestStatusApproved ApprovalRequestStatus = "Approved"
+	ApprovalRequestStatusRejected ApprovalRequestStatus = "Rejected"
+)
+
+type ApprovalTaskStatus string
+
+const (
+	// ApprovalTaskStatusWaiting menunggu step dengan sequence sebelumnya selesai
+	ApprovalTaskStatusWaiting   ApprovalTaskStatus = "Waiting"
+	ApprovalTaskStatusPending   ApprovalTaskStatus = "Pending"
+	ApprovalTaskStatusApproved  ApprovalTaskStatus = "Approved"
+	ApprovalTaskStatusRejected  ApprovalTaskStatus = "Rejected"
+	ApprovalTaskStatusCancelled ApprovalTaskStatus = "Cancelled"
+)
+
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApprove ApprovalDecision = "APPROVE"
+	ApprovalDecisionReject  ApprovalDecision = "REJECT"
+)
+
+// ApprovalRule menentukan step approval untuk satu jenis dokumen dengan nominal antara MinAmount
+// dan MaxAmount (nil berarti tanpa batas atas). Bila beberapa rule cocok, Priority terkecil dipakai.
+type ApprovalRule struct {
+	ID           uuid.UUID        `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code         string           `gorm:"type:varchar(30);not null;unique;" json:"code"`
+	Name         string           `gorm:"type:varchar(100);not null;" json:"name"`
+	DocumentType string           `gorm:"type:varchar(30);not null;index;" json:"document_type"`
+	MinAmount    decimal.Decimal  `gorm:"type:numeric(20,2);not null;default:0;" json:"min_amount"`
+	MaxAmount    *decimal.Decimal `gorm:"type:numeric(20,2);" json:"max_amount"`
+	Priority     int              `gorm:"not null;default:0;" json:"priority"`
+	IsActive     bool             `gorm:"not null;default:true;" json:"is_active"`
+	Description  string           `gorm:"type:text;" json:"description"`
+	CreatedAt    time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Steps []ApprovalRuleStep `gorm:"foreignKey:RuleID;references:ID;constraint:OnDelete:CASCADE;" json:"steps,omitempty"`
+}
+
+// TableName sets the table name for ApprovalRule model
+func (ApprovalRule) TableName() string {
+	return "approval_rules"
+}
+
+// Matches memeriksa apakah nominal dokumen berada dalam rentang rule
+func (r ApprovalRule) Matches(amount decimal.Decimal) bool {
+	return amount.GreaterThanOrEqual(r.MinAmount) && (r.MaxAmount == nil || amount.LessThanOrEqual(*r.MaxAmount))
+}
+
+// ApprovalRuleStep adalah satu approver dalam rule: role tertentu atau user tertentu. Step dengan
+// Sequence yang sama berjalan paralel dan semuanya harus menyetujui sebelum sequence berikutnya
+// dimulai. Step dilewati bila nominal dokumen di bawah MinAmount step.
+type ApprovalRuleStep struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	RuleID         uuid.UUID       `gorm:"type:uuid;not null;index;" json:"rule_id"`
+	Sequence       int             `gorm:"not null;" json:"sequence"`
+	Name           string          `gorm:"type:varchar(100);not null;" json:"name"`
+	ApproverRole   *Role           `gorm:"type:varchar(20);" json:"approver_role"`
+	ApproverUserID *uuid.UUID      `gorm:"type:uuid;" json:"approver_user_id"`
+	MinAmount      decimal.Decimal `gorm:"type:numeric(20,2);not null;default:0;" json:"min_amount"`
+}
+
+// TableName sets the table name for ApprovalRuleStep model
+func (ApprovalRuleStep) TableName() string {
+	return "approval_rule_steps"
+}
+
+// ApprovalRequest adalah proses approval satu dokumen. Step rule disalin menjadi task sehingga
+// perubahan rule tidak mempengaruhi request yang sedang berjalan. Dokumen tanpa rule yang cocok
+// langsung berstatus Approved tanpa task.
+type ApprovalRequest struct {
+	ID              uuid.UUID             `gorm:"type:uuid;primaryKey;" json:"id"`
+	DocumentType    string                `gorm:"type:varchar(30);not null;index:idx_approval_request_document;" json:"document_type"`
+	DocumentID      uuid.UUID             `gorm:"type:uuid;not null;index:idx_approval_request_document;" json:"document_id"`
+	DocumentNumber  string                `gorm:"type:varchar(50);not null;" json:"document_number"`
+	Amount          decimal.Decimal       `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	RuleID          *uuid.UUID            `gorm:"type:uuid;" json:"rule_id"`
+	Status          ApprovalRequestStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	CurrentSequence int                   `gorm:"not null;default:0;" json:"current_sequence"`
+	RequestedBy     uuid.UUID             `gorm:"type:uuid;not null;index;" json:"requested_by"`
+	DecidedBy       *uuid.UUID            `gorm:"type:uuid;" json:"decided_by"`
+	CompletedAt     *time.Time            `json:"completed_at"`
+	Comment         string                `gorm:"type:text;" json:"comment"`
+	CreatedAt       time.Time             `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time             `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Rule  *ApprovalRule  `gorm:"foreignKey:RuleID;references:ID;constraint:OnDelete:SET NULL;" json:"rule,omitempty"`
+	Tasks []ApprovalTask `gorm:"foreignKey:RequestID;references:ID;constraint:OnDelete:CASCADE;" json:"tasks,omitempty"`
+}
+
+// TableName sets the table name for ApprovalRequest model
+func (ApprovalRequest) TableName() string {
+	return "approval_requests"
+}
+
+// ApprovalTask adalah satu approval yang harus diberikan dalam request. OnBehalfOf diisi bila
+// keputusan diambil oleh penerima delegasi atas nama approver aslinya.
+type ApprovalTask struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;" json:"id"`
+	RequestID      uuid.UUID          `gorm:"type:uuid;not null;index;" json:"request_id"`
+	Sequence       int                `gorm:"not null;" json:"sequence"`
+	Name           string             `gorm:"type:varchar(100);not null;" json:"name"`
+	ApproverRole   *Role              `gorm:"type:varchar(20);index;" json:"approver_role"`
+	ApproverUserID *uuid.UUID         `gorm:"type:uuid;index;" json:"approver_user_id"`
+	Status         ApprovalTaskStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	ActedBy        *uuid.UUID         `gorm:"type:uuid;" json:"acted_by"`
+	OnBehalfOf     *uuid.UUID         `gorm:"type:uuid;" json:"on_behalf_of"`
+	ActedAt        *time.Time         `json:"acted_at"`
+	Comment        string             `gorm:"type:text;" json:"comment"`
+
+	Request *ApprovalRequest `gorm:"foreignKey:RequestID;references:ID;" json:"request,omitempty"`
+}
+
+// TableName sets the table name for ApprovalTask model
+func (ApprovalTask) TableName() string {
+	return "approval_tasks"
+}
+
+// ApprovalDelegation memberi DelegateID wewenang approval milik DelegatorID selama StartDate sampai
+// EndDate, untuk satu jenis dokumen atau semua jenis bila DocumentType kosong
+type ApprovalDelegation struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	DelegatorID  uuid.UUID `gorm:"type:uuid;not null;index;" json:"delegator_id"`
+	DelegateID   uuid.UUID `gorm:"type:uuid;not null;index;" json:"delegate_id"`
+	DocumentType string    `gorm:"type:varchar(30);" json:"document_type"`
+	StartDate    time.Time `gorm:"type:date;not null;" json:"start_date"`
+	EndDate      time.Time `gorm:"type:date;not null;" json:"end_date"`
+	Reason       string    `gorm:"type:text;" json:"reason"`
+	IsActive     bool      `gorm:"not null;default:true;" json:"is_active"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Delegator Users `gorm:"foreignKey:DelegatorID;references:ID;constraint:OnDelete:CASCADE;" json:"-"`
+	Delegate  Users `gorm:"foreignKey:DelegateID;references:ID;constraint:OnDelete:CASCADE;" json:"-"`
+}
+
+// TableName sets the table name for ApprovalDelegation model
+func (ApprovalDelegation) TableName() string {
+	return "approval_delegations"
+}
+
+// Covers memeriksa apakah delegasi berlaku untuk jenis dokumen pada tanggal tersebut. Hanya bagian
+// tanggal yang dibandingkan (sama seperti kolom date di database), sehingga delegasi berlaku sampai
+// akhir hari EndDate; date sebaiknya helper.Today() agar tidak bergeser karena zona waktu.
+func (d ApprovalDelegation) Covers(documentType string, date time.Time) bool {
+	day := date.Format("2006-01-02")
+	return d.IsActive &&
+		(d.DocumentType == "" || d.DocumentType == documentType) &&
+		day >= d.StartDate.Format("2006-01-02") && day <= d.EndDate.Format("2006-01-02")
+}
+
+// ApprovalSubmission adalah data dokumen yang diajukan ke approval engine oleh modul pemiliknya
+// (bukan tabel)
+type ApprovalSubmission struct {
+	DocumentType   string
+	DocumentID     uuid.UUID
+	DocumentNumber string
+	Amount         decimal.Decimal
+	RequestedBy    uuid.UUID
+}
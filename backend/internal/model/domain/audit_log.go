@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "CREATE"
+	AuditActionUpdate AuditAction = "UPDATE"
+	AuditActionDelete AuditAction = "DELETE"
+)
+
+// AuditLog mencatat satu perubahan data. Untuk UPDATE, OldValues dan NewValues hanya berisi kolom
+// yang berubah; untuk CREATE dan DELETE berisi seluruh kolom row. Tabel ini hanya boleh ditambah,
+// UPDATE dan DELETE ditolak oleh trigger database.
+type AuditLog struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey;" json:"id"`
+	Action     AuditAction `gorm:"type:varchar(10);not null;index;" json:"action"`
+	EntityType string      `gorm:"type:varchar(100);not null;index:idx_audit_log_entity;" json:"entity_type"`
+	EntityID   string      `gorm:"type:varchar(100);not null;index:idx_audit_log_entity;" json:"entity_id"`
+	ActorID    *uuid.UUID  `gorm:"type:uuid;index;" json:"actor_id"`
+	ActorEmail string      `gorm:"type:varchar(255);" json:"actor_email"`
+	ActorRole  Role        `gorm:"type:varchar(20);" json:"actor_role"`
+	IPAddress  string      `gorm:"type:varchar(45);" json:"ip_address"`
+	OldValues  *string     `gorm:"type:jsonb;" json:"old_values"`
+	NewValues  *string     `gorm:"type:jsonb;" json:"new_values"`
+	CreatedAt  time.Time   `gorm:"not null;index;" json:"created_at"`
+}
+
+// TableName sets the table name for AuditLog model
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type BankAccountType string
+
+const (
+	BankAccountTypeBank BankAccountType = "BANK"
+	BankAccountTypeCash BankAccountType = "CASH"
+)
+
+type BankStatementFormat string
+
+const (
+	BankStatementFormatCSV   BankStatementFormat = "CSV"
+	BankStatementFormatMT940 BankStatementFormat = "MT940"
+)
+
+type BankStatementLineStatus string
+
+const (
+	BankStatementLineStatusUnmatched BankStatementLineStatus = "Unmatched"
+	BankStatementLineStatusMatched   BankStatementLineStatus = "Matched"
+)
+
+type BankMatchMethod string
+
+const (
+	BankMatchMethodAuto   BankMatchMethod = "AUTO"
+	BankMatchMethodManual BankMatchMethod = "MANUAL"
+)
+
+// BankAccount adalah rekening bank atau kas kecil perusahaan. Setiap rekening terhubung ke satu
+// akun buku besar (GLAccountID) yang dipakai sebagai akun setoran penerimaan maupun akun
+// pembayaran, sehingga mutasi rekening koran bisa dicocokkan dengan transaksi yang dibukukan.
+type BankAccount struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code          string          `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name          string          `gorm:"type:varchar(100);not null;" json:"name"`
+	Type          BankAccountType `gorm:"type:varchar(10);not null;" json:"type"`
+	BankName      string          `gorm:"type:varchar(100);" json:"bank_name"`
+	AccountNumber string          `gorm:"type:varchar(50);" json:"account_number"`
+	AccountHolder string          `gorm:"type:varchar(150);" json:"account_holder"`
+	Currency      string          `gorm:"type:varchar(3);not null;" json:"currency"`
+	GLAccountID   uuid.UUID       `gorm:"type:uuid;not null;unique;" json:"gl_account_id"`
+	IsActive      bool            `gorm:"not null;default:true;" json:"is_active"`
+	Notes         string          `gorm:"type:text;" json:"notes"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+
+	GLAccount Account `gorm:"foreignKey:GLAccountID;references:ID;constraint:OnDelete:RESTRICT;" json:"gl_account,omitempty"`
+}
+
+// TableName sets the table name for BankAccount model
+func (BankAccount) TableName() string {
+	return "bank_accounts"
+}
+
+// BankStatement adalah satu file rekening koran yang diimport. Saldo awal dan akhir hanya terisi
+// bila tersedia di file (MT940 tag :60F: dan :62F:).
+type BankStatement struct {
+	ID             uuid.UUID           `gorm:"type:uuid;primaryKey;" json:"id"`
+	BankAccountID  uuid.UUID           `gorm:"type:uuid;not null;index;" json:"bank_account_id"`
+	Format         BankStatementFormat `gorm:"type:varchar(10);not null;" json:"format"`
+	FileName       string              `gorm:"type:varchar(255);" json:"file_name"`
+	StatementNo    string              `gorm:"type:varchar(50);" json:"statement_no"`
+	PeriodStart    time.Time           `gorm:"type:date;not null;" json:"period_start"`
+	PeriodEnd      time.Time           `gorm:"type:date;not null;" json:"period_end"`
+	OpeningBalance *decimal.Decimal    `gorm:"type:numeric(20,2);" json:"opening_balance"`
+	ClosingBalance *decimal.Decimal    `gorm:"type:numeric(20,2);" json:"closing_balance"`
+	TotalCredit    decimal.Decimal     `gorm:"type:numeric(20,2);not null;default:0;" json:"total_credit"`
+	TotalDebit     decimal.Decimal     `gorm:"type:numeric(20,2);not null;default:0;" json:"total_debit"`
+	LineCount      int                 `gorm:"not null;default:0;" json:"line_count"`
+	SkippedLines   int                 `gorm:"not null;default:0;" json:"skipped_lines"`
+	ImportedBy     uuid.UUID           `gorm:"type:uuid;not null;" json:"imported_by"`
+	CreatedAt      time.Time           `gorm:"autoCreateTime" json:"created_at"`
+
+	BankAccount BankAccount         `gorm:"foreignKey:BankAccountID;references:ID;constraint:OnDelete:RESTRICT;" json:"bank_account,omitempty"`
+	Lines       []BankStatementLine `gorm:"foreignKey:BankStatementID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for BankStatement model
+func (BankStatement) TableName() string {
+	return "bank_statements"
+}
+
+// BankStatementLine adalah satu mutasi rekening koran. Amount bertanda dari sisi perusahaan:
+// positif untuk uang masuk (kredit di rekening koran), negatif untuk uang keluar. Fingerprint
+// dipakai untuk melewati mutasi yang sudah pernah diimport dari file lain.
+type BankStatementLine struct {
+	ID                uuid.UUID               `gorm:"type:uuid;primaryKey;" json:"id"`
+	BankStatementID   uuid.UUID               `gorm:"type:uuid;not null;index;" json:"bank_statement_id"`
+	BankAccountID     uuid.UUID               `gorm:"type:uuid;not null;index;uniqueIndex:idx_bank_statement_line_fingerprint;" json:"bank_account_id"`
+	LineNo            int                     `gorm:"not null;" json:"line_no"`
+	TransactionDate   time.Time               `gorm:"type:date;not null;index;" json:"transaction_date"`
+	Amount            decimal.Decimal         `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	Reference         string                  `gorm:"type:varchar(100);" json:"reference"`
+	Description       string                  `gorm:"type:text;" json:"description"`
+	Fingerprint       string                  `gorm:"type:varchar(64);not null;uniqueIndex:idx_bank_statement_line_fingerprint;" json:"-"`
+	Status            BankStatementLineStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	MatchedSourceType string                  `gorm:"type:varchar(30);uniqueIndex:idx_bank_statement_line_match;" json:"matched_source_type"`
+	MatchedSourceID   *uuid.UUID              `gorm:"type:uuid;uniqueIndex:idx_bank_statement_line_match;" json:"matched_source_id"`
+	MatchMethod       BankMatchMethod         `gorm:"type:varchar(10);" json:"match_method"`
+	MatchedBy         *uuid.UUID              `gorm:"type:uuid;" json:"matched_by"`
+	MatchedAt         *time.Time              `json:"matched_at"`
+}
+
+// TableName sets the table name for BankStatementLine model
+func (BankStatementLine) TableName() string {
+	return "bank_statement_lines"
+}
+
+// IsMatched mengembalikan true bila mutasi sudah dicocokkan dengan transaksi
+func (l BankStatementLine) IsMatched() bool {
+	return l.Status == BankStatementLineStatusMatched
+}
+
+// BankBookTransaction adalah penerimaan customer atau batch pembayaran supplier yang sudah
+// diposting ke akun buku besar rekening bank (bukan tabel). Amount bertanda seperti mutasi
+// rekening koran dan dalam mata uang transaksi.
+type BankBookTransaction struct {
+	SourceType      string
+	SourceID        uuid.UUID
+	Number          string
+	TransactionDate time.Time
+	Reference       string
+	Counterparty    string
+	AccountID       uuid.UUID
+	Currency        string
+	Amount          decimal.Decimal
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BillOfMaterial adalah resep satu item produksi: komponen yang dibutuhkan untuk menghasilkan
+// Quantity satuan item. Komponen yang juga punya BOM default dianggap sub-assembly sehingga
+// struktur BOM bisa bertingkat. Setiap item hanya punya satu BOM default.
+type BillOfMaterial struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	ItemID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_bom_item_version;" json:"item_id"`
+	Version     int       `gorm:"not null;uniqueIndex:idx_bom_item_version;" json:"version"`
+	Description string    `gorm:"type:text;" json:"description"`
+	Quantity    float64   `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	IsDefault   bool      `gorm:"not null;index;" json:"is_default"`
+	IsActive    bool      `gorm:"not null;" json:"is_active"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Item  *Item                `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+	Lines []BillOfMaterialLine `gorm:"foreignKey:BillOfMaterialID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for BillOfMaterial model
+func (BillOfMaterial) TableName() string {
+	return "bills_of_material"
+}
+
+// BillOfMaterialLine dengan ScrapPercent menambah kebutuhan komponen untuk menutup susut produksi
+type BillOfMaterialLine struct {
+	ID               uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	BillOfMaterialID uuid.UUID `gorm:"type:uuid;not null;index;" json:"bill_of_material_id"`
+	LineNo           int       `gorm:"not null;" json:"line_no"`
+	ComponentItemID  uuid.UUID `gorm:"type:uuid;not null;index;" json:"component_item_id"`
+	Quantity         float64   `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	ScrapPercent     float64   `gorm:"type:numeric(5,2);not null;default:0;" json:"scrap_percent"`
+	Notes            string    `gorm:"type:text;" json:"notes"`
+
+	ComponentItem *Item `gorm:"foreignKey:ComponentItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"component_item,omitempty"`
+}
+
+// TableName sets the table name for BillOfMaterialLine model
+func (BillOfMaterialLine) TableName() string {
+	return "bill_of_material_lines"
+}
+
+// GrossQuantity adalah kebutuhan komponen termasuk susut untuk outputQuantity satuan item induk
+func (l BillOfMaterialLine) GrossQuantity(bomQuantity float64, outputQuantity float64) float64 {
+	return outputQuantity / bomQuantity * l.Quantity * (1 + l.ScrapPercent/100)
+}
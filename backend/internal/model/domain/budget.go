@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type BudgetStatus string
+
+const (
+	BudgetStatusDraft  BudgetStatus = "Draft"
+	BudgetStatusActive BudgetStatus = "Active"
+	BudgetStatusClosed BudgetStatus = "Closed"
+)
+
+// BudgetControlPeriod menentukan anggaran yang tersedia saat budget check
+type BudgetControlPeriod string
+
+const (
+	// BudgetControlAnnual membandingkan realisasi dengan anggaran setahun penuh
+	BudgetControlAnnual BudgetControlPeriod = "ANNUAL"
+	// BudgetControlYearToDate membandingkan realisasi dengan anggaran Januari sampai bulan transaksi
+	BudgetControlYearToDate BudgetControlPeriod = "YTD"
+)
+
+// BudgetControlAction menentukan verdict saat pengeluaran melebihi sisa anggaran
+type BudgetControlAction string
+
+const (
+	BudgetControlWarn  BudgetControlAction = "WARN"
+	BudgetControlBlock BudgetControlAction = "BLOCK"
+)
+
+type BudgetVerdict string
+
+const (
+	BudgetVerdictOK    BudgetVerdict = "OK"
+	BudgetVerdictWarn  BudgetVerdict = "WARN"
+	BudgetVerdictBlock BudgetVerdict = "BLOCK"
+)
+
+// CostCenter adalah departemen atau pusat biaya. ParentID dipakai untuk mengelompokkan pusat biaya
+// di bawah departemen; realisasi dicatat melalui CostCenterID pada baris jurnal.
+type CostCenter struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code        string     `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name        string     `gorm:"type:varchar(100);not null;" json:"name"`
+	ParentID    *uuid.UUID `gorm:"type:uuid;index;" json:"parent_id"`
+	ManagerName string     `gorm:"type:varchar(100);" json:"manager_name"`
+	IsActive    bool       `gorm:"not null;default:true;" json:"is_active"`
+	Notes       string     `gorm:"type:text;" json:"notes"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Parent *CostCenter `gorm:"foreignKey:ParentID;references:ID;constraint:OnDelete:RESTRICT;" json:"parent,omitempty"`
+}
+
+// TableName sets the table name for CostCenter model
+func (CostCenter) TableName() string {
+	return "cost_centers"
+}
+
+// Budget adalah anggaran tahunan satu cost center. Hanya satu budget per cost center per tahun;
+// baris anggaran hanya bisa diubah selama Draft dan hanya budget Active yang dipakai budget check.
+type Budget struct {
+	ID                   uuid.UUID           `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number               string              `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	Name                 string              `gorm:"type:varchar(150);not null;" json:"name"`
+	FiscalYear           int                 `gorm:"not null;uniqueIndex:idx_budget_year_cost_center;" json:"fiscal_year"`
+	CostCenterID         uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_budget_year_cost_center;" json:"cost_center_id"`
+	Status               BudgetStatus        `gorm:"type:varchar(20);not null;index;" json:"status"`
+	ControlPeriod        BudgetControlPeriod `gorm:"type:varchar(10);not null;" json:"control_period"`
+	ControlAction        BudgetControlAction `gorm:"type:varchar(10);not null;" json:"control_action"`
+	WarnThresholdPercent float64             `gorm:"type:numeric(5,2);not null;" json:"warn_threshold_percent"`
+	TotalAmount          decimal.Decimal     `gorm:"type:numeric(20,2);not null;default:0;" json:"total_amount"`
+	Notes                string              `gorm:"type:text;" json:"notes"`
+	CreatedBy            uuid.UUID           `gorm:"type:uuid;not null;" json:"created_by"`
+	ActivatedBy          *uuid.UUID          `gorm:"type:uuid;" json:"activated_by"`
+	ActivatedAt          *time.Time          `json:"activated_at"`
+	CreatedAt            time.Time           `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt            time.Time           `gorm:"autoUpdateTime" json:"updated_at"`
+
+	CostCenter CostCenter   `gorm:"foreignKey:CostCenterID;references:ID;constraint:OnDelete:RESTRICT;" json:"cost_center"`
+	Lines      []BudgetLine `gorm:"foreignKey:BudgetID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for Budget model
+func (Budget) TableName() string {
+	return "budgets"
+}
+
+// BudgetLine adalah anggaran satu akun untuk satu bulan (1-12) dalam tahun budget
+type BudgetLine struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	BudgetID  uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_budget_line_account_month;" json:"budget_id"`
+	AccountID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_budget_line_account_month;index;" json:"account_id"`
+	Month     int             `gorm:"not null;uniqueIndex:idx_budget_line_account_month;" json:"month"`
+	Amount    decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+
+	Account Account `gorm:"foreignKey:AccountID;references:ID;constraint:OnDelete:RESTRICT;" json:"account,omitempty"`
+}
+
+// TableName sets the table name for BudgetLine model
+func (BudgetLine) TableName() string {
+	return "budget_lines"
+}
+
+// BudgetAmount adalah hasil agregasi anggaran atau realisasi per cost center, akun dan bulan
+// (bukan tabel)
+type BudgetAmount struct {
+	CostCenterID uuid.UUID
+	AccountID    uuid.UUID
+	Month        int
+	Amount       decimal.Decimal
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Carrier adalah master data ekspedisi/jasa pengiriman. TrackingURL boleh berisi
+// placeholder {tracking_number} yang diganti dengan nomor resi pengiriman.
+type Carrier struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code        string    `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name        string    `gorm:"type:varchar(100);not null;" json:"name"`
+	ServiceType string    `gorm:"type:varchar(50);" json:"service_type"`
+	ContactName string    `gorm:"type:varchar(100);" json:"contact_name"`
+	Phone       string    `gorm:"type:varchar(30);" json:"phone"`
+	Email       string    `gorm:"type:varchar(100);" json:"email"`
+	TrackingURL string    `gorm:"type:varchar(255);" json:"tracking_url"`
+	IsActive    bool      `gorm:"not null;default:true;" json:"is_active"`
+	Notes       string    `gorm:"type:text;" json:"notes"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for Carrier model
+func (Carrier) TableName() string {
+	return "carriers"
+}
+
+// TrackingLink mengembalikan URL pelacakan untuk nomor resi, atau string kosong jika
+// carrier tidak punya template atau resi belum diisi
+func (c Carrier) TrackingLink(trackingNumber string) string {
+	if c.TrackingURL == "" || trackingNumber == "" {
+		return ""
+	}
+	return strings.ReplaceAll(c.TrackingURL, "{tracking_number}", trackingNumber)
+}
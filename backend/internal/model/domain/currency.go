@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BaseCurrency adalah mata uang pembukuan perusahaan; seluruh saldo buku besar dicatat dalam
+// mata uang ini dan transaksi valas dikonversi saat posting
+const BaseCurrency = "IDR"
+
+type ExchangeRateSource string
+
+const (
+	ExchangeRateSourceManual ExchangeRateSource = "Manual"
+	ExchangeRateSourceImport ExchangeRateSource = "Import"
+)
+
+// Currency adalah master mata uang. Code mengikuti ISO 4217 (IDR, USD, SGD).
+type Currency struct {
+	Code          string    `gorm:"type:varchar(3);primaryKey;" json:"code"`
+	Name          string    `gorm:"type:varchar(100);not null;" json:"name"`
+	Symbol        string    `gorm:"type:varchar(10);" json:"symbol"`
+	DecimalPlaces int       `gorm:"not null;default:2;" json:"decimal_places"`
+	IsActive      bool      `gorm:"not null;default:true;" json:"is_active"`
+	CreatedAt     time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for Currency model
+func (Currency) TableName() string {
+	return "currencies"
+}
+
+// ExchangeRate adalah kurs harian: 1 unit CurrencyCode = Rate mata uang dasar. Kurs yang
+// dipakai untuk suatu tanggal adalah kurs terakhir pada atau sebelum tanggal tersebut.
+type ExchangeRate struct {
+	ID           uuid.UUID          `gorm:"type:uuid;primaryKey;" json:"id"`
+	CurrencyCode string             `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rate_date;" json:"currency_code"`
+	RateDate     time.Time          `gorm:"type:date;not null;uniqueIndex:idx_exchange_rate_date;" json:"rate_date"`
+	Rate         float64            `gorm:"type:numeric(20,8);not null;" json:"rate"`
+	Source       ExchangeRateSource `gorm:"type:varchar(10);not null;" json:"source"`
+	CreatedBy    uuid.UUID          `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt    time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Currency *Currency `gorm:"foreignKey:CurrencyCode;references:Code;constraint:OnDelete:RESTRICT;" json:"currency,omitempty"`
+}
+
+// TableName sets the table name for ExchangeRate model
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+// CurrencySettingID adalah id tunggal baris pengaturan akun selisih kurs
+const CurrencySettingID = 1
+
+// CurrencySetting menyimpan akun selisih kurs. RealisedFXAccountID menampung laba/rugi kurs saat
+// pelunasan, UnrealisedFXAccountID menampung penyesuaian revaluasi akhir bulan.
+type CurrencySetting struct {
+	ID                    int        `gorm:"primaryKey;autoIncrement:false;" json:"id"`
+	RealisedFXAccountID   *uuid.UUID `gorm:"type:uuid;" json:"realised_fx_account_id"`
+	UnrealisedFXAccountID *uuid.UUID `gorm:"type:uuid;" json:"unrealised_fx_account_id"`
+	UpdatedBy             *uuid.UUID `gorm:"type:uuid;" json:"updated_by"`
+	UpdatedAt             time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for CurrencySetting model
+func (CurrencySetting) TableName() string {
+	return "currency_settings"
+}
+
+const (
+	// JournalSourceFXRevaluation adalah source_type jurnal revaluasi selisih kurs akhir bulan
+	JournalSourceFXRevaluation = "FX_REVALUATION"
+	// JournalSourceReceiptAllocation adalah source_type jurnal selisih kurs saat kredit customer
+	// dialokasikan ke invoice dengan kurs berbeda
+	JournalSourceReceiptAllocation = "RECEIPT_ALLOCATION"
+)
+
+type FXRevaluationSourceType string
+
+const (
+	FXRevaluationSourceSalesInvoice    FXRevaluationSourceType = "SALES_INVOICE"
+	FXRevaluationSourceCustomerReceipt FXRevaluationSourceType = "CUSTOMER_RECEIPT"
+	FXRevaluationSourceSupplierInvoice FXRevaluationSourceType = "SUPPLIER_INVOICE"
+)
+
+// FXRevaluation adalah revaluasi akhir bulan atas saldo piutang dan hutang valas yang masih
+// terbuka. Selisih antara nilai buku (kurs transaksi) dan nilai pada kurs penutupan dijurnal ke
+// akun selisih kurs belum terealisasi lalu dibalik otomatis pada ReversalDate (awal bulan berikut).
+// TotalAdjustment adalah laba (positif) atau rugi (negatif) selisih kurs bersih.
+type FXRevaluation struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number          string          `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	RevaluationDate time.Time       `gorm:"type:date;not null;uniqueIndex;" json:"revaluation_date"`
+	ReversalDate    time.Time       `gorm:"type:date;not null;" json:"reversal_date"`
+	TotalAdjustment decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"total_adjustment"`
+	JournalEntryID  *uuid.UUID      `gorm:"type:uuid;index;" json:"journal_entry_id"`
+	ReversalEntryID *uuid.UUID      `gorm:"type:uuid;index;" json:"reversal_entry_id"`
+	Notes           string          `gorm:"type:text;" json:"notes"`
+	CreatedBy       uuid.UUID       `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt       time.Time       `gorm:"autoCreateTime" json:"created_at"`
+
+	Lines []FXRevaluationLine `gorm:"foreignKey:FXRevaluationID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for FXRevaluation model
+func (FXRevaluation) TableName() string {
+	return "fx_revaluations"
+}
+
+// FXRevaluationLine adalah satu dokumen terbuka yang direvaluasi. OpenAmount dalam mata uang
+// dokumen, BookedBase dan RevaluedBase dalam mata uang dasar. Adjustment positif menambah saldo
+// akun kontrol (debit untuk piutang, kredit untuk hutang dan kredit customer).
+type FXRevaluationLine struct {
+	ID              uuid.UUID               `gorm:"type:uuid;primaryKey;" json:"id"`
+	FXRevaluationID uuid.UUID               `gorm:"type:uuid;not null;index;" json:"fx_revaluation_id"`
+	SourceType      FXRevaluationSourceType `gorm:"type:varchar(30);not null;" json:"source_type"`
+	SourceID        uuid.UUID               `gorm:"type:uuid;not null;index;" json:"source_id"`
+	Number          string                  `gorm:"type:varchar(30);not null;" json:"number"`
+	PartyName       string                  `gorm:"type:varchar(150);not null;" json:"party_name"`
+	Currency        string                  `gorm:"type:varchar(3);not null;" json:"currency"`
+	OpenAmount      decimal.Decimal         `gorm:"type:numeric(20,2);not null;" json:"open_amount"`
+	BookedRate      float64                 `gorm:"type:numeric(20,8);not null;" json:"booked_rate"`
+	ClosingRate     float64                 `gorm:"type:numeric(20,8);not null;" json:"closing_rate"`
+	BookedBase      decimal.Decimal         `gorm:"type:numeric(20,2);not null;" json:"booked_base"`
+	RevaluedBase    decimal.Decimal         `gorm:"type:numeric(20,2);not null;" json:"revalued_base"`
+	Adjustment      decimal.Decimal         `gorm:"type:numeric(20,2);not null;" json:"adjustment"`
+}
+
+// TableName sets the table name for FXRevaluationLine model
+func (FXRevaluationLine) TableName() string {
+	return "fx_revaluation_lines"
+}
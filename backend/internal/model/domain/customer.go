@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Customer adalah master data pelanggan. Customer nonaktif tidak boleh dipakai untuk
+// sales invoice maupun penerimaan baru, namun riwayat transaksinya tetap bisa dilihat.
+// CreditLimit 0 berarti customer tidak dibatasi plafon kredit.
+type Customer struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code            string          `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name            string          `gorm:"type:varchar(150);not null;index;" json:"name"`
+	NPWP            string          `gorm:"column:npwp;type:varchar(16);index;" json:"npwp"`
+	Email           string          `gorm:"type:varchar(100);" json:"email"`
+	Phone           string          `gorm:"type:varchar(30);" json:"phone"`
+	BillingAddress  string          `gorm:"type:text;" json:"billing_address"`
+	PaymentTermDays int             `gorm:"not null;default:0;" json:"payment_term_days"`
+	Currency        string          `gorm:"type:varchar(3);not null;" json:"currency"`
+	CreditLimit     decimal.Decimal `gorm:"type:numeric(20,2);not null;default:0;" json:"credit_limit"`
+	IsActive        bool            `gorm:"not null;default:true;" json:"is_active"`
+	Notes           string          `gorm:"type:text;" json:"notes"`
+	CreatedAt       time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for Customer model
+func (Customer) TableName() string {
+	return "customers"
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CustomerReceiptStatus string
+
+const (
+	CustomerReceiptStatusDraft     CustomerReceiptStatus = "Draft"
+	CustomerReceiptStatusPosted    CustomerReceiptStatus = "Posted"
+	CustomerReceiptStatusCancelled CustomerReceiptStatus = "Cancelled"
+)
+
+// JournalSourceCustomerReceipt adalah source_type jurnal penerimaan customer
+const JournalSourceCustomerReceipt = "CUSTOMER_RECEIPT"
+
+// CustomerReceipt adalah uang yang diterima dari customer. Saat diposting seluruh Amount dijurnal
+// (debit kas/bank, kredit piutang usaha) dan dialokasikan ke satu atau beberapa sales invoice.
+// Sisa yang belum dialokasikan (kelebihan bayar) menjadi kredit customer dan bisa dialokasikan
+// ke invoice berikutnya tanpa jurnal baru karena sudah mengurangi akun piutang.
+type CustomerReceipt struct {
+	ID               uuid.UUID             `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number           string                `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	CustomerID       uuid.UUID             `gorm:"type:uuid;not null;index;" json:"customer_id"`
+	CustomerName     string                `gorm:"type:varchar(150);not null;" json:"customer_name"`
+	ReceiptDate      time.Time             `gorm:"type:date;not null;index;" json:"receipt_date"`
+	Currency         string                `gorm:"type:varchar(3);not null;" json:"currency"`
+	ExchangeRate     float64               `gorm:"type:numeric(20,8);not null;default:1;" json:"exchange_rate"`
+	Amount           decimal.Decimal       `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	AllocatedAmount  decimal.Decimal       `gorm:"type:numeric(20,2);not null;default:0;" json:"allocated_amount"`
+	DepositAccountID uuid.UUID             `gorm:"type:uuid;not null;" json:"deposit_account_id"`
+	Reference        string                `gorm:"type:varchar(50);" json:"reference"`
+	Notes            string                `gorm:"type:text;" json:"notes"`
+	Status           CustomerReceiptStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	JournalEntryID   *uuid.UUID            `gorm:"type:uuid;index;" json:"journal_entry_id"`
+	CreatedBy        uuid.UUID             `gorm:"type:uuid;not null;" json:"created_by"`
+	PostedBy         *uuid.UUID            `gorm:"type:uuid;" json:"posted_by"`
+	PostedAt         *time.Time            `json:"posted_at"`
+	CreatedAt        time.Time             `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time             `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Allocations []ReceiptAllocation `gorm:"foreignKey:CustomerReceiptID;references:ID;constraint:OnDelete:CASCADE;" json:"allocations,omitempty"`
+}
+
+// TableName sets the table name for CustomerReceipt model
+func (CustomerReceipt) TableName() string {
+	return "customer_receipts"
+}
+
+// UnappliedAmount adalah kredit customer yang belum dialokasikan ke invoice
+func (r CustomerReceipt) UnappliedAmount() decimal.Decimal {
+	return r.Amount.Sub(r.AllocatedAmount)
+}
+
+// ReceiptAllocation mencatat pelunasan satu sales invoice dari sebuah penerimaan. AllocationDate
+// dipakai laporan umur piutang; alokasi saat posting memakai tanggal penerimaan, sedangkan alokasi
+// kredit di kemudian hari memakai tanggal alokasinya sendiri.
+type ReceiptAllocation struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	CustomerReceiptID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"customer_receipt_id"`
+	SalesInvoiceID    uuid.UUID       `gorm:"type:uuid;not null;index;" json:"sales_invoice_id"`
+	InvoiceNumber     string          `gorm:"type:varchar(30);not null;" json:"invoice_number"`
+	AllocationDate    time.Time       `gorm:"type:date;not null;index;" json:"allocation_date"`
+	Amount            decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	CreatedBy         uuid.UUID       `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt         time.Time       `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for ReceiptAllocation model
+func (ReceiptAllocation) TableName() string {
+	return "receipt_allocations"
+}
+
+// ReceivableCredit adalah kredit customer yang belum dialokasikan per tanggal tertentu (bukan tabel)
+type ReceivableCredit struct {
+	ReceiptID       uuid.UUID
+	Number          string
+	CustomerID      uuid.UUID
+	CustomerName    string
+	Currency        string
+	ExchangeRate    float64
+	ReceiptDate     time.Time
+	Amount          decimal.Decimal
+	AllocatedAmount decimal.Decimal
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// DocumentSequence menyimpan nomor terakhir yang dipakai per prefix dokumen per bulan
+type DocumentSequence struct {
+	Prefix     string    `gorm:"type:varchar(10);primaryKey;" json:"prefix"`
+	Period     string    `gorm:"type:varchar(6);primaryKey;" json:"period"`
+	LastNumber int       `gorm:"not null;" json:"last_number"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for DocumentSequence model
+func (DocumentSequence) TableName() string {
+	return "document_sequences"
+}
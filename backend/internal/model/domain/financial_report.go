@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AccountMovement adalah total debit dan kredit jurnal terposting satu akun dalam suatu rentang
+// tanggal (bukan tabel). Jurnal berstatus Reversed tetap dihitung karena jurnal baliknya juga
+// terposting dan saling meniadakan.
+type AccountMovement struct {
+	AccountID uuid.UUID
+	Debit     decimal.Decimal
+	Credit    decimal.Decimal
+}
+
+// Balance mengembalikan saldo bertanda debit (debit dikurangi kredit)
+func (m AccountMovement) Balance() decimal.Decimal {
+	return m.Debit.Sub(m.Credit)
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type DepreciationMethod string
+
+const (
+	// DepreciationMethodStraightLine menyusutkan nilai yang dapat disusutkan secara merata per bulan
+	DepreciationMethodStraightLine DepreciationMethod = "STRAIGHT_LINE"
+	// DepreciationMethodDecliningBalance menyusutkan nilai buku dengan tarif tetap (faktor / umur),
+	// beralih ke garis lurus saat penyusutan garis lurus atas sisa umur menjadi lebih besar
+	DepreciationMethodDecliningBalance DepreciationMethod = "DECLINING_BALANCE"
+)
+
+type FixedAssetStatus string
+
+const (
+	FixedAssetStatusDraft            FixedAssetStatus = "Draft"
+	FixedAssetStatusActive           FixedAssetStatus = "Active"
+	FixedAssetStatusFullyDepreciated FixedAssetStatus = "FullyDepreciated"
+	FixedAssetStatusDisposed         FixedAssetStatus = "Disposed"
+)
+
+const (
+	// JournalSourceAssetAcquisition menandai jurnal perolehan aset tetap
+	JournalSourceAssetAcquisition = "ASSET_ACQUISITION"
+	// JournalSourceDepreciation menandai jurnal penyusutan bulanan
+	JournalSourceDepreciation = "DEPRECIATION"
+	// JournalSourceAssetDisposal menandai jurnal pelepasan aset tetap
+	JournalSourceAssetDisposal = "ASSET_DISPOSAL"
+)
+
+// AssetCategory adalah kelompok aset tetap beserta default metode, umur manfaat dan akun-akunnya
+type AssetCategory struct {
+	ID                               uuid.UUID          `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code                             string             `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name                             string             `gorm:"type:varchar(100);not null;" json:"name"`
+	Method                           DepreciationMethod `gorm:"type:varchar(20);not null;" json:"method"`
+	UsefulLifeMonths                 int                `gorm:"not null;" json:"useful_life_months"`
+	DecliningFactor                  float64            `gorm:"type:numeric(5,2);not null;default:2;" json:"declining_factor"`
+	AssetAccountID                   uuid.UUID          `gorm:"type:uuid;not null;" json:"asset_account_id"`
+	AccumulatedDepreciationAccountID uuid.UUID          `gorm:"type:uuid;not null;" json:"accumulated_depreciation_account_id"`
+	DepreciationExpenseAccountID     uuid.UUID          `gorm:"type:uuid;not null;" json:"depreciation_expense_account_id"`
+	DisposalGainLossAccountID        uuid.UUID          `gorm:"type:uuid;not null;" json:"disposal_gain_loss_account_id"`
+	IsActive                         bool               `gorm:"not null;default:true;" json:"is_active"`
+	CreatedAt                        time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt                        time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for AssetCategory model
+func (AssetCategory) TableName() string {
+	return "asset_categories"
+}
+
+// FixedAsset adalah satu aset pada register aset tetap. Penyusutan dihitung per bulan mulai bulan
+// DepreciationStartDate; DepreciatedMonths dan LastDepreciationDate mencatat bulan terakhir yang
+// sudah dijurnal oleh depreciation run.
+type FixedAsset struct {
+	ID                      uuid.UUID          `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number                  string             `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	Name                    string             `gorm:"type:varchar(150);not null;" json:"name"`
+	Description             string             `gorm:"type:text;" json:"description"`
+	SerialNumber            string             `gorm:"type:varchar(100);" json:"serial_number"`
+	CategoryID              uuid.UUID          `gorm:"type:uuid;not null;index;" json:"category_id"`
+	Location                string             `gorm:"type:varchar(100);not null;" json:"location"`
+	AcquisitionDate         time.Time          `gorm:"type:date;not null;" json:"acquisition_date"`
+	DepreciationStartDate   time.Time          `gorm:"type:date;not null;" json:"depreciation_start_date"`
+	AcquisitionCost         decimal.Decimal    `gorm:"type:numeric(20,2);not null;" json:"acquisition_cost"`
+	SalvageValue            decimal.Decimal    `gorm:"type:numeric(20,2);not null;" json:"salvage_value"`
+	UsefulLifeMonths        int                `gorm:"not null;" json:"useful_life_months"`
+	Method                  DepreciationMethod `gorm:"type:varchar(20);not null;" json:"method"`
+	DecliningFactor         float64            `gorm:"type:numeric(5,2);not null;default:2;" json:"declining_factor"`
+	AccumulatedDepreciation decimal.Decimal    `gorm:"type:numeric(20,2);not null;default:0;" json:"accumulated_depreciation"`
+	DepreciatedMonths       int                `gorm:"not null;default:0;" json:"depreciated_months"`
+	LastDepreciationDate    *time.Time         `gorm:"type:date;" json:"last_depreciation_date"`
+	Status                  FixedAssetStatus   `gorm:"type:varchar(20);not null;index;" json:"status"`
+	AcquisitionEntryID      *uuid.UUID         `gorm:"type:uuid;" json:"acquisition_entry_id"`
+	DisposalDate            *time.Time         `gorm:"type:date;" json:"disposal_date"`
+	DisposalProceeds        decimal.Decimal    `gorm:"type:numeric(20,2);not null;default:0;" json:"disposal_proceeds"`
+	DisposalGainLoss        decimal.Decimal    `gorm:"type:numeric(20,2);not null;default:0;" json:"disposal_gain_loss"`
+	DisposalEntryID         *uuid.UUID         `gorm:"type:uuid;" json:"disposal_entry_id"`
+	Notes                   string             `gorm:"type:text;" json:"notes"`
+	CreatedBy               uuid.UUID          `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt               time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt               time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Category  AssetCategory        `gorm:"foreignKey:CategoryID;references:ID;" json:"category"`
+	Transfers []FixedAssetTransfer `gorm:"foreignKey:FixedAssetID;references:ID;constraint:OnDelete:CASCADE;" json:"transfers,omitempty"`
+}
+
+// TableName sets the table name for FixedAsset model
+func (FixedAsset) TableName() string {
+	return "fixed_assets"
+}
+
+// BookValue adalah harga perolehan dikurangi akumulasi penyusutan
+func (a FixedAsset) BookValue() decimal.Decimal {
+	return a.AcquisitionCost.Sub(a.AccumulatedDepreciation)
+}
+
+// DepreciableAmount adalah nilai yang disusutkan sepanjang umur manfaat
+func (a FixedAsset) DepreciableAmount() decimal.Decimal {
+	return a.AcquisitionCost.Sub(a.SalvageValue)
+}
+
+// NextDepreciationPeriod adalah tanggal akhir bulan berikutnya yang belum disusutkan
+func (a FixedAsset) NextDepreciationPeriod() time.Time {
+	start := time.Date(a.DepreciationStartDate.Year(), a.DepreciationStartDate.Month(), 1, 0, 0, 0, 0, a.DepreciationStartDate.Location())
+	return start.AddDate(0, a.DepreciatedMonths+1, -1)
+}
+
+// FixedAssetTransfer adalah riwayat perpindahan lokasi aset
+type FixedAssetTransfer struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	FixedAssetID uuid.UUID `gorm:"type:uuid;not null;index;" json:"fixed_asset_id"`
+	TransferDate time.Time `gorm:"type:date;not null;" json:"transfer_date"`
+	FromLocation string    `gorm:"type:varchar(100);not null;" json:"from_location"`
+	ToLocation   string    `gorm:"type:varchar(100);not null;" json:"to_location"`
+	Notes        string    `gorm:"type:text;" json:"notes"`
+	CreatedBy    uuid.UUID `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for FixedAssetTransfer model
+func (FixedAssetTransfer) TableName() string {
+	return "fixed_asset_transfers"
+}
+
+// DepreciationRun adalah penyusutan bulanan seluruh aset aktif sampai PeriodEnd, dijurnal
+// dalam satu journal entry yang dikelompokkan per akun
+type DepreciationRun struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number         string          `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	PeriodEnd      time.Time       `gorm:"type:date;not null;uniqueIndex;" json:"period_end"`
+	TotalAmount    decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	JournalEntryID *uuid.UUID      `gorm:"type:uuid;" json:"journal_entry_id"`
+	Notes          string          `gorm:"type:text;" json:"notes"`
+	CreatedBy      uuid.UUID       `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt      time.Time       `gorm:"autoCreateTime" json:"created_at"`
+
+	Lines []DepreciationRunLine `gorm:"foreignKey:DepreciationRunID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for DepreciationRun model
+func (DepreciationRun) TableName() string {
+	return "depreciation_runs"
+}
+
+// DepreciationRunLine adalah penyusutan satu aset dalam satu run. Months lebih dari 1 bila aset
+// menyusul bulan-bulan yang belum pernah disusutkan.
+type DepreciationRunLine struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	DepreciationRunID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"depreciation_run_id"`
+	FixedAssetID      uuid.UUID       `gorm:"type:uuid;not null;index;" json:"fixed_asset_id"`
+	AssetNumber       string          `gorm:"type:varchar(30);not null;" json:"asset_number"`
+	AssetName         string          `gorm:"type:varchar(150);not null;" json:"asset_name"`
+	Months            int             `gorm:"not null;" json:"months"`
+	Amount            decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	AccumulatedAfter  decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"accumulated_after"`
+	BookValueAfter    decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"book_value_after"`
+}
+
+// TableName sets the table name for DepreciationRunLine model
+func (DepreciationRunLine) TableName() string {
+	return "depreciation_run_lines"
+}
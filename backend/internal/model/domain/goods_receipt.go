@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockSourceGoodsReceipt adalah source_type mutasi stok yang berasal dari goods receipt
+const StockSourceGoodsReceipt = "GOODS_RECEIPT"
+
+// GoodsReceipt (GRN) mencatat penerimaan barang fisik di gudang atas satu purchase order.
+// Dokumen ini tidak bisa diubah setelah disimpan; koreksi dilakukan lewat mutasi stok.
+type GoodsReceipt struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number          string    `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	PurchaseOrderID uuid.UUID `gorm:"type:uuid;not null;index;" json:"purchase_order_id"`
+	SupplierID      uuid.UUID `gorm:"type:uuid;not null;index;" json:"supplier_id"`
+	WarehouseID     uuid.UUID `gorm:"type:uuid;not null;index;" json:"warehouse_id"`
+	ReceiptDate     time.Time `gorm:"type:date;not null;index;" json:"receipt_date"`
+	DeliveryNote    string    `gorm:"type:varchar(100);" json:"delivery_note"`
+	Notes           string    `gorm:"type:text;" json:"notes"`
+	ReceivedBy      uuid.UUID `gorm:"type:uuid;not null;" json:"received_by"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	PurchaseOrder *PurchaseOrder     `gorm:"foreignKey:PurchaseOrderID;references:ID;constraint:OnDelete:RESTRICT;" json:"purchase_order,omitempty"`
+	Warehouse     *Warehouse         `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"warehouse,omitempty"`
+	Lines         []GoodsReceiptLine `gorm:"foreignKey:GoodsReceiptID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for GoodsReceipt model
+func (GoodsReceipt) TableName() string {
+	return "goods_receipts"
+}
+
+// GoodsReceiptLine menyimpan snapshot kuantitas dipesan dan sudah diterima sebelumnya
+// sehingga perbandingan ordered vs received tetap terbaca walau purchase order berubah.
+type GoodsReceiptLine struct {
+	ID                  uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	GoodsReceiptID      uuid.UUID  `gorm:"type:uuid;not null;index;" json:"goods_receipt_id"`
+	PurchaseOrderLineID uuid.UUID  `gorm:"type:uuid;not null;index;" json:"purchase_order_line_id"`
+	LineNo              int        `gorm:"not null;" json:"line_no"`
+	ItemID              *uuid.UUID `gorm:"type:uuid;" json:"item_id"`
+	BinID               *uuid.UUID `gorm:"type:uuid;" json:"bin_id"`
+	Description         string     `gorm:"type:text;not null;" json:"description"`
+	UOM                 string     `gorm:"type:varchar(20);not null;" json:"uom"`
+	OrderedQuantity     float64    `gorm:"type:numeric(18,4);not null;" json:"ordered_quantity"`
+	PreviouslyReceived  float64    `gorm:"type:numeric(18,4);not null;" json:"previously_received"`
+	ReceivedQuantity    float64    `gorm:"type:numeric(18,4);not null;" json:"received_quantity"`
+}
+
+// TableName sets the table name for GoodsReceiptLine model
+func (GoodsReceiptLine) TableName() string {
+	return "goods_receipt_lines"
+}
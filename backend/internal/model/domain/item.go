@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Item adalah master barang yang disimpan di gudang. StandardCost adalah biaya standar per
+// satuan yang dipakai untuk menghitung biaya standar dan aktual work order. LeadTimeDays
+// (waktu pembelian atau produksi) dan MinOrderQuantity dipakai MRP saat membuat saran order.
+type Item struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code         string          `gorm:"type:varchar(30);not null;unique;" json:"code"`
+	Name         string          `gorm:"type:varchar(150);not null;index;" json:"name"`
+	Description  string          `gorm:"type:text;" json:"description"`
+	Category     string          `gorm:"type:varchar(50);index;" json:"category"`
+	UOM          string          `gorm:"type:varchar(20);not null;" json:"uom"`
+	StandardCost decimal.Decimal `gorm:"type:numeric(20,2);not null;default:0;" json:"standard_cost"`
+	LeadTimeDays int             `gorm:"not null;default:0;" json:"lead_time_days"`
+	MinOrderQty  float64         `gorm:"type:numeric(18,4);not null;default:0;" json:"min_order_qty"`
+	IsActive     bool            `gorm:"not null;" json:"is_active"`
+	CreatedAt    time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for Item model
+func (Item) TableName() string {
+	return "items"
+}
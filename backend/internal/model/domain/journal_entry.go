@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type JournalStatus string
+
+const (
+	JournalStatusDraft    JournalStatus = "Draft"
+	JournalStatusPosted   JournalStatus = "Posted"
+	JournalStatusReversed JournalStatus = "Reversed"
+)
+
+// JournalSourceManual dipakai untuk jurnal yang diinput langsung oleh user
+const JournalSourceManual = "MANUAL"
+
+type JournalEntry struct {
+	ID           uuid.UUID     `gorm:"type:uuid;primaryKey;" json:"id"`
+	EntryNumber  string        `gorm:"type:varchar(30);not null;unique;" json:"entry_number"`
+	EntryDate    time.Time     `gorm:"type:date;not null;index;" json:"entry_date"`
+	Description  string        `gorm:"type:text;not null;" json:"description"`
+	Reference    string        `gorm:"type:varchar(100);" json:"reference"`
+	SourceType   string        `gorm:"type:varchar(30);not null;index:idx_journal_source;" json:"source_type"`
+	SourceID     *uuid.UUID    `gorm:"type:uuid;index:idx_journal_source;" json:"source_id"`
+	Status       JournalStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	ReversalOfID *uuid.UUID    `gorm:"type:uuid;index;" json:"reversal_of_id"`
+	CreatedBy    uuid.UUID     `gorm:"type:uuid;not null;" json:"created_by"`
+	PostedBy     *uuid.UUID    `gorm:"type:uuid;" json:"posted_by"`
+	PostedAt     *time.Time    `json:"posted_at"`
+	CreatedAt    time.Time     `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time     `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Lines []JournalLine `gorm:"foreignKey:JournalEntryID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for JournalEntry model
+func (JournalEntry) TableName() string {
+	return "journal_entries"
+}
+
+// JournalLine selalu dibukukan dalam mata uang dasar (Debit/Credit). Untuk transaksi valas,
+// nilai asli disimpan di ForeignDebit/ForeignCredit beserta kurs yang dipakai saat posting.
+type JournalLine struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	JournalEntryID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"journal_entry_id"`
+	LineNo         int             `gorm:"not null;" json:"line_no"`
+	AccountID      uuid.UUID       `gorm:"type:uuid;not null;index;" json:"account_id"`
+	Description    string          `gorm:"type:text;" json:"description"`
+	Debit          decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"debit"`
+	Credit         decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"credit"`
+	Currency       string          `gorm:"type:varchar(3);not null;default:'IDR';" json:"currency"`
+	ExchangeRate   float64         `gorm:"type:numeric(20,8);not null;default:1;" json:"exchange_rate"`
+	ForeignDebit   decimal.Decimal `gorm:"type:numeric(20,2);not null;default:0;" json:"foreign_debit"`
+	ForeignCredit  decimal.Decimal `gorm:"type:numeric(20,2);not null;default:0;" json:"foreign_credit"`
+	// CostCenterID opsional, dipakai untuk menghitung realisasi anggaran per cost center
+	CostCenterID *uuid.UUID `gorm:"type:uuid;index;" json:"cost_center_id"`
+
+	Account    Account     `gorm:"foreignKey:AccountID;references:ID;constraint:OnDelete:RESTRICT;" json:"account,omitempty"`
+	CostCenter *CostCenter `gorm:"foreignKey:CostCenterID;references:ID;constraint:OnDelete:RESTRICT;" json:"cost_center,omitempty"`
+}
+
+// TableName sets the table name for JournalLine model
+func (JournalLine) TableName() string {
+	return "journal_lines"
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MRPRunStatus string
+
+const (
+	MRPRunStatusQueued    MRPRunStatus = "Queued"
+	MRPRunStatusRunning   MRPRunStatus = "Running"
+	MRPRunStatusCompleted MRPRunStatus = "Completed"
+	MRPRunStatusFailed    MRPRunStatus = "Failed"
+)
+
+type MRPSuggestionType string
+
+const (
+	MRPSuggestionPurchase   MRPSuggestionType = "Purchase"
+	MRPSuggestionProduction MRPSuggestionType = "Production"
+)
+
+type MRPSuggestionStatus string
+
+const (
+	MRPSuggestionStatusOpen      MRPSuggestionStatus = "Open"
+	MRPSuggestionStatusConverted MRPSuggestionStatus = "Converted"
+)
+
+// MRPRun adalah satu perhitungan MRP untuk satu gudang dalam horizon perencanaan. Perhitungan
+// berjalan di background; Progress (0-100) dan Message diperbarui selama run berjalan dan
+// Message berisi penyebab kegagalan jika Status Failed.
+type MRPRun struct {
+	ID           uuid.UUID    `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number       string       `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	WarehouseID  uuid.UUID    `gorm:"type:uuid;not null;index;" json:"warehouse_id"`
+	HorizonStart time.Time    `gorm:"type:date;not null;" json:"horizon_start"`
+	HorizonEnd   time.Time    `gorm:"type:date;not null;" json:"horizon_end"`
+	Status       MRPRunStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	Progress     int          `gorm:"not null;default:0;" json:"progress"`
+	Message      string       `gorm:"type:text;" json:"message"`
+	Notes        string       `gorm:"type:text;" json:"notes"`
+	CreatedBy    uuid.UUID    `gorm:"type:uuid;not null;" json:"created_by"`
+	StartedAt    *time.Time   `json:"started_at"`
+	FinishedAt   *time.Time   `json:"finished_at"`
+	CreatedAt    time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time    `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Warehouse *Warehouse  `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"warehouse,omitempty"`
+	Demands   []MRPDemand `gorm:"foreignKey:MRPRunID;references:ID;constraint:OnDelete:CASCADE;" json:"demands,omitempty"`
+}
+
+// TableName sets the table name for MRPRun model
+func (MRPRun) TableName() string {
+	return "mrp_runs"
+}
+
+// MRPDemand adalah kebutuhan independen (jadwal produksi induk) yang dimasukkan saat run dibuat
+type MRPDemand struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	MRPRunID  uuid.UUID `gorm:"type:uuid;not null;index;" json:"mrp_run_id"`
+	LineNo    int       `gorm:"not null;" json:"line_no"`
+	ItemID    uuid.UUID `gorm:"type:uuid;not null;" json:"item_id"`
+	Quantity  float64   `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	DueDate   time.Time `gorm:"type:date;not null;" json:"due_date"`
+	Reference string    `gorm:"type:varchar(100);" json:"reference"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for MRPDemand model
+func (MRPDemand) TableName() string {
+	return "mrp_demands"
+}
+
+// MRPItemResult adalah ringkasan netting satu item. LowLevelCode adalah level terdalam item
+// di struktur BOM; item diproses dari level terendah agar seluruh kebutuhan turunan dari
+// induknya sudah terkumpul.
+type MRPItemResult struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	MRPRunID          uuid.UUID `gorm:"type:uuid;not null;index;" json:"mrp_run_id"`
+	ItemID            uuid.UUID `gorm:"type:uuid;not null;" json:"item_id"`
+	LowLevelCode      int       `gorm:"not null;" json:"low_level_code"`
+	OnHand            float64   `gorm:"type:numeric(18,4);not null;" json:"on_hand"`
+	ScheduledReceipts float64   `gorm:"type:numeric(18,4);not null;" json:"scheduled_receipts"`
+	GrossRequirement  float64   `gorm:"type:numeric(18,4);not null;" json:"gross_requirement"`
+	NetRequirement    float64   `gorm:"type:numeric(18,4);not null;" json:"net_requirement"`
+	PlannedQuantity   float64   `gorm:"type:numeric(18,4);not null;" json:"planned_quantity"`
+	EndingBalance     float64   `gorm:"type:numeric(18,4);not null;" json:"ending_balance"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for MRPItemResult model
+func (MRPItemResult) TableName() string {
+	return "mrp_item_results"
+}
+
+// MRPSuggestion adalah saran order hasil MRP: Purchase untuk item tanpa BOM default dan
+// Production untuk item yang punya BOM default. ReleaseDate adalah DueDate dikurangi lead time;
+// IsPastDue menandakan ReleaseDate sudah lewat dari awal horizon.
+type MRPSuggestion struct {
+	ID             uuid.UUID           `gorm:"type:uuid;primaryKey;" json:"id"`
+	MRPRunID       uuid.UUID           `gorm:"type:uuid;not null;index;" json:"mrp_run_id"`
+	ItemID         uuid.UUID           `gorm:"type:uuid;not null;" json:"item_id"`
+	SuggestionType MRPSuggestionType   `gorm:"type:varchar(20);not null;" json:"suggestion_type"`
+	NetRequirement float64             `gorm:"type:numeric(18,4);not null;" json:"net_requirement"`
+	Quantity       float64             `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	ReleaseDate    time.Time           `gorm:"type:date;not null;" json:"release_date"`
+	DueDate        time.Time           `gorm:"type:date;not null;" json:"due_date"`
+	IsPastDue      bool                `gorm:"not null;" json:"is_past_due"`
+	Status         MRPSuggestionStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	DocumentID     *uuid.UUID          `gorm:"type:uuid;" json:"document_id"`
+	DocumentNumber string              `gorm:"type:varchar(30);" json:"document_number"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for MRPSuggestion model
+func (MRPSuggestion) TableName() string {
+	return "mrp_suggestions"
+}
+
+// PlanningSupply adalah pasokan terjadwal (sisa purchase order atau requisition terbuka) yang
+// dipakai MRP saat netting (bukan tabel)
+type PlanningSupply struct {
+	ItemID   uuid.UUID
+	Quantity float64
+	Date     time.Time
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayableSettingID adalah id tunggal baris pengaturan akun hutang
+const PayableSettingID = 1
+
+// PayableSetting menyimpan akun default untuk jurnal hutang otomatis. PurchaseAccountID didebit
+// sebesar subtotal invoice saat approval, PayableAccountID adalah akun kontrol hutang usaha.
+type PayableSetting struct {
+	ID                int        `gorm:"primaryKey;autoIncrement:false;" json:"id"`
+	PayableAccountID  *uuid.UUID `gorm:"type:uuid;" json:"payable_account_id"`
+	PurchaseAccountID *uuid.UUID `gorm:"type:uuid;" json:"purchase_account_id"`
+	UpdatedBy         *uuid.UUID `gorm:"type:uuid;" json:"updated_by"`
+	UpdatedAt         time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for PayableSetting model
+func (PayableSetting) TableName() string {
+	return "payable_settings"
+}
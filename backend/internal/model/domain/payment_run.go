@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type PaymentRunStatus string
+
+const (
+	PaymentRunStatusDraft     PaymentRunStatus = "Draft"
+	PaymentRunStatusPosted    PaymentRunStatus = "Posted"
+	PaymentRunStatusCancelled PaymentRunStatus = "Cancelled"
+)
+
+// JournalSourcePaymentBatch adalah source_type jurnal pembayaran hutang per batch supplier
+const JournalSourcePaymentBatch = "PAYMENT_BATCH"
+
+// PaymentRun adalah proposal pembayaran invoice supplier yang sudah jatuh tempo. Invoice
+// dikelompokkan menjadi satu batch per supplier; saat diposting setiap batch menghasilkan
+// satu jurnal (debit hutang usaha, kredit akun kas/bank).
+type PaymentRun struct {
+	ID               uuid.UUID        `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number           string           `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	PaymentDate      time.Time        `gorm:"type:date;not null;index;" json:"payment_date"`
+	DueUntil         time.Time        `gorm:"type:date;not null;" json:"due_until"`
+	Currency         string           `gorm:"type:varchar(3);not null;" json:"currency"`
+	ExchangeRate     float64          `gorm:"type:numeric(20,8);not null;default:1;" json:"exchange_rate"`
+	PaymentAccountID uuid.UUID        `gorm:"type:uuid;not null;" json:"payment_account_id"`
+	Notes            string           `gorm:"type:text;" json:"notes"`
+	Status           PaymentRunStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	TotalAmount      decimal.Decimal  `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	CreatedBy        uuid.UUID        `gorm:"type:uuid;not null;" json:"created_by"`
+	PostedBy         *uuid.UUID       `gorm:"type:uuid;" json:"posted_by"`
+	PostedAt         *time.Time       `json:"posted_at"`
+	CreatedAt        time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Batches []PaymentBatch `gorm:"foreignKey:PaymentRunID;references:ID;constraint:OnDelete:CASCADE;" json:"batches,omitempty"`
+}
+
+// TableName sets the table name for PaymentRun model
+func (PaymentRun) TableName() string {
+	return "payment_runs"
+}
+
+// PaymentBatch menyimpan snapshot rekening bank utama supplier saat run dibuat
+type PaymentBatch struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	PaymentRunID   uuid.UUID       `gorm:"type:uuid;not null;index;" json:"payment_run_id"`
+	BatchNo        int             `gorm:"not null;" json:"batch_no"`
+	SupplierID     uuid.UUID       `gorm:"type:uuid;not null;index;" json:"supplier_id"`
+	SupplierName   string          `gorm:"type:varchar(150);not null;" json:"supplier_name"`
+	BankName       string          `gorm:"type:varchar(100);" json:"bank_name"`
+	AccountNumber  string          `gorm:"type:varchar(50);" json:"account_number"`
+	AccountName    string          `gorm:"type:varchar(150);" json:"account_name"`
+	TotalAmount    decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	JournalEntryID *uuid.UUID      `gorm:"type:uuid;index;" json:"journal_entry_id"`
+
+	Lines []PaymentBatchLine `gorm:"foreignKey:PaymentBatchID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for PaymentBatch model
+func (PaymentBatch) TableName() string {
+	return "payment_batches"
+}
+
+type PaymentBatchLine struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	PaymentBatchID    uuid.UUID       `gorm:"type:uuid;not null;index;" json:"payment_batch_id"`
+	SupplierInvoiceID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"supplier_invoice_id"`
+	InvoiceNumber     string          `gorm:"type:varchar(30);not null;" json:"invoice_number"`
+	SupplierInvoiceNo string          `gorm:"type:varchar(50);not null;" json:"supplier_invoice_no"`
+	DueDate           time.Time       `gorm:"type:date;not null;" json:"due_date"`
+	Amount            decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+}
+
+// TableName sets the table name for PaymentBatchLine model
+func (PaymentBatchLine) TableName() string {
+	return "payment_batch_lines"
+}
+
+// PayableOutstanding adalah sisa hutang satu invoice per tanggal tertentu (bukan tabel)
+type PayableOutstanding struct {
+	InvoiceID         uuid.UUID
+	Number            string
+	SupplierInvoiceNo string
+	SupplierID        uuid.UUID
+	SupplierName      string
+	Currency          string
+	ExchangeRate      float64
+	InvoiceDate       time.Time
+	DueDate           time.Time
+	TotalAmount       decimal.Decimal
+	PaidAmount        decimal.Decimal
+}
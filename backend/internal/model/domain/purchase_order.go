@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusDraft             PurchaseOrderStatus = "Draft"
+	PurchaseOrderStatusApproved          PurchaseOrderStatus = "Approved"
+	PurchaseOrderStatusSent              PurchaseOrderStatus = "Sent"
+	PurchaseOrderStatusPartiallyReceived PurchaseOrderStatus = "PartiallyReceived"
+	PurchaseOrderStatusClosed            PurchaseOrderStatus = "Closed"
+	PurchaseOrderStatusCancelled         PurchaseOrderStatus = "Cancelled"
+)
+
+type PurchaseOrder struct {
+	ID              uuid.UUID           `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number          string              `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	RequisitionID   *uuid.UUID          `gorm:"type:uuid;index;" json:"requisition_id"`
+	SupplierID      uuid.UUID           `gorm:"type:uuid;index;" json:"supplier_id"`
+	SupplierName    string              `gorm:"type:varchar(150);not null;" json:"supplier_name"`
+	Currency        string              `gorm:"type:varchar(3);not null;" json:"currency"`
+	PaymentTermDays int                 `gorm:"not null;default:0;" json:"payment_term_days"`
+	OrderDate       time.Time           `gorm:"type:date;not null;index;" json:"order_date"`
+	ExpectedDate    time.Time           `gorm:"type:date;not null;" json:"expected_date"`
+	Notes           string              `gorm:"type:text;" json:"notes"`
+	Status          PurchaseOrderStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	TotalAmount     decimal.Decimal     `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	CreatedBy       uuid.UUID           `gorm:"type:uuid;not null;" json:"created_by"`
+	ApprovedBy      *uuid.UUID          `gorm:"type:uuid;" json:"approved_by"`
+	ApprovedAt      *time.Time          `json:"approved_at"`
+	SentAt          *time.Time          `json:"sent_at"`
+	ClosedAt        *time.Time          `json:"closed_at"`
+	CreatedAt       time.Time           `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time           `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Lines []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for PurchaseOrder model
+func (PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+type PurchaseOrderLine struct {
+	ID               uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	PurchaseOrderID  uuid.UUID       `gorm:"type:uuid;not null;index;" json:"purchase_order_id"`
+	LineNo           int             `gorm:"not null;" json:"line_no"`
+	ItemID           *uuid.UUID      `gorm:"type:uuid;index;" json:"item_id"`
+	Description      string          `gorm:"type:text;not null;" json:"description"`
+	Quantity         float64         `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	UOM              string          `gorm:"type:varchar(20);not null;" json:"uom"`
+	UnitPrice        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"unit_price"`
+	LineTotal        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"line_total"`
+	ReceivedQuantity float64         `gorm:"type:numeric(18,4);not null;" json:"received_quantity"`
+	ExpectedDate     *time.Time      `gorm:"type:date;" json:"expected_date"`
+}
+
+// TableName sets the table name for PurchaseOrderLine model
+func (PurchaseOrderLine) TableName() string {
+	return "purchase_order_lines"
+}
+
+// OutstandingQuantity adalah kuantitas yang belum diterima
+func (l PurchaseOrderLine) OutstandingQuantity() float64 {
+	return l.Quantity - l.ReceivedQuantity
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type RequisitionStatus string
+
+const (
+	RequisitionStatusDraft     RequisitionStatus = "Draft"
+	RequisitionStatusSubmitted RequisitionStatus = "Submitted"
+	RequisitionStatusApproved  RequisitionStatus = "Approved"
+	RequisitionStatusRejected  RequisitionStatus = "Rejected"
+	RequisitionStatusConverted RequisitionStatus = "Converted"
+)
+
+type PurchaseRequisition struct {
+	ID              uuid.UUID         `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number          string            `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	RequestDate     time.Time         `gorm:"type:date;not null;" json:"request_date"`
+	RequiredDate    time.Time         `gorm:"type:date;not null;" json:"required_date"`
+	Notes           string            `gorm:"type:text;" json:"notes"`
+	Status          RequisitionStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	RequestedBy     uuid.UUID         `gorm:"type:uuid;not null;index;" json:"requested_by"`
+	ApprovedBy      *uuid.UUID        `gorm:"type:uuid;" json:"approved_by"`
+	ApprovedAt      *time.Time        `json:"approved_at"`
+	RejectReason    string            `gorm:"type:text;" json:"reject_reason"`
+	PurchaseOrderID *uuid.UUID        `gorm:"type:uuid;" json:"purchase_order_id"`
+	CreatedAt       time.Time         `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Lines []PurchaseRequisitionLine `gorm:"foreignKey:RequisitionID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for PurchaseRequisition model
+func (PurchaseRequisition) TableName() string {
+	return "purchase_requisitions"
+}
+
+// PurchaseRequisitionLine dengan ItemID diteruskan ke baris purchase order saat konversi
+// sehingga barangnya tercatat sebagai stok masuk saat goods receipt
+type PurchaseRequisitionLine struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	RequisitionID      uuid.UUID       `gorm:"type:uuid;not null;index;" json:"requisition_id"`
+	LineNo             int             `gorm:"not null;" json:"line_no"`
+	ItemID             *uuid.UUID      `gorm:"type:uuid;index;" json:"item_id"`
+	Description        string          `gorm:"type:text;not null;" json:"description"`
+	Quantity           float64         `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	UOM                string          `gorm:"type:varchar(20);not null;" json:"uom"`
+	EstimatedUnitPrice decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"estimated_unit_price"`
+}
+
+// TableName sets the table name for PurchaseRequisitionLine model
+func (PurchaseRequisitionLine) TableName() string {
+	return "purchase_requisition_lines"
+}
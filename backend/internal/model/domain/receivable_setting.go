@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReceivableSettingID adalah id tunggal baris pengaturan akun piutang
+const ReceivableSettingID = 1
+
+// ReceivableSetting menyimpan akun default untuk jurnal piutang otomatis. ReceivableAccountID
+// adalah akun kontrol piutang usaha, RevenueAccountID dikredit untuk baris invoice yang tidak
+// menyebutkan akun pendapatan sendiri.
+type ReceivableSetting struct {
+	ID                  int        `gorm:"primaryKey;autoIncrement:false;" json:"id"`
+	ReceivableAccountID *uuid.UUID `gorm:"type:uuid;" json:"receivable_account_id"`
+	RevenueAccountID    *uuid.UUID `gorm:"type:uuid;" json:"revenue_account_id"`
+	UpdatedBy           *uuid.UUID `gorm:"type:uuid;" json:"updated_by"`
+	UpdatedAt           time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for ReceivableSetting model
+func (ReceivableSetting) TableName() string {
+	return "receivable_settings"
+}
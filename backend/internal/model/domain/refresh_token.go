@@ -6,13 +6,37 @@ import (
 	"github.com/google/uuid"
 )
 
+// Alasan refresh token dicabut
+const (
+	RefreshTokenRevokedReuse  = "REUSE_DETECTED"
+	RefreshTokenRevokedLogout = "LOGOUT"
+	// RefreshTokenRevokedByUser dipakai saat user mencabut sesi dari daftar sesinya
+	RefreshTokenRevokedByUser = "SESSION_REVOKED"
+)
+
+// RefreshToken adalah satu refresh token yang pernah diterbitkan. Token hasil rotasi tetap berada
+// dalam FamilyID yang sama dengan token login awalnya; token lama tidak dihapus melainkan diberi
+// RotatedAt sehingga pemakaian ulang token lama bisa dikenali dan seluruh family dicabut.
+//
+// Satu family adalah satu sesi login di satu perangkat. DeviceName dan SignedInAt diwariskan ke
+// token hasil rotasi, sedangkan UserAgent, IPAddress dan LastUsedAt diperbarui setiap rotasi.
 type RefreshToken struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
-	UserID    uuid.UUID `gorm:"type:uuid;not null;index;" json:"user_id"`
-	Token     string    `gorm:"type:text;not null;unique;" json:"token"`
-	ExpiresAt time.Time `gorm:"not null;" json:"expires_at"`
-	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index;" json:"user_id"`
+	FamilyID      uuid.UUID  `gorm:"type:uuid;not null;default:gen_random_uuid();index;" json:"family_id"`
+	ParentID      *uuid.UUID `gorm:"type:uuid;" json:"parent_id"`
+	Token         string     `gorm:"type:text;not null;unique;" json:"token"`
+	ExpiresAt     time.Time  `gorm:"not null;" json:"expires_at"`
+	RotatedAt     *time.Time `json:"rotated_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	RevokedReason string     `gorm:"type:varchar(30);" json:"revoked_reason"`
+	DeviceName    string     `gorm:"type:varchar(100);" json:"device_name"`
+	UserAgent     string     `gorm:"type:varchar(255);" json:"user_agent"`
+	IPAddress     string     `gorm:"type:varchar(45);" json:"ip_address"`
+	SignedInAt    time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP;" json:"signed_in_at"`
+	LastUsedAt    time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP;" json:"last_used_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
 
 	// Foreign key relationship
 	User Users `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE;" json:"user,omitempty"`
@@ -21,4 +45,9 @@ type RefreshToken struct {
 // TableName sets the table name for RefreshToken model
 func (RefreshToken) TableName() string {
 	return "refresh_tokens"
-}
\ No newline at end of file
+}
+
+// IsActive memeriksa apakah token masih bisa ditukar dengan token baru
+func (t RefreshToken) IsActive() bool {
+	return t.RotatedAt == nil && t.RevokedAt == nil
+}
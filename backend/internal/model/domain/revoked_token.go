@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Jenis entri denylist token
+const (
+	// RevokedTokenTypeAccess mencabut satu access token berdasarkan jti
+	RevokedTokenTypeAccess = "ACCESS_TOKEN"
+	// RevokedTokenTypeSession mencabut semua access token yang diterbitkan untuk satu sesi (sid)
+	RevokedTokenTypeSession = "SESSION"
+)
+
+// RevokedToken adalah entri denylist yang diperiksa AuthMiddleware. ID berisi jti atau session id
+// sesuai Type. Entri hanya perlu disimpan sampai ExpiresAt, yaitu saat token yang dicabut sudah
+// kedaluwarsa dengan sendirinya.
+type RevokedToken struct {
+	ID        string    `gorm:"type:varchar(36);primaryKey;" json:"id"`
+	Type      string    `gorm:"type:varchar(20);not null;" json:"type"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index;" json:"user_id"`
+	Reason    string    `gorm:"type:varchar(30);" json:"reason"`
+	ExpiresAt time.Time `gorm:"not null;index;" json:"expires_at"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for RevokedToken model
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
+
+// UserTokenState adalah kolom user yang menentukan apakah token miliknya masih berlaku (bukan tabel)
+type UserTokenState struct {
+	ID               uuid.UUID
+	IsActive         bool
+	TokensValidAfter *time.Time
+}
+
+// Accepts memeriksa apakah token yang diterbitkan pada issuedAt masih berlaku untuk user ini
+func (s UserTokenState) Accepts(issuedAt time.Time) bool {
+	return s.IsActive && (s.TokensValidAfter == nil || !issuedAt.Before(*s.TokensValidAfter))
+}
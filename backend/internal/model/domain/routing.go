@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Routing adalah urutan operasi untuk memproduksi satu item. Setiap item hanya punya satu
+// routing default yang dipakai saat work order dibuat tanpa menyebutkan routing.
+type Routing struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	ItemID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_routing_item_version;" json:"item_id"`
+	Version     int       `gorm:"not null;uniqueIndex:idx_routing_item_version;" json:"version"`
+	Description string    `gorm:"type:text;" json:"description"`
+	IsDefault   bool      `gorm:"not null;index;" json:"is_default"`
+	IsActive    bool      `gorm:"not null;" json:"is_active"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Item       *Item              `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+	Operations []RoutingOperation `gorm:"foreignKey:RoutingID;references:ID;constraint:OnDelete:CASCADE;" json:"operations,omitempty"`
+}
+
+// TableName sets the table name for Routing model
+func (Routing) TableName() string {
+	return "routings"
+}
+
+// RoutingOperation menyimpan waktu standar: SetupMinutes sekali per work order dan
+// RunMinutesPerUnit untuk setiap satuan yang diproduksi
+type RoutingOperation struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	RoutingID         uuid.UUID `gorm:"type:uuid;not null;index;" json:"routing_id"`
+	Sequence          int       `gorm:"not null;" json:"sequence"`
+	WorkCenterID      uuid.UUID `gorm:"type:uuid;not null;index;" json:"work_center_id"`
+	Description       string    `gorm:"type:varchar(200);not null;" json:"description"`
+	SetupMinutes      float64   `gorm:"type:numeric(10,2);not null;" json:"setup_minutes"`
+	RunMinutesPerUnit float64   `gorm:"type:numeric(10,4);not null;" json:"run_minutes_per_unit"`
+
+	WorkCenter *WorkCenter `gorm:"foreignKey:WorkCenterID;references:ID;constraint:OnDelete:RESTRICT;" json:"work_center,omitempty"`
+}
+
+// TableName sets the table name for RoutingOperation model
+func (RoutingOperation) TableName() string {
+	return "routing_operations"
+}
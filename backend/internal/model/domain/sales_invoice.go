@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SalesInvoiceStatus string
+
+const (
+	SalesInvoiceStatusDraft     SalesInvoiceStatus = "Draft"
+	SalesInvoiceStatusPosted    SalesInvoiceStatus = "Posted"
+	SalesInvoiceStatusPaid      SalesInvoiceStatus = "Paid"
+	SalesInvoiceStatusCancelled SalesInvoiceStatus = "Cancelled"
+)
+
+// JournalSourceSalesInvoice adalah source_type jurnal piutang yang dibuat saat invoice diposting
+const JournalSourceSalesInvoice = "SALES_INVOICE"
+
+// SalesInvoice adalah tagihan ke customer. TotalAmount adalah nilai piutang: SubtotalAmount
+// ditambah pajak keluaran (misal PPN) dikurangi pajak yang dipotong customer (misal PPh 23).
+// PaidAmount bertambah setiap kali penerimaan customer dialokasikan ke invoice ini.
+type SalesInvoice struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number         string             `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	CustomerID     uuid.UUID          `gorm:"type:uuid;not null;index;" json:"customer_id"`
+	CustomerName   string             `gorm:"type:varchar(150);not null;" json:"customer_name"`
+	InvoiceDate    time.Time          `gorm:"type:date;not null;index;" json:"invoice_date"`
+	DueDate        time.Time          `gorm:"type:date;not null;index;" json:"due_date"`
+	Currency       string             `gorm:"type:varchar(3);not null;" json:"currency"`
+	ExchangeRate   float64            `gorm:"type:numeric(20,8);not null;default:1;" json:"exchange_rate"`
+	Reference      string             `gorm:"type:varchar(50);" json:"reference"`
+	TaxInvoiceNo   string             `gorm:"type:varchar(20);index;" json:"tax_invoice_no"`
+	Notes          string             `gorm:"type:text;" json:"notes"`
+	Status         SalesInvoiceStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	SubtotalAmount decimal.Decimal    `gorm:"type:numeric(20,2);not null;" json:"subtotal_amount"`
+	TaxAmount      decimal.Decimal    `gorm:"type:numeric(20,2);not null;" json:"tax_amount"`
+	TotalAmount    decimal.Decimal    `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	PaidAmount     decimal.Decimal    `gorm:"type:numeric(20,2);not null;default:0;" json:"paid_amount"`
+	JournalEntryID *uuid.UUID         `gorm:"type:uuid;index;" json:"journal_entry_id"`
+	CreatedBy      uuid.UUID          `gorm:"type:uuid;not null;" json:"created_by"`
+	PostedBy       *uuid.UUID         `gorm:"type:uuid;" json:"posted_by"`
+	PostedAt       *time.Time         `json:"posted_at"`
+	CreatedAt      time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Lines []SalesInvoiceLine `gorm:"foreignKey:SalesInvoiceID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+	Taxes []SalesInvoiceTax  `gorm:"foreignKey:SalesInvoiceID;references:ID;constraint:OnDelete:CASCADE;" json:"taxes,omitempty"`
+}
+
+// TableName sets the table name for SalesInvoice model
+func (SalesInvoice) TableName() string {
+	return "sales_invoices"
+}
+
+// OutstandingAmount adalah sisa piutang yang belum dilunasi
+func (i SalesInvoice) OutstandingAmount() decimal.Decimal {
+	return i.TotalAmount.Sub(i.PaidAmount)
+}
+
+// SalesInvoiceLine dengan RevenueAccountID kosong memakai akun pendapatan default saat posting
+type SalesInvoiceLine struct {
+	ID               uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	SalesInvoiceID   uuid.UUID       `gorm:"type:uuid;not null;index;" json:"sales_invoice_id"`
+	LineNo           int             `gorm:"not null;" json:"line_no"`
+	ItemID           *uuid.UUID      `gorm:"type:uuid;index;" json:"item_id"`
+	Description      string          `gorm:"type:text;not null;" json:"description"`
+	Quantity         float64         `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	UOM              string          `gorm:"type:varchar(20);not null;" json:"uom"`
+	UnitPrice        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"unit_price"`
+	LineTotal        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"line_total"`
+	RevenueAccountID *uuid.UUID      `gorm:"type:uuid;" json:"revenue_account_id"`
+}
+
+// TableName sets the table name for SalesInvoiceLine model
+func (SalesInvoiceLine) TableName() string {
+	return "sales_invoice_lines"
+}
+
+// SalesInvoiceTax adalah baris pajak sales invoice. Pajak keluaran (IsWithholding = false) menambah
+// piutang dan dikredit ke akun pajak; pajak yang dipotong customer mengurangi piutang dan didebit
+// ke akun pajak dibayar di muka.
+type SalesInvoiceTax struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	SalesInvoiceID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"sales_invoice_id"`
+	LineNo         int             `gorm:"not null;" json:"line_no"`
+	TaxCode        string          `gorm:"type:varchar(20);not null;" json:"tax_code"`
+	TaxType        TaxType         `gorm:"type:varchar(10);" json:"tax_type"`
+	Description    string          `gorm:"type:varchar(200);" json:"description"`
+	AccountID      uuid.UUID       `gorm:"type:uuid;not null;" json:"account_id"`
+	BaseAmount     decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"base_amount"`
+	Rate           float64         `gorm:"type:numeric(7,4);not null;" json:"rate"`
+	Amount         decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	IsWithholding  bool            `gorm:"not null;" json:"is_withholding"`
+}
+
+// TableName sets the table name for SalesInvoiceTax model
+func (SalesInvoiceTax) TableName() string {
+	return "sales_invoice_taxes"
+}
+
+// SignedAmount adalah pengaruh baris pajak terhadap piutang customer
+func (t SalesInvoiceTax) SignedAmount() decimal.Decimal {
+	if t.IsWithholding {
+		return t.Amount.Neg()
+	}
+	return t.Amount
+}
+
+// ReceivableOutstanding adalah sisa piutang satu invoice per tanggal tertentu (bukan tabel)
+type ReceivableOutstanding struct {
+	InvoiceID    uuid.UUID
+	Number       string
+	CustomerID   uuid.UUID
+	CustomerName string
+	Currency     string
+	ExchangeRate float64
+	InvoiceDate  time.Time
+	DueDate      time.Time
+	TotalAmount  decimal.Decimal
+	PaidAmount   decimal.Decimal
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SalesOrderStatus string
+
+const (
+	SalesOrderStatusDraft              SalesOrderStatus = "Draft"
+	SalesOrderStatusCreditHold         SalesOrderStatus = "CreditHold"
+	SalesOrderStatusConfirmed          SalesOrderStatus = "Confirmed"
+	SalesOrderStatusPartiallyFulfilled SalesOrderStatus = "PartiallyFulfilled"
+	SalesOrderStatusFulfilled          SalesOrderStatus = "Fulfilled"
+	SalesOrderStatusCancelled          SalesOrderStatus = "Cancelled"
+)
+
+type SalesOrderLineStatus string
+
+const (
+	SalesOrderLineUnfulfilled        SalesOrderLineStatus = "Unfulfilled"
+	SalesOrderLinePartiallyFulfilled SalesOrderLineStatus = "PartiallyFulfilled"
+	SalesOrderLineFulfilled          SalesOrderLineStatus = "Fulfilled"
+)
+
+// SalesOrder adalah pesanan penjualan dari customer. Saat dikonfirmasi, CreditExposure
+// (piutang terbuka + order terbuka lain + order ini) dibandingkan dengan CreditLimit customer;
+// order yang melebihi plafon ditahan di status CreditHold sampai disetujui lewat override.
+type SalesOrder struct {
+	ID                   uuid.UUID        `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number               string           `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	CustomerID           uuid.UUID        `gorm:"type:uuid;not null;index;" json:"customer_id"`
+	CustomerName         string           `gorm:"type:varchar(150);not null;" json:"customer_name"`
+	OrderDate            time.Time        `gorm:"type:date;not null;index;" json:"order_date"`
+	RequestedDate        *time.Time       `gorm:"type:date;" json:"requested_date"`
+	Currency             string           `gorm:"type:varchar(3);not null;" json:"currency"`
+	Reference            string           `gorm:"type:varchar(100);" json:"reference"`
+	ShippingAddress      string           `gorm:"type:text;" json:"shipping_address"`
+	Notes                string           `gorm:"type:text;" json:"notes"`
+	Status               SalesOrderStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	SubtotalAmount       decimal.Decimal  `gorm:"type:numeric(20,2);not null;" json:"subtotal_amount"`
+	DiscountAmount       decimal.Decimal  `gorm:"type:numeric(20,2);not null;" json:"discount_amount"`
+	TaxAmount            decimal.Decimal  `gorm:"type:numeric(20,2);not null;" json:"tax_amount"`
+	TotalAmount          decimal.Decimal  `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	CreditLimit          decimal.Decimal  `gorm:"type:numeric(20,2);not null;default:0;" json:"credit_limit"`
+	CreditExposure       decimal.Decimal  `gorm:"type:numeric(20,2);not null;default:0;" json:"credit_exposure"`
+	CreditOverrideBy     *uuid.UUID       `gorm:"type:uuid;" json:"credit_override_by"`
+	CreditOverrideAt     *time.Time       `json:"credit_override_at"`
+	CreditOverrideReason string           `gorm:"type:text;" json:"credit_override_reason"`
+	CreatedBy            uuid.UUID        `gorm:"type:uuid;not null;" json:"created_by"`
+	ConfirmedBy          *uuid.UUID       `gorm:"type:uuid;" json:"confirmed_by"`
+	ConfirmedAt          *time.Time       `json:"confirmed_at"`
+	CancelledAt          *time.Time       `json:"cancelled_at"`
+	CreatedAt            time.Time        `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt            time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Customer *Customer        `gorm:"foreignKey:CustomerID;references:ID;constraint:OnDelete:RESTRICT;" json:"customer,omitempty"`
+	Lines    []SalesOrderLine `gorm:"foreignKey:SalesOrderID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName sets the table name for SalesOrder model
+func (SalesOrder) TableName() string {
+	return "sales_orders"
+}
+
+// IsOpen menandakan order sudah dikonfirmasi dan masih menunggu pengiriman
+func (o SalesOrder) IsOpen() bool {
+	return o.Status == SalesOrderStatusConfirmed || o.Status == SalesOrderStatusPartiallyFulfilled
+}
+
+// SalesOrderLine menyimpan harga dan hasil perhitungan per baris: NetAmount adalah nilai setelah
+// diskon, TaxAmount dihitung dari NetAmount dan LineTotal = NetAmount + TaxAmount.
+type SalesOrderLine struct {
+	ID               uuid.UUID            `gorm:"type:uuid;primaryKey;" json:"id"`
+	SalesOrderID     uuid.UUID            `gorm:"type:uuid;not null;index;" json:"sales_order_id"`
+	LineNo           int                  `gorm:"not null;" json:"line_no"`
+	ItemID           uuid.UUID            `gorm:"type:uuid;not null;index;" json:"item_id"`
+	Description      string               `gorm:"type:text;not null;" json:"description"`
+	UOM              string               `gorm:"type:varchar(20);not null;" json:"uom"`
+	Quantity         float64              `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	UnitPrice        decimal.Decimal      `gorm:"type:numeric(20,2);not null;" json:"unit_price"`
+	DiscountPercent  float64              `gorm:"type:numeric(7,4);not null;default:0;" json:"discount_percent"`
+	DiscountAmount   decimal.Decimal      `gorm:"type:numeric(20,2);not null;default:0;" json:"discount_amount"`
+	NetAmount        decimal.Decimal      `gorm:"type:numeric(20,2);not null;" json:"net_amount"`
+	TaxPercent       float64              `gorm:"type:numeric(7,4);not null;default:0;" json:"tax_percent"`
+	TaxAmount        decimal.Decimal      `gorm:"type:numeric(20,2);not null;default:0;" json:"tax_amount"`
+	LineTotal        decimal.Decimal      `gorm:"type:numeric(20,2);not null;" json:"line_total"`
+	ShippedQuantity  float64              `gorm:"type:numeric(18,4);not null;default:0;" json:"shipped_quantity"`
+	FulfilmentStatus SalesOrderLineStatus `gorm:"type:varchar(20);not null;" json:"fulfilment_status"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for SalesOrderLine model
+func (SalesOrderLine) TableName() string {
+	return "sales_order_lines"
+}
+
+// OutstandingQuantity adalah kuantitas yang belum dikirim
+func (l SalesOrderLine) OutstandingQuantity() float64 {
+	return l.Quantity - l.ShippedQuantity
+}
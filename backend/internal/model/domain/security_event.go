@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Jenis security event
+const (
+	SecurityEventRefreshTokenReuse = "REFRESH_TOKEN_REUSE"
+	// SecurityEventTwoFactorFailed dicatat setiap kode 2FA atau recovery code salah saat login dan
+	// dipakai untuk membatasi percobaan per user
+	SecurityEventTwoFactorFailed = "TWO_FACTOR_FAILED"
+)
+
+// SecurityEvent mencatat kejadian keamanan yang perlu ditindaklanjuti admin, misalnya refresh
+// token yang dicuri lalu dipakai ulang setelah rotasi
+type SecurityEvent struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	EventType   string     `gorm:"type:varchar(50);not null;index;" json:"event_type"`
+	UserID      *uuid.UUID `gorm:"type:uuid;index;" json:"user_id"`
+	Description string     `gorm:"type:text;not null;" json:"description"`
+	IPAddress   string     `gorm:"type:varchar(45);" json:"ip_address"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;index;" json:"created_at"`
+}
+
+// TableName sets the table name for SecurityEvent model
+func (SecurityEvent) TableName() string {
+	return "security_events"
+}
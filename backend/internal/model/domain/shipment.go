@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusPlanned   ShipmentStatus = "Planned"
+	ShipmentStatusPicked    ShipmentStatus = "Picked"
+	ShipmentStatusShipped   ShipmentStatus = "Shipped"
+	ShipmentStatusDelivered ShipmentStatus = "Delivered"
+	ShipmentStatusReturned  ShipmentStatus = "Returned"
+)
+
+// StockSourceShipment adalah source_type mutasi stok yang berasal dari pengiriman
+const StockSourceShipment = "SHIPMENT"
+
+// Shipment adalah pengiriman barang keluar dari satu gudang. Stok dikurangi saat status
+// Shipped dan dikembalikan ke gudang saat Returned. Setiap perubahan status dicatat di
+// Events beserta waktunya. Pengiriman yang terhubung ke sales order ikut memperbarui
+// kuantitas terkirim baris order saat Shipped maupun Returned.
+type Shipment struct {
+	ID              uuid.UUID      `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number          string         `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	ShipmentDate    time.Time      `gorm:"type:date;not null;index;" json:"shipment_date"`
+	WarehouseID     uuid.UUID      `gorm:"type:uuid;not null;index;" json:"warehouse_id"`
+	CarrierID       *uuid.UUID     `gorm:"type:uuid;index;" json:"carrier_id"`
+	CustomerID      *uuid.UUID     `gorm:"type:uuid;index;" json:"customer_id"`
+	SalesOrderID    *uuid.UUID     `gorm:"type:uuid;index;" json:"sales_order_id"`
+	RecipientName   string         `gorm:"type:varchar(150);not null;" json:"recipient_name"`
+	RecipientPhone  string         `gorm:"type:varchar(30);" json:"recipient_phone"`
+	ShippingAddress string         `gorm:"type:text;not null;" json:"shipping_address"`
+	TrackingNumber  string         `gorm:"type:varchar(100);index;" json:"tracking_number"`
+	Reference       string         `gorm:"type:varchar(100);" json:"reference"`
+	Notes           string         `gorm:"type:text;" json:"notes"`
+	Status          ShipmentStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	ReceivedBy      string         `gorm:"type:varchar(150);" json:"received_by"`
+	ReturnReason    string         `gorm:"type:text;" json:"return_reason"`
+	CreatedBy       uuid.UUID      `gorm:"type:uuid;not null;" json:"created_by"`
+	PickedAt        *time.Time     `json:"picked_at"`
+	ShippedAt       *time.Time     `json:"shipped_at"`
+	DeliveredAt     *time.Time     `json:"delivered_at"`
+	ReturnedAt      *time.Time     `json:"returned_at"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Warehouse   *Warehouse           `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"warehouse,omitempty"`
+	Carrier     *Carrier             `gorm:"foreignKey:CarrierID;references:ID;constraint:OnDelete:RESTRICT;" json:"carrier,omitempty"`
+	Customer    *Customer            `gorm:"foreignKey:CustomerID;references:ID;constraint:OnDelete:RESTRICT;" json:"customer,omitempty"`
+	SalesOrder  *SalesOrder          `gorm:"foreignKey:SalesOrderID;references:ID;constraint:OnDelete:RESTRICT;" json:"sales_order,omitempty"`
+	Lines       []ShipmentLine       `gorm:"foreignKey:ShipmentID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+	Packages    []ShipmentPackage    `gorm:"foreignKey:ShipmentID;references:ID;constraint:OnDelete:CASCADE;" json:"packages,omitempty"`
+	Events      []ShipmentEvent      `gorm:"foreignKey:ShipmentID;references:ID;constraint:OnDelete:CASCADE;" json:"events,omitempty"`
+	Attachments []ShipmentAttachment `gorm:"foreignKey:ShipmentID;references:ID;constraint:OnDelete:CASCADE;" json:"attachments,omitempty"`
+}
+
+// TableName sets the table name for Shipment model
+func (Shipment) TableName() string {
+	return "shipments"
+}
+
+// ShipmentLine adalah barang yang dikirim. PackageNo menunjuk ShipmentPackage tempat
+// barang dikemas; 0 berarti belum dikemas. SalesOrderLineID wajib diisi bila pengiriman
+// terhubung ke sales order.
+type ShipmentLine struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	ShipmentID       uuid.UUID  `gorm:"type:uuid;not null;index;" json:"shipment_id"`
+	LineNo           int        `gorm:"not null;" json:"line_no"`
+	ItemID           uuid.UUID  `gorm:"type:uuid;not null;index;" json:"item_id"`
+	BinID            *uuid.UUID `gorm:"type:uuid;" json:"bin_id"`
+	SalesOrderLineID *uuid.UUID `gorm:"type:uuid;index;" json:"sales_order_line_id"`
+	Description      string     `gorm:"type:text;not null;" json:"description"`
+	UOM              string     `gorm:"type:varchar(20);not null;" json:"uom"`
+	Quantity         float64    `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	PackageNo        int        `gorm:"not null;default:0;" json:"package_no"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for ShipmentLine model
+func (ShipmentLine) TableName() string {
+	return "shipment_lines"
+}
+
+// ShipmentPackage adalah satu koli di packing list. Berat dalam kilogram dan dimensi dalam centimeter.
+type ShipmentPackage struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	ShipmentID  uuid.UUID `gorm:"type:uuid;not null;index;" json:"shipment_id"`
+	PackageNo   int       `gorm:"not null;" json:"package_no"`
+	PackageType string    `gorm:"type:varchar(30);not null;" json:"package_type"`
+	Weight      float64   `gorm:"type:numeric(18,4);not null;default:0;" json:"weight"`
+	Length      float64   `gorm:"type:numeric(18,2);not null;default:0;" json:"length"`
+	Width       float64   `gorm:"type:numeric(18,2);not null;default:0;" json:"width"`
+	Height      float64   `gorm:"type:numeric(18,2);not null;default:0;" json:"height"`
+	Notes       string    `gorm:"type:text;" json:"notes"`
+}
+
+// TableName sets the table name for ShipmentPackage model
+func (ShipmentPackage) TableName() string {
+	return "shipment_packages"
+}
+
+// ShipmentEvent adalah riwayat pelacakan: perubahan status maupun checkpoint dari ekspedisi
+// (Status tetap berisi status pengiriman saat checkpoint dicatat)
+type ShipmentEvent struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;" json:"id"`
+	ShipmentID  uuid.UUID      `gorm:"type:uuid;not null;index;" json:"shipment_id"`
+	Status      ShipmentStatus `gorm:"type:varchar(20);not null;" json:"status"`
+	EventAt     time.Time      `gorm:"not null;" json:"event_at"`
+	Location    string         `gorm:"type:varchar(150);" json:"location"`
+	Description string         `gorm:"type:text;not null;" json:"description"`
+	CreatedBy   uuid.UUID      `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for ShipmentEvent model
+func (ShipmentEvent) TableName() string {
+	return "shipment_events"
+}
+
+// ShipmentAttachment menyimpan metadata bukti pengiriman (foto, surat jalan bertanda tangan).
+// File disimpan di storage terpisah; yang dicatat di sini hanya lokasi dan informasinya.
+type ShipmentAttachment struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	ShipmentID  uuid.UUID `gorm:"type:uuid;not null;index;" json:"shipment_id"`
+	FileName    string    `gorm:"type:varchar(255);not null;" json:"file_name"`
+	ContentType string    `gorm:"type:varchar(100);not null;" json:"content_type"`
+	FileSize    int64     `gorm:"not null;" json:"file_size"`
+	StorageURL  string    `gorm:"type:varchar(500);not null;" json:"storage_url"`
+	Description string    `gorm:"type:text;" json:"description"`
+	UploadedBy  uuid.UUID `gorm:"type:uuid;not null;" json:"uploaded_by"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for ShipmentAttachment model
+func (ShipmentAttachment) TableName() string {
+	return "shipment_attachments"
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StockMovementType string
+
+const (
+	StockMovementReceipt    StockMovementType = "Receipt"
+	StockMovementIssue      StockMovementType = "Issue"
+	StockMovementTransfer   StockMovementType = "Transfer"
+	StockMovementAdjustment StockMovementType = "Adjustment"
+)
+
+// StockSourceManual dipakai untuk mutasi stok yang diinput langsung oleh user
+const StockSourceManual = "MANUAL"
+
+// StockMovement adalah satu baris mutasi stok yang tidak pernah diubah maupun dihapus.
+// Quantity bertanda: positif untuk barang masuk, negatif untuk barang keluar. Transfer
+// disimpan sebagai dua baris dengan nomor dokumen yang sama. Saldo on-hand per
+// item/lokasi adalah SUM(quantity) dari tabel ini.
+type StockMovement struct {
+	ID           uuid.UUID         `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number       string            `gorm:"type:varchar(30);not null;index;" json:"number"`
+	MovementType StockMovementType `gorm:"type:varchar(20);not null;index;" json:"movement_type"`
+	MovementDate time.Time         `gorm:"type:date;not null;index;" json:"movement_date"`
+	ItemID       uuid.UUID         `gorm:"type:uuid;not null;index:idx_stock_location;" json:"item_id"`
+	WarehouseID  uuid.UUID         `gorm:"type:uuid;not null;index:idx_stock_location;" json:"warehouse_id"`
+	BinID        *uuid.UUID        `gorm:"type:uuid;index:idx_stock_location;" json:"bin_id"`
+	Quantity     float64           `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	Reference    string            `gorm:"type:varchar(100);" json:"reference"`
+	SourceType   string            `gorm:"type:varchar(30);not null;index:idx_stock_source;" json:"source_type"`
+	SourceID     *uuid.UUID        `gorm:"type:uuid;index:idx_stock_source;" json:"source_id"`
+	Notes        string            `gorm:"type:text;" json:"notes"`
+	CreatedBy    uuid.UUID         `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt    time.Time         `gorm:"autoCreateTime" json:"created_at"`
+
+	Item      *Item         `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+	Warehouse *Warehouse    `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"warehouse,omitempty"`
+	Bin       *WarehouseBin `gorm:"foreignKey:BinID;references:ID;constraint:OnDelete:RESTRICT;" json:"bin,omitempty"`
+}
+
+// TableName sets the table name for StockMovement model
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+// StockBalance adalah hasil agregasi saldo on-hand per item/lokasi (bukan tabel)
+type StockBalance struct {
+	ItemID        uuid.UUID
+	ItemCode      string
+	ItemName      string
+	UOM           string
+	WarehouseID   uuid.UUID
+	WarehouseCode string
+	BinID         *uuid.UUID
+	BinCode       string
+	Quantity      float64
+}
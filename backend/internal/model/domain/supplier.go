@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SupplierStatus string
+
+const (
+	SupplierStatusActive  SupplierStatus = "Active"
+	SupplierStatusBlocked SupplierStatus = "Blocked"
+)
+
+// Supplier adalah master data pemasok. Supplier berstatus Blocked tidak boleh
+// dipakai untuk membuat purchase order baru.
+type Supplier struct {
+	ID              uuid.UUID      `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code            string         `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name            string         `gorm:"type:varchar(150);not null;index;" json:"name"`
+	NPWP            string         `gorm:"column:npwp;type:varchar(16);index;" json:"npwp"`
+	Email           string         `gorm:"type:varchar(100);" json:"email"`
+	Phone           string         `gorm:"type:varchar(30);" json:"phone"`
+	PaymentTermDays int            `gorm:"not null;default:0;" json:"payment_term_days"`
+	Currency        string         `gorm:"type:varchar(3);not null;" json:"currency"`
+	Status          SupplierStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	BlockReason     string         `gorm:"type:text;" json:"block_reason"`
+	Notes           string         `gorm:"type:text;" json:"notes"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Addresses    []SupplierAddress     `gorm:"foreignKey:SupplierID;references:ID;constraint:OnDelete:CASCADE;" json:"addresses,omitempty"`
+	Contacts     []SupplierContact     `gorm:"foreignKey:SupplierID;references:ID;constraint:OnDelete:CASCADE;" json:"contacts,omitempty"`
+	BankAccounts []SupplierBankAccount `gorm:"foreignKey:SupplierID;references:ID;constraint:OnDelete:CASCADE;" json:"bank_accounts,omitempty"`
+}
+
+// TableName sets the table name for Supplier model
+func (Supplier) TableName() string {
+	return "suppliers"
+}
+
+type SupplierAddress struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	SupplierID uuid.UUID `gorm:"type:uuid;not null;index;" json:"supplier_id"`
+	Label      string    `gorm:"type:varchar(50);not null;" json:"label"`
+	Address    string    `gorm:"type:text;not null;" json:"address"`
+	City       string    `gorm:"type:varchar(100);" json:"city"`
+	Province   string    `gorm:"type:varchar(100);" json:"province"`
+	PostalCode string    `gorm:"type:varchar(10);" json:"postal_code"`
+	IsPrimary  bool      `gorm:"not null;" json:"is_primary"`
+}
+
+// TableName sets the table name for SupplierAddress model
+func (SupplierAddress) TableName() string {
+	return "supplier_addresses"
+}
+
+type SupplierContact struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	SupplierID uuid.UUID `gorm:"type:uuid;not null;index;" json:"supplier_id"`
+	Name       string    `gorm:"type:varchar(100);not null;" json:"name"`
+	Position   string    `gorm:"type:varchar(100);" json:"position"`
+	Email      string    `gorm:"type:varchar(100);" json:"email"`
+	Phone      string    `gorm:"type:varchar(30);" json:"phone"`
+	IsPrimary  bool      `gorm:"not null;" json:"is_primary"`
+}
+
+// TableName sets the table name for SupplierContact model
+func (SupplierContact) TableName() string {
+	return "supplier_contacts"
+}
+
+type SupplierBankAccount struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	SupplierID    uuid.UUID `gorm:"type:uuid;not null;index;" json:"supplier_id"`
+	BankName      string    `gorm:"type:varchar(100);not null;" json:"bank_name"`
+	AccountNumber string    `gorm:"type:varchar(50);not null;" json:"account_number"`
+	AccountName   string    `gorm:"type:varchar(150);not null;" json:"account_name"`
+	IsPrimary     bool      `gorm:"not null;" json:"is_primary"`
+}
+
+// TableName sets the table name for SupplierBankAccount model
+func (SupplierBankAccount) TableName() string {
+	return "supplier_bank_accounts"
+}
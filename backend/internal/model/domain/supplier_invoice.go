@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SupplierInvoiceStatus string
+
+const (
+	SupplierInvoiceStatusDraft     SupplierInvoiceStatus = "Draft"
+	SupplierInvoiceStatusApproved  SupplierInvoiceStatus = "Approved"
+	SupplierInvoiceStatusPaid      SupplierInvoiceStatus = "Paid"
+	SupplierInvoiceStatusCancelled SupplierInvoiceStatus = "Cancelled"
+)
+
+// InvoiceMatchStatus adalah hasil three-way match terakhir (purchase order, goods receipt, invoice)
+type InvoiceMatchStatus string
+
+const (
+	InvoiceMatchStatusMatched  InvoiceMatchStatus = "Matched"
+	InvoiceMatchStatusVariance InvoiceMatchStatus = "Variance"
+)
+
+// JournalSourceSupplierInvoice adalah source_type jurnal hutang yang dibuat saat invoice di-approve
+const JournalSourceSupplierInvoice = "SUPPLIER_INVOICE"
+
+// SupplierInvoice adalah tagihan supplier atas satu purchase order. Nomor internal (Number)
+// diterbitkan sistem, sedangkan SupplierInvoiceNo adalah nomor pada dokumen supplier.
+// TotalAmount adalah nilai yang harus dibayar ke supplier: SubtotalAmount ditambah pajak
+// (misal PPN masukan) dikurangi pajak yang dipotong (misal PPh), sehingga TaxAmount bisa negatif.
+type SupplierInvoice struct {
+	ID                uuid.UUID             `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number            string                `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	SupplierInvoiceNo string                `gorm:"type:varchar(50);not null;index:idx_supplier_invoice_no;" json:"supplier_invoice_no"`
+	SupplierID        uuid.UUID             `gorm:"type:uuid;not null;index:idx_supplier_invoice_no;" json:"supplier_id"`
+	SupplierName      string                `gorm:"type:varchar(150);not null;" json:"supplier_name"`
+	PurchaseOrderID   uuid.UUID             `gorm:"type:uuid;not null;index;" json:"purchase_order_id"`
+	InvoiceDate       time.Time             `gorm:"type:date;not null;index;" json:"invoice_date"`
+	DueDate           time.Time             `gorm:"type:date;not null;index;" json:"due_date"`
+	Currency          string                `gorm:"type:varchar(3);not null;" json:"currency"`
+	ExchangeRate      float64               `gorm:"type:numeric(20,8);not null;default:1;" json:"exchange_rate"`
+	Notes             string                `gorm:"type:text;" json:"notes"`
+	Status            SupplierInvoiceStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	MatchStatus       InvoiceMatchStatus    `gorm:"type:varchar(20);not null;index;" json:"match_status"`
+	SubtotalAmount    decimal.Decimal       `gorm:"type:numeric(20,2);not null;" json:"subtotal_amount"`
+	TaxAmount         decimal.Decimal       `gorm:"type:numeric(20,2);not null;" json:"tax_amount"`
+	TotalAmount       decimal.Decimal       `gorm:"type:numeric(20,2);not null;" json:"total_amount"`
+	PaidAmount        decimal.Decimal       `gorm:"type:numeric(20,2);not null;default:0;" json:"paid_amount"`
+	JournalEntryID    *uuid.UUID            `gorm:"type:uuid;index;" json:"journal_entry_id"`
+	CreatedBy         uuid.UUID             `gorm:"type:uuid;not null;" json:"created_by"`
+	ApprovedBy        *uuid.UUID            `gorm:"type:uuid;" json:"approved_by"`
+	ApprovedAt        *time.Time            `json:"approved_at"`
+	CreatedAt         time.Time             `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time             `gorm:"autoUpdateTime" json:"updated_at"`
+
+	PurchaseOrder *PurchaseOrder        `gorm:"foreignKey:PurchaseOrderID;references:ID;constraint:OnDelete:RESTRICT;" json:"purchase_order,omitempty"`
+	Lines         []SupplierInvoiceLine `gorm:"foreignKey:SupplierInvoiceID;references:ID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+	Taxes         []SupplierInvoiceTax  `gorm:"foreignKey:SupplierInvoiceID;references:ID;constraint:OnDelete:CASCADE;" json:"taxes,omitempty"`
+}
+
+// TableName sets the table name for SupplierInvoice model
+func (SupplierInvoice) TableName() string {
+	return "supplier_invoices"
+}
+
+type SupplierInvoiceLine struct {
+	ID                  uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	SupplierInvoiceID   uuid.UUID       `gorm:"type:uuid;not null;index;" json:"supplier_invoice_id"`
+	PurchaseOrderLineID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"purchase_order_line_id"`
+	LineNo              int             `gorm:"not null;" json:"line_no"`
+	Description         string          `gorm:"type:text;not null;" json:"description"`
+	Quantity            float64         `gorm:"type:numeric(18,4);not null;" json:"quantity"`
+	UnitPrice           decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"unit_price"`
+	LineTotal           decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"line_total"`
+}
+
+// TableName sets the table name for SupplierInvoiceLine model
+func (SupplierInvoiceLine) TableName() string {
+	return "supplier_invoice_lines"
+}
+
+// OutstandingAmount adalah sisa tagihan yang belum dibayar
+func (i SupplierInvoice) OutstandingAmount() decimal.Decimal {
+	return i.TotalAmount.Sub(i.PaidAmount)
+}
+
+// SupplierInvoiceTax adalah baris pajak invoice. Pajak biasa (IsWithholding = false) menambah
+// tagihan dan didebit ke akun pajak; pajak potong mengurangi tagihan dan dikredit ke akun pajak.
+type SupplierInvoiceTax struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	SupplierInvoiceID uuid.UUID       `gorm:"type:uuid;not null;index;" json:"supplier_invoice_id"`
+	LineNo            int             `gorm:"not null;" json:"line_no"`
+	TaxCode           string          `gorm:"type:varchar(20);not null;" json:"tax_code"`
+	TaxType           TaxType         `gorm:"type:varchar(10);" json:"tax_type"`
+	Description       string          `gorm:"type:varchar(200);" json:"description"`
+	AccountID         uuid.UUID       `gorm:"type:uuid;not null;" json:"account_id"`
+	BaseAmount        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"base_amount"`
+	Rate              float64         `gorm:"type:numeric(7,4);not null;" json:"rate"`
+	Amount            decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount"`
+	IsWithholding     bool            `gorm:"not null;" json:"is_withholding"`
+}
+
+// TableName sets the table name for SupplierInvoiceTax model
+func (SupplierInvoiceTax) TableName() string {
+	return "supplier_invoice_taxes"
+}
+
+// SignedAmount adalah pengaruh baris pajak terhadap nilai yang harus dibayar ke supplier
+func (t SupplierInvoiceTax) SignedAmount() decimal.Decimal {
+	if t.IsWithholding {
+		return t.Amount.Neg()
+	}
+	return t.Amount
+}
+
+// MatchToleranceID adalah id tunggal baris pengaturan toleransi three-way match
+const MatchToleranceID = 1
+
+// MatchTolerance menyimpan batas selisih yang masih diterima saat three-way match.
+// Kelebihan harga satu baris lolos bila masih dalam PricePercent atau nilainya (kuantitas x selisih
+// harga) tidak melebihi AmountAbsolute. Hanya ada satu baris; jika belum pernah diatur semua
+// toleransi bernilai nol (harus persis sama).
+type MatchTolerance struct {
+	ID              int             `gorm:"primaryKey;autoIncrement:false;" json:"id"`
+	QuantityPercent float64         `gorm:"type:numeric(5,2);not null;" json:"quantity_percent"`
+	PricePercent    float64         `gorm:"type:numeric(5,2);not null;" json:"price_percent"`
+	AmountAbsolute  decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"amount_absolute"`
+	UpdatedBy       *uuid.UUID      `gorm:"type:uuid;" json:"updated_by"`
+	UpdatedAt       time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for MatchTolerance model
+func (MatchTolerance) TableName() string {
+	return "match_tolerances"
+}
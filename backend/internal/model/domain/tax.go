@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TaxType string
+
+const (
+	// TaxTypePPN adalah Pajak Pertambahan Nilai: pajak keluaran di sales invoice, pajak masukan di supplier invoice
+	TaxTypePPN TaxType = "PPN"
+	// TaxTypePPh23 adalah PPh Pasal 23 yang dipotong atas jasa, sewa selain tanah/bangunan, royalti dan dividen
+	TaxTypePPh23 TaxType = "PPH23"
+	// TaxTypePPh42 adalah PPh Pasal 4 ayat (2) yang bersifat final, misal sewa tanah/bangunan dan jasa konstruksi
+	TaxTypePPh42 TaxType = "PPH4_2"
+	TaxTypeOther TaxType = "OTHER"
+)
+
+// IsWithholding menandakan jenis pajak yang dipotong dari tagihan, bukan ditambahkan
+func (t TaxType) IsWithholding() bool {
+	return t == TaxTypePPh23 || t == TaxTypePPh42
+}
+
+// TaxTransactionCodeNormal adalah kode jenis transaksi faktur pajak untuk penyerahan kepada
+// pembeli bukan pemungut PPN
+const TaxTransactionCodeNormal = "01"
+
+// TaxCode adalah master kode pajak yang dipakai baris pajak invoice. Tarif, akun dan sifat potong
+// diambil dari master sehingga perubahan tarif (misal PPN 11% ke 12%) cukup lewat kode baru.
+// BaseFactor adalah pengali DPP nilai lain (misal 11/12), 1 berarti DPP sama dengan subtotal.
+// NoNPWPSurchargePercent menaikkan tarif pemotongan bila supplier tidak memiliki NPWP
+// (PPh 23 dikenakan 100% lebih tinggi).
+type TaxCode struct {
+	ID                     uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code                   string     `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name                   string     `gorm:"type:varchar(100);not null;" json:"name"`
+	Type                   TaxType    `gorm:"type:varchar(10);not null;index;" json:"type"`
+	Rate                   float64    `gorm:"type:numeric(7,4);not null;" json:"rate"`
+	BaseFactor             float64    `gorm:"type:numeric(9,6);not null;default:1;" json:"base_factor"`
+	NoNPWPSurchargePercent float64    `gorm:"column:no_npwp_surcharge_percent;type:numeric(7,4);not null;default:0;" json:"no_npwp_surcharge_percent"`
+	SalesAccountID         *uuid.UUID `gorm:"type:uuid;" json:"sales_account_id"`
+	PurchaseAccountID      *uuid.UUID `gorm:"type:uuid;" json:"purchase_account_id"`
+	IsActive               bool       `gorm:"not null;default:true;" json:"is_active"`
+	Notes                  string     `gorm:"type:text;" json:"notes"`
+	CreatedAt              time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt              time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for TaxCode model
+func (TaxCode) TableName() string {
+	return "tax_codes"
+}
+
+// IsWithholding menandakan pajak dipotong dari tagihan (PPh), bukan ditambahkan
+func (t TaxCode) IsWithholding() bool {
+	return t.Type.IsWithholding()
+}
+
+// EffectiveRate adalah tarif terhadap subtotal setelah memperhitungkan DPP nilai lain,
+// misal 12% x 11/12 = 11%
+func (t TaxCode) EffectiveRate() float64 {
+	return t.Rate * t.BaseFactor
+}
+
+// TaxInvoiceRange adalah jatah Nomor Seri Faktur Pajak (NSFP) dari DJP untuk satu tahun pajak.
+// Nomor dipakai berurutan mulai NextSerial sampai EndSerial saat sales invoice ber-PPN diposting.
+type TaxInvoiceRange struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Year        int       `gorm:"not null;index;" json:"year"`
+	Prefix      string    `gorm:"type:varchar(3);not null;" json:"prefix"`
+	StartSerial int64     `gorm:"not null;" json:"start_serial"`
+	EndSerial   int64     `gorm:"not null;" json:"end_serial"`
+	NextSerial  int64     `gorm:"not null;" json:"next_serial"`
+	IsActive    bool      `gorm:"not null;default:true;" json:"is_active"`
+	Notes       string    `gorm:"type:text;" json:"notes"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for TaxInvoiceRange model
+func (TaxInvoiceRange) TableName() string {
+	return "tax_invoice_ranges"
+}
+
+// RemainingNumbers adalah jumlah nomor faktur yang belum dipakai
+func (r TaxInvoiceRange) RemainingNumbers() int64 {
+	if r.NextSerial > r.EndSerial {
+		return 0
+	}
+	return r.EndSerial - r.NextSerial + 1
+}
+
+// FormatTaxInvoiceNumber menyusun nomor faktur pajak lengkap, contoh: 010.000-25.00000001
+// (kode transaksi, status pengganti, prefix NSFP, dua digit tahun, nomor urut)
+func FormatTaxInvoiceNumber(transactionCode string, replacement bool, prefix string, year int, serial int64) string {
+	status := 0
+	if replacement {
+		status = 1
+	}
+	return fmt.Sprintf("%s%d.%s-%02d.%08d", transactionCode, status, prefix, year%100, serial)
+}
+
+// WithholdingTaxRow adalah satu pemotongan PPh atas supplier invoice untuk laporan (bukan tabel)
+type WithholdingTaxRow struct {
+	SupplierInvoiceID uuid.UUID
+	InvoiceNumber     string
+	SupplierInvoiceNo string
+	InvoiceDate       time.Time
+	SupplierID        uuid.UUID
+	SupplierName      string
+	SupplierNPWP      string `gorm:"column:supplier_npwp"`
+	Currency          string
+	ExchangeRate      float64
+	TaxCode           string
+	TaxType           TaxType
+	BaseAmount        decimal.Decimal
+	Rate              float64
+	Amount            decimal.Decimal
+}
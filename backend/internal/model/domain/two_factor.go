@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserRecoveryCode adalah kode pemulihan sekali pakai untuk login saat perangkat authenticator
+// tidak tersedia. Hanya hash SHA-256 kode yang disimpan.
+type UserRecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index;" json:"user_id"`
+	CodeHash  string     `gorm:"type:varchar(64);not null;" json:"-"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+
+	User Users `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE;" json:"-"`
+}
+
+// TableName sets the table name for UserRecoveryCode model
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
+
+// LoginChallenge adalah tahap kedua login untuk user dengan 2FA. Token challenge hanya dikirim ke
+// client sekali; database menyimpan hash-nya. Challenge habis setelah dipakai, kedaluwarsa, atau
+// melewati batas percobaan kode.
+type LoginChallenge struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index;" json:"user_id"`
+	TokenHash  string     `gorm:"type:varchar(64);not null;unique;" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null;" json:"expires_at"`
+	Attempts   int        `gorm:"not null;default:0;" json:"attempts"`
+	ConsumedAt *time.Time `json:"consumed_at"`
+	IPAddress  string     `gorm:"type:varchar(45);" json:"ip_address"`
+	DeviceName string     `gorm:"type:varchar(100);" json:"device_name"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+
+	User Users `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE;" json:"-"`
+}
+
+// TableName sets the table name for LoginChallenge model
+func (LoginChallenge) TableName() string {
+	return "login_challenges"
+}
+
+// TwoFactorPolicy mewajibkan 2FA untuk semua user dengan role tertentu. Role tanpa row policy
+// tidak diwajibkan.
+type TwoFactorPolicy struct {
+	Role      Role       `gorm:"type:varchar(20);primaryKey;" json:"role"`
+	Required  bool       `gorm:"not null;default:false;" json:"required"`
+	UpdatedBy *uuid.UUID `gorm:"type:uuid;" json:"updated_by"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for TwoFactorPolicy model
+func (TwoFactorPolicy) TableName() string {
+	return "two_factor_policies"
+}
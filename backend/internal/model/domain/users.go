@@ -10,14 +10,38 @@ type Role string
 
 const (
 	RoleSuperAdmin Role = "Admin"
+	RoleFinance    Role = "Finance"
+	RolePurchasing Role = "Purchasing"
+	RolePPC        Role = "PPC"
+	RoleLogistics  Role = "Logistics"
+	RoleWarehouse  Role = "Warehouse"
+	RoleSales      Role = "Sales"
 )
 
+// Roles berisi seluruh role yang dikenal sistem
+var Roles = []Role{RoleSuperAdmin, RoleFinance, RolePurchasing, RolePPC, RoleLogistics, RoleWarehouse, RoleSales}
+
 type Users struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
-	Name      string    `json:"name" gorm:"not null;column:name"`
-	Email     string    `json:"email" gorm:"not null;unique;column:email"`
-	Password  string    `json:"password" gorm:"not null;column:password"`
-	Role      Role      `json:"role" gorm:"not null;column:role"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Name     string    `json:"name" gorm:"not null;column:name"`
+	Email    string    `json:"email" gorm:"not null;unique;column:email"`
+	Password string    `json:"password" gorm:"not null;column:password"`
+	Role     Role      `json:"role" gorm:"not null;column:role"`
+
+	// TwoFactorEnabled baru true setelah kode TOTP pertama diverifikasi; sebelum itu TOTPSecret
+	// hanya berisi secret yang sedang didaftarkan. TOTPSecret disimpan terenkripsi (AES-GCM dengan
+	// kunci aplikasi) dan hanya didekripsi di two_factor_helper. TOTPLastStep mencegah kode yang
+	// sama dipakai ulang.
+	TwoFactorEnabled   bool       `json:"two_factor_enabled" gorm:"not null;default:false;column:two_factor_enabled"`
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at" gorm:"column:two_factor_enabled_at"`
+	TOTPSecret         string     `json:"-" gorm:"column:totp_secret"`
+	TOTPLastStep       int64      `json:"-" gorm:"not null;default:0;column:totp_last_step"`
+
+	// User nonaktif tidak bisa login dan semua tokennya langsung ditolak. Token yang diterbitkan
+	// sebelum TokensValidAfter (mis. sebelum ganti password) ditolak AuthMiddleware.
+	IsActive         bool       `json:"is_active" gorm:"not null;default:true;column:is_active"`
+	TokensValidAfter *time.Time `json:"-" gorm:"column:tokens_valid_after"`
+
 	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 }
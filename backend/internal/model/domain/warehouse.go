@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Warehouse struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code      string    `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name      string    `gorm:"type:varchar(100);not null;" json:"name"`
+	Address   string    `gorm:"type:text;" json:"address"`
+	IsActive  bool      `gorm:"not null;" json:"is_active"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Bins []WarehouseBin `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"bins,omitempty"`
+}
+
+// TableName sets the table name for Warehouse model
+func (Warehouse) TableName() string {
+	return "warehouses"
+}
+
+// WarehouseBin adalah lokasi penyimpanan (rak/bin) di dalam gudang
+type WarehouseBin struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	WarehouseID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_warehouse_bin_code;" json:"warehouse_id"`
+	Code        string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_warehouse_bin_code;" json:"code"`
+	Name        string    `gorm:"type:varchar(100);" json:"name"`
+	IsActive    bool      `gorm:"not null;" json:"is_active"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for WarehouseBin model
+func (WarehouseBin) TableName() string {
+	return "warehouse_bins"
+}
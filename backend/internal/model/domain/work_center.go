@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WorkCenter adalah mesin atau lini produksi tempat operasi routing dikerjakan.
+// HourlyRate adalah tarif biaya (tenaga kerja dan overhead) per jam.
+type WorkCenter struct {
+	ID         uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Code       string          `gorm:"type:varchar(20);not null;unique;" json:"code"`
+	Name       string          `gorm:"type:varchar(100);not null;" json:"name"`
+	HourlyRate decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"hourly_rate"`
+	IsActive   bool            `gorm:"not null;" json:"is_active"`
+	CreatedAt  time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName sets the table name for WorkCenter model
+func (WorkCenter) TableName() string {
+	return "work_centers"
+}
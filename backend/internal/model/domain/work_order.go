@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type WorkOrderStatus string
+
+const (
+	WorkOrderStatusDraft      WorkOrderStatus = "Draft"
+	WorkOrderStatusReleased   WorkOrderStatus = "Released"
+	WorkOrderStatusInProgress WorkOrderStatus = "InProgress"
+	WorkOrderStatusCompleted  WorkOrderStatus = "Completed"
+	WorkOrderStatusCancelled  WorkOrderStatus = "Cancelled"
+)
+
+// StockSourceWorkOrder adalah source_type mutasi stok pemakaian komponen dan hasil produksi
+const StockSourceWorkOrder = "WORK_ORDER"
+
+// WorkOrder adalah perintah produksi satu item. Komponen (satu tingkat BOM) dan operasi routing
+// disalin saat work order dibuat sehingga perubahan BOM/routing tidak mengubah work order berjalan.
+// Komponen diambil dari dan hasil produksi masuk ke WarehouseID.
+type WorkOrder struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	Number            string          `gorm:"type:varchar(30);not null;unique;" json:"number"`
+	ItemID            uuid.UUID       `gorm:"type:uuid;not null;index;" json:"item_id"`
+	BillOfMaterialID  uuid.UUID       `gorm:"type:uuid;not null;" json:"bill_of_material_id"`
+	RoutingID         *uuid.UUID      `gorm:"type:uuid;" json:"routing_id"`
+	WarehouseID       uuid.UUID       `gorm:"type:uuid;not null;index;" json:"warehouse_id"`
+	PlannedQuantity   float64         `gorm:"type:numeric(18,4);not null;" json:"planned_quantity"`
+	CompletedQuantity float64         `gorm:"type:numeric(18,4);not null;default:0;" json:"completed_quantity"`
+	ScrapQuantity     float64         `gorm:"type:numeric(18,4);not null;default:0;" json:"scrap_quantity"`
+	PlannedStartDate  time.Time       `gorm:"type:date;not null;index;" json:"planned_start_date"`
+	PlannedEndDate    time.Time       `gorm:"type:date;not null;" json:"planned_end_date"`
+	Status            WorkOrderStatus `gorm:"type:varchar(20);not null;index;" json:"status"`
+	Notes             string          `gorm:"type:text;" json:"notes"`
+	CreatedBy         uuid.UUID       `gorm:"type:uuid;not null;" json:"created_by"`
+	ReleasedAt        *time.Time      `json:"released_at"`
+	CompletedAt       *time.Time      `json:"completed_at"`
+	CreatedAt         time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Item       *Item                `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+	Warehouse  *Warehouse           `gorm:"foreignKey:WarehouseID;references:ID;constraint:OnDelete:RESTRICT;" json:"warehouse,omitempty"`
+	Materials  []WorkOrderMaterial  `gorm:"foreignKey:WorkOrderID;references:ID;constraint:OnDelete:CASCADE;" json:"materials,omitempty"`
+	Operations []WorkOrderOperation `gorm:"foreignKey:WorkOrderID;references:ID;constraint:OnDelete:CASCADE;" json:"operations,omitempty"`
+	Outputs    []WorkOrderOutput    `gorm:"foreignKey:WorkOrderID;references:ID;constraint:OnDelete:CASCADE;" json:"outputs,omitempty"`
+}
+
+// TableName sets the table name for WorkOrder model
+func (WorkOrder) TableName() string {
+	return "work_orders"
+}
+
+// IsOpen menandakan work order masih memegang reservasi komponen
+func (w WorkOrder) IsOpen() bool {
+	return w.Status == WorkOrderStatusReleased || w.Status == WorkOrderStatusInProgress
+}
+
+// WorkOrderMaterial adalah kebutuhan satu komponen. ReservedQuantity diisi saat release;
+// bagian reservasi yang belum dikeluarkan (ReservedQuantity - IssuedQuantity) tidak boleh
+// direservasi work order lain. StandardUnitCost disalin dari item saat work order dibuat.
+type WorkOrderMaterial struct {
+	ID               uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	WorkOrderID      uuid.UUID       `gorm:"type:uuid;not null;index;" json:"work_order_id"`
+	LineNo           int             `gorm:"not null;" json:"line_no"`
+	ItemID           uuid.UUID       `gorm:"type:uuid;not null;index;" json:"item_id"`
+	RequiredQuantity float64         `gorm:"type:numeric(18,4);not null;" json:"required_quantity"`
+	ReservedQuantity float64         `gorm:"type:numeric(18,4);not null;default:0;" json:"reserved_quantity"`
+	IssuedQuantity   float64         `gorm:"type:numeric(18,4);not null;default:0;" json:"issued_quantity"`
+	StandardUnitCost decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"standard_unit_cost"`
+
+	Item *Item `gorm:"foreignKey:ItemID;references:ID;constraint:OnDelete:RESTRICT;" json:"item,omitempty"`
+}
+
+// TableName sets the table name for WorkOrderMaterial model
+func (WorkOrderMaterial) TableName() string {
+	return "work_order_materials"
+}
+
+// OpenReservation adalah sisa reservasi yang belum dikeluarkan dari gudang
+func (m WorkOrderMaterial) OpenReservation() float64 {
+	if m.ReservedQuantity <= m.IssuedQuantity {
+		return 0
+	}
+	return m.ReservedQuantity - m.IssuedQuantity
+}
+
+// WorkOrderOperation menyalin operasi routing beserta tarif work center; ActualMinutes
+// bertambah setiap kali hasil produksi dilaporkan
+type WorkOrderOperation struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey;" json:"id"`
+	WorkOrderID       uuid.UUID       `gorm:"type:uuid;not null;index;" json:"work_order_id"`
+	Sequence          int             `gorm:"not null;" json:"sequence"`
+	WorkCenterID      uuid.UUID       `gorm:"type:uuid;not null;" json:"work_center_id"`
+	WorkCenterCode    string          `gorm:"type:varchar(20);not null;" json:"work_center_code"`
+	Description       string          `gorm:"type:varchar(200);not null;" json:"description"`
+	SetupMinutes      float64         `gorm:"type:numeric(10,2);not null;" json:"setup_minutes"`
+	RunMinutesPerUnit float64         `gorm:"type:numeric(10,4);not null;" json:"run_minutes_per_unit"`
+	HourlyRate        decimal.Decimal `gorm:"type:numeric(20,2);not null;" json:"hourly_rate"`
+	ActualMinutes     float64         `gorm:"type:numeric(12,2);not null;default:0;" json:"actual_minutes"`
+}
+
+// TableName sets the table name for WorkOrderOperation model
+func (WorkOrderOperation) TableName() string {
+	return "work_order_operations"
+}
+
+// StandardMinutes adalah waktu standar operasi untuk quantity satuan
+func (o WorkOrderOperation) StandardMinutes(quantity float64) float64 {
+	return o.SetupMinutes + o.RunMinutesPerUnit*quantity
+}
+
+// WorkOrderOutput adalah satu laporan hasil produksi: barang jadi yang masuk gudang dan scrap
+type WorkOrderOutput struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;" json:"id"`
+	WorkOrderID    uuid.UUID `gorm:"type:uuid;not null;index;" json:"work_order_id"`
+	OutputDate     time.Time `gorm:"type:date;not null;" json:"output_date"`
+	GoodQuantity   float64   `gorm:"type:numeric(18,4);not null;" json:"good_quantity"`
+	ScrapQuantity  float64   `gorm:"type:numeric(18,4);not null;" json:"scrap_quantity"`
+	MovementNumber string    `gorm:"type:varchar(30);" json:"movement_number"`
+	Notes          string    `gorm:"type:text;" json:"notes"`
+	CreatedBy      uuid.UUID `gorm:"type:uuid;not null;" json:"created_by"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName sets the table name for WorkOrderOutput model
+func (WorkOrderOutput) TableName() string {
+	return "work_order_outputs"
+}
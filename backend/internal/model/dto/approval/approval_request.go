@@ -0,0 +1,80 @@
+package approval
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ApprovalRuleRequest: max_amount kosong berarti tanpa batas atas; rule dengan priority terkecil
+// dipakai bila beberapa rule cocok dengan nominal dokumen
+type ApprovalRuleRequest struct {
+	Code         string                    `json:"code" validate:"required,max=30"`
+	Name         string                    `json:"name" validate:"required,min=2,max=100"`
+	DocumentType string                    `json:"document_type" validate:"required,oneof=PURCHASE_REQUISITION PURCHASE_ORDER SUPPLIER_INVOICE PAYMENT_RUN SALES_ORDER JOURNAL_ENTRY BUDGET"`
+	MinAmount    decimal.Decimal           `json:"min_amount" validate:"gte=0"`
+	MaxAmount    *decimal.Decimal          `json:"max_amount" validate:"omitempty,gte=0"`
+	Priority     int                       `json:"priority" validate:"gte=0,lte=1000"`
+	Description  string                    `json:"description" validate:"max=1000"`
+	Steps        []ApprovalRuleStepRequest `json:"steps" validate:"required,min=1,dive"`
+}
+
+// ApprovalRuleStepRequest: isi salah satu dari approver_role atau approver_user_id. Step dengan
+// sequence yang sama berjalan paralel; min_amount membuat step hanya berlaku untuk nominal besar.
+type ApprovalRuleStepRequest struct {
+	Sequence       int             `json:"sequence" validate:"required,min=1,max=20"`
+	Name           string          `json:"name" validate:"required,max=100"`
+	ApproverRole   string          `json:"approver_role" validate:"omitempty,oneof=Admin Finance Purchasing PPC Logistics Warehouse Sales"`
+	ApproverUserID *uuid.UUID      `json:"approver_user_id"`
+	MinAmount      decimal.Decimal `json:"min_amount" validate:"gte=0"`
+}
+
+type ApprovalRuleUpdateRequest struct {
+	ApprovalRuleRequest
+	IsActive bool `json:"is_active"`
+}
+
+// ApprovalRuleFilterRequest berisi filter opsional untuk daftar rule approval
+type ApprovalRuleFilterRequest struct {
+	DocumentType string `query:"document_type"`
+	ActiveOnly   bool   `query:"active_only"`
+	Search       string `query:"search"`
+}
+
+// ApprovalDecisionRequest: comment wajib saat menolak
+type ApprovalDecisionRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+type ApprovalRejectRequest struct {
+	Comment string `json:"comment" validate:"required,max=1000"`
+}
+
+// ApprovalRequestFilterRequest: mine hanya menampilkan request yang diajukan user yang login
+type ApprovalRequestFilterRequest struct {
+	DocumentType string `query:"document_type"`
+	Status       string `query:"status"`
+	DocumentID   string `query:"document_id"`
+	Mine         bool   `query:"mine"`
+}
+
+// ApprovalInboxFilterRequest berisi filter opsional untuk inbox approval
+type ApprovalInboxFilterRequest struct {
+	DocumentType string `query:"document_type"`
+}
+
+// ApprovalDelegationRequest: document_type kosong berarti semua jenis dokumen. delegator_id hanya
+// boleh diisi admin untuk membuat delegasi atas nama user lain; kosong berarti user yang login.
+type ApprovalDelegationRequest struct {
+	DelegatorID  *uuid.UUID `json:"delegator_id"`
+	DelegateID   uuid.UUID  `json:"delegate_id" validate:"required"`
+	DocumentType string     `json:"document_type" validate:"omitempty,oneof=PURCHASE_REQUISITION PURCHASE_ORDER SUPPLIER_INVOICE PAYMENT_RUN SALES_ORDER JOURNAL_ENTRY BUDGET"`
+	StartDate    string     `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate      string     `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Reason       string     `json:"reason" validate:"max=1000"`
+}
+
+// ApprovalDelegationFilterRequest: admin melihat semua delegasi, user lain hanya delegasi yang
+// diberikan atau diterimanya
+type ApprovalDelegationFilterRequest struct {
+	ActiveOnly bool `query:"active_only"`
+}
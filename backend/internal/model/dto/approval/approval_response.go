@@ -0,0 +1,94 @@
+package approval
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type ApprovalRuleResponse struct {
+	ID           uuid.UUID                  `json:"id"`
+	Code         string                     `json:"code"`
+	Name         string                     `json:"name"`
+	DocumentType string                     `json:"document_type"`
+	MinAmount    decimal.Decimal            `json:"min_amount"`
+	MaxAmount    *decimal.Decimal           `json:"max_amount"`
+	Priority     int                        `json:"priority"`
+	IsActive     bool                       `json:"is_active"`
+	Description  string                     `json:"description"`
+	CreatedAt    string                     `json:"created_at"`
+	UpdatedAt    string                     `json:"updated_at"`
+	Steps        []ApprovalRuleStepResponse `json:"steps"`
+}
+
+type ApprovalRuleStepResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	Sequence       int             `json:"sequence"`
+	Name           string          `json:"name"`
+	ApproverRole   *domain.Role    `json:"approver_role"`
+	ApproverUserID *uuid.UUID      `json:"approver_user_id"`
+	MinAmount      decimal.Decimal `json:"min_amount"`
+}
+
+type ApprovalRequestResponse struct {
+	ID              uuid.UUID                    `json:"id"`
+	DocumentType    string                       `json:"document_type"`
+	DocumentID      uuid.UUID                    `json:"document_id"`
+	DocumentNumber  string                       `json:"document_number"`
+	Amount          decimal.Decimal              `json:"amount"`
+	RuleID          *uuid.UUID                   `json:"rule_id"`
+	RuleCode        string                       `json:"rule_code,omitempty"`
+	RuleName        string                       `json:"rule_name,omitempty"`
+	Status          domain.ApprovalRequestStatus `json:"status"`
+	CurrentSequence int                          `json:"current_sequence"`
+	RequestedBy     uuid.UUID                    `json:"requested_by"`
+	DecidedBy       *uuid.UUID                   `json:"decided_by"`
+	CompletedAt     string                       `json:"completed_at,omitempty"`
+	Comment         string                       `json:"comment"`
+	CreatedAt       string                       `json:"created_at"`
+	Tasks           []ApprovalTaskResponse       `json:"tasks,omitempty"`
+}
+
+type ApprovalTaskResponse struct {
+	ID             uuid.UUID                 `json:"id"`
+	Sequence       int                       `json:"sequence"`
+	Name           string                    `json:"name"`
+	ApproverRole   *domain.Role              `json:"approver_role"`
+	ApproverUserID *uuid.UUID                `json:"approver_user_id"`
+	Status         domain.ApprovalTaskStatus `json:"status"`
+	ActedBy        *uuid.UUID                `json:"acted_by"`
+	OnBehalfOf     *uuid.UUID                `json:"on_behalf_of"`
+	ActedAt        string                    `json:"acted_at,omitempty"`
+	Comment        string                    `json:"comment"`
+}
+
+// ApprovalInboxItemResponse adalah satu task yang menunggu keputusan user yang login
+type ApprovalInboxItemResponse struct {
+	TaskID         uuid.UUID       `json:"task_id"`
+	TaskName       string          `json:"task_name"`
+	Sequence       int             `json:"sequence"`
+	ApproverRole   *domain.Role    `json:"approver_role"`
+	ApproverUserID *uuid.UUID      `json:"approver_user_id"`
+	RequestID      uuid.UUID       `json:"request_id"`
+	DocumentType   string          `json:"document_type"`
+	DocumentID     uuid.UUID       `json:"document_id"`
+	DocumentNumber string          `json:"document_number"`
+	Amount         decimal.Decimal `json:"amount"`
+	RequestedBy    uuid.UUID       `json:"requested_by"`
+	RequestedAt    string          `json:"requested_at"`
+}
+
+type ApprovalDelegationResponse struct {
+	ID            uuid.UUID `json:"id"`
+	DelegatorID   uuid.UUID `json:"delegator_id"`
+	DelegatorName string    `json:"delegator_name"`
+	DelegateID    uuid.UUID `json:"delegate_id"`
+	DelegateName  string    `json:"delegate_name"`
+	DocumentType  string    `json:"document_type"`
+	StartDate     string    `json:"start_date"`
+	EndDate       string    `json:"end_date"`
+	Reason        string    `json:"reason"`
+	IsActive      bool      `json:"is_active"`
+	CreatedAt     string    `json:"created_at"`
+}
@@ -0,0 +1,90 @@
+package asset
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AssetCategoryRequest: declining_factor hanya dipakai metode DECLINING_BALANCE (kosong berarti 2,
+// yaitu double declining)
+type AssetCategoryRequest struct {
+	Code                             string    `json:"code" validate:"required,max=20"`
+	Name                             string    `json:"name" validate:"required,min=2,max=100"`
+	Method                           string    `json:"method" validate:"required,oneof=STRAIGHT_LINE DECLINING_BALANCE"`
+	UsefulLifeMonths                 int       `json:"useful_life_months" validate:"required,min=1,max=600"`
+	DecliningFactor                  float64   `json:"declining_factor" validate:"gte=0,lte=10"`
+	AssetAccountID                   uuid.UUID `json:"asset_account_id" validate:"required"`
+	AccumulatedDepreciationAccountID uuid.UUID `json:"accumulated_depreciation_account_id" validate:"required"`
+	DepreciationExpenseAccountID     uuid.UUID `json:"depreciation_expense_account_id" validate:"required"`
+	DisposalGainLossAccountID        uuid.UUID `json:"disposal_gain_loss_account_id" validate:"required"`
+}
+
+type AssetCategoryUpdateRequest struct {
+	AssetCategoryRequest
+	IsActive bool `json:"is_active"`
+}
+
+// AssetCategoryFilterRequest berisi filter opsional untuk daftar kategori aset
+type AssetCategoryFilterRequest struct {
+	ActiveOnly bool   `query:"active_only"`
+	Search     string `query:"search"`
+}
+
+// FixedAssetRequest dipakai untuk membuat maupun mengubah aset berstatus Draft. Metode, umur
+// manfaat dan faktor yang kosong mengikuti kategori; depreciation_start_date yang kosong berarti
+// penyusutan dimulai pada bulan perolehan.
+type FixedAssetRequest struct {
+	Name                  string          `json:"name" validate:"required,min=2,max=150"`
+	Description           string          `json:"description" validate:"max=1000"`
+	SerialNumber          string          `json:"serial_number" validate:"max=100"`
+	CategoryID            string          `json:"category_id" validate:"required,uuid"`
+	Location              string          `json:"location" validate:"required,max=100"`
+	AcquisitionDate       string          `json:"acquisition_date" validate:"required,datetime=2006-01-02"`
+	DepreciationStartDate string          `json:"depreciation_start_date" validate:"omitempty,datetime=2006-01-02"`
+	AcquisitionCost       decimal.Decimal `json:"acquisition_cost" validate:"gt=0"`
+	SalvageValue          decimal.Decimal `json:"salvage_value" validate:"gte=0"`
+	UsefulLifeMonths      int             `json:"useful_life_months" validate:"omitempty,min=1,max=600"`
+	Method                string          `json:"method" validate:"omitempty,oneof=STRAIGHT_LINE DECLINING_BALANCE"`
+	DecliningFactor       float64         `json:"declining_factor" validate:"gte=0,lte=10"`
+	Notes                 string          `json:"notes" validate:"max=1000"`
+}
+
+// FixedAssetActivateRequest: offset_account_id adalah akun lawan perolehan, misal kas/bank atau
+// akun penampung pembelian aset
+type FixedAssetActivateRequest struct {
+	OffsetAccountID uuid.UUID `json:"offset_account_id" validate:"required"`
+}
+
+type FixedAssetTransferRequest struct {
+	TransferDate string `json:"transfer_date" validate:"required,datetime=2006-01-02"`
+	ToLocation   string `json:"to_location" validate:"required,max=100"`
+	Notes        string `json:"notes" validate:"max=1000"`
+}
+
+// FixedAssetDisposalRequest: proceeds_account_id wajib bila ada hasil penjualan
+type FixedAssetDisposalRequest struct {
+	DisposalDate      string          `json:"disposal_date" validate:"required,datetime=2006-01-02"`
+	Proceeds          decimal.Decimal `json:"proceeds" validate:"gte=0"`
+	ProceedsAccountID *uuid.UUID      `json:"proceeds_account_id"`
+	Notes             string          `json:"notes" validate:"max=1000"`
+}
+
+// FixedAssetFilterRequest berisi filter opsional untuk daftar aset
+type FixedAssetFilterRequest struct {
+	Status     string `query:"status"`
+	CategoryID string `query:"category_id"`
+	Location   string `query:"location"`
+	Search     string `query:"search"`
+}
+
+// DepreciationRunRequest: period_end harus tanggal terakhir suatu bulan
+type DepreciationRunRequest struct {
+	PeriodEnd string `json:"period_end" validate:"required,datetime=2006-01-02"`
+	Notes     string `json:"notes" validate:"max=1000"`
+}
+
+// DepreciationRunFilterRequest berisi filter opsional untuk daftar depreciation run
+type DepreciationRunFilterRequest struct {
+	DateFrom string `query:"date_from"`
+	DateTo   string `query:"date_to"`
+}
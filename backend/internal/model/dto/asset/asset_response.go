@@ -0,0 +1,109 @@
+package asset
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type AssetCategoryResponse struct {
+	ID                               uuid.UUID                 `json:"id"`
+	Code                             string                    `json:"code"`
+	Name                             string                    `json:"name"`
+	Method                           domain.DepreciationMethod `json:"method"`
+	UsefulLifeMonths                 int                       `json:"useful_life_months"`
+	DecliningFactor                  float64                   `json:"declining_factor"`
+	AssetAccountID                   uuid.UUID                 `json:"asset_account_id"`
+	AccumulatedDepreciationAccountID uuid.UUID                 `json:"accumulated_depreciation_account_id"`
+	DepreciationExpenseAccountID     uuid.UUID                 `json:"depreciation_expense_account_id"`
+	DisposalGainLossAccountID        uuid.UUID                 `json:"disposal_gain_loss_account_id"`
+	IsActive                         bool                      `json:"is_active"`
+	CreatedAt                        string                    `json:"created_at"`
+	UpdatedAt                        string                    `json:"updated_at"`
+}
+
+type FixedAssetResponse struct {
+	ID                      uuid.UUID                    `json:"id"`
+	Number                  string                       `json:"number"`
+	Name                    string                       `json:"name"`
+	Description             string                       `json:"description"`
+	SerialNumber            string                       `json:"serial_number"`
+	CategoryID              uuid.UUID                    `json:"category_id"`
+	CategoryCode            string                       `json:"category_code"`
+	CategoryName            string                       `json:"category_name"`
+	Location                string                       `json:"location"`
+	AcquisitionDate         string                       `json:"acquisition_date"`
+	DepreciationStartDate   string                       `json:"depreciation_start_date"`
+	AcquisitionCost         decimal.Decimal              `json:"acquisition_cost"`
+	SalvageValue            decimal.Decimal              `json:"salvage_value"`
+	UsefulLifeMonths        int                          `json:"useful_life_months"`
+	Method                  domain.DepreciationMethod    `json:"method"`
+	DecliningFactor         float64                      `json:"declining_factor"`
+	AccumulatedDepreciation decimal.Decimal              `json:"accumulated_depreciation"`
+	BookValue               decimal.Decimal              `json:"book_value"`
+	DepreciatedMonths       int                          `json:"depreciated_months"`
+	LastDepreciationDate    string                       `json:"last_depreciation_date,omitempty"`
+	Status                  domain.FixedAssetStatus      `json:"status"`
+	AcquisitionEntryID      *uuid.UUID                   `json:"acquisition_entry_id"`
+	DisposalDate            string                       `json:"disposal_date,omitempty"`
+	DisposalProceeds        decimal.Decimal              `json:"disposal_proceeds"`
+	DisposalGainLoss        decimal.Decimal              `json:"disposal_gain_loss"`
+	DisposalEntryID         *uuid.UUID                   `json:"disposal_entry_id"`
+	Notes                   string                       `json:"notes"`
+	CreatedBy               uuid.UUID                    `json:"created_by"`
+	CreatedAt               string                       `json:"created_at"`
+	UpdatedAt               string                       `json:"updated_at"`
+	Transfers               []FixedAssetTransferResponse `json:"transfers,omitempty"`
+}
+
+type FixedAssetTransferResponse struct {
+	ID           uuid.UUID `json:"id"`
+	TransferDate string    `json:"transfer_date"`
+	FromLocation string    `json:"from_location"`
+	ToLocation   string    `json:"to_location"`
+	Notes        string    `json:"notes"`
+	CreatedBy    uuid.UUID `json:"created_by"`
+	CreatedAt    string    `json:"created_at"`
+}
+
+// DepreciationScheduleLineResponse adalah penyusutan satu bulan; Posted menandakan bulan yang
+// sudah dijurnal, sisanya proyeksi
+type DepreciationScheduleLineResponse struct {
+	MonthNo     int             `json:"month_no"`
+	PeriodEnd   string          `json:"period_end"`
+	Amount      decimal.Decimal `json:"amount"`
+	Accumulated decimal.Decimal `json:"accumulated"`
+	BookValue   decimal.Decimal `json:"book_value"`
+	Posted      bool            `json:"posted"`
+}
+
+type DepreciationScheduleResponse struct {
+	FixedAssetID uuid.UUID                          `json:"fixed_asset_id"`
+	Number       string                             `json:"number"`
+	Method       domain.DepreciationMethod          `json:"method"`
+	Lines        []DepreciationScheduleLineResponse `json:"lines"`
+}
+
+type DepreciationRunResponse struct {
+	ID             uuid.UUID                     `json:"id"`
+	Number         string                        `json:"number"`
+	PeriodEnd      string                        `json:"period_end"`
+	TotalAmount    decimal.Decimal               `json:"total_amount"`
+	JournalEntryID *uuid.UUID                    `json:"journal_entry_id"`
+	Notes          string                        `json:"notes"`
+	CreatedBy      uuid.UUID                     `json:"created_by"`
+	CreatedAt      string                        `json:"created_at"`
+	Lines          []DepreciationRunLineResponse `json:"lines,omitempty"`
+}
+
+type DepreciationRunLineResponse struct {
+	ID               uuid.UUID       `json:"id"`
+	FixedAssetID     uuid.UUID       `json:"fixed_asset_id"`
+	AssetNumber      string          `json:"asset_number"`
+	AssetName        string          `json:"asset_name"`
+	Months           int             `json:"months"`
+	Amount           decimal.Decimal `json:"amount"`
+	AccumulatedAfter decimal.Decimal `json:"accumulated_after"`
+	BookValueAfter   decimal.Decimal `json:"book_value_after"`
+}
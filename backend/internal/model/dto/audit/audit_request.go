@@ -0,0 +1,12 @@
+package audit
+
+// AuditLogFilterRequest berisi filter opsional untuk daftar audit log. entity_type adalah nama
+// tabel (contoh: users); date_from dan date_to inklusif menurut tanggal Asia/Jakarta.
+type AuditLogFilterRequest struct {
+	EntityType string `query:"entity_type"`
+	EntityID   string `query:"entity_id"`
+	UserID     string `query:"user_id"`
+	Action     string `query:"action"`
+	DateFrom   string `query:"date_from"`
+	DateTo     string `query:"date_to"`
+}
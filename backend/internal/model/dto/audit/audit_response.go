@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"encoding/json"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogResponse struct {
+	ID         uuid.UUID          `json:"id"`
+	Action     domain.AuditAction `json:"action"`
+	EntityType string             `json:"entity_type"`
+	EntityID   string             `json:"entity_id"`
+	ActorID    *uuid.UUID         `json:"actor_id"`
+	ActorEmail string             `json:"actor_email"`
+	ActorRole  domain.Role        `json:"actor_role"`
+	IPAddress  string             `json:"ip_address"`
+	OldValues  json.RawMessage    `json:"old_values" swaggertype:"object"`
+	NewValues  json.RawMessage    `json:"new_values" swaggertype:"object"`
+	CreatedAt  string             `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package auth
+
+// JSONWebKey adalah public key dalam format JWK (RFC 7517). n/e diisi untuk RSA, crv/x untuk Ed25519.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JWKSResponse dikirim apa adanya (bukan dibungkus WebResponse) agar bisa dibaca library JWT standar
+type JWKSResponse struct {
+	Keys []JSONWebKey `json:"keys"`
+}
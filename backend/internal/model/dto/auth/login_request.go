@@ -3,4 +3,6 @@ package auth
 type AuthLoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8,max=20"`
+	// DeviceName opsional, ditampilkan di daftar sesi (mis. "Laptop kantor")
+	DeviceName string `json:"device_name" validate:"omitempty,max=100"`
 }
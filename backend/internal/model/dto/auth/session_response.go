@@ -0,0 +1,20 @@
+package auth
+
+import "github.com/google/uuid"
+
+// SessionResponse adalah satu sesi login (satu perangkat). Current menandai sesi tempat access
+// token request ini diterbitkan.
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	SignedInAt string    `json:"signed_in_at"`
+	LastUsedAt string    `json:"last_used_at"`
+	ExpiresAt  string    `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+type RevokeSessionsResponse struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
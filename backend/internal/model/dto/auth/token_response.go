@@ -1,7 +1,16 @@
 package auth
 
+// TokenResponse: bila TwoFactorRequired true, token belum diterbitkan dan client harus
+// menyelesaikan login di /auth/login/2fa memakai ChallengeToken. RecoveryCodes hanya terisi saat
+// user menyelesaikan pendaftaran 2FA di tengah login.
 type TokenResponse struct {
 	AccessToken  string        `json:"access_token"`
 	RefreshToken string        `json:"refresh_token"`
 	User         *UserResponse `json:"user,omitempty"`
+
+	TwoFactorRequired  bool     `json:"two_factor_required,omitempty"`
+	EnrollmentRequired bool     `json:"enrollment_required,omitempty"`
+	ChallengeToken     string   `json:"challenge_token,omitempty"`
+	ChallengeExpiresIn int      `json:"challenge_expires_in,omitempty"`
+	RecoveryCodes      []string `json:"recovery_codes,omitempty"`
 }
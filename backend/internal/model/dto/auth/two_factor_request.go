@@ -0,0 +1,28 @@
+package auth
+
+// TwoFactorLoginRequest menyelesaikan login tahap kedua: isi code dari aplikasi authenticator,
+// atau recovery_code bila perangkat tidak tersedia
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"omitempty,len=6,numeric"`
+	RecoveryCode   string `json:"recovery_code" validate:"omitempty,max=32"`
+}
+
+// TwoFactorChallengeRequest dipakai untuk mendaftarkan authenticator di tengah login bagi user
+// yang role-nya mewajibkan 2FA tetapi belum mendaftar
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type TwoFactorDisableRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type TwoFactorPolicyRequest struct {
+	Required bool `json:"required"`
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+// TwoFactorSetupResponse berisi secret yang harus didaftarkan ke aplikasi authenticator;
+// provisioning_uri di-encode menjadi QR code oleh frontend
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+	Issuer          string `json:"issuer"`
+	Account         string `json:"account"`
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool   `json:"enabled"`
+	RequiredByRole         bool   `json:"required_by_role"`
+	EnabledAt              string `json:"enabled_at,omitempty"`
+	RemainingRecoveryCodes int64  `json:"remaining_recovery_codes"`
+}
+
+// RecoveryCodesResponse hanya dikirim sekali saat kode dibuat; server hanya menyimpan hash-nya
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorPolicyResponse struct {
+	Role      domain.Role `json:"role"`
+	Required  bool        `json:"required"`
+	UpdatedBy *uuid.UUID  `json:"updated_by"`
+	UpdatedAt string      `json:"updated_at,omitempty"`
+}
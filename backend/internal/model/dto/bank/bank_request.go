@@ -0,0 +1,57 @@
+package bank
+
+import "github.com/google/uuid"
+
+// BankAccountRequest: gl_account_id adalah akun buku besar bertipe Asset yang dipakai sebagai akun
+// setoran penerimaan dan akun pembayaran untuk rekening ini
+type BankAccountRequest struct {
+	Code          string    `json:"code" validate:"required,max=20"`
+	Name          string    `json:"name" validate:"required,min=2,max=100"`
+	Type          string    `json:"type" validate:"required,oneof=BANK CASH"`
+	BankName      string    `json:"bank_name" validate:"required_if=Type BANK,max=100"`
+	AccountNumber string    `json:"account_number" validate:"required_if=Type BANK,max=50"`
+	AccountHolder string    `json:"account_holder" validate:"max=150"`
+	Currency      string    `json:"currency" validate:"required,len=3"`
+	GLAccountID   uuid.UUID `json:"gl_account_id" validate:"required"`
+	Notes         string    `json:"notes" validate:"max=1000"`
+}
+
+type BankAccountUpdateRequest struct {
+	BankAccountRequest
+	IsActive bool `json:"is_active"`
+}
+
+// BankAccountFilterRequest berisi filter opsional untuk daftar rekening
+type BankAccountFilterRequest struct {
+	Type       string `query:"type"`
+	ActiveOnly bool   `query:"active_only"`
+	Search     string `query:"search"`
+}
+
+// BankStatementImportRequest dikirim sebagai field multipart bersama file rekening koran. Format
+// yang kosong dideteksi dari isi file.
+type BankStatementImportRequest struct {
+	BankAccountID string `form:"bank_account_id" validate:"required,uuid"`
+	Format        string `form:"format" validate:"omitempty,oneof=CSV MT940"`
+}
+
+type BankStatementFilterRequest struct {
+	BankAccountID string `query:"bank_account_id"`
+}
+
+// BankAutoMatchRequest: date_tolerance_days adalah selisih hari maksimum antara tanggal mutasi dan
+// tanggal transaksi (kosong berarti 3 hari). bank_statement_id membatasi pencocokan ke satu file.
+type BankAutoMatchRequest struct {
+	BankStatementID   string `json:"bank_statement_id" validate:"omitempty,uuid"`
+	DateToleranceDays int    `json:"date_tolerance_days" validate:"gte=0,lte=31"`
+}
+
+type BankManualMatchRequest struct {
+	SourceType string    `json:"source_type" validate:"required,oneof=CUSTOMER_RECEIPT PAYMENT_BATCH"`
+	SourceID   uuid.UUID `json:"source_id" validate:"required"`
+}
+
+type BankReconciliationRequest struct {
+	DateFrom string `query:"date_from" validate:"required,datetime=2006-01-02"`
+	DateTo   string `query:"date_to" validate:"required,datetime=2006-01-02"`
+}
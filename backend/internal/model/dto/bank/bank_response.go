@@ -0,0 +1,96 @@
+package bank
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type BankAccountResponse struct {
+	ID            uuid.UUID              `json:"id"`
+	Code          string                 `json:"code"`
+	Name          string                 `json:"name"`
+	Type          domain.BankAccountType `json:"type"`
+	BankName      string                 `json:"bank_name"`
+	AccountNumber string                 `json:"account_number"`
+	AccountHolder string                 `json:"account_holder"`
+	Currency      string                 `json:"currency"`
+	GLAccountID   uuid.UUID              `json:"gl_account_id"`
+	GLAccountCode string                 `json:"gl_account_code"`
+	GLAccountName string                 `json:"gl_account_name"`
+	IsActive      bool                   `json:"is_active"`
+	Notes         string                 `json:"notes"`
+	CreatedAt     string                 `json:"created_at"`
+	UpdatedAt     string                 `json:"updated_at"`
+}
+
+type BankStatementResponse struct {
+	ID              uuid.UUID                   `json:"id"`
+	BankAccountID   uuid.UUID                   `json:"bank_account_id"`
+	BankAccountCode string                      `json:"bank_account_code"`
+	BankAccountName string                      `json:"bank_account_name"`
+	Format          domain.BankStatementFormat  `json:"format"`
+	FileName        string                      `json:"file_name"`
+	StatementNo     string                      `json:"statement_no"`
+	PeriodStart     string                      `json:"period_start"`
+	PeriodEnd       string                      `json:"period_end"`
+	OpeningBalance  *decimal.Decimal            `json:"opening_balance"`
+	ClosingBalance  *decimal.Decimal            `json:"closing_balance"`
+	TotalCredit     decimal.Decimal             `json:"total_credit"`
+	TotalDebit      decimal.Decimal             `json:"total_debit"`
+	LineCount       int                         `json:"line_count"`
+	SkippedLines    int                         `json:"skipped_lines"`
+	ImportedBy      uuid.UUID                   `json:"imported_by"`
+	CreatedAt       string                      `json:"created_at"`
+	Lines           []BankStatementLineResponse `json:"lines,omitempty"`
+}
+
+type BankStatementLineResponse struct {
+	ID                uuid.UUID                      `json:"id"`
+	BankStatementID   uuid.UUID                      `json:"bank_statement_id"`
+	LineNo            int                            `json:"line_no"`
+	TransactionDate   string                         `json:"transaction_date"`
+	Amount            decimal.Decimal                `json:"amount"`
+	Reference         string                         `json:"reference"`
+	Description       string                         `json:"description"`
+	Status            domain.BankStatementLineStatus `json:"status"`
+	MatchedSourceType string                         `json:"matched_source_type,omitempty"`
+	MatchedSourceID   *uuid.UUID                     `json:"matched_source_id,omitempty"`
+	MatchMethod       domain.BankMatchMethod         `json:"match_method,omitempty"`
+	MatchedBy         *uuid.UUID                     `json:"matched_by,omitempty"`
+	MatchedAt         string                         `json:"matched_at,omitempty"`
+}
+
+// BankBookTransactionResponse adalah penerimaan atau pembayaran yang belum muncul di rekening koran
+type BankBookTransactionResponse struct {
+	SourceType      string          `json:"source_type"`
+	SourceID        uuid.UUID       `json:"source_id"`
+	Number          string          `json:"number"`
+	TransactionDate string          `json:"transaction_date"`
+	Reference       string          `json:"reference"`
+	Counterparty    string          `json:"counterparty"`
+	Currency        string          `json:"currency"`
+	Amount          decimal.Decimal `json:"amount"`
+}
+
+type BankAutoMatchResponse struct {
+	ExaminedLines  int                         `json:"examined_lines"`
+	MatchedLines   int                         `json:"matched_lines"`
+	UnmatchedLines int                         `json:"unmatched_lines"`
+	Matches        []BankStatementLineResponse `json:"matches"`
+}
+
+// BankReconciliationResponse adalah ruang kerja rekonsiliasi satu rekening untuk suatu periode:
+// seluruh mutasi rekening koran beserta status pencocokannya dan transaksi buku yang masih terbuka
+type BankReconciliationResponse struct {
+	BankAccount             BankAccountResponse           `json:"bank_account"`
+	DateFrom                string                        `json:"date_from"`
+	DateTo                  string                        `json:"date_to"`
+	MatchedLines            int                           `json:"matched_lines"`
+	UnmatchedLines          int                           `json:"unmatched_lines"`
+	UnmatchedStatementTotal decimal.Decimal               `json:"unmatched_statement_total"`
+	UnmatchedBookTotal      decimal.Decimal               `json:"unmatched_book_total"`
+	StatementLines          []BankStatementLineResponse   `json:"statement_lines"`
+	UnmatchedTransactions   []BankBookTransactionResponse `json:"unmatched_transactions"`
+}
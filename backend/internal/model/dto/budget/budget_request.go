@@ -0,0 +1,71 @@
+package budget
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CostCenterRequest: parent_id diisi bila cost center berada di bawah departemen lain
+type CostCenterRequest struct {
+	Code        string     `json:"code" validate:"required,max=20"`
+	Name        string     `json:"name" validate:"required,min=2,max=100"`
+	ParentID    *uuid.UUID `json:"parent_id"`
+	ManagerName string     `json:"manager_name" validate:"max=100"`
+	Notes       string     `json:"notes" validate:"max=1000"`
+}
+
+type CostCenterUpdateRequest struct {
+	CostCenterRequest
+	IsActive bool `json:"is_active"`
+}
+
+// CostCenterFilterRequest berisi filter opsional untuk daftar cost center
+type CostCenterFilterRequest struct {
+	ParentID   string `query:"parent_id"`
+	ActiveOnly bool   `query:"active_only"`
+	Search     string `query:"search"`
+}
+
+// BudgetRequest dipakai untuk membuat maupun mengubah budget berstatus Draft. control_period
+// kosong berarti YTD, control_action kosong berarti WARN dan warn_threshold_percent 0 berarti 90.
+type BudgetRequest struct {
+	Name                 string              `json:"name" validate:"required,min=2,max=150"`
+	FiscalYear           int                 `json:"fiscal_year" validate:"required,min=2000,max=2100"`
+	CostCenterID         uuid.UUID           `json:"cost_center_id" validate:"required"`
+	ControlPeriod        string              `json:"control_period" validate:"omitempty,oneof=ANNUAL YTD"`
+	ControlAction        string              `json:"control_action" validate:"omitempty,oneof=WARN BLOCK"`
+	WarnThresholdPercent float64             `json:"warn_threshold_percent" validate:"gte=0,lte=100"`
+	Notes                string              `json:"notes" validate:"max=1000"`
+	Lines                []BudgetLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+// BudgetLineRequest: monthly_amounts berisi 12 nilai Januari sampai Desember. Bila kosong,
+// annual_amount dibagi rata ke 12 bulan dan selisih pembulatan masuk ke Desember.
+type BudgetLineRequest struct {
+	AccountID      uuid.UUID         `json:"account_id" validate:"required"`
+	MonthlyAmounts []decimal.Decimal `json:"monthly_amounts" validate:"omitempty,len=12,dive,gte=0"`
+	AnnualAmount   decimal.Decimal   `json:"annual_amount" validate:"gte=0"`
+}
+
+// BudgetFilterRequest berisi filter opsional untuk daftar budget
+type BudgetFilterRequest struct {
+	FiscalYear   int    `query:"fiscal_year"`
+	CostCenterID string `query:"cost_center_id"`
+	Status       string `query:"status"`
+}
+
+// BudgetVsActualRequest: month_to kosong berarti sampai Desember
+type BudgetVsActualRequest struct {
+	FiscalYear   int    `query:"fiscal_year" validate:"required,min=2000,max=2100"`
+	MonthTo      int    `query:"month_to" validate:"omitempty,min=1,max=12"`
+	CostCenterID string `query:"cost_center_id"`
+	AccountID    string `query:"account_id"`
+}
+
+// BudgetCheckRequest adalah rencana pengeluaran yang akan diperiksa terhadap sisa anggaran
+type BudgetCheckRequest struct {
+	CostCenterID uuid.UUID       `json:"cost_center_id" validate:"required"`
+	AccountID    uuid.UUID       `json:"account_id" validate:"required"`
+	Date         string          `json:"date" validate:"required,datetime=2006-01-02"`
+	Amount       decimal.Decimal `json:"amount" validate:"gt=0"`
+}
@@ -0,0 +1,107 @@
+package budget
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CostCenterResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	ParentID    *uuid.UUID `json:"parent_id"`
+	ParentCode  string     `json:"parent_code,omitempty"`
+	ParentName  string     `json:"parent_name,omitempty"`
+	ManagerName string     `json:"manager_name"`
+	IsActive    bool       `json:"is_active"`
+	Notes       string     `json:"notes"`
+	CreatedAt   string     `json:"created_at"`
+	UpdatedAt   string     `json:"updated_at"`
+}
+
+type BudgetResponse struct {
+	ID                   uuid.UUID                  `json:"id"`
+	Number               string                     `json:"number"`
+	Name                 string                     `json:"name"`
+	FiscalYear           int                        `json:"fiscal_year"`
+	CostCenterID         uuid.UUID                  `json:"cost_center_id"`
+	CostCenterCode       string                     `json:"cost_center_code"`
+	CostCenterName       string                     `json:"cost_center_name"`
+	Status               domain.BudgetStatus        `json:"status"`
+	ControlPeriod        domain.BudgetControlPeriod `json:"control_period"`
+	ControlAction        domain.BudgetControlAction `json:"control_action"`
+	WarnThresholdPercent float64                    `json:"warn_threshold_percent"`
+	TotalAmount          decimal.Decimal            `json:"total_amount"`
+	Notes                string                     `json:"notes"`
+	CreatedBy            uuid.UUID                  `json:"created_by"`
+	ActivatedBy          *uuid.UUID                 `json:"activated_by"`
+	ActivatedAt          string                     `json:"activated_at,omitempty"`
+	CreatedAt            string                     `json:"created_at"`
+	UpdatedAt            string                     `json:"updated_at"`
+	Lines                []BudgetLineResponse       `json:"lines,omitempty"`
+}
+
+// BudgetLineResponse adalah anggaran satu akun; monthly_amounts berurutan Januari sampai Desember
+type BudgetLineResponse struct {
+	AccountID      uuid.UUID         `json:"account_id"`
+	AccountCode    string            `json:"account_code"`
+	AccountName    string            `json:"account_name"`
+	MonthlyAmounts []decimal.Decimal `json:"monthly_amounts"`
+	AnnualAmount   decimal.Decimal   `json:"annual_amount"`
+}
+
+// BudgetVsActualMonthResponse: variance positif berarti realisasi masih di bawah anggaran
+type BudgetVsActualMonthResponse struct {
+	Month    int             `json:"month"`
+	Budget   decimal.Decimal `json:"budget"`
+	Actual   decimal.Decimal `json:"actual"`
+	Variance decimal.Decimal `json:"variance"`
+}
+
+// BudgetVsActualLineResponse: budget, actual dan variance dihitung Januari sampai month_to;
+// utilization_percent 0 bila anggarannya nol
+type BudgetVsActualLineResponse struct {
+	CostCenterID       uuid.UUID                     `json:"cost_center_id"`
+	CostCenterCode     string                        `json:"cost_center_code"`
+	CostCenterName     string                        `json:"cost_center_name"`
+	AccountID          uuid.UUID                     `json:"account_id"`
+	AccountCode        string                        `json:"account_code"`
+	AccountName        string                        `json:"account_name"`
+	AnnualBudget       decimal.Decimal               `json:"annual_budget"`
+	Budget             decimal.Decimal               `json:"budget"`
+	Actual             decimal.Decimal               `json:"actual"`
+	Variance           decimal.Decimal               `json:"variance"`
+	UtilizationPercent float64                       `json:"utilization_percent"`
+	Months             []BudgetVsActualMonthResponse `json:"months"`
+}
+
+type BudgetVsActualResponse struct {
+	FiscalYear         int                          `json:"fiscal_year"`
+	MonthTo            int                          `json:"month_to"`
+	AnnualBudget       decimal.Decimal              `json:"annual_budget"`
+	Budget             decimal.Decimal              `json:"budget"`
+	Actual             decimal.Decimal              `json:"actual"`
+	Variance           decimal.Decimal              `json:"variance"`
+	UtilizationPercent float64                      `json:"utilization_percent"`
+	Lines              []BudgetVsActualLineResponse `json:"lines"`
+}
+
+// BudgetCheckResponse: available adalah anggaran sesuai control_period, remaining_after adalah sisa
+// anggaran bila pengeluaran yang diperiksa jadi dibukukan
+type BudgetCheckResponse struct {
+	Verdict                 domain.BudgetVerdict       `json:"verdict"`
+	Message                 string                     `json:"message"`
+	BudgetID                *uuid.UUID                 `json:"budget_id"`
+	BudgetNumber            string                     `json:"budget_number,omitempty"`
+	ControlPeriod           domain.BudgetControlPeriod `json:"control_period,omitempty"`
+	ControlAction           domain.BudgetControlAction `json:"control_action,omitempty"`
+	WarnThresholdPercent    float64                    `json:"warn_threshold_percent"`
+	Available               decimal.Decimal            `json:"available"`
+	Actual                  decimal.Decimal            `json:"actual"`
+	Remaining               decimal.Decimal            `json:"remaining"`
+	Amount                  decimal.Decimal            `json:"amount"`
+	RemainingAfter          decimal.Decimal            `json:"remaining_after"`
+	UtilizationAfterPercent float64                    `json:"utilization_after_percent"`
+}
@@ -0,0 +1,61 @@
+package currency
+
+import "github.com/google/uuid"
+
+type CurrencyCreateRequest struct {
+	Code          string `json:"code" validate:"required,len=3,alpha"`
+	Name          string `json:"name" validate:"required,min=2,max=100"`
+	Symbol        string `json:"symbol" validate:"max=10"`
+	DecimalPlaces int    `json:"decimal_places" validate:"min=0,max=4"`
+}
+
+type CurrencyUpdateRequest struct {
+	Name          string `json:"name" validate:"required,min=2,max=100"`
+	Symbol        string `json:"symbol" validate:"max=10"`
+	DecimalPlaces int    `json:"decimal_places" validate:"min=0,max=4"`
+	IsActive      bool   `json:"is_active"`
+}
+
+// CurrencyFilterRequest berisi filter opsional untuk daftar mata uang
+type CurrencyFilterRequest struct {
+	ActiveOnly bool   `query:"active_only"`
+	Search     string `query:"search"`
+}
+
+// ExchangeRateRequest: rate adalah nilai 1 unit currency_code dalam mata uang dasar (IDR).
+// Kurs yang sudah ada untuk tanggal yang sama akan ditimpa.
+type ExchangeRateRequest struct {
+	CurrencyCode string  `json:"currency_code" validate:"required,len=3"`
+	RateDate     string  `json:"rate_date" validate:"required"`
+	Rate         float64 `json:"rate" validate:"required,gt=0"`
+}
+
+// ExchangeRateFilterRequest berisi filter opsional untuk daftar kurs
+type ExchangeRateFilterRequest struct {
+	CurrencyCode string `query:"currency_code"`
+	DateFrom     string `query:"date_from"`
+	DateTo       string `query:"date_to"`
+}
+
+// ExchangeRateLookupRequest mencari kurs yang berlaku untuk suatu tanggal; date kosong berarti hari ini
+type ExchangeRateLookupRequest struct {
+	CurrencyCode string `query:"currency_code" validate:"required,len=3"`
+	Date         string `query:"date"`
+}
+
+type CurrencySettingRequest struct {
+	RealisedFXAccountID   uuid.UUID `json:"realised_fx_account_id" validate:"required"`
+	UnrealisedFXAccountID uuid.UUID `json:"unrealised_fx_account_id" validate:"required"`
+}
+
+// FXRevaluationRequest: revaluation_date harus tanggal terakhir suatu bulan
+type FXRevaluationRequest struct {
+	RevaluationDate string `json:"revaluation_date" validate:"required"`
+	Notes           string `json:"notes" validate:"max=1000"`
+}
+
+// FXRevaluationFilterRequest berisi filter opsional untuk daftar revaluasi
+type FXRevaluationFilterRequest struct {
+	DateFrom string `query:"date_from"`
+	DateTo   string `query:"date_to"`
+}
@@ -0,0 +1,81 @@
+package currency
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CurrencyResponse struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	DecimalPlaces int    `json:"decimal_places"`
+	IsBase        bool   `json:"is_base"`
+	IsActive      bool   `json:"is_active"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type ExchangeRateResponse struct {
+	ID           uuid.UUID                 `json:"id"`
+	CurrencyCode string                    `json:"currency_code"`
+	RateDate     string                    `json:"rate_date"`
+	Rate         float64                   `json:"rate"`
+	Source       domain.ExchangeRateSource `json:"source"`
+	CreatedBy    uuid.UUID                 `json:"created_by"`
+	UpdatedAt    string                    `json:"updated_at"`
+}
+
+// ExchangeRateImportResponse merangkum hasil import CSV kurs
+type ExchangeRateImportResponse struct {
+	ImportedRows int      `json:"imported_rows"`
+	Currencies   []string `json:"currencies"`
+	DateFrom     string   `json:"date_from"`
+	DateTo       string   `json:"date_to"`
+}
+
+// ExchangeRateLookupResponse: RateDate adalah tanggal kurs yang dipakai (bisa sebelum tanggal yang diminta)
+type ExchangeRateLookupResponse struct {
+	CurrencyCode string  `json:"currency_code"`
+	Date         string  `json:"date"`
+	RateDate     string  `json:"rate_date"`
+	Rate         float64 `json:"rate"`
+}
+
+type CurrencySettingResponse struct {
+	BaseCurrency          string     `json:"base_currency"`
+	RealisedFXAccountID   *uuid.UUID `json:"realised_fx_account_id"`
+	UnrealisedFXAccountID *uuid.UUID `json:"unrealised_fx_account_id"`
+	UpdatedBy             *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt             string     `json:"updated_at,omitempty"`
+}
+
+type FXRevaluationResponse struct {
+	ID              uuid.UUID                   `json:"id"`
+	Number          string                      `json:"number"`
+	RevaluationDate string                      `json:"revaluation_date"`
+	ReversalDate    string                      `json:"reversal_date"`
+	TotalAdjustment decimal.Decimal             `json:"total_adjustment"`
+	JournalEntryID  *uuid.UUID                  `json:"journal_entry_id"`
+	ReversalEntryID *uuid.UUID                  `json:"reversal_entry_id"`
+	Notes           string                      `json:"notes"`
+	CreatedBy       uuid.UUID                   `json:"created_by"`
+	CreatedAt       string                      `json:"created_at"`
+	Lines           []FXRevaluationLineResponse `json:"lines,omitempty"`
+}
+
+type FXRevaluationLineResponse struct {
+	SourceType   domain.FXRevaluationSourceType `json:"source_type"`
+	SourceID     uuid.UUID                      `json:"source_id"`
+	Number       string                         `json:"number"`
+	PartyName    string                         `json:"party_name"`
+	Currency     string                         `json:"currency"`
+	OpenAmount   decimal.Decimal                `json:"open_amount"`
+	BookedRate   float64                        `json:"booked_rate"`
+	ClosingRate  float64                        `json:"closing_rate"`
+	BookedBase   decimal.Decimal                `json:"booked_base"`
+	RevaluedBase decimal.Decimal                `json:"revalued_base"`
+	Adjustment   decimal.Decimal                `json:"adjustment"`
+}
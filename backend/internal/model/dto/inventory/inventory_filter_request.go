@@ -0,0 +1,21 @@
+package inventory
+
+// MasterFilterRequest berisi filter opsional untuk daftar item dan gudang
+type MasterFilterRequest struct {
+	Search string `query:"search"`
+}
+
+// StockMovementFilterRequest berisi filter opsional untuk daftar mutasi stok
+type StockMovementFilterRequest struct {
+	ItemID       string `query:"item_id"`
+	WarehouseID  string `query:"warehouse_id"`
+	MovementType string `query:"movement_type"`
+	DateFrom     string `query:"date_from"`
+	DateTo       string `query:"date_to"`
+}
+
+// StockBalanceFilterRequest berisi filter opsional untuk saldo on-hand
+type StockBalanceFilterRequest struct {
+	ItemID      string `query:"item_id"`
+	WarehouseID string `query:"warehouse_id"`
+}
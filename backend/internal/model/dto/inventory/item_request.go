@@ -0,0 +1,25 @@
+package inventory
+
+import "github.com/shopspring/decimal"
+
+type ItemCreateRequest struct {
+	Code         string          `json:"code" validate:"required,max=30"`
+	Name         string          `json:"name" validate:"required,min=2,max=150"`
+	Description  string          `json:"description" validate:"max=1000"`
+	Category     string          `json:"category" validate:"max=50"`
+	UOM          string          `json:"uom" validate:"required,max=20"`
+	StandardCost decimal.Decimal `json:"standard_cost" validate:"gte=0"`
+	LeadTimeDays int             `json:"lead_time_days" validate:"gte=0,lte=365"`
+	MinOrderQty  float64         `json:"min_order_qty" validate:"gte=0"`
+}
+
+type ItemUpdateRequest struct {
+	Name         string          `json:"name" validate:"required,min=2,max=150"`
+	Description  string          `json:"description" validate:"max=1000"`
+	Category     string          `json:"category" validate:"max=50"`
+	UOM          string          `json:"uom" validate:"required,max=20"`
+	StandardCost decimal.Decimal `json:"standard_cost" validate:"gte=0"`
+	LeadTimeDays int             `json:"lead_time_days" validate:"gte=0,lte=365"`
+	MinOrderQty  float64         `json:"min_order_qty" validate:"gte=0"`
+	IsActive     bool            `json:"is_active"`
+}
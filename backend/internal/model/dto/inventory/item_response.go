@@ -0,0 +1,21 @@
+package inventory
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type ItemResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	Code         string          `json:"code"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Category     string          `json:"category"`
+	UOM          string          `json:"uom"`
+	StandardCost decimal.Decimal `json:"standard_cost"`
+	LeadTimeDays int             `json:"lead_time_days"`
+	MinOrderQty  float64         `json:"min_order_qty"`
+	IsActive     bool            `json:"is_active"`
+	CreatedAt    string          `json:"created_at"`
+	UpdatedAt    string          `json:"updated_at"`
+}
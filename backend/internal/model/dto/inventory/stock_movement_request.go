@@ -0,0 +1,23 @@
+package inventory
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+// StockMovementRequest dipakai untuk semua jenis mutasi stok manual.
+// Quantity harus positif kecuali untuk Adjustment (negatif berarti pengurangan stok).
+// ToWarehouseID dan ToBinID hanya dipakai untuk Transfer.
+type StockMovementRequest struct {
+	MovementType  domain.StockMovementType `json:"movement_type" validate:"required,oneof='Receipt' 'Issue' 'Transfer' 'Adjustment'"`
+	MovementDate  string                   `json:"movement_date" validate:"required,datetime=2006-01-02"`
+	ItemID        uuid.UUID                `json:"item_id" validate:"required"`
+	WarehouseID   uuid.UUID                `json:"warehouse_id" validate:"required"`
+	BinID         *uuid.UUID               `json:"bin_id"`
+	ToWarehouseID *uuid.UUID               `json:"to_warehouse_id"`
+	ToBinID       *uuid.UUID               `json:"to_bin_id"`
+	Quantity      float64                  `json:"quantity" validate:"ne=0"`
+	Reference     string                   `json:"reference" validate:"max=100"`
+	Notes         string                   `json:"notes" validate:"max=1000"`
+}
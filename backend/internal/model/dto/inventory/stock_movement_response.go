@@ -0,0 +1,40 @@
+package inventory
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type StockMovementResponse struct {
+	ID            uuid.UUID                `json:"id"`
+	Number        string                   `json:"number"`
+	MovementType  domain.StockMovementType `json:"movement_type"`
+	MovementDate  string                   `json:"movement_date"`
+	ItemID        uuid.UUID                `json:"item_id"`
+	ItemCode      string                   `json:"item_code,omitempty"`
+	ItemName      string                   `json:"item_name,omitempty"`
+	WarehouseID   uuid.UUID                `json:"warehouse_id"`
+	WarehouseCode string                   `json:"warehouse_code,omitempty"`
+	BinID         *uuid.UUID               `json:"bin_id"`
+	BinCode       string                   `json:"bin_code,omitempty"`
+	Quantity      float64                  `json:"quantity"`
+	Reference     string                   `json:"reference"`
+	SourceType    string                   `json:"source_type"`
+	SourceID      *uuid.UUID               `json:"source_id"`
+	Notes         string                   `json:"notes"`
+	CreatedBy     uuid.UUID                `json:"created_by"`
+	CreatedAt     string                   `json:"created_at"`
+}
+
+type StockBalanceResponse struct {
+	ItemID        uuid.UUID  `json:"item_id"`
+	ItemCode      string     `json:"item_code"`
+	ItemName      string     `json:"item_name"`
+	UOM           string     `json:"uom"`
+	WarehouseID   uuid.UUID  `json:"warehouse_id"`
+	WarehouseCode string     `json:"warehouse_code"`
+	BinID         *uuid.UUID `json:"bin_id"`
+	BinCode       string     `json:"bin_code,omitempty"`
+	Quantity      float64    `json:"quantity"`
+}
@@ -0,0 +1,18 @@
+package inventory
+
+type WarehouseCreateRequest struct {
+	Code    string `json:"code" validate:"required,max=20"`
+	Name    string `json:"name" validate:"required,min=2,max=100"`
+	Address string `json:"address" validate:"max=500"`
+}
+
+type WarehouseUpdateRequest struct {
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Address  string `json:"address" validate:"max=500"`
+	IsActive bool   `json:"is_active"`
+}
+
+type BinCreateRequest struct {
+	Code string `json:"code" validate:"required,max=20"`
+	Name string `json:"name" validate:"max=100"`
+}
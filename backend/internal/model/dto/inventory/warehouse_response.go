@@ -0,0 +1,21 @@
+package inventory
+
+import "github.com/google/uuid"
+
+type WarehouseResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	Code      string        `json:"code"`
+	Name      string        `json:"name"`
+	Address   string        `json:"address"`
+	IsActive  bool          `json:"is_active"`
+	CreatedAt string        `json:"created_at"`
+	UpdatedAt string        `json:"updated_at"`
+	Bins      []BinResponse `json:"bins,omitempty"`
+}
+
+type BinResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	IsActive bool      `json:"is_active"`
+}
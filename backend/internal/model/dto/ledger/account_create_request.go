@@ -0,0 +1,17 @@
+package ledger
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type AccountCreateRequest struct {
+	Code       string             `json:"code" validate:"required,max=20"`
+	Name       string             `json:"name" validate:"required,min=2,max=100"`
+	Type       domain.AccountType `json:"type" validate:"required,oneof='Asset' 'Liability' 'Equity' 'Revenue' 'Expense'"`
+	ParentID   *uuid.UUID         `json:"parent_id"`
+	IsPostable bool               `json:"is_postable"`
+	// CashFlowCategory hanya untuk akun Asset, Liability dan Equity
+	CashFlowCategory string `json:"cash_flow_category" validate:"omitempty,oneof=CASH OPERATING INVESTING FINANCING"`
+}
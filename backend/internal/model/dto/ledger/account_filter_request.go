@@ -0,0 +1,9 @@
+package ledger
+
+import "erpfinance/internal/model/domain"
+
+// AccountFilterRequest berisi filter opsional untuk daftar akun
+type AccountFilterRequest struct {
+	Search string             `query:"search"`
+	Type   domain.AccountType `query:"type"`
+}
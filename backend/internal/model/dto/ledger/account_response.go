@@ -0,0 +1,26 @@
+package ledger
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type AccountResponse struct {
+	ID               uuid.UUID               `json:"id"`
+	Code             string                  `json:"code"`
+	Name             string                  `json:"name"`
+	Type             domain.AccountType      `json:"type"`
+	ParentID         *uuid.UUID              `json:"parent_id"`
+	IsPostable       bool                    `json:"is_postable"`
+	IsActive         bool                    `json:"is_active"`
+	CashFlowCategory domain.CashFlowCategory `json:"cash_flow_category"`
+	CreatedAt        string                  `json:"created_at"`
+	UpdatedAt        string                  `json:"updated_at"`
+}
+
+// AccountTreeResponse adalah akun beserta sub-akunnya untuk tampilan hirarki
+type AccountTreeResponse struct {
+	AccountResponse
+	Children []AccountTreeResponse `json:"children"`
+}
@@ -0,0 +1,12 @@
+package ledger
+
+import "github.com/google/uuid"
+
+type AccountUpdateRequest struct {
+	Name       string     `json:"name" validate:"required,min=2,max=100"`
+	ParentID   *uuid.UUID `json:"parent_id"`
+	IsPostable bool       `json:"is_postable"`
+	IsActive   bool       `json:"is_active"`
+	// CashFlowCategory hanya untuk akun Asset, Liability dan Equity
+	CashFlowCategory string `json:"cash_flow_category" validate:"omitempty,oneof=CASH OPERATING INVESTING FINANCING"`
+}
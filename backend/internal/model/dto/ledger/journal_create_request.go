@@ -0,0 +1,21 @@
+package ledger
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type JournalCreateRequest struct {
+	EntryDate   string               `json:"entry_date" validate:"required,datetime=2006-01-02"`
+	Description string               `json:"description" validate:"required,max=500"`
+	Reference   string               `json:"reference" validate:"max=100"`
+	Lines       []JournalLineRequest `json:"lines" validate:"required,min=2,dive"`
+}
+
+type JournalLineRequest struct {
+	AccountID    uuid.UUID       `json:"account_id" validate:"required"`
+	CostCenterID *uuid.UUID      `json:"cost_center_id"`
+	Description  string          `json:"description" validate:"max=500"`
+	Debit        decimal.Decimal `json:"debit" validate:"gte=0"`
+	Credit       decimal.Decimal `json:"credit" validate:"gte=0"`
+}
@@ -0,0 +1,10 @@
+package ledger
+
+import "erpfinance/internal/model/domain"
+
+// JournalFilterRequest berisi filter opsional untuk daftar jurnal
+type JournalFilterRequest struct {
+	Status   domain.JournalStatus `query:"status"`
+	DateFrom string               `query:"date_from"`
+	DateTo   string               `query:"date_to"`
+}
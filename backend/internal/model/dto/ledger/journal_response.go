@@ -0,0 +1,44 @@
+package ledger
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type JournalResponse struct {
+	ID           uuid.UUID             `json:"id"`
+	EntryNumber  string                `json:"entry_number"`
+	EntryDate    string                `json:"entry_date"`
+	Description  string                `json:"description"`
+	Reference    string                `json:"reference"`
+	SourceType   string                `json:"source_type"`
+	SourceID     *uuid.UUID            `json:"source_id"`
+	Status       domain.JournalStatus  `json:"status"`
+	ReversalOfID *uuid.UUID            `json:"reversal_of_id"`
+	TotalDebit   decimal.Decimal       `json:"total_debit"`
+	TotalCredit  decimal.Decimal       `json:"total_credit"`
+	CreatedBy    uuid.UUID             `json:"created_by"`
+	PostedBy     *uuid.UUID            `json:"posted_by"`
+	PostedAt     string                `json:"posted_at,omitempty"`
+	CreatedAt    string                `json:"created_at"`
+	Lines        []JournalLineResponse `json:"lines,omitempty"`
+}
+
+type JournalLineResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	LineNo         int             `json:"line_no"`
+	AccountID      uuid.UUID       `json:"account_id"`
+	AccountCode    string          `json:"account_code"`
+	AccountName    string          `json:"account_name"`
+	CostCenterID   *uuid.UUID      `json:"cost_center_id"`
+	CostCenterCode string          `json:"cost_center_code,omitempty"`
+	Description    string          `json:"description"`
+	Debit          decimal.Decimal `json:"debit"`
+	Credit         decimal.Decimal `json:"credit"`
+	Currency       string          `json:"currency"`
+	ExchangeRate   float64         `json:"exchange_rate"`
+	ForeignDebit   decimal.Decimal `json:"foreign_debit"`
+	ForeignCredit  decimal.Decimal `json:"foreign_credit"`
+}
@@ -0,0 +1,6 @@
+package ledger
+
+type JournalReverseRequest struct {
+	ReversalDate string `json:"reversal_date" validate:"required,datetime=2006-01-02"`
+	Description  string `json:"description" validate:"max=500"`
+}
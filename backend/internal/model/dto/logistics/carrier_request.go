@@ -0,0 +1,29 @@
+package logistics
+
+// CarrierCreateRequest: tracking_url boleh berisi placeholder {tracking_number}
+type CarrierCreateRequest struct {
+	Code        string `json:"code" validate:"required,max=20"`
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	ServiceType string `json:"service_type" validate:"max=50"`
+	ContactName string `json:"contact_name" validate:"max=100"`
+	Phone       string `json:"phone" validate:"max=30"`
+	Email       string `json:"email" validate:"omitempty,email,max=100"`
+	TrackingURL string `json:"tracking_url" validate:"omitempty,max=255"`
+	Notes       string `json:"notes" validate:"max=1000"`
+}
+
+type CarrierUpdateRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	ServiceType string `json:"service_type" validate:"max=50"`
+	ContactName string `json:"contact_name" validate:"max=100"`
+	Phone       string `json:"phone" validate:"max=30"`
+	Email       string `json:"email" validate:"omitempty,email,max=100"`
+	TrackingURL string `json:"tracking_url" validate:"omitempty,max=255"`
+	Notes       string `json:"notes" validate:"max=1000"`
+	IsActive    bool   `json:"is_active"`
+}
+
+// CarrierFilterRequest berisi filter opsional untuk daftar carrier
+type CarrierFilterRequest struct {
+	Search string `query:"search"`
+}
@@ -0,0 +1,18 @@
+package logistics
+
+import "github.com/google/uuid"
+
+type CarrierResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	ServiceType string    `json:"service_type"`
+	ContactName string    `json:"contact_name"`
+	Phone       string    `json:"phone"`
+	Email       string    `json:"email"`
+	TrackingURL string    `json:"tracking_url"`
+	IsActive    bool      `json:"is_active"`
+	Notes       string    `json:"notes"`
+	CreatedAt   string    `json:"created_at"`
+	UpdatedAt   string    `json:"updated_at"`
+}
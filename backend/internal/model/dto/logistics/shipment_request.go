@@ -0,0 +1,95 @@
+package logistics
+
+import "github.com/google/uuid"
+
+// ShipmentRequest dipakai untuk membuat maupun mengubah pengiriman berstatus Planned.
+// recipient_name dan shipping_address yang kosong diisi dari data customer. Bila sales_order_id
+// diisi, customer mengikuti sales order dan setiap baris harus menunjuk baris order.
+type ShipmentRequest struct {
+	ShipmentDate    string                   `json:"shipment_date" validate:"required,datetime=2006-01-02"`
+	WarehouseID     uuid.UUID                `json:"warehouse_id" validate:"required"`
+	CarrierID       string                   `json:"carrier_id" validate:"omitempty,uuid"`
+	CustomerID      string                   `json:"customer_id" validate:"omitempty,uuid"`
+	SalesOrderID    string                   `json:"sales_order_id" validate:"omitempty,uuid"`
+	RecipientName   string                   `json:"recipient_name" validate:"max=150"`
+	RecipientPhone  string                   `json:"recipient_phone" validate:"max=30"`
+	ShippingAddress string                   `json:"shipping_address" validate:"max=1000"`
+	Reference       string                   `json:"reference" validate:"max=100"`
+	Notes           string                   `json:"notes" validate:"max=1000"`
+	Lines           []ShipmentLineRequest    `json:"lines" validate:"required,min=1,dive"`
+	Packages        []ShipmentPackageRequest `json:"packages" validate:"omitempty,dive"`
+}
+
+// ShipmentLineRequest: package_no 0 berarti barang belum dikemas
+type ShipmentLineRequest struct {
+	ItemID           uuid.UUID `json:"item_id" validate:"required"`
+	BinID            string    `json:"bin_id" validate:"omitempty,uuid"`
+	SalesOrderLineID string    `json:"sales_order_line_id" validate:"omitempty,uuid"`
+	Description      string    `json:"description" validate:"max=500"`
+	Quantity         float64   `json:"quantity" validate:"gt=0"`
+	PackageNo        int       `json:"package_no" validate:"gte=0"`
+}
+
+// ShipmentPackageRequest: weight dalam kilogram, dimensi dalam centimeter
+type ShipmentPackageRequest struct {
+	PackageNo   int     `json:"package_no" validate:"gt=0"`
+	PackageType string  `json:"package_type" validate:"required,max=30"`
+	Weight      float64 `json:"weight" validate:"gte=0"`
+	Length      float64 `json:"length" validate:"gte=0"`
+	Width       float64 `json:"width" validate:"gte=0"`
+	Height      float64 `json:"height" validate:"gte=0"`
+	Notes       string  `json:"notes" validate:"max=500"`
+}
+
+// ShipmentPackingRequest mengganti seluruh koli dan penempatan barang ke koli
+// selama pengiriman masih Planned atau Picked; baris yang tidak disebut menjadi belum dikemas
+type ShipmentPackingRequest struct {
+	Packages []ShipmentPackageRequest       `json:"packages" validate:"omitempty,dive"`
+	Lines    []ShipmentLinePackagingRequest `json:"lines" validate:"omitempty,dive"`
+}
+
+type ShipmentLinePackagingRequest struct {
+	LineID    uuid.UUID `json:"line_id" validate:"required"`
+	PackageNo int       `json:"package_no" validate:"gte=0"`
+}
+
+// ShipmentShipRequest: carrier wajib sudah terisi di pengiriman atau dikirim di sini
+type ShipmentShipRequest struct {
+	CarrierID      string `json:"carrier_id" validate:"omitempty,uuid"`
+	TrackingNumber string `json:"tracking_number" validate:"max=100"`
+	Notes          string `json:"notes" validate:"max=500"`
+}
+
+type ShipmentDeliverRequest struct {
+	ReceivedBy  string                      `json:"received_by" validate:"required,max=150"`
+	Notes       string                      `json:"notes" validate:"max=500"`
+	Attachments []ShipmentAttachmentRequest `json:"attachments" validate:"omitempty,dive"`
+}
+
+type ShipmentReturnRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// ShipmentTrackingRequest mencatat checkpoint dari ekspedisi; event_at kosong berarti saat ini
+type ShipmentTrackingRequest struct {
+	EventAt     string `json:"event_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Location    string `json:"location" validate:"max=150"`
+	Description string `json:"description" validate:"required,max=500"`
+}
+
+// ShipmentAttachmentRequest berisi metadata file bukti pengiriman yang sudah diunggah ke storage
+type ShipmentAttachmentRequest struct {
+	FileName    string `json:"file_name" validate:"required,max=255"`
+	ContentType string `json:"content_type" validate:"required,max=100"`
+	FileSize    int64  `json:"file_size" validate:"gt=0"`
+	StorageURL  string `json:"storage_url" validate:"required,url,max=500"`
+	Description string `json:"description" validate:"max=500"`
+}
+
+// ShipmentFilterRequest berisi filter opsional untuk daftar pengiriman
+type ShipmentFilterRequest struct {
+	Status     string `query:"status"`
+	CarrierID  string `query:"carrier_id"`
+	CustomerID string `query:"customer_id"`
+	Search     string `query:"search"`
+}
@@ -0,0 +1,110 @@
+package logistics
+
+import "github.com/google/uuid"
+
+type ShipmentResponse struct {
+	ID               uuid.UUID                    `json:"id"`
+	Number           string                       `json:"number"`
+	ShipmentDate     string                       `json:"shipment_date"`
+	WarehouseID      uuid.UUID                    `json:"warehouse_id"`
+	WarehouseCode    string                       `json:"warehouse_code"`
+	CarrierID        *uuid.UUID                   `json:"carrier_id"`
+	CarrierCode      string                       `json:"carrier_code"`
+	CarrierName      string                       `json:"carrier_name"`
+	CustomerID       *uuid.UUID                   `json:"customer_id"`
+	CustomerCode     string                       `json:"customer_code"`
+	CustomerName     string                       `json:"customer_name"`
+	SalesOrderID     *uuid.UUID                   `json:"sales_order_id"`
+	SalesOrderNumber string                       `json:"sales_order_number"`
+	RecipientName    string                       `json:"recipient_name"`
+	RecipientPhone   string                       `json:"recipient_phone"`
+	ShippingAddress  string                       `json:"shipping_address"`
+	TrackingNumber   string                       `json:"tracking_number"`
+	TrackingLink     string                       `json:"tracking_link"`
+	Reference        string                       `json:"reference"`
+	Notes            string                       `json:"notes"`
+	Status           string                       `json:"status"`
+	ReceivedBy       string                       `json:"received_by"`
+	ReturnReason     string                       `json:"return_reason"`
+	TotalPackages    int                          `json:"total_packages"`
+	TotalWeight      float64                      `json:"total_weight"`
+	CreatedBy        uuid.UUID                    `json:"created_by"`
+	PickedAt         string                       `json:"picked_at"`
+	ShippedAt        string                       `json:"shipped_at"`
+	DeliveredAt      string                       `json:"delivered_at"`
+	ReturnedAt       string                       `json:"returned_at"`
+	CreatedAt        string                       `json:"created_at"`
+	UpdatedAt        string                       `json:"updated_at"`
+	Lines            []ShipmentLineResponse       `json:"lines,omitempty"`
+	Packages         []ShipmentPackageResponse    `json:"packages,omitempty"`
+	Events           []ShipmentEventResponse      `json:"events,omitempty"`
+	Attachments      []ShipmentAttachmentResponse `json:"attachments,omitempty"`
+}
+
+type ShipmentLineResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	LineNo           int        `json:"line_no"`
+	ItemID           uuid.UUID  `json:"item_id"`
+	ItemCode         string     `json:"item_code"`
+	BinID            *uuid.UUID `json:"bin_id"`
+	SalesOrderLineID *uuid.UUID `json:"sales_order_line_id"`
+	Description      string     `json:"description"`
+	UOM              string     `json:"uom"`
+	Quantity         float64    `json:"quantity"`
+	PackageNo        int        `json:"package_no"`
+}
+
+type ShipmentPackageResponse struct {
+	ID          uuid.UUID `json:"id"`
+	PackageNo   int       `json:"package_no"`
+	PackageType string    `json:"package_type"`
+	Weight      float64   `json:"weight"`
+	Length      float64   `json:"length"`
+	Width       float64   `json:"width"`
+	Height      float64   `json:"height"`
+	Notes       string    `json:"notes"`
+}
+
+type ShipmentEventResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Status      string    `json:"status"`
+	EventAt     string    `json:"event_at"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	CreatedBy   uuid.UUID `json:"created_by"`
+}
+
+type ShipmentAttachmentResponse struct {
+	ID          uuid.UUID `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	FileSize    int64     `json:"file_size"`
+	StorageURL  string    `json:"storage_url"`
+	Description string    `json:"description"`
+	UploadedBy  uuid.UUID `json:"uploaded_by"`
+	CreatedAt   string    `json:"created_at"`
+}
+
+// PackingListResponse adalah packing list pengiriman: barang dikelompokkan per koli
+type PackingListResponse struct {
+	ShipmentID      uuid.UUID                    `json:"shipment_id"`
+	Number          string                       `json:"number"`
+	ShipmentDate    string                       `json:"shipment_date"`
+	Status          string                       `json:"status"`
+	WarehouseCode   string                       `json:"warehouse_code"`
+	CarrierName     string                       `json:"carrier_name"`
+	TrackingNumber  string                       `json:"tracking_number"`
+	RecipientName   string                       `json:"recipient_name"`
+	RecipientPhone  string                       `json:"recipient_phone"`
+	ShippingAddress string                       `json:"shipping_address"`
+	Packages        []PackingListPackageResponse `json:"packages"`
+	UnpackedLines   []ShipmentLineResponse       `json:"unpacked_lines"`
+	TotalPackages   int                          `json:"total_packages"`
+	TotalWeight     float64                      `json:"total_weight"`
+	TotalQuantity   float64                      `json:"total_quantity"`
+}
+
+type PackingListPackageResponse struct {
+	ShipmentPackageResponse
+	Lines []ShipmentLineResponse `json:"lines"`
+}
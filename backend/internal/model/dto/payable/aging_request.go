@@ -0,0 +1,8 @@
+package payable
+
+// AgingFilterRequest dengan as_of kosong memakai tanggal hari ini
+type AgingFilterRequest struct {
+	AsOf       string `query:"as_of"`
+	SupplierID string `query:"supplier_id"`
+	Currency   string `query:"currency"`
+}
@@ -0,0 +1,46 @@
+package payable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AgingBuckets mengelompokkan sisa hutang berdasarkan jumlah hari lewat jatuh tempo
+type AgingBuckets struct {
+	Current    decimal.Decimal `json:"current"`
+	Days1To30  decimal.Decimal `json:"days_1_30"`
+	Days31To60 decimal.Decimal `json:"days_31_60"`
+	Days61To90 decimal.Decimal `json:"days_61_90"`
+	Over90     decimal.Decimal `json:"over_90"`
+	Total      decimal.Decimal `json:"total"`
+}
+
+type AgingReportResponse struct {
+	AsOf      string                  `json:"as_of"`
+	Suppliers []AgingSupplierResponse `json:"suppliers"`
+	Totals    []AgingTotalResponse    `json:"totals"`
+}
+
+type AgingSupplierResponse struct {
+	SupplierID   uuid.UUID              `json:"supplier_id"`
+	SupplierName string                 `json:"supplier_name"`
+	Currency     string                 `json:"currency"`
+	Buckets      AgingBuckets           `json:"buckets"`
+	Invoices     []AgingInvoiceResponse `json:"invoices"`
+}
+
+type AgingInvoiceResponse struct {
+	InvoiceID         uuid.UUID       `json:"invoice_id"`
+	Number            string          `json:"number"`
+	SupplierInvoiceNo string          `json:"supplier_invoice_no"`
+	InvoiceDate       string          `json:"invoice_date"`
+	DueDate           string          `json:"due_date"`
+	DaysOverdue       int             `json:"days_overdue"`
+	OutstandingAmount decimal.Decimal `json:"outstanding_amount"`
+}
+
+// AgingTotalResponse adalah total per mata uang karena nominal beda mata uang tidak dijumlahkan
+type AgingTotalResponse struct {
+	Currency string       `json:"currency"`
+	Buckets  AgingBuckets `json:"buckets"`
+}
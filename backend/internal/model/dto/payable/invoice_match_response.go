@@ -0,0 +1,37 @@
+package payable
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceMatchResponse adalah laporan selisih three-way match satu supplier invoice
+type InvoiceMatchResponse struct {
+	InvoiceID      uuid.UUID                  `json:"invoice_id"`
+	InvoiceNumber  string                     `json:"invoice_number"`
+	MatchStatus    domain.InvoiceMatchStatus  `json:"match_status"`
+	Tolerance      MatchToleranceResponse     `json:"tolerance"`
+	ExpectedAmount decimal.Decimal            `json:"expected_amount"`
+	InvoicedAmount decimal.Decimal            `json:"invoiced_amount"`
+	AmountVariance decimal.Decimal            `json:"amount_variance"`
+	Lines          []InvoiceMatchLineResponse `json:"lines"`
+}
+
+type InvoiceMatchLineResponse struct {
+	LineNo              int             `json:"line_no"`
+	PurchaseOrderLineID uuid.UUID       `json:"purchase_order_line_id"`
+	Description         string          `json:"description"`
+	OrderedQuantity     float64         `json:"ordered_quantity"`
+	ReceivedQuantity    float64         `json:"received_quantity"`
+	PreviouslyInvoiced  float64         `json:"previously_invoiced"`
+	BillableQuantity    float64         `json:"billable_quantity"`
+	InvoicedQuantity    float64         `json:"invoiced_quantity"`
+	QuantityVariance    float64         `json:"quantity_variance"`
+	OrderUnitPrice      decimal.Decimal `json:"order_unit_price"`
+	InvoiceUnitPrice    decimal.Decimal `json:"invoice_unit_price"`
+	PriceVariance       decimal.Decimal `json:"price_variance"`
+	Matched             bool            `json:"matched"`
+	Issues              []string        `json:"issues,omitempty"`
+}
@@ -0,0 +1,9 @@
+package payable
+
+import "github.com/shopspring/decimal"
+
+type MatchToleranceRequest struct {
+	QuantityPercent float64         `json:"quantity_percent" validate:"gte=0,lte=100"`
+	PricePercent    float64         `json:"price_percent" validate:"gte=0,lte=100"`
+	AmountAbsolute  decimal.Decimal `json:"amount_absolute" validate:"gte=0"`
+}
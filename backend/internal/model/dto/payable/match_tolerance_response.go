@@ -0,0 +1,14 @@
+package payable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type MatchToleranceResponse struct {
+	QuantityPercent float64         `json:"quantity_percent"`
+	PricePercent    float64         `json:"price_percent"`
+	AmountAbsolute  decimal.Decimal `json:"amount_absolute"`
+	UpdatedBy       *uuid.UUID      `json:"updated_by,omitempty"`
+	UpdatedAt       string          `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,8 @@
+package payable
+
+import "github.com/google/uuid"
+
+type PayableSettingRequest struct {
+	PayableAccountID  uuid.UUID `json:"payable_account_id" validate:"required"`
+	PurchaseAccountID uuid.UUID `json:"purchase_account_id" validate:"required"`
+}
@@ -0,0 +1,10 @@
+package payable
+
+import "github.com/google/uuid"
+
+type PayableSettingResponse struct {
+	PayableAccountID  *uuid.UUID `json:"payable_account_id"`
+	PurchaseAccountID *uuid.UUID `json:"purchase_account_id"`
+	UpdatedBy         *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt         string     `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,19 @@
+package payable
+
+import "github.com/google/uuid"
+
+// PaymentRunRequest memilih invoice Approved dengan mata uang yang sama dan jatuh tempo
+// paling lambat due_until. supplier_ids yang kosong berarti semua supplier aktif.
+type PaymentRunRequest struct {
+	PaymentDate      string      `json:"payment_date" validate:"required,datetime=2006-01-02"`
+	DueUntil         string      `json:"due_until" validate:"required,datetime=2006-01-02"`
+	Currency         string      `json:"currency" validate:"required,len=3"`
+	PaymentAccountID uuid.UUID   `json:"payment_account_id" validate:"required"`
+	SupplierIDs      []uuid.UUID `json:"supplier_ids"`
+	Notes            string      `json:"notes" validate:"max=1000"`
+}
+
+// PaymentRunFilterRequest berisi filter opsional untuk daftar payment run
+type PaymentRunFilterRequest struct {
+	Status string `query:"status"`
+}
@@ -0,0 +1,49 @@
+package payable
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type PaymentRunResponse struct {
+	ID               uuid.UUID               `json:"id"`
+	Number           string                  `json:"number"`
+	PaymentDate      string                  `json:"payment_date"`
+	DueUntil         string                  `json:"due_until"`
+	Currency         string                  `json:"currency"`
+	ExchangeRate     float64                 `json:"exchange_rate"`
+	PaymentAccountID uuid.UUID               `json:"payment_account_id"`
+	Notes            string                  `json:"notes"`
+	Status           domain.PaymentRunStatus `json:"status"`
+	TotalAmount      decimal.Decimal         `json:"total_amount"`
+	CreatedBy        uuid.UUID               `json:"created_by"`
+	PostedBy         *uuid.UUID              `json:"posted_by"`
+	PostedAt         string                  `json:"posted_at,omitempty"`
+	CreatedAt        string                  `json:"created_at"`
+	UpdatedAt        string                  `json:"updated_at"`
+	Batches          []PaymentBatchResponse  `json:"batches,omitempty"`
+}
+
+type PaymentBatchResponse struct {
+	ID             uuid.UUID                  `json:"id"`
+	BatchNo        int                        `json:"batch_no"`
+	SupplierID     uuid.UUID                  `json:"supplier_id"`
+	SupplierName   string                     `json:"supplier_name"`
+	BankName       string                     `json:"bank_name"`
+	AccountNumber  string                     `json:"account_number"`
+	AccountName    string                     `json:"account_name"`
+	TotalAmount    decimal.Decimal            `json:"total_amount"`
+	JournalEntryID *uuid.UUID                 `json:"journal_entry_id"`
+	Lines          []PaymentBatchLineResponse `json:"lines"`
+}
+
+type PaymentBatchLineResponse struct {
+	ID                uuid.UUID       `json:"id"`
+	SupplierInvoiceID uuid.UUID       `json:"supplier_invoice_id"`
+	InvoiceNumber     string          `json:"invoice_number"`
+	SupplierInvoiceNo string          `json:"supplier_invoice_no"`
+	DueDate           string          `json:"due_date"`
+	Amount            decimal.Decimal `json:"amount"`
+}
@@ -0,0 +1,8 @@
+package payable
+
+// SupplierInvoiceFilterRequest berisi filter opsional untuk daftar supplier invoice
+type SupplierInvoiceFilterRequest struct {
+	Status      string `query:"status"`
+	MatchStatus string `query:"match_status"`
+	Search      string `query:"search"`
+}
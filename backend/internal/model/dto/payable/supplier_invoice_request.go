@@ -0,0 +1,39 @@
+package payable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierInvoiceRequest dipakai untuk membuat maupun mengubah supplier invoice berstatus Draft.
+// Setiap baris harus menunjuk baris purchase order yang ditagih. due_date yang kosong dihitung
+// dari invoice_date ditambah termin pembayaran purchase order.
+type SupplierInvoiceRequest struct {
+	PurchaseOrderID   uuid.UUID                    `json:"purchase_order_id" validate:"required"`
+	SupplierInvoiceNo string                       `json:"supplier_invoice_no" validate:"required,max=50"`
+	InvoiceDate       string                       `json:"invoice_date" validate:"required,datetime=2006-01-02"`
+	DueDate           string                       `json:"due_date" validate:"omitempty,datetime=2006-01-02"`
+	Currency          string                       `json:"currency" validate:"required,len=3"`
+	Notes             string                       `json:"notes" validate:"max=1000"`
+	Lines             []SupplierInvoiceLineRequest `json:"lines" validate:"required,min=1,dive"`
+	Taxes             []SupplierInvoiceTaxRequest  `json:"taxes" validate:"omitempty,dive"`
+}
+
+type SupplierInvoiceLineRequest struct {
+	PurchaseOrderLineID uuid.UUID       `json:"purchase_order_line_id" validate:"required"`
+	Description         string          `json:"description" validate:"max=500"`
+	Quantity            float64         `json:"quantity" validate:"gt=0"`
+	UnitPrice           decimal.Decimal `json:"unit_price" validate:"gte=0"`
+}
+
+// SupplierInvoiceTaxRequest dengan base_amount kosong memakai subtotal invoice sebagai DPP. Bila tax_code
+// terdaftar di master kode pajak, tarif, akun dan sifat potong diambil dari master; selain itu
+// account_id dan rate wajib diisi.
+type SupplierInvoiceTaxRequest struct {
+	TaxCode       string           `json:"tax_code" validate:"required,max=20"`
+	Description   string           `json:"description" validate:"max=200"`
+	AccountID     *uuid.UUID       `json:"account_id"`
+	Rate          float64          `json:"rate" validate:"gte=0,lte=100"`
+	BaseAmount    *decimal.Decimal `json:"base_amount" validate:"omitempty,gte=0"`
+	IsWithholding bool             `json:"is_withholding"`
+}
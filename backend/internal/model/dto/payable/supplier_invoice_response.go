@@ -0,0 +1,61 @@
+package payable
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SupplierInvoiceResponse struct {
+	ID                  uuid.UUID                     `json:"id"`
+	Number              string                        `json:"number"`
+	SupplierInvoiceNo   string                        `json:"supplier_invoice_no"`
+	SupplierID          uuid.UUID                     `json:"supplier_id"`
+	SupplierName        string                        `json:"supplier_name"`
+	PurchaseOrderID     uuid.UUID                     `json:"purchase_order_id"`
+	PurchaseOrderNumber string                        `json:"purchase_order_number,omitempty"`
+	InvoiceDate         string                        `json:"invoice_date"`
+	DueDate             string                        `json:"due_date"`
+	Currency            string                        `json:"currency"`
+	ExchangeRate        float64                       `json:"exchange_rate"`
+	Notes               string                        `json:"notes"`
+	Status              domain.SupplierInvoiceStatus  `json:"status"`
+	MatchStatus         domain.InvoiceMatchStatus     `json:"match_status"`
+	SubtotalAmount      decimal.Decimal               `json:"subtotal_amount"`
+	TaxAmount           decimal.Decimal               `json:"tax_amount"`
+	TotalAmount         decimal.Decimal               `json:"total_amount"`
+	PaidAmount          decimal.Decimal               `json:"paid_amount"`
+	OutstandingAmount   decimal.Decimal               `json:"outstanding_amount"`
+	JournalEntryID      *uuid.UUID                    `json:"journal_entry_id"`
+	CreatedBy           uuid.UUID                     `json:"created_by"`
+	ApprovedBy          *uuid.UUID                    `json:"approved_by"`
+	ApprovedAt          string                        `json:"approved_at,omitempty"`
+	CreatedAt           string                        `json:"created_at"`
+	UpdatedAt           string                        `json:"updated_at"`
+	Lines               []SupplierInvoiceLineResponse `json:"lines,omitempty"`
+	Taxes               []SupplierInvoiceTaxResponse  `json:"taxes,omitempty"`
+}
+
+type SupplierInvoiceLineResponse struct {
+	ID                  uuid.UUID       `json:"id"`
+	PurchaseOrderLineID uuid.UUID       `json:"purchase_order_line_id"`
+	LineNo              int             `json:"line_no"`
+	Description         string          `json:"description"`
+	Quantity            float64         `json:"quantity"`
+	UnitPrice           decimal.Decimal `json:"unit_price"`
+	LineTotal           decimal.Decimal `json:"line_total"`
+}
+
+type SupplierInvoiceTaxResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	LineNo        int             `json:"line_no"`
+	TaxCode       string          `json:"tax_code"`
+	TaxType       domain.TaxType  `json:"tax_type"`
+	Description   string          `json:"description"`
+	AccountID     uuid.UUID       `json:"account_id"`
+	BaseAmount    decimal.Decimal `json:"base_amount"`
+	Rate          float64         `json:"rate"`
+	Amount        decimal.Decimal `json:"amount"`
+	IsWithholding bool            `json:"is_withholding"`
+}
@@ -0,0 +1,6 @@
+package period
+
+type FiscalYearCreateRequest struct {
+	Code      string `json:"code" validate:"required,max=20"`
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+}
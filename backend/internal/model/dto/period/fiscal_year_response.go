@@ -0,0 +1,27 @@
+package period
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type FiscalYearResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	Code      string           `json:"code"`
+	StartDate string           `json:"start_date"`
+	EndDate   string           `json:"end_date"`
+	CreatedAt string           `json:"created_at"`
+	Periods   []PeriodResponse `json:"periods,omitempty"`
+}
+
+type PeriodResponse struct {
+	ID           uuid.UUID           `json:"id"`
+	FiscalYearID uuid.UUID           `json:"fiscal_year_id"`
+	PeriodNo     int                 `json:"period_no"`
+	Name         string              `json:"name"`
+	StartDate    string              `json:"start_date"`
+	EndDate      string              `json:"end_date"`
+	Status       domain.PeriodStatus `json:"status"`
+	UpdatedAt    string              `json:"updated_at"`
+}
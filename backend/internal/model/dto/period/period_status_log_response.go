@@ -0,0 +1,17 @@
+package period
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type PeriodStatusLogResponse struct {
+	ID         uuid.UUID           `json:"id"`
+	PeriodID   uuid.UUID           `json:"period_id"`
+	FromStatus domain.PeriodStatus `json:"from_status"`
+	ToStatus   domain.PeriodStatus `json:"to_status"`
+	Reason     string              `json:"reason"`
+	ChangedBy  uuid.UUID           `json:"changed_by"`
+	CreatedAt  string              `json:"created_at"`
+}
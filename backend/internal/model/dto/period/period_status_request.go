@@ -0,0 +1,8 @@
+package period
+
+import "erpfinance/internal/model/domain"
+
+type PeriodStatusRequest struct {
+	Status domain.PeriodStatus `json:"status" validate:"required,oneof='Open' 'SoftClosed' 'Locked'"`
+	Reason string              `json:"reason" validate:"required,min=5,max=500"`
+}
@@ -0,0 +1,26 @@
+package ppc
+
+import "github.com/google/uuid"
+
+// BillOfMaterialRequest dipakai untuk membuat maupun mengubah BOM. Quantity adalah jumlah
+// item induk yang dihasilkan oleh komponen pada lines.
+type BillOfMaterialRequest struct {
+	ItemID      uuid.UUID                   `json:"item_id" validate:"required"`
+	Description string                      `json:"description" validate:"max=500"`
+	Quantity    float64                     `json:"quantity" validate:"gt=0"`
+	IsDefault   bool                        `json:"is_default"`
+	IsActive    bool                        `json:"is_active"`
+	Lines       []BillOfMaterialLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type BillOfMaterialLineRequest struct {
+	ComponentItemID uuid.UUID `json:"component_item_id" validate:"required"`
+	Quantity        float64   `json:"quantity" validate:"gt=0"`
+	ScrapPercent    float64   `json:"scrap_percent" validate:"gte=0,lt=100"`
+	Notes           string    `json:"notes" validate:"max=500"`
+}
+
+// BOMExplodeRequest dengan quantity kosong memakai quantity dasar BOM
+type BOMExplodeRequest struct {
+	Quantity float64 `query:"quantity" validate:"gte=0"`
+}
@@ -0,0 +1,59 @@
+package ppc
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type BillOfMaterialResponse struct {
+	ID          uuid.UUID                    `json:"id"`
+	ItemID      uuid.UUID                    `json:"item_id"`
+	ItemCode    string                       `json:"item_code"`
+	ItemName    string                       `json:"item_name"`
+	Version     int                          `json:"version"`
+	Description string                       `json:"description"`
+	Quantity    float64                      `json:"quantity"`
+	IsDefault   bool                         `json:"is_default"`
+	IsActive    bool                         `json:"is_active"`
+	CreatedAt   string                       `json:"created_at"`
+	UpdatedAt   string                       `json:"updated_at"`
+	Lines       []BillOfMaterialLineResponse `json:"lines,omitempty"`
+}
+
+type BillOfMaterialLineResponse struct {
+	ID              uuid.UUID `json:"id"`
+	LineNo          int       `json:"line_no"`
+	ComponentItemID uuid.UUID `json:"component_item_id"`
+	ComponentCode   string    `json:"component_code"`
+	ComponentName   string    `json:"component_name"`
+	UOM             string    `json:"uom"`
+	Quantity        float64   `json:"quantity"`
+	ScrapPercent    float64   `json:"scrap_percent"`
+	Notes           string    `json:"notes"`
+}
+
+// BOMExplodeResponse adalah struktur BOM bertingkat untuk quantity tertentu beserta roll-up
+// biaya standar material. Komponen tanpa BOM default dihitung dengan standard cost item.
+type BOMExplodeResponse struct {
+	BillOfMaterialID uuid.UUID         `json:"bill_of_material_id"`
+	ItemID           uuid.UUID         `json:"item_id"`
+	ItemCode         string            `json:"item_code"`
+	ItemName         string            `json:"item_name"`
+	Quantity         float64           `json:"quantity"`
+	MaterialCost     decimal.Decimal   `json:"material_cost"`
+	UnitCost         decimal.Decimal   `json:"unit_cost"`
+	Components       []BOMNodeResponse `json:"components"`
+}
+
+type BOMNodeResponse struct {
+	Level            int               `json:"level"`
+	ItemID           uuid.UUID         `json:"item_id"`
+	ItemCode         string            `json:"item_code"`
+	ItemName         string            `json:"item_name"`
+	UOM              string            `json:"uom"`
+	Quantity         float64           `json:"quantity"`
+	BillOfMaterialID *uuid.UUID        `json:"bill_of_material_id"`
+	UnitCost         decimal.Decimal   `json:"unit_cost"`
+	TotalCost        decimal.Decimal   `json:"total_cost"`
+	Components       []BOMNodeResponse `json:"components,omitempty"`
+}
@@ -0,0 +1,37 @@
+package ppc
+
+import "github.com/google/uuid"
+
+// MRPRunRequest memulai run MRP untuk satu gudang. demands adalah jadwal produksi induk
+// (kebutuhan independen) dan due_date setiap demand harus berada di dalam horizon.
+type MRPRunRequest struct {
+	WarehouseID  uuid.UUID          `json:"warehouse_id" validate:"required"`
+	HorizonStart string             `json:"horizon_start" validate:"required,datetime=2006-01-02"`
+	HorizonEnd   string             `json:"horizon_end" validate:"required,datetime=2006-01-02"`
+	Notes        string             `json:"notes" validate:"max=1000"`
+	Demands      []MRPDemandRequest `json:"demands" validate:"required,min=1,dive"`
+}
+
+type MRPDemandRequest struct {
+	ItemID    uuid.UUID `json:"item_id" validate:"required"`
+	Quantity  float64   `json:"quantity" validate:"gt=0"`
+	DueDate   string    `json:"due_date" validate:"required,datetime=2006-01-02"`
+	Reference string    `json:"reference" validate:"max=100"`
+}
+
+// MRPConvertRequest mengkonversi saran Open menjadi requisition/work order Draft;
+// suggestion_ids kosong berarti seluruh saran Open milik run
+type MRPConvertRequest struct {
+	SuggestionIDs []uuid.UUID `json:"suggestion_ids" validate:"omitempty,dive,required"`
+}
+
+// MRPRunFilterRequest berisi filter opsional untuk daftar run MRP
+type MRPRunFilterRequest struct {
+	Status string `query:"status"`
+}
+
+// MRPSuggestionFilterRequest berisi filter opsional untuk saran order satu run
+type MRPSuggestionFilterRequest struct {
+	Type   string `query:"type"`
+	Status string `query:"status"`
+}
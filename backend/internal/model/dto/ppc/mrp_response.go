@@ -0,0 +1,72 @@
+package ppc
+
+import "github.com/google/uuid"
+
+type MRPRunResponse struct {
+	ID            uuid.UUID           `json:"id"`
+	Number        string              `json:"number"`
+	WarehouseID   uuid.UUID           `json:"warehouse_id"`
+	WarehouseCode string              `json:"warehouse_code"`
+	HorizonStart  string              `json:"horizon_start"`
+	HorizonEnd    string              `json:"horizon_end"`
+	Status        string              `json:"status"`
+	Progress      int                 `json:"progress"`
+	Message       string              `json:"message"`
+	Notes         string              `json:"notes"`
+	CreatedBy     uuid.UUID           `json:"created_by"`
+	StartedAt     string              `json:"started_at"`
+	FinishedAt    string              `json:"finished_at"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+	Demands       []MRPDemandResponse `json:"demands,omitempty"`
+}
+
+type MRPDemandResponse struct {
+	ID        uuid.UUID `json:"id"`
+	LineNo    int       `json:"line_no"`
+	ItemID    uuid.UUID `json:"item_id"`
+	ItemCode  string    `json:"item_code"`
+	ItemName  string    `json:"item_name"`
+	Quantity  float64   `json:"quantity"`
+	DueDate   string    `json:"due_date"`
+	Reference string    `json:"reference"`
+}
+
+type MRPItemResultResponse struct {
+	ItemID            uuid.UUID `json:"item_id"`
+	ItemCode          string    `json:"item_code"`
+	ItemName          string    `json:"item_name"`
+	UOM               string    `json:"uom"`
+	LowLevelCode      int       `json:"low_level_code"`
+	OnHand            float64   `json:"on_hand"`
+	ScheduledReceipts float64   `json:"scheduled_receipts"`
+	GrossRequirement  float64   `json:"gross_requirement"`
+	NetRequirement    float64   `json:"net_requirement"`
+	PlannedQuantity   float64   `json:"planned_quantity"`
+	EndingBalance     float64   `json:"ending_balance"`
+}
+
+type MRPSuggestionResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	ItemID         uuid.UUID  `json:"item_id"`
+	ItemCode       string     `json:"item_code"`
+	ItemName       string     `json:"item_name"`
+	UOM            string     `json:"uom"`
+	SuggestionType string     `json:"suggestion_type"`
+	NetRequirement float64    `json:"net_requirement"`
+	Quantity       float64    `json:"quantity"`
+	ReleaseDate    string     `json:"release_date"`
+	DueDate        string     `json:"due_date"`
+	IsPastDue      bool       `json:"is_past_due"`
+	Status         string     `json:"status"`
+	DocumentID     *uuid.UUID `json:"document_id"`
+	DocumentNumber string     `json:"document_number"`
+}
+
+// MRPConvertResponse berisi dokumen yang dibuat dari saran MRP
+type MRPConvertResponse struct {
+	PurchaseRequisitionID     *uuid.UUID `json:"purchase_requisition_id"`
+	PurchaseRequisitionNumber string     `json:"purchase_requisition_number"`
+	WorkOrderNumbers          []string   `json:"work_order_numbers"`
+	ConvertedCount            int        `json:"converted_count"`
+}
@@ -0,0 +1,19 @@
+package ppc
+
+import "github.com/google/uuid"
+
+type RoutingRequest struct {
+	ItemID      uuid.UUID                 `json:"item_id" validate:"required"`
+	Description string                    `json:"description" validate:"max=500"`
+	IsDefault   bool                      `json:"is_default"`
+	IsActive    bool                      `json:"is_active"`
+	Operations  []RoutingOperationRequest `json:"operations" validate:"required,min=1,dive"`
+}
+
+type RoutingOperationRequest struct {
+	Sequence          int       `json:"sequence" validate:"gt=0"`
+	WorkCenterID      uuid.UUID `json:"work_center_id" validate:"required"`
+	Description       string    `json:"description" validate:"required,max=200"`
+	SetupMinutes      float64   `json:"setup_minutes" validate:"gte=0"`
+	RunMinutesPerUnit float64   `json:"run_minutes_per_unit" validate:"gte=0"`
+}
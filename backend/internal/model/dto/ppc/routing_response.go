@@ -0,0 +1,27 @@
+package ppc
+
+import "github.com/google/uuid"
+
+type RoutingResponse struct {
+	ID          uuid.UUID                  `json:"id"`
+	ItemID      uuid.UUID                  `json:"item_id"`
+	ItemCode    string                     `json:"item_code"`
+	ItemName    string                     `json:"item_name"`
+	Version     int                        `json:"version"`
+	Description string                     `json:"description"`
+	IsDefault   bool                       `json:"is_default"`
+	IsActive    bool                       `json:"is_active"`
+	CreatedAt   string                     `json:"created_at"`
+	UpdatedAt   string                     `json:"updated_at"`
+	Operations  []RoutingOperationResponse `json:"operations,omitempty"`
+}
+
+type RoutingOperationResponse struct {
+	ID                uuid.UUID `json:"id"`
+	Sequence          int       `json:"sequence"`
+	WorkCenterID      uuid.UUID `json:"work_center_id"`
+	WorkCenterCode    string    `json:"work_center_code"`
+	Description       string    `json:"description"`
+	SetupMinutes      float64   `json:"setup_minutes"`
+	RunMinutesPerUnit float64   `json:"run_minutes_per_unit"`
+}
@@ -0,0 +1,21 @@
+package ppc
+
+import "github.com/shopspring/decimal"
+
+type WorkCenterCreateRequest struct {
+	Code       string          `json:"code" validate:"required,max=20"`
+	Name       string          `json:"name" validate:"required,min=2,max=100"`
+	HourlyRate decimal.Decimal `json:"hourly_rate" validate:"gte=0"`
+}
+
+type WorkCenterUpdateRequest struct {
+	Name       string          `json:"name" validate:"required,min=2,max=100"`
+	HourlyRate decimal.Decimal `json:"hourly_rate" validate:"gte=0"`
+	IsActive   bool            `json:"is_active"`
+}
+
+// PPCFilterRequest berisi filter opsional untuk daftar work center, BOM dan routing
+type PPCFilterRequest struct {
+	Search string `query:"search"`
+	ItemID string `query:"item_id"`
+}
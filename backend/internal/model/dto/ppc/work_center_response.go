@@ -0,0 +1,16 @@
+package ppc
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type WorkCenterResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	Code       string          `json:"code"`
+	Name       string          `json:"name"`
+	HourlyRate decimal.Decimal `json:"hourly_rate"`
+	IsActive   bool            `json:"is_active"`
+	CreatedAt  string          `json:"created_at"`
+	UpdatedAt  string          `json:"updated_at"`
+}
@@ -0,0 +1,51 @@
+package ppc
+
+import "github.com/google/uuid"
+
+// WorkOrderRequest dengan bill_of_material_id/routing_id kosong memakai BOM/routing default item
+type WorkOrderRequest struct {
+	ItemID           uuid.UUID `json:"item_id" validate:"required"`
+	BillOfMaterialID string    `json:"bill_of_material_id" validate:"omitempty,uuid"`
+	RoutingID        string    `json:"routing_id" validate:"omitempty,uuid"`
+	WarehouseID      uuid.UUID `json:"warehouse_id" validate:"required"`
+	PlannedQuantity  float64   `json:"planned_quantity" validate:"gt=0"`
+	PlannedStartDate string    `json:"planned_start_date" validate:"required,datetime=2006-01-02"`
+	PlannedEndDate   string    `json:"planned_end_date" validate:"required,datetime=2006-01-02"`
+	Notes            string    `json:"notes" validate:"max=1000"`
+}
+
+// WorkOrderIssueRequest mengeluarkan komponen dari gudang work order ke lantai produksi
+type WorkOrderIssueRequest struct {
+	IssueDate string                      `json:"issue_date" validate:"required,datetime=2006-01-02"`
+	Notes     string                      `json:"notes" validate:"max=500"`
+	Lines     []WorkOrderIssueLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type WorkOrderIssueLineRequest struct {
+	MaterialID uuid.UUID `json:"material_id" validate:"required"`
+	Quantity   float64   `json:"quantity" validate:"gt=0"`
+	BinID      string    `json:"bin_id" validate:"omitempty,uuid"`
+}
+
+// WorkOrderOutputRequest mencatat hasil produksi; good_quantity masuk ke gudang work order
+// sedangkan scrap_quantity hanya dicatat
+type WorkOrderOutputRequest struct {
+	OutputDate    string                          `json:"output_date" validate:"required,datetime=2006-01-02"`
+	GoodQuantity  float64                         `json:"good_quantity" validate:"gte=0"`
+	ScrapQuantity float64                         `json:"scrap_quantity" validate:"gte=0"`
+	BinID         string                          `json:"bin_id" validate:"omitempty,uuid"`
+	Notes         string                          `json:"notes" validate:"max=500"`
+	Operations    []WorkOrderOperationTimeRequest `json:"operations" validate:"omitempty,dive"`
+}
+
+type WorkOrderOperationTimeRequest struct {
+	OperationID   uuid.UUID `json:"operation_id" validate:"required"`
+	ActualMinutes float64   `json:"actual_minutes" validate:"gt=0"`
+}
+
+// WorkOrderFilterRequest berisi filter opsional untuk daftar work order
+type WorkOrderFilterRequest struct {
+	Status string `query:"status"`
+	ItemID string `query:"item_id"`
+	Search string `query:"search"`
+}
@@ -0,0 +1,112 @@
+package ppc
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type WorkOrderResponse struct {
+	ID                uuid.UUID                    `json:"id"`
+	Number            string                       `json:"number"`
+	ItemID            uuid.UUID                    `json:"item_id"`
+	ItemCode          string                       `json:"item_code"`
+	ItemName          string                       `json:"item_name"`
+	BillOfMaterialID  uuid.UUID                    `json:"bill_of_material_id"`
+	RoutingID         *uuid.UUID                   `json:"routing_id"`
+	WarehouseID       uuid.UUID                    `json:"warehouse_id"`
+	WarehouseCode     string                       `json:"warehouse_code"`
+	PlannedQuantity   float64                      `json:"planned_quantity"`
+	CompletedQuantity float64                      `json:"completed_quantity"`
+	ScrapQuantity     float64                      `json:"scrap_quantity"`
+	PlannedStartDate  string                       `json:"planned_start_date"`
+	PlannedEndDate    string                       `json:"planned_end_date"`
+	Status            string                       `json:"status"`
+	Notes             string                       `json:"notes"`
+	CreatedBy         uuid.UUID                    `json:"created_by"`
+	ReleasedAt        string                       `json:"released_at"`
+	CompletedAt       string                       `json:"completed_at"`
+	CreatedAt         string                       `json:"created_at"`
+	UpdatedAt         string                       `json:"updated_at"`
+	Materials         []WorkOrderMaterialResponse  `json:"materials,omitempty"`
+	Operations        []WorkOrderOperationResponse `json:"operations,omitempty"`
+	Outputs           []WorkOrderOutputResponse    `json:"outputs,omitempty"`
+}
+
+type WorkOrderMaterialResponse struct {
+	ID               uuid.UUID       `json:"id"`
+	LineNo           int             `json:"line_no"`
+	ItemID           uuid.UUID       `json:"item_id"`
+	ItemCode         string          `json:"item_code"`
+	ItemName         string          `json:"item_name"`
+	UOM              string          `json:"uom"`
+	RequiredQuantity float64         `json:"required_quantity"`
+	ReservedQuantity float64         `json:"reserved_quantity"`
+	IssuedQuantity   float64         `json:"issued_quantity"`
+	StandardUnitCost decimal.Decimal `json:"standard_unit_cost"`
+}
+
+type WorkOrderOperationResponse struct {
+	ID                uuid.UUID       `json:"id"`
+	Sequence          int             `json:"sequence"`
+	WorkCenterID      uuid.UUID       `json:"work_center_id"`
+	WorkCenterCode    string          `json:"work_center_code"`
+	Description       string          `json:"description"`
+	SetupMinutes      float64         `json:"setup_minutes"`
+	RunMinutesPerUnit float64         `json:"run_minutes_per_unit"`
+	HourlyRate        decimal.Decimal `json:"hourly_rate"`
+	ActualMinutes     float64         `json:"actual_minutes"`
+}
+
+type WorkOrderOutputResponse struct {
+	ID             uuid.UUID `json:"id"`
+	OutputDate     string    `json:"output_date"`
+	GoodQuantity   float64   `json:"good_quantity"`
+	ScrapQuantity  float64   `json:"scrap_quantity"`
+	MovementNumber string    `json:"movement_number"`
+	Notes          string    `json:"notes"`
+	CreatedBy      uuid.UUID `json:"created_by"`
+	CreatedAt      string    `json:"created_at"`
+}
+
+// WorkOrderCostResponse membandingkan biaya aktual dengan biaya standar. Biaya standar
+// dihitung untuk planned quantity; varians dihitung terhadap biaya standar hasil produksi
+// yang baik (completed quantity).
+type WorkOrderCostResponse struct {
+	WorkOrderID          uuid.UUID                `json:"work_order_id"`
+	Number               string                   `json:"number"`
+	Status               string                   `json:"status"`
+	PlannedQuantity      float64                  `json:"planned_quantity"`
+	CompletedQuantity    float64                  `json:"completed_quantity"`
+	ScrapQuantity        float64                  `json:"scrap_quantity"`
+	StandardMaterialCost decimal.Decimal          `json:"standard_material_cost"`
+	StandardLaborCost    decimal.Decimal          `json:"standard_labor_cost"`
+	StandardTotalCost    decimal.Decimal          `json:"standard_total_cost"`
+	StandardUnitCost     decimal.Decimal          `json:"standard_unit_cost"`
+	ActualMaterialCost   decimal.Decimal          `json:"actual_material_cost"`
+	ActualLaborCost      decimal.Decimal          `json:"actual_labor_cost"`
+	ActualTotalCost      decimal.Decimal          `json:"actual_total_cost"`
+	ActualUnitCost       decimal.Decimal          `json:"actual_unit_cost"`
+	EarnedStandardCost   decimal.Decimal          `json:"earned_standard_cost"`
+	Variance             decimal.Decimal          `json:"variance"`
+	Materials            []WorkOrderMaterialCost  `json:"materials"`
+	Operations           []WorkOrderOperationCost `json:"operations"`
+}
+
+type WorkOrderMaterialCost struct {
+	ItemID           uuid.UUID       `json:"item_id"`
+	ItemCode         string          `json:"item_code"`
+	StandardQuantity float64         `json:"standard_quantity"`
+	ActualQuantity   float64         `json:"actual_quantity"`
+	StandardUnitCost decimal.Decimal `json:"standard_unit_cost"`
+	StandardCost     decimal.Decimal `json:"standard_cost"`
+	ActualCost       decimal.Decimal `json:"actual_cost"`
+}
+
+type WorkOrderOperationCost struct {
+	Sequence        int             `json:"sequence"`
+	WorkCenterCode  string          `json:"work_center_code"`
+	StandardMinutes float64         `json:"standard_minutes"`
+	ActualMinutes   float64         `json:"actual_minutes"`
+	StandardCost    decimal.Decimal `json:"standard_cost"`
+	ActualCost      decimal.Decimal `json:"actual_cost"`
+}
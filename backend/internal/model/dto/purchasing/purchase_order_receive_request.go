@@ -0,0 +1,17 @@
+package purchasing
+
+import "github.com/google/uuid"
+
+// PurchaseOrderReceiveRequest dipertahankan untuk kompatibilitas endpoint receive lama; penerimaan
+// dicatat sebagai goods receipt sehingga gudang tujuan wajib diisi. receipt_date default hari ini.
+type PurchaseOrderReceiveRequest struct {
+	WarehouseID  uuid.UUID                  `json:"warehouse_id" validate:"required"`
+	ReceiptDate  string                     `json:"receipt_date" validate:"omitempty,datetime=2006-01-02"`
+	DeliveryNote string                     `json:"delivery_note" validate:"max=100"`
+	Lines        []PurchaseOrderReceiveLine `json:"lines" validate:"required,min=1,dive"`
+}
+
+type PurchaseOrderReceiveLine struct {
+	LineID   uuid.UUID `json:"line_id" validate:"required"`
+	Quantity float64   `json:"quantity" validate:"gt=0"`
+}
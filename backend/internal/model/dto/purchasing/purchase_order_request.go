@@ -0,0 +1,28 @@
+package purchasing
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PurchaseOrderRequest dipakai untuk membuat maupun mengubah purchase order berstatus Draft.
+// Currency dan payment_term_days yang kosong akan memakai default dari master supplier.
+type PurchaseOrderRequest struct {
+	SupplierID      uuid.UUID                  `json:"supplier_id" validate:"required"`
+	Currency        string                     `json:"currency" validate:"omitempty,len=3"`
+	PaymentTermDays *int                       `json:"payment_term_days" validate:"omitempty,gte=0,lte=365"`
+	OrderDate       string                     `json:"order_date" validate:"required,datetime=2006-01-02"`
+	ExpectedDate    string                     `json:"expected_date" validate:"required,datetime=2006-01-02"`
+	Notes           string                     `json:"notes" validate:"max=1000"`
+	Lines           []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+// PurchaseOrderLineRequest dengan item_id akan dicatat sebagai stok masuk saat goods receipt
+type PurchaseOrderLineRequest struct {
+	ItemID       string          `json:"item_id" validate:"omitempty,uuid"`
+	Description  string          `json:"description" validate:"required,max=500"`
+	Quantity     float64         `json:"quantity" validate:"gt=0"`
+	UOM          string          `json:"uom" validate:"required,max=20"`
+	UnitPrice    decimal.Decimal `json:"unit_price" validate:"gte=0"`
+	ExpectedDate string          `json:"expected_date" validate:"omitempty,datetime=2006-01-02"`
+}
@@ -0,0 +1,45 @@
+package purchasing
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type PurchaseOrderResponse struct {
+	ID              uuid.UUID                   `json:"id"`
+	Number          string                      `json:"number"`
+	RequisitionID   *uuid.UUID                  `json:"requisition_id"`
+	SupplierID      uuid.UUID                   `json:"supplier_id"`
+	SupplierName    string                      `json:"supplier_name"`
+	Currency        string                      `json:"currency"`
+	PaymentTermDays int                         `json:"payment_term_days"`
+	OrderDate       string                      `json:"order_date"`
+	ExpectedDate    string                      `json:"expected_date"`
+	Notes           string                      `json:"notes"`
+	Status          domain.PurchaseOrderStatus  `json:"status"`
+	TotalAmount     decimal.Decimal             `json:"total_amount"`
+	CreatedBy       uuid.UUID                   `json:"created_by"`
+	ApprovedBy      *uuid.UUID                  `json:"approved_by"`
+	ApprovedAt      string                      `json:"approved_at,omitempty"`
+	SentAt          string                      `json:"sent_at,omitempty"`
+	ClosedAt        string                      `json:"closed_at,omitempty"`
+	CreatedAt       string                      `json:"created_at"`
+	UpdatedAt       string                      `json:"updated_at"`
+	Lines           []PurchaseOrderLineResponse `json:"lines,omitempty"`
+}
+
+type PurchaseOrderLineResponse struct {
+	ID                  uuid.UUID       `json:"id"`
+	LineNo              int             `json:"line_no"`
+	ItemID              *uuid.UUID      `json:"item_id"`
+	Description         string          `json:"description"`
+	Quantity            float64         `json:"quantity"`
+	UOM                 string          `json:"uom"`
+	UnitPrice           decimal.Decimal `json:"unit_price"`
+	LineTotal           decimal.Decimal `json:"line_total"`
+	ReceivedQuantity    float64         `json:"received_quantity"`
+	OutstandingQuantity float64         `json:"outstanding_quantity"`
+	ExpectedDate        string          `json:"expected_date,omitempty"`
+}
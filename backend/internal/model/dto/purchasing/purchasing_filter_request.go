@@ -0,0 +1,7 @@
+package purchasing
+
+// PurchasingFilterRequest berisi filter opsional untuk daftar requisition dan purchase order
+type PurchasingFilterRequest struct {
+	Status string `query:"status"`
+	Search string `query:"search"`
+}
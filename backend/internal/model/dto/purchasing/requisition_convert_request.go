@@ -0,0 +1,23 @@
+package purchasing
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RequisitionConvertRequest berisi data supplier dan harga untuk membuat purchase order dari requisition.
+// Harga baris yang tidak disebutkan akan memakai estimated_unit_price dari requisition,
+// sedangkan currency dan payment_term_days yang kosong memakai default dari master supplier.
+type RequisitionConvertRequest struct {
+	SupplierID      uuid.UUID                     `json:"supplier_id" validate:"required"`
+	Currency        string                        `json:"currency" validate:"omitempty,len=3"`
+	PaymentTermDays *int                          `json:"payment_term_days" validate:"omitempty,gte=0,lte=365"`
+	OrderDate       string                        `json:"order_date" validate:"required,datetime=2006-01-02"`
+	ExpectedDate    string                        `json:"expected_date" validate:"required,datetime=2006-01-02"`
+	Lines           []RequisitionConvertLinePrice `json:"lines" validate:"dive"`
+}
+
+type RequisitionConvertLinePrice struct {
+	RequisitionLineID uuid.UUID       `json:"requisition_line_id" validate:"required"`
+	UnitPrice         decimal.Decimal `json:"unit_price" validate:"gte=0"`
+}
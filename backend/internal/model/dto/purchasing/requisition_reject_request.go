@@ -0,0 +1,5 @@
+package purchasing
+
+type RequisitionRejectRequest struct {
+	Reason string `json:"reason" validate:"required,min=5,max=500"`
+}
@@ -0,0 +1,19 @@
+package purchasing
+
+import "github.com/shopspring/decimal"
+
+// RequisitionRequest dipakai untuk membuat maupun mengubah purchase requisition berstatus Draft
+type RequisitionRequest struct {
+	RequestDate  string                   `json:"request_date" validate:"required,datetime=2006-01-02"`
+	RequiredDate string                   `json:"required_date" validate:"required,datetime=2006-01-02"`
+	Notes        string                   `json:"notes" validate:"max=1000"`
+	Lines        []RequisitionLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type RequisitionLineRequest struct {
+	ItemID             string          `json:"item_id" validate:"omitempty,uuid"`
+	Description        string          `json:"description" validate:"required,max=500"`
+	Quantity           float64         `json:"quantity" validate:"gt=0"`
+	UOM                string          `json:"uom" validate:"required,max=20"`
+	EstimatedUnitPrice decimal.Decimal `json:"estimated_unit_price" validate:"gte=0"`
+}
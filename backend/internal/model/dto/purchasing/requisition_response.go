@@ -0,0 +1,36 @@
+package purchasing
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type RequisitionResponse struct {
+	ID              uuid.UUID                 `json:"id"`
+	Number          string                    `json:"number"`
+	RequestDate     string                    `json:"request_date"`
+	RequiredDate    string                    `json:"required_date"`
+	Notes           string                    `json:"notes"`
+	Status          domain.RequisitionStatus  `json:"status"`
+	RequestedBy     uuid.UUID                 `json:"requested_by"`
+	ApprovedBy      *uuid.UUID                `json:"approved_by"`
+	ApprovedAt      string                    `json:"approved_at,omitempty"`
+	RejectReason    string                    `json:"reject_reason,omitempty"`
+	PurchaseOrderID *uuid.UUID                `json:"purchase_order_id"`
+	EstimatedTotal  decimal.Decimal           `json:"estimated_total"`
+	CreatedAt       string                    `json:"created_at"`
+	UpdatedAt       string                    `json:"updated_at"`
+	Lines           []RequisitionLineResponse `json:"lines,omitempty"`
+}
+
+type RequisitionLineResponse struct {
+	ID                 uuid.UUID       `json:"id"`
+	LineNo             int             `json:"line_no"`
+	ItemID             *uuid.UUID      `json:"item_id"`
+	Description        string          `json:"description"`
+	Quantity           float64         `json:"quantity"`
+	UOM                string          `json:"uom"`
+	EstimatedUnitPrice decimal.Decimal `json:"estimated_unit_price"`
+}
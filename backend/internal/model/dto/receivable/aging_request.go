@@ -0,0 +1,8 @@
+package receivable
+
+// AgingFilterRequest dengan as_of kosong memakai tanggal hari ini
+type AgingFilterRequest struct {
+	AsOf       string `query:"as_of"`
+	CustomerID string `query:"customer_id"`
+	Currency   string `query:"currency"`
+}
@@ -0,0 +1,51 @@
+package receivable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AgingBuckets mengelompokkan sisa piutang berdasarkan jumlah hari lewat jatuh tempo
+type AgingBuckets struct {
+	Current    decimal.Decimal `json:"current"`
+	Days1To30  decimal.Decimal `json:"days_1_30"`
+	Days31To60 decimal.Decimal `json:"days_31_60"`
+	Days61To90 decimal.Decimal `json:"days_61_90"`
+	Over90     decimal.Decimal `json:"over_90"`
+	Total      decimal.Decimal `json:"total"`
+}
+
+type AgingReportResponse struct {
+	AsOf      string                  `json:"as_of"`
+	Customers []AgingCustomerResponse `json:"customers"`
+	Totals    []AgingTotalResponse    `json:"totals"`
+}
+
+// AgingCustomerResponse memisahkan kredit yang belum dialokasikan dari bucket invoice;
+// NetBalance adalah total bucket dikurangi kredit tersebut
+type AgingCustomerResponse struct {
+	CustomerID      uuid.UUID              `json:"customer_id"`
+	CustomerName    string                 `json:"customer_name"`
+	Currency        string                 `json:"currency"`
+	Buckets         AgingBuckets           `json:"buckets"`
+	UnappliedCredit decimal.Decimal        `json:"unapplied_credit"`
+	NetBalance      decimal.Decimal        `json:"net_balance"`
+	Invoices        []AgingInvoiceResponse `json:"invoices"`
+}
+
+type AgingInvoiceResponse struct {
+	InvoiceID         uuid.UUID       `json:"invoice_id"`
+	Number            string          `json:"number"`
+	InvoiceDate       string          `json:"invoice_date"`
+	DueDate           string          `json:"due_date"`
+	DaysOverdue       int             `json:"days_overdue"`
+	OutstandingAmount decimal.Decimal `json:"outstanding_amount"`
+}
+
+// AgingTotalResponse adalah total per mata uang karena nominal beda mata uang tidak dijumlahkan
+type AgingTotalResponse struct {
+	Currency        string          `json:"currency"`
+	Buckets         AgingBuckets    `json:"buckets"`
+	UnappliedCredit decimal.Decimal `json:"unapplied_credit"`
+	NetBalance      decimal.Decimal `json:"net_balance"`
+}
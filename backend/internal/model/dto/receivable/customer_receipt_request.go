@@ -0,0 +1,39 @@
+package receivable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerReceiptRequest membuat penerimaan Draft. Total allocations boleh lebih kecil dari
+// amount; sisanya menjadi kredit customer setelah penerimaan diposting.
+type CustomerReceiptRequest struct {
+	CustomerID       uuid.UUID                  `json:"customer_id" validate:"required"`
+	ReceiptDate      string                     `json:"receipt_date" validate:"required,datetime=2006-01-02"`
+	Amount           decimal.Decimal            `json:"amount" validate:"gt=0"`
+	DepositAccountID uuid.UUID                  `json:"deposit_account_id" validate:"required"`
+	Reference        string                     `json:"reference" validate:"max=50"`
+	Notes            string                     `json:"notes" validate:"max=1000"`
+	Allocations      []ReceiptAllocationRequest `json:"allocations" validate:"omitempty,dive"`
+}
+
+type ReceiptAllocationRequest struct {
+	SalesInvoiceID uuid.UUID       `json:"sales_invoice_id" validate:"required"`
+	Amount         decimal.Decimal `json:"amount" validate:"gt=0"`
+}
+
+// ReceiptAllocateRequest mengalokasikan kredit penerimaan yang sudah diposting ke invoice lain.
+// allocation_date kosong memakai tanggal hari ini.
+type ReceiptAllocateRequest struct {
+	AllocationDate string                     `json:"allocation_date" validate:"omitempty,datetime=2006-01-02"`
+	Allocations    []ReceiptAllocationRequest `json:"allocations" validate:"required,min=1,dive"`
+}
+
+// CustomerReceiptFilterRequest berisi filter opsional untuk daftar penerimaan customer.
+// unapplied=true hanya menampilkan penerimaan Posted yang masih punya kredit.
+type CustomerReceiptFilterRequest struct {
+	Status     string `query:"status"`
+	CustomerID string `query:"customer_id"`
+	Unapplied  bool   `query:"unapplied"`
+	Search     string `query:"search"`
+}
@@ -0,0 +1,41 @@
+package receivable
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CustomerReceiptResponse struct {
+	ID               uuid.UUID                    `json:"id"`
+	Number           string                       `json:"number"`
+	CustomerID       uuid.UUID                    `json:"customer_id"`
+	CustomerName     string                       `json:"customer_name"`
+	ReceiptDate      string                       `json:"receipt_date"`
+	Currency         string                       `json:"currency"`
+	ExchangeRate     float64                      `json:"exchange_rate"`
+	Amount           decimal.Decimal              `json:"amount"`
+	AllocatedAmount  decimal.Decimal              `json:"allocated_amount"`
+	UnappliedAmount  decimal.Decimal              `json:"unapplied_amount"`
+	DepositAccountID uuid.UUID                    `json:"deposit_account_id"`
+	Reference        string                       `json:"reference"`
+	Notes            string                       `json:"notes"`
+	Status           domain.CustomerReceiptStatus `json:"status"`
+	JournalEntryID   *uuid.UUID                   `json:"journal_entry_id"`
+	CreatedBy        uuid.UUID                    `json:"created_by"`
+	PostedBy         *uuid.UUID                   `json:"posted_by"`
+	PostedAt         string                       `json:"posted_at,omitempty"`
+	CreatedAt        string                       `json:"created_at"`
+	UpdatedAt        string                       `json:"updated_at"`
+	Allocations      []ReceiptAllocationResponse  `json:"allocations,omitempty"`
+}
+
+type ReceiptAllocationResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	SalesInvoiceID uuid.UUID       `json:"sales_invoice_id"`
+	InvoiceNumber  string          `json:"invoice_number"`
+	AllocationDate string          `json:"allocation_date"`
+	Amount         decimal.Decimal `json:"amount"`
+	CreatedBy      uuid.UUID       `json:"created_by"`
+}
@@ -0,0 +1,31 @@
+package receivable
+
+import "github.com/shopspring/decimal"
+
+// CustomerRequest dipakai untuk membuat maupun mengubah customer
+type CustomerRequest struct {
+	Code            string          `json:"code" validate:"required,max=20"`
+	Name            string          `json:"name" validate:"required,min=2,max=150"`
+	NPWP            string          `json:"npwp" validate:"max=25"`
+	Email           string          `json:"email" validate:"omitempty,email,max=100"`
+	Phone           string          `json:"phone" validate:"max=30"`
+	BillingAddress  string          `json:"billing_address" validate:"max=500"`
+	PaymentTermDays int             `json:"payment_term_days" validate:"gte=0,lte=365"`
+	Currency        string          `json:"currency" validate:"required,len=3"`
+	CreditLimit     decimal.Decimal `json:"credit_limit" validate:"gte=0"`
+	IsActive        *bool           `json:"is_active"`
+	Notes           string          `json:"notes" validate:"max=1000"`
+}
+
+// CustomerFilterRequest berisi filter opsional untuk daftar customer
+type CustomerFilterRequest struct {
+	Search   string `query:"search"`
+	IsActive string `query:"is_active"`
+}
+
+// CustomerStatementRequest dengan date_from kosong memakai awal bulan berjalan dan date_to
+// kosong memakai tanggal hari ini
+type CustomerStatementRequest struct {
+	DateFrom string `query:"date_from"`
+	DateTo   string `query:"date_to"`
+}
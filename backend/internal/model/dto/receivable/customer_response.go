@@ -0,0 +1,54 @@
+package receivable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CustomerResponse struct {
+	ID              uuid.UUID       `json:"id"`
+	Code            string          `json:"code"`
+	Name            string          `json:"name"`
+	NPWP            string          `json:"npwp"`
+	Email           string          `json:"email"`
+	Phone           string          `json:"phone"`
+	BillingAddress  string          `json:"billing_address"`
+	PaymentTermDays int             `json:"payment_term_days"`
+	Currency        string          `json:"currency"`
+	CreditLimit     decimal.Decimal `json:"credit_limit"`
+	IsActive        bool            `json:"is_active"`
+	Notes           string          `json:"notes"`
+	CreatedAt       string          `json:"created_at"`
+	UpdatedAt       string          `json:"updated_at"`
+}
+
+// CustomerStatementResponse adalah rekening koran customer: saldo awal, mutasi invoice dan
+// penerimaan secara kronologis dengan saldo berjalan, lalu saldo akhir. Saldo positif berarti
+// customer masih berhutang, saldo negatif berarti ada kelebihan bayar.
+type CustomerStatementResponse struct {
+	CustomerID      uuid.UUID               `json:"customer_id"`
+	CustomerCode    string                  `json:"customer_code"`
+	CustomerName    string                  `json:"customer_name"`
+	Currency        string                  `json:"currency"`
+	DateFrom        string                  `json:"date_from"`
+	DateTo          string                  `json:"date_to"`
+	OpeningBalance  decimal.Decimal         `json:"opening_balance"`
+	TotalInvoiced   decimal.Decimal         `json:"total_invoiced"`
+	TotalReceived   decimal.Decimal         `json:"total_received"`
+	ClosingBalance  decimal.Decimal         `json:"closing_balance"`
+	UnappliedCredit decimal.Decimal         `json:"unapplied_credit"`
+	Lines           []StatementLineResponse `json:"lines"`
+	OpenInvoices    []AgingInvoiceResponse  `json:"open_invoices"`
+}
+
+type StatementLineResponse struct {
+	Date         string          `json:"date"`
+	DocumentType string          `json:"document_type"`
+	DocumentID   uuid.UUID       `json:"document_id"`
+	Number       string          `json:"number"`
+	Reference    string          `json:"reference"`
+	DueDate      string          `json:"due_date,omitempty"`
+	Debit        decimal.Decimal `json:"debit"`
+	Credit       decimal.Decimal `json:"credit"`
+	Balance      decimal.Decimal `json:"balance"`
+}
@@ -0,0 +1,8 @@
+package receivable
+
+import "github.com/google/uuid"
+
+type ReceivableSettingRequest struct {
+	ReceivableAccountID uuid.UUID `json:"receivable_account_id" validate:"required"`
+	RevenueAccountID    uuid.UUID `json:"revenue_account_id" validate:"required"`
+}
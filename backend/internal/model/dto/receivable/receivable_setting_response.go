@@ -0,0 +1,10 @@
+package receivable
+
+import "github.com/google/uuid"
+
+type ReceivableSettingResponse struct {
+	ReceivableAccountID *uuid.UUID `json:"receivable_account_id"`
+	RevenueAccountID    *uuid.UUID `json:"revenue_account_id"`
+	UpdatedBy           *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt           string     `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,47 @@
+package receivable
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SalesInvoiceRequest dipakai untuk membuat maupun mengubah sales invoice berstatus Draft.
+// Mata uang mengikuti customer; due_date yang kosong dihitung dari invoice_date ditambah
+// termin pembayaran customer.
+type SalesInvoiceRequest struct {
+	CustomerID  uuid.UUID                 `json:"customer_id" validate:"required"`
+	InvoiceDate string                    `json:"invoice_date" validate:"required,datetime=2006-01-02"`
+	DueDate     string                    `json:"due_date" validate:"omitempty,datetime=2006-01-02"`
+	Reference   string                    `json:"reference" validate:"max=50"`
+	Notes       string                    `json:"notes" validate:"max=1000"`
+	Lines       []SalesInvoiceLineRequest `json:"lines" validate:"required,min=1,dive"`
+	Taxes       []SalesInvoiceTaxRequest  `json:"taxes" validate:"omitempty,dive"`
+}
+
+type SalesInvoiceLineRequest struct {
+	ItemID           string          `json:"item_id" validate:"omitempty,uuid"`
+	Description      string          `json:"description" validate:"required,max=500"`
+	Quantity         float64         `json:"quantity" validate:"gt=0"`
+	UOM              string          `json:"uom" validate:"required,max=20"`
+	UnitPrice        decimal.Decimal `json:"unit_price" validate:"gte=0"`
+	RevenueAccountID string          `json:"revenue_account_id" validate:"omitempty,uuid"`
+}
+
+// SalesInvoiceTaxRequest dengan base_amount kosong memakai subtotal invoice sebagai DPP. Bila tax_code
+// terdaftar di master kode pajak, tarif, akun dan sifat potong diambil dari master; selain itu
+// account_id dan rate wajib diisi.
+type SalesInvoiceTaxRequest struct {
+	TaxCode       string           `json:"tax_code" validate:"required,max=20"`
+	Description   string           `json:"description" validate:"max=200"`
+	AccountID     *uuid.UUID       `json:"account_id"`
+	Rate          float64          `json:"rate" validate:"gte=0,lte=100"`
+	BaseAmount    *decimal.Decimal `json:"base_amount" validate:"omitempty,gte=0"`
+	IsWithholding bool             `json:"is_withholding"`
+}
+
+// SalesInvoiceFilterRequest berisi filter opsional untuk daftar sales invoice
+type SalesInvoiceFilterRequest struct {
+	Status     string `query:"status"`
+	CustomerID string `query:"customer_id"`
+	Search     string `query:"search"`
+}
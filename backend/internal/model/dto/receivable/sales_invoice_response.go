@@ -0,0 +1,61 @@
+package receivable
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SalesInvoiceResponse struct {
+	ID                uuid.UUID                  `json:"id"`
+	Number            string                     `json:"number"`
+	CustomerID        uuid.UUID                  `json:"customer_id"`
+	CustomerName      string                     `json:"customer_name"`
+	InvoiceDate       string                     `json:"invoice_date"`
+	DueDate           string                     `json:"due_date"`
+	Currency          string                     `json:"currency"`
+	ExchangeRate      float64                    `json:"exchange_rate"`
+	Reference         string                     `json:"reference"`
+	TaxInvoiceNo      string                     `json:"tax_invoice_no"`
+	Notes             string                     `json:"notes"`
+	Status            domain.SalesInvoiceStatus  `json:"status"`
+	SubtotalAmount    decimal.Decimal            `json:"subtotal_amount"`
+	TaxAmount         decimal.Decimal            `json:"tax_amount"`
+	TotalAmount       decimal.Decimal            `json:"total_amount"`
+	PaidAmount        decimal.Decimal            `json:"paid_amount"`
+	OutstandingAmount decimal.Decimal            `json:"outstanding_amount"`
+	JournalEntryID    *uuid.UUID                 `json:"journal_entry_id"`
+	CreatedBy         uuid.UUID                  `json:"created_by"`
+	PostedBy          *uuid.UUID                 `json:"posted_by"`
+	PostedAt          string                     `json:"posted_at,omitempty"`
+	CreatedAt         string                     `json:"created_at"`
+	UpdatedAt         string                     `json:"updated_at"`
+	Lines             []SalesInvoiceLineResponse `json:"lines,omitempty"`
+	Taxes             []SalesInvoiceTaxResponse  `json:"taxes,omitempty"`
+}
+
+type SalesInvoiceLineResponse struct {
+	ID               uuid.UUID       `json:"id"`
+	LineNo           int             `json:"line_no"`
+	ItemID           *uuid.UUID      `json:"item_id"`
+	Description      string          `json:"description"`
+	Quantity         float64         `json:"quantity"`
+	UOM              string          `json:"uom"`
+	UnitPrice        decimal.Decimal `json:"unit_price"`
+	LineTotal        decimal.Decimal `json:"line_total"`
+	RevenueAccountID *uuid.UUID      `json:"revenue_account_id"`
+}
+
+type SalesInvoiceTaxResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	LineNo        int             `json:"line_no"`
+	TaxCode       string          `json:"tax_code"`
+	TaxType       domain.TaxType  `json:"tax_type"`
+	Description   string          `json:"description"`
+	AccountID     uuid.UUID       `json:"account_id"`
+	BaseAmount    decimal.Decimal `json:"base_amount"`
+	Rate          float64         `json:"rate"`
+	Amount        decimal.Decimal `json:"amount"`
+	IsWithholding bool            `json:"is_withholding"`
+}
@@ -0,0 +1,7 @@
+package receiving
+
+// GoodsReceiptFilterRequest berisi filter opsional untuk daftar goods receipt
+type GoodsReceiptFilterRequest struct {
+	PurchaseOrderID string `query:"purchase_order_id"`
+	Search          string `query:"search"`
+}
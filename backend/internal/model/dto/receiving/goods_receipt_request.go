@@ -0,0 +1,20 @@
+package receiving
+
+import "github.com/google/uuid"
+
+// GoodsReceiptRequest mencatat penerimaan barang atas purchase order berstatus Sent atau PartiallyReceived.
+// Baris purchase order yang tidak dicantumkan dianggap belum diterima.
+type GoodsReceiptRequest struct {
+	PurchaseOrderID uuid.UUID                 `json:"purchase_order_id" validate:"required"`
+	WarehouseID     uuid.UUID                 `json:"warehouse_id" validate:"required"`
+	ReceiptDate     string                    `json:"receipt_date" validate:"required,datetime=2006-01-02"`
+	DeliveryNote    string                    `json:"delivery_note" validate:"max=100"`
+	Notes           string                    `json:"notes" validate:"max=1000"`
+	Lines           []GoodsReceiptLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type GoodsReceiptLineRequest struct {
+	PurchaseOrderLineID uuid.UUID `json:"purchase_order_line_id" validate:"required"`
+	Quantity            float64   `json:"quantity" validate:"gt=0"`
+	BinID               string    `json:"bin_id" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,34 @@
+package receiving
+
+import "github.com/google/uuid"
+
+type GoodsReceiptResponse struct {
+	ID                  uuid.UUID                  `json:"id"`
+	Number              string                     `json:"number"`
+	PurchaseOrderID     uuid.UUID                  `json:"purchase_order_id"`
+	PurchaseOrderNumber string                     `json:"purchase_order_number,omitempty"`
+	SupplierID          uuid.UUID                  `json:"supplier_id"`
+	SupplierName        string                     `json:"supplier_name,omitempty"`
+	WarehouseID         uuid.UUID                  `json:"warehouse_id"`
+	WarehouseCode       string                     `json:"warehouse_code,omitempty"`
+	ReceiptDate         string                     `json:"receipt_date"`
+	DeliveryNote        string                     `json:"delivery_note"`
+	Notes               string                     `json:"notes"`
+	ReceivedBy          uuid.UUID                  `json:"received_by"`
+	CreatedAt           string                     `json:"created_at"`
+	Lines               []GoodsReceiptLineResponse `json:"lines,omitempty"`
+}
+
+type GoodsReceiptLineResponse struct {
+	ID                  uuid.UUID  `json:"id"`
+	PurchaseOrderLineID uuid.UUID  `json:"purchase_order_line_id"`
+	LineNo              int        `json:"line_no"`
+	ItemID              *uuid.UUID `json:"item_id"`
+	BinID               *uuid.UUID `json:"bin_id"`
+	Description         string     `json:"description"`
+	UOM                 string     `json:"uom"`
+	OrderedQuantity     float64    `json:"ordered_quantity"`
+	PreviouslyReceived  float64    `json:"previously_received"`
+	ReceivedQuantity    float64    `json:"received_quantity"`
+	OutstandingQuantity float64    `json:"outstanding_quantity"`
+}
@@ -0,0 +1,22 @@
+package report
+
+// FinancialReportRequest dipakai neraca saldo, laba rugi dan arus kas. Periode pembanding
+// (compare_date_from/compare_date_to) opsional dan ditampilkan sebagai kolom kedua. max_level
+// membatasi kedalaman hirarki akun yang ditampilkan (0 berarti semua level); saldo sub-akun
+// yang disembunyikan tetap terakumulasi di akun induknya.
+type FinancialReportRequest struct {
+	DateFrom        string `query:"date_from" validate:"required,datetime=2006-01-02"`
+	DateTo          string `query:"date_to" validate:"required,datetime=2006-01-02"`
+	CompareDateFrom string `query:"compare_date_from" validate:"required_with=CompareDateTo,omitempty,datetime=2006-01-02"`
+	CompareDateTo   string `query:"compare_date_to" validate:"required_with=CompareDateFrom,omitempty,datetime=2006-01-02"`
+	MaxLevel        int    `query:"max_level" validate:"gte=0,lte=10"`
+	IncludeZero     bool   `query:"include_zero"`
+}
+
+// BalanceSheetRequest: neraca disusun per tanggal, compare_as_of opsional sebagai kolom pembanding
+type BalanceSheetRequest struct {
+	AsOf        string `query:"as_of" validate:"required,datetime=2006-01-02"`
+	CompareAsOf string `query:"compare_as_of" validate:"omitempty,datetime=2006-01-02"`
+	MaxLevel    int    `query:"max_level" validate:"gte=0,lte=10"`
+	IncludeZero bool   `query:"include_zero"`
+}
@@ -0,0 +1,93 @@
+package report
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReportColumnResponse menjelaskan satu kolom nilai; urutannya sama dengan urutan Amounts
+type ReportColumnResponse struct {
+	Label    string `json:"label"`
+	DateFrom string `json:"date_from,omitempty"`
+	DateTo   string `json:"date_to"`
+}
+
+// StatementLineResponse adalah satu baris laporan. AccountID kosong untuk baris hasil
+// perhitungan (misal laba bersih). Nilai akun header adalah jumlah seluruh sub-akunnya.
+type StatementLineResponse struct {
+	AccountID *uuid.UUID        `json:"account_id"`
+	Code      string            `json:"code"`
+	Name      string            `json:"name"`
+	Level     int               `json:"level"`
+	IsHeader  bool              `json:"is_header"`
+	Amounts   []decimal.Decimal `json:"amounts"`
+}
+
+type StatementSectionResponse struct {
+	Name   string                  `json:"name"`
+	Lines  []StatementLineResponse `json:"lines"`
+	Totals []decimal.Decimal       `json:"totals"`
+}
+
+type TrialBalanceAmountResponse struct {
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	Debit          decimal.Decimal `json:"debit"`
+	Credit         decimal.Decimal `json:"credit"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+}
+
+// TrialBalanceLineResponse: saldo bertanda debit (positif debit, negatif kredit)
+type TrialBalanceLineResponse struct {
+	AccountID uuid.UUID                    `json:"account_id"`
+	Code      string                       `json:"code"`
+	Name      string                       `json:"name"`
+	Type      domain.AccountType           `json:"type"`
+	Level     int                          `json:"level"`
+	IsHeader  bool                         `json:"is_header"`
+	Amounts   []TrialBalanceAmountResponse `json:"amounts"`
+}
+
+// TrialBalanceTotalResponse hanya menjumlahkan akun postable agar saldo induk tidak terhitung dua kali
+type TrialBalanceTotalResponse struct {
+	Debit         decimal.Decimal `json:"debit"`
+	Credit        decimal.Decimal `json:"credit"`
+	ClosingDebit  decimal.Decimal `json:"closing_debit"`
+	ClosingCredit decimal.Decimal `json:"closing_credit"`
+	IsBalanced    bool            `json:"is_balanced"`
+}
+
+type TrialBalanceResponse struct {
+	Columns []ReportColumnResponse      `json:"columns"`
+	Lines   []TrialBalanceLineResponse  `json:"lines"`
+	Totals  []TrialBalanceTotalResponse `json:"totals"`
+}
+
+type BalanceSheetResponse struct {
+	Columns                   []ReportColumnResponse   `json:"columns"`
+	Assets                    StatementSectionResponse `json:"assets"`
+	Liabilities               StatementSectionResponse `json:"liabilities"`
+	Equity                    StatementSectionResponse `json:"equity"`
+	TotalLiabilitiesAndEquity []decimal.Decimal        `json:"total_liabilities_and_equity"`
+	IsBalanced                []bool                   `json:"is_balanced"`
+}
+
+type IncomeStatementResponse struct {
+	Columns   []ReportColumnResponse   `json:"columns"`
+	Revenue   StatementSectionResponse `json:"revenue"`
+	Expenses  StatementSectionResponse `json:"expenses"`
+	NetIncome []decimal.Decimal        `json:"net_income"`
+}
+
+// CashFlowResponse disusun dengan metode tidak langsung: laba bersih disesuaikan dengan
+// perubahan akun neraca non-kas sesuai kategori arus kasnya
+type CashFlowResponse struct {
+	Columns         []ReportColumnResponse   `json:"columns"`
+	Operating       StatementSectionResponse `json:"operating"`
+	Investing       StatementSectionResponse `json:"investing"`
+	Financing       StatementSectionResponse `json:"financing"`
+	NetChangeInCash []decimal.Decimal        `json:"net_change_in_cash"`
+	OpeningCash     []decimal.Decimal        `json:"opening_cash"`
+	ClosingCash     []decimal.Decimal        `json:"closing_cash"`
+}
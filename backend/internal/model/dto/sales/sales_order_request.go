@@ -0,0 +1,41 @@
+package sales
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SalesOrderRequest dipakai untuk membuat maupun mengubah sales order berstatus Draft atau
+// CreditHold. Currency mengikuti customer; shipping_address kosong memakai alamat customer.
+type SalesOrderRequest struct {
+	CustomerID      uuid.UUID               `json:"customer_id" validate:"required"`
+	OrderDate       string                  `json:"order_date" validate:"required,datetime=2006-01-02"`
+	RequestedDate   string                  `json:"requested_date" validate:"omitempty,datetime=2006-01-02"`
+	Reference       string                  `json:"reference" validate:"max=100"`
+	ShippingAddress string                  `json:"shipping_address" validate:"max=1000"`
+	Notes           string                  `json:"notes" validate:"max=1000"`
+	Lines           []SalesOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+// SalesOrderLineRequest: discount_percent dan tax_percent dalam persen, misal 11 untuk PPN 11%.
+// Description dan satuan yang kosong diambil dari master item.
+type SalesOrderLineRequest struct {
+	ItemID          uuid.UUID       `json:"item_id" validate:"required"`
+	Description     string          `json:"description" validate:"max=500"`
+	Quantity        float64         `json:"quantity" validate:"gt=0"`
+	UnitPrice       decimal.Decimal `json:"unit_price" validate:"gte=0"`
+	DiscountPercent float64         `json:"discount_percent" validate:"gte=0,lte=100"`
+	TaxPercent      float64         `json:"tax_percent" validate:"gte=0,lte=100"`
+}
+
+// CreditOverrideRequest berisi alasan persetujuan order yang melebihi plafon kredit
+type CreditOverrideRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// SalesOrderFilterRequest berisi filter opsional untuk daftar sales order
+type SalesOrderFilterRequest struct {
+	Status     string `query:"status"`
+	CustomerID string `query:"customer_id"`
+	Search     string `query:"search"`
+}
@@ -0,0 +1,74 @@
+package sales
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type SalesOrderResponse struct {
+	ID                   uuid.UUID                `json:"id"`
+	Number               string                   `json:"number"`
+	CustomerID           uuid.UUID                `json:"customer_id"`
+	CustomerName         string                   `json:"customer_name"`
+	OrderDate            string                   `json:"order_date"`
+	RequestedDate        string                   `json:"requested_date"`
+	Currency             string                   `json:"currency"`
+	Reference            string                   `json:"reference"`
+	ShippingAddress      string                   `json:"shipping_address"`
+	Notes                string                   `json:"notes"`
+	Status               domain.SalesOrderStatus  `json:"status"`
+	SubtotalAmount       decimal.Decimal          `json:"subtotal_amount"`
+	DiscountAmount       decimal.Decimal          `json:"discount_amount"`
+	TaxAmount            decimal.Decimal          `json:"tax_amount"`
+	TotalAmount          decimal.Decimal          `json:"total_amount"`
+	CreditLimit          decimal.Decimal          `json:"credit_limit"`
+	CreditExposure       decimal.Decimal          `json:"credit_exposure"`
+	CreditOverrideBy     *uuid.UUID               `json:"credit_override_by"`
+	CreditOverrideAt     string                   `json:"credit_override_at"`
+	CreditOverrideReason string                   `json:"credit_override_reason"`
+	CreatedBy            uuid.UUID                `json:"created_by"`
+	ConfirmedBy          *uuid.UUID               `json:"confirmed_by"`
+	ConfirmedAt          string                   `json:"confirmed_at"`
+	CancelledAt          string                   `json:"cancelled_at"`
+	CreatedAt            string                   `json:"created_at"`
+	UpdatedAt            string                   `json:"updated_at"`
+	Lines                []SalesOrderLineResponse `json:"lines,omitempty"`
+}
+
+type SalesOrderLineResponse struct {
+	ID                  uuid.UUID                   `json:"id"`
+	LineNo              int                         `json:"line_no"`
+	ItemID              uuid.UUID                   `json:"item_id"`
+	ItemCode            string                      `json:"item_code"`
+	Description         string                      `json:"description"`
+	UOM                 string                      `json:"uom"`
+	Quantity            float64                     `json:"quantity"`
+	UnitPrice           decimal.Decimal             `json:"unit_price"`
+	DiscountPercent     float64                     `json:"discount_percent"`
+	DiscountAmount      decimal.Decimal             `json:"discount_amount"`
+	NetAmount           decimal.Decimal             `json:"net_amount"`
+	TaxPercent          float64                     `json:"tax_percent"`
+	TaxAmount           decimal.Decimal             `json:"tax_amount"`
+	LineTotal           decimal.Decimal             `json:"line_total"`
+	ShippedQuantity     float64                     `json:"shipped_quantity"`
+	OutstandingQuantity float64                     `json:"outstanding_quantity"`
+	FulfilmentStatus    domain.SalesOrderLineStatus `json:"fulfilment_status"`
+}
+
+// CustomerCreditResponse merangkum posisi kredit customer. Exposure adalah piutang terbuka
+// dikurangi kredit yang belum dialokasikan ditambah nilai order terbuka yang belum dikirim.
+// AvailableCredit bernilai 0 bila customer tidak dibatasi plafon (credit_limit 0).
+type CustomerCreditResponse struct {
+	CustomerID      uuid.UUID       `json:"customer_id"`
+	CustomerCode    string          `json:"customer_code"`
+	CustomerName    string          `json:"customer_name"`
+	Currency        string          `json:"currency"`
+	CreditLimit     decimal.Decimal `json:"credit_limit"`
+	OpenReceivable  decimal.Decimal `json:"open_receivable"`
+	OpenOrders      decimal.Decimal `json:"open_orders"`
+	Exposure        decimal.Decimal `json:"exposure"`
+	AvailableCredit decimal.Decimal `json:"available_credit"`
+	IsUnlimited     bool            `json:"is_unlimited"`
+}
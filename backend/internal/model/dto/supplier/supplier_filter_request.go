@@ -0,0 +1,7 @@
+package supplier
+
+// SupplierFilterRequest berisi filter opsional untuk daftar supplier
+type SupplierFilterRequest struct {
+	Search string `query:"search"`
+	Status string `query:"status"`
+}
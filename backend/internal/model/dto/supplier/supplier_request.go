@@ -0,0 +1,41 @@
+package supplier
+
+// SupplierRequest dipakai untuk membuat maupun mengubah supplier.
+// Alamat, kontak dan rekening bank selalu dikirim lengkap dan menggantikan data lama.
+type SupplierRequest struct {
+	Code            string                       `json:"code" validate:"required,max=20"`
+	Name            string                       `json:"name" validate:"required,min=2,max=150"`
+	NPWP            string                       `json:"npwp" validate:"max=25"`
+	Email           string                       `json:"email" validate:"omitempty,email,max=100"`
+	Phone           string                       `json:"phone" validate:"max=30"`
+	PaymentTermDays int                          `json:"payment_term_days" validate:"gte=0,lte=365"`
+	Currency        string                       `json:"currency" validate:"required,len=3"`
+	Notes           string                       `json:"notes" validate:"max=1000"`
+	Addresses       []SupplierAddressRequest     `json:"addresses" validate:"dive"`
+	Contacts        []SupplierContactRequest     `json:"contacts" validate:"dive"`
+	BankAccounts    []SupplierBankAccountRequest `json:"bank_accounts" validate:"dive"`
+}
+
+type SupplierAddressRequest struct {
+	Label      string `json:"label" validate:"required,max=50"`
+	Address    string `json:"address" validate:"required,max=500"`
+	City       string `json:"city" validate:"max=100"`
+	Province   string `json:"province" validate:"max=100"`
+	PostalCode string `json:"postal_code" validate:"max=10"`
+	IsPrimary  bool   `json:"is_primary"`
+}
+
+type SupplierContactRequest struct {
+	Name      string `json:"name" validate:"required,max=100"`
+	Position  string `json:"position" validate:"max=100"`
+	Email     string `json:"email" validate:"omitempty,email,max=100"`
+	Phone     string `json:"phone" validate:"max=30"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type SupplierBankAccountRequest struct {
+	BankName      string `json:"bank_name" validate:"required,max=100"`
+	AccountNumber string `json:"account_number" validate:"required,max=50"`
+	AccountName   string `json:"account_name" validate:"required,max=150"`
+	IsPrimary     bool   `json:"is_primary"`
+}
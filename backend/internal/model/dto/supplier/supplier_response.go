@@ -0,0 +1,53 @@
+package supplier
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+)
+
+type SupplierResponse struct {
+	ID              uuid.UUID                     `json:"id"`
+	Code            string                        `json:"code"`
+	Name            string                        `json:"name"`
+	NPWP            string                        `json:"npwp"`
+	Email           string                        `json:"email"`
+	Phone           string                        `json:"phone"`
+	PaymentTermDays int                           `json:"payment_term_days"`
+	Currency        string                        `json:"currency"`
+	Status          domain.SupplierStatus         `json:"status"`
+	BlockReason     string                        `json:"block_reason,omitempty"`
+	Notes           string                        `json:"notes"`
+	CreatedAt       string                        `json:"created_at"`
+	UpdatedAt       string                        `json:"updated_at"`
+	Addresses       []SupplierAddressResponse     `json:"addresses,omitempty"`
+	Contacts        []SupplierContactResponse     `json:"contacts,omitempty"`
+	BankAccounts    []SupplierBankAccountResponse `json:"bank_accounts,omitempty"`
+}
+
+type SupplierAddressResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Label      string    `json:"label"`
+	Address    string    `json:"address"`
+	City       string    `json:"city"`
+	Province   string    `json:"province"`
+	PostalCode string    `json:"postal_code"`
+	IsPrimary  bool      `json:"is_primary"`
+}
+
+type SupplierContactResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Position  string    `json:"position"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	IsPrimary bool      `json:"is_primary"`
+}
+
+type SupplierBankAccountResponse struct {
+	ID            uuid.UUID `json:"id"`
+	BankName      string    `json:"bank_name"`
+	AccountNumber string    `json:"account_number"`
+	AccountName   string    `json:"account_name"`
+	IsPrimary     bool      `json:"is_primary"`
+}
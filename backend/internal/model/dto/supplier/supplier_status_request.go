@@ -0,0 +1,8 @@
+package supplier
+
+import "erpfinance/internal/model/domain"
+
+type SupplierStatusRequest struct {
+	Status domain.SupplierStatus `json:"status" validate:"required,oneof='Active' 'Blocked'"`
+	Reason string                `json:"reason" validate:"max=500"`
+}
@@ -0,0 +1,60 @@
+package tax
+
+import "github.com/google/uuid"
+
+// TaxCodeRequest: base_factor adalah pengali DPP nilai lain (kosong berarti 1), akun penjualan
+// dipakai sales invoice dan akun pembelian dipakai supplier invoice
+type TaxCodeRequest struct {
+	Code                   string     `json:"code" validate:"required,max=20"`
+	Name                   string     `json:"name" validate:"required,min=2,max=100"`
+	Type                   string     `json:"type" validate:"required,oneof=PPN PPH23 PPH4_2 OTHER"`
+	Rate                   float64    `json:"rate" validate:"gt=0,lte=100"`
+	BaseFactor             float64    `json:"base_factor" validate:"gte=0,lte=1"`
+	NoNPWPSurchargePercent float64    `json:"no_npwp_surcharge_percent" validate:"gte=0,lte=100"`
+	SalesAccountID         *uuid.UUID `json:"sales_account_id"`
+	PurchaseAccountID      *uuid.UUID `json:"purchase_account_id"`
+	Notes                  string     `json:"notes" validate:"max=1000"`
+}
+
+// TaxCodeUpdateRequest: perubahan tarif hanya berlaku untuk invoice draft yang disimpan ulang;
+// invoice yang sudah diposting tetap memakai tarif saat itu
+type TaxCodeUpdateRequest struct {
+	TaxCodeRequest
+	IsActive bool `json:"is_active"`
+}
+
+// TaxCodeFilterRequest berisi filter opsional untuk daftar kode pajak
+type TaxCodeFilterRequest struct {
+	Type       string `query:"type"`
+	ActiveOnly bool   `query:"active_only"`
+	Search     string `query:"search"`
+}
+
+// TaxInvoiceRangeRequest adalah jatah NSFP dari DJP. Prefix adalah 3 digit kode NSFP,
+// serial adalah 8 digit nomor urut.
+type TaxInvoiceRangeRequest struct {
+	Year        int    `json:"year" validate:"required,min=2000,max=2100"`
+	Prefix      string `json:"prefix" validate:"required,len=3,numeric"`
+	StartSerial int64  `json:"start_serial" validate:"required,min=1,max=99999999"`
+	EndSerial   int64  `json:"end_serial" validate:"required,min=1,max=99999999"`
+	Notes       string `json:"notes" validate:"max=1000"`
+}
+
+// TaxInvoiceRangeUpdateRequest hanya mengubah status aktif dan catatan; nomor yang sudah
+// terpakai tidak bisa diubah
+type TaxInvoiceRangeUpdateRequest struct {
+	IsActive bool   `json:"is_active"`
+	Notes    string `json:"notes" validate:"max=1000"`
+}
+
+// TaxInvoiceRangeFilterRequest berisi filter opsional untuk daftar range faktur pajak
+type TaxInvoiceRangeFilterRequest struct {
+	Year int `query:"year"`
+}
+
+// TaxPeriodRequest adalah rentang tanggal invoice untuk export e-Faktur dan laporan PPh
+type TaxPeriodRequest struct {
+	DateFrom string `query:"date_from" validate:"required"`
+	DateTo   string `query:"date_to" validate:"required"`
+	TaxType  string `query:"tax_type" validate:"omitempty,oneof=PPH23 PPH4_2"`
+}
@@ -0,0 +1,75 @@
+package tax
+
+import (
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TaxCodeResponse struct {
+	ID                     uuid.UUID      `json:"id"`
+	Code                   string         `json:"code"`
+	Name                   string         `json:"name"`
+	Type                   domain.TaxType `json:"type"`
+	Rate                   float64        `json:"rate"`
+	BaseFactor             float64        `json:"base_factor"`
+	EffectiveRate          float64        `json:"effective_rate"`
+	NoNPWPSurchargePercent float64        `json:"no_npwp_surcharge_percent"`
+	SalesAccountID         *uuid.UUID     `json:"sales_account_id"`
+	PurchaseAccountID      *uuid.UUID     `json:"purchase_account_id"`
+	IsWithholding          bool           `json:"is_withholding"`
+	IsActive               bool           `json:"is_active"`
+	Notes                  string         `json:"notes"`
+	CreatedAt              string         `json:"created_at"`
+	UpdatedAt              string         `json:"updated_at"`
+}
+
+type TaxInvoiceRangeResponse struct {
+	ID               uuid.UUID `json:"id"`
+	Year             int       `json:"year"`
+	Prefix           string    `json:"prefix"`
+	StartSerial      int64     `json:"start_serial"`
+	EndSerial        int64     `json:"end_serial"`
+	NextSerial       int64     `json:"next_serial"`
+	NextNumber       string    `json:"next_number,omitempty"`
+	RemainingNumbers int64     `json:"remaining_numbers"`
+	IsActive         bool      `json:"is_active"`
+	Notes            string    `json:"notes"`
+	CreatedBy        uuid.UUID `json:"created_by"`
+	CreatedAt        string    `json:"created_at"`
+	UpdatedAt        string    `json:"updated_at"`
+}
+
+type WithholdingLineResponse struct {
+	SupplierInvoiceID uuid.UUID       `json:"supplier_invoice_id"`
+	InvoiceNumber     string          `json:"invoice_number"`
+	SupplierInvoiceNo string          `json:"supplier_invoice_no"`
+	InvoiceDate       string          `json:"invoice_date"`
+	SupplierID        uuid.UUID       `json:"supplier_id"`
+	SupplierName      string          `json:"supplier_name"`
+	SupplierNPWP      string          `json:"supplier_npwp"`
+	TaxCode           string          `json:"tax_code"`
+	TaxType           domain.TaxType  `json:"tax_type"`
+	Currency          string          `json:"currency"`
+	ExchangeRate      float64         `json:"exchange_rate"`
+	Rate              float64         `json:"rate"`
+	BaseAmount        decimal.Decimal `json:"base_amount"`
+	Amount            decimal.Decimal `json:"amount"`
+	BaseAmountIDR     decimal.Decimal `json:"base_amount_idr"`
+	AmountIDR         decimal.Decimal `json:"amount_idr"`
+}
+
+// WithholdingSummaryResponse adalah total PPh yang dipotong per jenis pajak dalam rupiah
+type WithholdingSummaryResponse struct {
+	TaxType       domain.TaxType  `json:"tax_type"`
+	BaseAmountIDR decimal.Decimal `json:"base_amount_idr"`
+	AmountIDR     decimal.Decimal `json:"amount_idr"`
+}
+
+type WithholdingReportResponse struct {
+	DateFrom string                       `json:"date_from"`
+	DateTo   string                       `json:"date_to"`
+	Lines    []WithholdingLineResponse    `json:"lines"`
+	Summary  []WithholdingSummaryResponse `json:"summary"`
+}
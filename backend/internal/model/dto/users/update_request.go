@@ -7,5 +7,5 @@ import (
 type UsersUpdateRequest struct {
 	Name  string      `json:"name" validate:"required"`
 	Email string      `json:"email" validate:"required,email"`
-	Role  domain.Role `json:"role" validate:"oneof='Admin' 'PPC' 'Purchasing' 'Warehouse' 'Logistics'"`
+	Role  domain.Role `json:"role" validate:"oneof='Admin' 'Finance' 'PPC' 'Purchasing' 'Warehouse' 'Logistics' 'Sales'"`
 }
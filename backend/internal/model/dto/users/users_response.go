@@ -11,6 +11,7 @@ type UsersResponse struct {
 	Name      string      `json:"name"`
 	Email     string      `json:"email"`
 	Role      domain.Role `json:"role"`
+	IsActive  bool        `json:"is_active"`
 	CreatedAt string      `json:"created_at"`
 	UpdatedAt string      `json:"updated_at"`
 }
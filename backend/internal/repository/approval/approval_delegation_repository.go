@@ -0,0 +1,21 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalDelegationRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, delegation domain.ApprovalDelegation) (domain.ApprovalDelegation, error)
+	Update(ctx context.Context, tx *gorm.DB, delegation domain.ApprovalDelegation) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalDelegation, error)
+	// FindActiveForDelegate mengembalikan delegasi aktif kepada delegateID yang berlaku pada
+	// date (tanggal tanpa jam, lihat helper.Today)
+	FindActiveForDelegate(ctx context.Context, tx *gorm.DB, delegateID uuid.UUID, date time.Time) ([]domain.ApprovalDelegation, error)
+	// FindAllWithPagination mengembalikan delegasi yang diberikan atau diterima userID (nil berarti semua)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, userID *uuid.UUID, activeOnly bool, page, limit int) ([]domain.ApprovalDelegation, int64, error)
+}
@@ -0,0 +1,80 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalDelegationRepositoryImpl struct{}
+
+func NewApprovalDelegationRepository() ApprovalDelegationRepository {
+	return &ApprovalDelegationRepositoryImpl{}
+}
+
+func (repository *ApprovalDelegationRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, delegation domain.ApprovalDelegation) (domain.ApprovalDelegation, error) {
+	err := tx.WithContext(ctx).Omit("Delegator", "Delegate").Create(&delegation).Error
+	if err != nil {
+		return domain.ApprovalDelegation{}, err
+	}
+	return delegation, nil
+}
+
+func (repository *ApprovalDelegationRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, delegation domain.ApprovalDelegation) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&delegation).Select("*").Omit("CreatedAt", "Delegator", "Delegate").Updates(delegation).Error
+}
+
+func (repository *ApprovalDelegationRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalDelegation, error) {
+	var delegation domain.ApprovalDelegation
+
+	err := tx.WithContext(ctx).Preload("Delegator").Preload("Delegate").Where("id = ?", id).First(&delegation).Error
+	if err != nil {
+		return domain.ApprovalDelegation{}, err
+	}
+	return delegation, nil
+}
+
+func (repository *ApprovalDelegationRepositoryImpl) FindActiveForDelegate(ctx context.Context, tx *gorm.DB, delegateID uuid.UUID, date time.Time) ([]domain.ApprovalDelegation, error) {
+	var delegations []domain.ApprovalDelegation
+
+	err := tx.WithContext(ctx).
+		Preload("Delegator").
+		Where("delegate_id = ? AND is_active = ? AND start_date <= ? AND end_date >= ?", delegateID, true, date, date).
+		Find(&delegations).Error
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (repository *ApprovalDelegationRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, userID *uuid.UUID, activeOnly bool, page, limit int) ([]domain.ApprovalDelegation, int64, error) {
+	var delegations []domain.ApprovalDelegation
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.ApprovalDelegation{})
+	if userID != nil {
+		query = query.Where("delegator_id = ? OR delegate_id = ?", *userID, *userID)
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Delegator").Preload("Delegate").Order("start_date DESC, created_at DESC").Offset(offset).Limit(limit).Find(&delegations).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return delegations, totalItems, nil
+}
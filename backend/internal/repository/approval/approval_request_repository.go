@@ -0,0 +1,26 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalRequestRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) (domain.ApprovalRequest, error)
+	Update(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) error
+	UpdateTask(ctx context.Context, tx *gorm.DB, task domain.ApprovalTask) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRequest, error)
+	// FindByIdForUpdate mengunci request beserta task-nya agar keputusan paralel diproses bergantian
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRequest, error)
+	FindPendingByDocument(ctx context.Context, tx *gorm.DB, documentType string, documentID uuid.UUID) (domain.ApprovalRequest, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, documentType, status string, documentID, requestedBy *uuid.UUID, page, limit int) ([]domain.ApprovalRequest, int64, error)
+	// FindInbox mengembalikan task Pending yang boleh diputuskan user: ditujukan ke user atau
+	// role-nya, atau ke user yang mendelegasikan wewenangnya pada tanggal today. Request milik user
+	// sendiri dan request yang task-nya sudah pernah diputuskan user tidak ikut. anyApprover untuk
+	// admin yang boleh memutuskan semua task.
+	FindInbox(ctx context.Context, tx *gorm.DB, userID uuid.UUID, role domain.Role, anyApprover bool, today time.Time, documentType string, page, limit int) ([]domain.ApprovalTask, int64, error)
+}
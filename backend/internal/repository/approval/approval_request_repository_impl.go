@@ -0,0 +1,157 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ApprovalRequestRepositoryImpl struct{}
+
+func NewApprovalRequestRepository() ApprovalRequestRepository {
+	return &ApprovalRequestRepositoryImpl{}
+}
+
+func orderTasks(db *gorm.DB) *gorm.DB {
+	return db.Order("sequence ASC, name ASC")
+}
+
+func (repository *ApprovalRequestRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) (domain.ApprovalRequest, error) {
+	err := tx.WithContext(ctx).Omit("Rule").Create(&request).Error
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+	return request, nil
+}
+
+func (repository *ApprovalRequestRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&request).Error
+}
+
+func (repository *ApprovalRequestRepositoryImpl) UpdateTask(ctx context.Context, tx *gorm.DB, task domain.ApprovalTask) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&task).Error
+}
+
+func (repository *ApprovalRequestRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRequest, error) {
+	var request domain.ApprovalRequest
+
+	err := tx.WithContext(ctx).
+		Preload("Rule").
+		Preload("Tasks", orderTasks).
+		Where("id = ?", id).
+		First(&request).Error
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+	return request, nil
+}
+
+func (repository *ApprovalRequestRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRequest, error) {
+	var request domain.ApprovalRequest
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&request).Error
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+
+	err = orderTasks(tx.WithContext(ctx)).Where("request_id = ?", request.ID).Find(&request.Tasks).Error
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+	return request, nil
+}
+
+func (repository *ApprovalRequestRepositoryImpl) FindPendingByDocument(ctx context.Context, tx *gorm.DB, documentType string, documentID uuid.UUID) (domain.ApprovalRequest, error) {
+	var request domain.ApprovalRequest
+
+	err := tx.WithContext(ctx).
+		Where("document_type = ? AND document_id = ? AND status = ?", documentType, documentID, domain.ApprovalRequestStatusPending).
+		First(&request).Error
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+	return request, nil
+}
+
+func (repository *ApprovalRequestRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, documentType, status string, documentID, requestedBy *uuid.UUID, page, limit int) ([]domain.ApprovalRequest, int64, error) {
+	var requests []domain.ApprovalRequest
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.ApprovalRequest{})
+	if documentType != "" {
+		query = query.Where("document_type = ?", documentType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if documentID != nil {
+		query = query.Where("document_id = ?", *documentID)
+	}
+	if requestedBy != nil {
+		query = query.Where("requested_by = ?", *requestedBy)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Rule").Order("created_at DESC").Offset(offset).Limit(limit).Find(&requests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, totalItems, nil
+}
+
+func (repository *ApprovalRequestRepositoryImpl) FindInbox(ctx context.Context, tx *gorm.DB, userID uuid.UUID, role domain.Role, anyApprover bool, today time.Time, documentType string, page, limit int) ([]domain.ApprovalTask, int64, error) {
+	var tasks []domain.ApprovalTask
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.ApprovalTask{}).
+		Joins("JOIN approval_requests AS r ON r.id = approval_tasks.request_id").
+		Where("approval_tasks.status = ? AND r.status = ?", domain.ApprovalTaskStatusPending, domain.ApprovalRequestStatusPending).
+		Where("r.requested_by <> ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM approval_tasks AS done WHERE done.request_id = r.id AND done.acted_by = ?)", userID)
+	if documentType != "" {
+		query = query.Where("r.document_type = ?", documentType)
+	}
+	if !anyApprover {
+		query = query.Where(`(approval_tasks.approver_user_id = ?
+			OR (approval_tasks.approver_user_id IS NULL AND approval_tasks.approver_role = ?)
+			OR EXISTS (
+				SELECT 1 FROM approval_delegations AS d
+				JOIN users AS u ON u.id = d.delegator_id
+				WHERE d.delegate_id = ? AND d.is_active AND d.start_date <= ? AND d.end_date >= ?
+					AND d.delegator_id <> r.requested_by
+					AND (d.document_type = '' OR d.document_type IS NULL OR d.document_type = r.document_type)
+					AND (approval_tasks.approver_user_id = d.delegator_id
+						OR (approval_tasks.approver_user_id IS NULL AND approval_tasks.approver_role = u.role))
+			))`, userID, role, userID, today, today)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Select("approval_tasks.*").Preload("Request").Order("r.created_at ASC, approval_tasks.sequence ASC").Offset(offset).Limit(limit).Find(&tasks).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, totalItems, nil
+}
@@ -0,0 +1,21 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalRuleRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, rule domain.ApprovalRule) (domain.ApprovalRule, error)
+	Update(ctx context.Context, tx *gorm.DB, rule domain.ApprovalRule) error
+	// ReplaceSteps menghapus seluruh step rule lalu menyimpan step yang baru
+	ReplaceSteps(ctx context.Context, tx *gorm.DB, ruleID uuid.UUID, steps []domain.ApprovalRuleStep) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRule, error)
+	ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error)
+	// FindActiveByDocumentType mengembalikan rule aktif beserta step-nya, diurutkan menurut prioritas
+	FindActiveByDocumentType(ctx context.Context, tx *gorm.DB, documentType string) ([]domain.ApprovalRule, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, documentType string, activeOnly bool, search string, page, limit int) ([]domain.ApprovalRule, int64, error)
+}
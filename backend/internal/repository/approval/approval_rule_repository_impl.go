@@ -0,0 +1,113 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalRuleRepositoryImpl struct{}
+
+func NewApprovalRuleRepository() ApprovalRuleRepository {
+	return &ApprovalRuleRepositoryImpl{}
+}
+
+func orderSteps(db *gorm.DB) *gorm.DB {
+	return db.Order("sequence ASC, name ASC")
+}
+
+func (repository *ApprovalRuleRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, rule domain.ApprovalRule) (domain.ApprovalRule, error) {
+	err := tx.WithContext(ctx).Create(&rule).Error
+	if err != nil {
+		return domain.ApprovalRule{}, err
+	}
+	return rule, nil
+}
+
+func (repository *ApprovalRuleRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, rule domain.ApprovalRule) error {
+	// Select("*") agar field bool bernilai false dan max_amount nil tetap ikut di-update
+	return tx.WithContext(ctx).Model(&rule).Select("*").Omit("CreatedAt", "Steps").Updates(rule).Error
+}
+
+func (repository *ApprovalRuleRepositoryImpl) ReplaceSteps(ctx context.Context, tx *gorm.DB, ruleID uuid.UUID, steps []domain.ApprovalRuleStep) error {
+	err := tx.WithContext(ctx).Where("rule_id = ?", ruleID).Delete(&domain.ApprovalRuleStep{}).Error
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&steps).Error
+}
+
+func (repository *ApprovalRuleRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.ApprovalRule, error) {
+	var rule domain.ApprovalRule
+
+	err := tx.WithContext(ctx).Preload("Steps", orderSteps).Where("id = ?", id).First(&rule).Error
+	if err != nil {
+		return domain.ApprovalRule{}, err
+	}
+	return rule, nil
+}
+
+func (repository *ApprovalRuleRepositoryImpl) ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.ApprovalRule{}).Where("code = ?", code)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *ApprovalRuleRepositoryImpl) FindActiveByDocumentType(ctx context.Context, tx *gorm.DB, documentType string) ([]domain.ApprovalRule, error) {
+	var rules []domain.ApprovalRule
+
+	err := tx.WithContext(ctx).
+		Preload("Steps", orderSteps).
+		Where("document_type = ? AND is_active = ?", documentType, true).
+		Order("priority ASC, min_amount DESC, code ASC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (repository *ApprovalRuleRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, documentType string, activeOnly bool, search string, page, limit int) ([]domain.ApprovalRule, int64, error) {
+	var rules []domain.ApprovalRule
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.ApprovalRule{})
+	if documentType != "" {
+		query = query.Where("document_type = ?", documentType)
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Steps", orderSteps).Order("document_type ASC, priority ASC, code ASC").Offset(offset).Limit(limit).Find(&rules).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rules, totalItems, nil
+}
@@ -0,0 +1,17 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AssetCategoryRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, category domain.AssetCategory) (domain.AssetCategory, error)
+	Update(ctx context.Context, tx *gorm.DB, category domain.AssetCategory) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AssetCategory, error)
+	ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, activeOnly bool, search string, page, limit int) ([]domain.AssetCategory, int64, error)
+}
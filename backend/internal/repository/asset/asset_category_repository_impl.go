@@ -0,0 +1,81 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AssetCategoryRepositoryImpl struct{}
+
+func NewAssetCategoryRepository() AssetCategoryRepository {
+	return &AssetCategoryRepositoryImpl{}
+}
+
+func (repository *AssetCategoryRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, category domain.AssetCategory) (domain.AssetCategory, error) {
+	err := tx.WithContext(ctx).Create(&category).Error
+	if err != nil {
+		return domain.AssetCategory{}, err
+	}
+	return category, nil
+}
+
+func (repository *AssetCategoryRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, category domain.AssetCategory) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&category).Select("*").Omit("CreatedAt").Updates(category).Error
+}
+
+func (repository *AssetCategoryRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AssetCategory, error) {
+	var category domain.AssetCategory
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&category).Error
+	if err != nil {
+		return domain.AssetCategory{}, err
+	}
+	return category, nil
+}
+
+func (repository *AssetCategoryRepositoryImpl) ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.AssetCategory{}).Where("code = ?", code)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *AssetCategoryRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, activeOnly bool, search string, page, limit int) ([]domain.AssetCategory, int64, error) {
+	var categories []domain.AssetCategory
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.AssetCategory{})
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&categories).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return categories, totalItems, nil
+}
@@ -0,0 +1,19 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DepreciationRunRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, run domain.DepreciationRun) (domain.DepreciationRun, error)
+	Update(ctx context.Context, tx *gorm.DB, run domain.DepreciationRun) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.DepreciationRun, error)
+	// ExistsOnOrAfter memeriksa apakah sudah ada run untuk periode tersebut atau periode sesudahnya
+	ExistsOnOrAfter(ctx context.Context, tx *gorm.DB, periodEnd time.Time) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, dateFrom, dateTo *time.Time, page, limit int) ([]domain.DepreciationRun, int64, error)
+}
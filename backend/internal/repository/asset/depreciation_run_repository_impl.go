@@ -0,0 +1,85 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DepreciationRunRepositoryImpl struct{}
+
+func NewDepreciationRunRepository() DepreciationRunRepository {
+	return &DepreciationRunRepositoryImpl{}
+}
+
+func (repository *DepreciationRunRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, run domain.DepreciationRun) (domain.DepreciationRun, error) {
+	err := tx.WithContext(ctx).Create(&run).Error
+	if err != nil {
+		return domain.DepreciationRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *DepreciationRunRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, run domain.DepreciationRun) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&run).Error
+}
+
+func (repository *DepreciationRunRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.DepreciationRun, error) {
+	var run domain.DepreciationRun
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("asset_number ASC")
+		}).
+		Where("id = ?", id).
+		First(&run).Error
+	if err != nil {
+		return domain.DepreciationRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *DepreciationRunRepositoryImpl) ExistsOnOrAfter(ctx context.Context, tx *gorm.DB, periodEnd time.Time) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).
+		Model(&domain.DepreciationRun{}).
+		Where("period_end >= ?", periodEnd).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *DepreciationRunRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, dateFrom, dateTo *time.Time, page, limit int) ([]domain.DepreciationRun, int64, error) {
+	var runs []domain.DepreciationRun
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.DepreciationRun{})
+	if dateFrom != nil {
+		query = query.Where("period_end >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		query = query.Where("period_end <= ?", *dateTo)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("period_end DESC").Offset(offset).Limit(limit).Find(&runs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return runs, totalItems, nil
+}
@@ -0,0 +1,21 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FixedAssetRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, asset domain.FixedAsset) (domain.FixedAsset, error)
+	Update(ctx context.Context, tx *gorm.DB, asset domain.FixedAsset) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FixedAsset, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FixedAsset, error)
+	// LockDepreciable mengunci aset aktif yang mulai disusutkan paling lambat periodEnd
+	LockDepreciable(ctx context.Context, tx *gorm.DB, periodEnd time.Time) ([]domain.FixedAsset, error)
+	CreateTransfer(ctx context.Context, tx *gorm.DB, transfer domain.FixedAssetTransfer) error
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, categoryID *uuid.UUID, location, search string, page, limit int) ([]domain.FixedAsset, int64, error)
+}
@@ -0,0 +1,134 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FixedAssetRepositoryImpl struct{}
+
+func NewFixedAssetRepository() FixedAssetRepository {
+	return &FixedAssetRepositoryImpl{}
+}
+
+func (repository *FixedAssetRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, asset domain.FixedAsset) (domain.FixedAsset, error) {
+	err := tx.WithContext(ctx).Omit(clause.Associations).Create(&asset).Error
+	if err != nil {
+		return domain.FixedAsset{}, err
+	}
+	return asset, nil
+}
+
+func (repository *FixedAssetRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, asset domain.FixedAsset) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&asset).Error
+}
+
+func (repository *FixedAssetRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FixedAsset, error) {
+	var asset domain.FixedAsset
+
+	err := tx.WithContext(ctx).
+		Preload("Category").
+		Preload("Transfers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("transfer_date ASC, created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&asset).Error
+	if err != nil {
+		return domain.FixedAsset{}, err
+	}
+	return asset, nil
+}
+
+func (repository *FixedAssetRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FixedAsset, error) {
+	var asset domain.FixedAsset
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&asset).Error
+	if err != nil {
+		return domain.FixedAsset{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("id = ?", asset.CategoryID).First(&asset.Category).Error
+	if err != nil {
+		return domain.FixedAsset{}, err
+	}
+	return asset, nil
+}
+
+func (repository *FixedAssetRepositoryImpl) LockDepreciable(ctx context.Context, tx *gorm.DB, periodEnd time.Time) ([]domain.FixedAsset, error) {
+	var assets []domain.FixedAsset
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("status = ? AND depreciation_start_date <= ?", domain.FixedAssetStatusActive, periodEnd).
+		Order("number ASC").
+		Find(&assets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs := make([]uuid.UUID, 0, len(assets))
+	for _, asset := range assets {
+		categoryIDs = append(categoryIDs, asset.CategoryID)
+	}
+	var categories []domain.AssetCategory
+	if len(categoryIDs) > 0 {
+		err = tx.WithContext(ctx).Where("id IN ?", categoryIDs).Find(&categories).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	categoryByID := make(map[uuid.UUID]domain.AssetCategory, len(categories))
+	for _, category := range categories {
+		categoryByID[category.ID] = category
+	}
+	for i := range assets {
+		assets[i].Category = categoryByID[assets[i].CategoryID]
+	}
+	return assets, nil
+}
+
+func (repository *FixedAssetRepositoryImpl) CreateTransfer(ctx context.Context, tx *gorm.DB, transfer domain.FixedAssetTransfer) error {
+	return tx.WithContext(ctx).Create(&transfer).Error
+}
+
+func (repository *FixedAssetRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, categoryID *uuid.UUID, location, search string, page, limit int) ([]domain.FixedAsset, int64, error) {
+	var assets []domain.FixedAsset
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.FixedAsset{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if categoryID != nil {
+		query = query.Where("category_id = ?", *categoryID)
+	}
+	if location != "" {
+		query = query.Where("location ILIKE ?", "%"+location+"%")
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR name ILIKE ? OR serial_number ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Category").Order("number ASC").Offset(offset).Limit(limit).Find(&assets).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return assets, totalItems, nil
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepository interface {
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AuditLog, error)
+	// FindAllWithPagination memfilter log menurut entity, actor dan rentang waktu [from, to)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, entityType, entityID string, actorID *uuid.UUID, action string, from, to *time.Time, page, limit int) ([]domain.AuditLog, int64, error)
+}
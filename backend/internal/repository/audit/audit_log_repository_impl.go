@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepositoryImpl struct{}
+
+func NewAuditLogRepository() AuditLogRepository {
+	return &AuditLogRepositoryImpl{}
+}
+
+func (repository *AuditLogRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AuditLog, error) {
+	var auditLog domain.AuditLog
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&auditLog).Error
+	if err != nil {
+		return domain.AuditLog{}, err
+	}
+	return auditLog, nil
+}
+
+func (repository *AuditLogRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, entityType, entityID string, actorID *uuid.UUID, action string, from, to *time.Time, page, limit int) ([]domain.AuditLog, int64, error) {
+	var auditLogs []domain.AuditLog
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.AuditLog{})
+	if entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+	if entityID != "" {
+		query = query.Where("entity_id = ?", entityID)
+	}
+	if actorID != nil {
+		query = query.Where("actor_id = ?", *actorID)
+	}
+	if action != "" {
+		query = query.Where("action = ?", action)
+	}
+	if from != nil {
+		query = query.Where("created_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("created_at < ?", *to)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("created_at DESC, id").Offset(offset).Limit(limit).Find(&auditLogs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return auditLogs, totalItems, nil
+}
@@ -0,0 +1,357 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// beforeRowsKey menyimpan isi row sebelum UPDATE/DELETE di instance statement
+	beforeRowsKey = "audit:before_rows"
+
+	redactedValue = "[REDACTED]"
+)
+
+// skippedTables tidak diaudit: audit_logs sendiri, counter penomoran dokumen yang berubah setiap
+// kali dokumen dibuat, dan denylist token yang dibersihkan otomatis setelah kedaluwarsa
+var skippedTables = map[string]bool{
+	"audit_logs":         true,
+	"document_sequences": true,
+	"revoked_tokens":     true,
+}
+
+// redactedColumns tidak pernah disimpan nilainya di audit log
+var redactedColumns = map[string]bool{
+	"password":    true,
+	"token":       true,
+	"token_hash":  true,
+	"code_hash":   true,
+	"totp_secret": true,
+}
+
+// AuditPlugin adalah plugin gorm yang menulis audit log untuk setiap create, update dan delete
+// melalui model. Log ditulis dengan koneksi statement yang sama sehingga ikut transaksi pemanggil
+// dan ikut di-rollback bila transaksi gagal. Actor dan IP dibaca dari context request (Locals
+// fiber "userClaims" dan "requestIP"); perubahan di luar request HTTP tercatat tanpa actor.
+type AuditPlugin struct{}
+
+func NewAuditPlugin() *AuditPlugin {
+	return &AuditPlugin{}
+}
+
+func (plugin *AuditPlugin) Name() string {
+	return "audit"
+}
+
+func (plugin *AuditPlugin) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().After("gorm:create").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_create", plugin.afterCreate); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").After("gorm:before_update").
+		Register("audit:before_update", plugin.captureBefore); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("gorm:update").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_update", plugin.afterUpdate); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("gorm:delete").After("gorm:before_delete").
+		Register("audit:before_delete", plugin.captureBefore); err != nil {
+		return err
+	}
+	return db.Callback().Delete().After("gorm:delete").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_delete", plugin.afterDelete)
+}
+
+func (plugin *AuditPlugin) afterCreate(db *gorm.DB) {
+	if !auditable(db) || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	var entries []domain.AuditLog
+	eachModel(db.Statement.ReflectValue, func(value reflect.Value) {
+		row := rowValues(db.Statement, value)
+		entries = append(entries, newEntry(db.Statement, domain.AuditActionCreate, entityID(db.Statement, value), nil, row))
+	})
+	writeEntries(db, entries)
+}
+
+// captureBefore membaca row yang akan diubah atau dihapus dengan kondisi yang sama dengan statement
+func (plugin *AuditPlugin) captureBefore(db *gorm.DB) {
+	if !auditable(db) {
+		return
+	}
+
+	rows, err := findAffectedRows(db)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+	db.InstanceSet(beforeRowsKey, rows)
+}
+
+func (plugin *AuditPlugin) afterUpdate(db *gorm.DB) {
+	before, ok := beforeRows(db)
+	if !ok || len(before) == 0 {
+		return
+	}
+
+	after, err := findRowsByPrimaryKey(db, before)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+
+	var entries []domain.AuditLog
+	for _, oldValue := range before {
+		id := entityID(db.Statement, oldValue)
+		newValue, found := after[id]
+		if !found {
+			continue
+		}
+		oldRow, newRow := diffRows(rowValues(db.Statement, oldValue), rowValues(db.Statement, newValue))
+		if len(newRow) == 0 {
+			continue
+		}
+		entries = append(entries, newEntry(db.Statement, domain.AuditActionUpdate, id, oldRow, newRow))
+	}
+	writeEntries(db, entries)
+}
+
+func (plugin *AuditPlugin) afterDelete(db *gorm.DB) {
+	before, ok := beforeRows(db)
+	if !ok || len(before) == 0 || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	var entries []domain.AuditLog
+	for _, oldValue := range before {
+		entries = append(entries, newEntry(db.Statement, domain.AuditActionDelete, entityID(db.Statement, oldValue), rowValues(db.Statement, oldValue), nil))
+	}
+	writeEntries(db, entries)
+}
+
+// auditable hanya meloloskan statement model dengan satu primary key yang berhasil dijalankan
+func auditable(db *gorm.DB) bool {
+	return db.Error == nil &&
+		db.Statement.Schema != nil &&
+		db.Statement.Schema.PrioritizedPrimaryField != nil &&
+		!skippedTables[db.Statement.Table]
+}
+
+func beforeRows(db *gorm.DB) ([]reflect.Value, bool) {
+	if db.Error != nil {
+		return nil, false
+	}
+	value, ok := db.InstanceGet(beforeRowsKey)
+	if !ok {
+		return nil, false
+	}
+	rows, ok := value.([]reflect.Value)
+	return rows, ok
+}
+
+// newSession membuat query baru di koneksi (dan transaksi) yang sama tanpa menjalankan hook model
+func newSession(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true, SkipHooks: true})
+}
+
+// findAffectedRows memakai klausa WHERE statement ditambah primary key model bila terisi, sama
+// seperti kondisi yang dipakai gorm saat menjalankan UPDATE/DELETE
+func findAffectedRows(db *gorm.DB) ([]reflect.Value, error) {
+	stmt := db.Statement
+	query := newSession(db).Table(stmt.Table)
+	conditions := 0
+
+	if where, ok := stmt.Clauses["WHERE"]; ok {
+		if whereClause, ok := where.Expression.(clause.Where); ok && len(whereClause.Exprs) > 0 {
+			query = query.Clauses(clause.Where{Exprs: whereClause.Exprs})
+			conditions++
+		}
+	}
+
+	var primaryKeys []interface{}
+	if stmt.Model != nil {
+		eachModel(reflect.ValueOf(stmt.Model), func(value reflect.Value) {
+			if key, isZero := stmt.Schema.PrioritizedPrimaryField.ValueOf(stmt.Context, value); !isZero {
+				primaryKeys = append(primaryKeys, key)
+			}
+		})
+	}
+	if len(primaryKeys) > 0 {
+		query = query.Where(clause.IN{Column: clause.Column{Table: stmt.Table, Name: stmt.Schema.PrioritizedPrimaryField.DBName}, Values: primaryKeys})
+		conditions++
+	}
+
+	// Tanpa kondisi gorm sendiri akan menolak statement (ErrMissingWhereClause)
+	if conditions == 0 {
+		return nil, nil
+	}
+
+	rows := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+	if err := query.Find(rows.Interface()).Error; err != nil {
+		return nil, err
+	}
+	return collectRows(rows.Elem()), nil
+}
+
+// findRowsByPrimaryKey membaca ulang row setelah UPDATE, dikelompokkan menurut ID entity
+func findRowsByPrimaryKey(db *gorm.DB, before []reflect.Value) (map[string]reflect.Value, error) {
+	stmt := db.Statement
+	primaryField := stmt.Schema.PrioritizedPrimaryField
+
+	primaryKeys := make([]interface{}, 0, len(before))
+	for _, value := range before {
+		key, _ := primaryField.ValueOf(stmt.Context, value)
+		primaryKeys = append(primaryKeys, key)
+	}
+
+	rows := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+	err := newSession(db).Table(stmt.Table).
+		Where(clause.IN{Column: clause.Column{Name: primaryField.DBName}, Values: primaryKeys}).
+		Find(rows.Interface()).Error
+	if err != nil {
+		return nil, err
+	}
+
+	after := make(map[string]reflect.Value, len(before))
+	for _, value := range collectRows(rows.Elem()) {
+		after[entityID(stmt, value)] = value
+	}
+	return after, nil
+}
+
+func collectRows(slice reflect.Value) []reflect.Value {
+	rows := make([]reflect.Value, 0, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		rows = append(rows, slice.Index(i))
+	}
+	return rows
+}
+
+// eachModel memanggil fn untuk setiap struct di value (struct, pointer, atau slice/array)
+func eachModel(value reflect.Value, fn func(reflect.Value)) {
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			eachModel(value.Index(i), fn)
+		}
+	case reflect.Struct:
+		fn(value)
+	}
+}
+
+func entityID(stmt *gorm.Statement, value reflect.Value) string {
+	key, _ := stmt.Schema.PrioritizedPrimaryField.ValueOf(stmt.Context, value)
+	return fmt.Sprint(key)
+}
+
+// rowValues mengubah struct model menjadi map nama kolom ke nilai
+func rowValues(stmt *gorm.Statement, value reflect.Value) map[string]interface{} {
+	row := make(map[string]interface{}, len(stmt.Schema.DBNames))
+	for _, dbName := range stmt.Schema.DBNames {
+		field := stmt.Schema.FieldsByDBName[dbName]
+		if field == nil {
+			continue
+		}
+		row[dbName], _ = field.ValueOf(stmt.Context, value)
+	}
+	return row
+}
+
+// diffRows mengembalikan nilai lama dan baru hanya untuk kolom yang berubah. updated_at diabaikan
+// agar penyimpanan tanpa perubahan tidak menghasilkan log.
+func diffRows(oldRow, newRow map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	oldChanges := map[string]interface{}{}
+	newChanges := map[string]interface{}{}
+	for column, newValue := range newRow {
+		if column == "updated_at" {
+			continue
+		}
+		oldValue := oldRow[column]
+		if sameValue(oldValue, newValue) {
+			continue
+		}
+		oldChanges[column] = oldValue
+		newChanges[column] = newValue
+	}
+	return oldChanges, newChanges
+}
+
+func sameValue(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return string(aJSON) == string(bJSON)
+}
+
+func newEntry(stmt *gorm.Statement, action domain.AuditAction, entityID string, oldValues, newValues map[string]interface{}) domain.AuditLog {
+	entry := domain.AuditLog{
+		ID:         uuid.New(),
+		Action:     action,
+		EntityType: stmt.Table,
+		EntityID:   entityID,
+		OldValues:  encodeValues(oldValues),
+		NewValues:  encodeValues(newValues),
+		CreatedAt:  time.Now(),
+	}
+	applyRequestInfo(stmt.Context, &entry)
+	return entry
+}
+
+// encodeValues mengubah nilai kolom menjadi JSON; kolom sensitif hanya ditandai tanpa nilainya
+func encodeValues(values map[string]interface{}) *string {
+	if values == nil {
+		return nil
+	}
+	for column := range values {
+		if redactedColumns[column] {
+			values[column] = redactedValue
+		}
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	result := string(encoded)
+	return &result
+}
+
+// applyRequestInfo mengisi actor dari JWT dan IP dari context request. Context yang diteruskan
+// handler (fiber ctx.Context()) membaca Locals melalui Value.
+func applyRequestInfo(ctx context.Context, entry *domain.AuditLog) {
+	if ctx == nil {
+		return
+	}
+	if claims, ok := ctx.Value("userClaims").(*helper.JWTClaim); ok && claims != nil {
+		actorID := claims.ID
+		entry.ActorID = &actorID
+		entry.ActorEmail = claims.Email
+		entry.ActorRole = claims.Role
+	}
+	if ip, ok := ctx.Value("requestIP").(string); ok {
+		entry.IPAddress = ip
+	}
+}
+
+func writeEntries(db *gorm.DB, entries []domain.AuditLog) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := newSession(db).Create(&entries).Error; err != nil {
+		db.AddError(err)
+	}
+}
@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, event domain.SecurityEvent) error
+	// CountByUserSince menghitung event dengan jenis tersebut milik user sejak waktu since
+	CountByUserSince(ctx context.Context, tx *gorm.DB, userID uuid.UUID, eventType string, since time.Time) (int64, error)
+}
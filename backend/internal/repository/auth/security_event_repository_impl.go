@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SecurityEventRepositoryImpl struct{}
+
+func NewSecurityEventRepository() SecurityEventRepository {
+	return &SecurityEventRepositoryImpl{}
+}
+
+func (repository *SecurityEventRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, event domain.SecurityEvent) error {
+	return tx.WithContext(ctx).Create(&event).Error
+}
+
+func (repository *SecurityEventRepositoryImpl) CountByUserSince(ctx context.Context, tx *gorm.DB, userID uuid.UUID, eventType string, since time.Time) (int64, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).Model(&domain.SecurityEvent{}).
+		Where("user_id = ? AND event_type = ? AND created_at >= ?", userID, eventType, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
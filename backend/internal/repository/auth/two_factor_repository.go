@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TwoFactorRepository interface {
+	// FindUserByIdForUpdate mengunci row user agar verifikasi kode dan pencatatan step TOTP tidak
+	// bisa berjalan bersamaan
+	FindUserByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Users, error)
+	// UpdateUserTwoFactor menyimpan kolom 2FA user termasuk nilai kosong/false
+	UpdateUserTwoFactor(ctx context.Context, tx *gorm.DB, user domain.Users) error
+
+	// ReplaceRecoveryCodes menghapus seluruh kode pemulihan user lalu menyimpan hash kode baru
+	ReplaceRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID, codeHashes []string) error
+	DeleteRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error
+	FindUnusedRecoveryCode(ctx context.Context, tx *gorm.DB, userID uuid.UUID, codeHash string) (domain.UserRecoveryCode, error)
+	UpdateRecoveryCode(ctx context.Context, tx *gorm.DB, code domain.UserRecoveryCode) error
+	CountUnusedRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID) (int64, error)
+
+	CreateChallenge(ctx context.Context, tx *gorm.DB, challenge domain.LoginChallenge) error
+	FindChallengeByTokenHashForUpdate(ctx context.Context, tx *gorm.DB, tokenHash string) (domain.LoginChallenge, error)
+	UpdateChallenge(ctx context.Context, tx *gorm.DB, challenge domain.LoginChallenge) error
+	// DeleteExpiredChallenges membersihkan challenge kedaluwarsa milik user saat login baru dibuat
+	DeleteExpiredChallenges(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error
+
+	// IsRequiredForRole mengembalikan false bila role tidak memiliki policy
+	IsRequiredForRole(ctx context.Context, tx *gorm.DB, role domain.Role) (bool, error)
+	FindAllPolicies(ctx context.Context, tx *gorm.DB) ([]domain.TwoFactorPolicy, error)
+	SavePolicy(ctx context.Context, tx *gorm.DB, policy domain.TwoFactorPolicy) error
+}
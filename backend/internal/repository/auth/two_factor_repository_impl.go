@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TwoFactorRepositoryImpl struct{}
+
+func NewTwoFactorRepository() TwoFactorRepository {
+	return &TwoFactorRepositoryImpl{}
+}
+
+func (repository *TwoFactorRepositoryImpl) FindUserByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Users, error) {
+	var user domain.Users
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&user).Error
+	if err != nil {
+		return domain.Users{}, err
+	}
+	return user, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) UpdateUserTwoFactor(ctx context.Context, tx *gorm.DB, user domain.Users) error {
+	return tx.WithContext(ctx).Model(&user).
+		Select("TwoFactorEnabled", "TwoFactorEnabledAt", "TOTPSecret", "TOTPLastStep", "UpdatedAt").
+		Updates(user).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) ReplaceRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID, codeHashes []string) error {
+	if err := repository.DeleteRecoveryCodes(ctx, tx, userID); err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]domain.UserRecoveryCode, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		codes = append(codes, domain.UserRecoveryCode{
+			ID:       uuid.New(),
+			UserID:   userID,
+			CodeHash: codeHash,
+		})
+	}
+	return tx.WithContext(ctx).Omit("User").Create(&codes).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) DeleteRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error {
+	return tx.WithContext(ctx).Where("user_id = ?", userID).Delete(&domain.UserRecoveryCode{}).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) FindUnusedRecoveryCode(ctx context.Context, tx *gorm.DB, userID uuid.UUID, codeHash string) (domain.UserRecoveryCode, error) {
+	var code domain.UserRecoveryCode
+
+	err := tx.WithContext(ctx).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		First(&code).Error
+	if err != nil {
+		return domain.UserRecoveryCode{}, err
+	}
+	return code, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) UpdateRecoveryCode(ctx context.Context, tx *gorm.DB, code domain.UserRecoveryCode) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&code).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) CountUnusedRecoveryCodes(ctx context.Context, tx *gorm.DB, userID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).Model(&domain.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) CreateChallenge(ctx context.Context, tx *gorm.DB, challenge domain.LoginChallenge) error {
+	return tx.WithContext(ctx).Omit("User").Create(&challenge).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) FindChallengeByTokenHashForUpdate(ctx context.Context, tx *gorm.DB, tokenHash string) (domain.LoginChallenge, error) {
+	var challenge domain.LoginChallenge
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", tokenHash).
+		First(&challenge).Error
+	if err != nil {
+		return domain.LoginChallenge{}, err
+	}
+	return challenge, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) UpdateChallenge(ctx context.Context, tx *gorm.DB, challenge domain.LoginChallenge) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&challenge).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) DeleteExpiredChallenges(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error {
+	return tx.WithContext(ctx).
+		Where("user_id = ? AND (expires_at < ? OR consumed_at IS NOT NULL)", userID, time.Now()).
+		Delete(&domain.LoginChallenge{}).Error
+}
+
+func (repository *TwoFactorRepositoryImpl) IsRequiredForRole(ctx context.Context, tx *gorm.DB, role domain.Role) (bool, error) {
+	var policy domain.TwoFactorPolicy
+
+	err := tx.WithContext(ctx).Where("role = ?", role).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return policy.Required, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) FindAllPolicies(ctx context.Context, tx *gorm.DB) ([]domain.TwoFactorPolicy, error) {
+	var policies []domain.TwoFactorPolicy
+
+	err := tx.WithContext(ctx).Order("role ASC").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (repository *TwoFactorRepositoryImpl) SavePolicy(ctx context.Context, tx *gorm.DB, policy domain.TwoFactorPolicy) error {
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "role"}},
+		DoUpdates: clause.AssignmentColumns([]string{"required", "updated_by", "updated_at"}),
+	}).Create(&policy).Error
+}
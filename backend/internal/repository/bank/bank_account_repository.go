@@ -0,0 +1,18 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BankAccountRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, account domain.BankAccount) (domain.BankAccount, error)
+	Update(ctx context.Context, tx *gorm.DB, account domain.BankAccount) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankAccount, error)
+	ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error)
+	ExistsByGLAccount(ctx context.Context, tx *gorm.DB, glAccountID uuid.UUID, excludeID *uuid.UUID) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, accountType string, activeOnly bool, search string, page, limit int) ([]domain.BankAccount, int64, error)
+}
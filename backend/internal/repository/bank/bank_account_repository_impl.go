@@ -0,0 +1,100 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BankAccountRepositoryImpl struct{}
+
+func NewBankAccountRepository() BankAccountRepository {
+	return &BankAccountRepositoryImpl{}
+}
+
+func (repository *BankAccountRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, account domain.BankAccount) (domain.BankAccount, error) {
+	err := tx.WithContext(ctx).Omit(clause.Associations).Create(&account).Error
+	if err != nil {
+		return domain.BankAccount{}, err
+	}
+	return account, nil
+}
+
+func (repository *BankAccountRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, account domain.BankAccount) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&account).Select("*").Omit("CreatedAt", "GLAccount").Updates(account).Error
+}
+
+func (repository *BankAccountRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankAccount, error) {
+	var account domain.BankAccount
+
+	err := tx.WithContext(ctx).Preload("GLAccount").Where("id = ?", id).First(&account).Error
+	if err != nil {
+		return domain.BankAccount{}, err
+	}
+	return account, nil
+}
+
+func (repository *BankAccountRepositoryImpl) ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.BankAccount{}).Where("code = ?", code)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *BankAccountRepositoryImpl) ExistsByGLAccount(ctx context.Context, tx *gorm.DB, glAccountID uuid.UUID, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.BankAccount{}).Where("gl_account_id = ?", glAccountID)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *BankAccountRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, accountType string, activeOnly bool, search string, page, limit int) ([]domain.BankAccount, int64, error) {
+	var accounts []domain.BankAccount
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.BankAccount{})
+	if accountType != "" {
+		query = query.Where("type = ?", accountType)
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ? OR account_number ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("GLAccount").Order("code ASC").Offset(offset).Limit(limit).Find(&accounts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, totalItems, nil
+}
@@ -0,0 +1,19 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BankBookRepository membaca penerimaan customer dan batch pembayaran supplier yang sudah
+// diposting ke akun buku besar sebuah rekening bank
+type BankBookRepository interface {
+	// FindUnmatched mengembalikan transaksi yang belum dicocokkan dengan mutasi rekening koran
+	FindUnmatched(ctx context.Context, tx *gorm.DB, glAccountID uuid.UUID, currency string, dateFrom, dateTo time.Time) ([]domain.BankBookTransaction, error)
+	FindBySource(ctx context.Context, tx *gorm.DB, sourceType string, sourceID uuid.UUID) (domain.BankBookTransaction, error)
+	IsMatched(ctx context.Context, tx *gorm.DB, sourceType string, sourceID uuid.UUID) (bool, error)
+}
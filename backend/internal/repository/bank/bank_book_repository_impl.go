@@ -0,0 +1,100 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const receiptColumns = "'" + domain.JournalSourceCustomerReceipt + "' AS source_type, r.id AS source_id, r.number, r.receipt_date AS transaction_date, r.reference, r.customer_name AS counterparty, r.deposit_account_id AS account_id, r.currency, r.amount"
+
+// Satu batch pembayaran menjadi satu transfer keluar, sehingga nominalnya bertanda negatif
+const paymentBatchColumns = "'" + domain.JournalSourcePaymentBatch + "' AS source_type, b.id AS source_id, p.number, p.payment_date AS transaction_date, b.account_number AS reference, b.supplier_name AS counterparty, p.payment_account_id AS account_id, p.currency, -b.total_amount AS amount"
+
+type BankBookRepositoryImpl struct{}
+
+func NewBankBookRepository() BankBookRepository {
+	return &BankBookRepositoryImpl{}
+}
+
+func (repository *BankBookRepositoryImpl) FindUnmatched(ctx context.Context, tx *gorm.DB, glAccountID uuid.UUID, currency string, dateFrom, dateTo time.Time) ([]domain.BankBookTransaction, error) {
+	var receipts []domain.BankBookTransaction
+
+	err := tx.WithContext(ctx).
+		Table("customer_receipts AS r").
+		Select(receiptColumns).
+		Where("r.status = ? AND r.deposit_account_id = ? AND r.currency = ?", domain.CustomerReceiptStatusPosted, glAccountID, currency).
+		Where("r.receipt_date BETWEEN ? AND ?", dateFrom, dateTo).
+		Where("NOT EXISTS (SELECT 1 FROM bank_statement_lines AS s WHERE s.matched_source_type = ? AND s.matched_source_id = r.id)", domain.JournalSourceCustomerReceipt).
+		Scan(&receipts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []domain.BankBookTransaction
+
+	err = tx.WithContext(ctx).
+		Table("payment_batches AS b").
+		Select(paymentBatchColumns).
+		Joins("JOIN payment_runs AS p ON p.id = b.payment_run_id").
+		Where("p.status = ? AND p.payment_account_id = ? AND p.currency = ?", domain.PaymentRunStatusPosted, glAccountID, currency).
+		Where("p.payment_date BETWEEN ? AND ?", dateFrom, dateTo).
+		Where("NOT EXISTS (SELECT 1 FROM bank_statement_lines AS s WHERE s.matched_source_type = ? AND s.matched_source_id = b.id)", domain.JournalSourcePaymentBatch).
+		Scan(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := append(receipts, payments...)
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if !transactions[i].TransactionDate.Equal(transactions[j].TransactionDate) {
+			return transactions[i].TransactionDate.Before(transactions[j].TransactionDate)
+		}
+		return transactions[i].Number < transactions[j].Number
+	})
+	return transactions, nil
+}
+
+func (repository *BankBookRepositoryImpl) FindBySource(ctx context.Context, tx *gorm.DB, sourceType string, sourceID uuid.UUID) (domain.BankBookTransaction, error) {
+	var transaction domain.BankBookTransaction
+
+	var query *gorm.DB
+	switch sourceType {
+	case domain.JournalSourceCustomerReceipt:
+		query = tx.WithContext(ctx).
+			Table("customer_receipts AS r").
+			Select(receiptColumns).
+			Where("r.id = ? AND r.status = ?", sourceID, domain.CustomerReceiptStatusPosted)
+	case domain.JournalSourcePaymentBatch:
+		query = tx.WithContext(ctx).
+			Table("payment_batches AS b").
+			Select(paymentBatchColumns).
+			Joins("JOIN payment_runs AS p ON p.id = b.payment_run_id").
+			Where("b.id = ? AND p.status = ?", sourceID, domain.PaymentRunStatusPosted)
+	default:
+		return domain.BankBookTransaction{}, gorm.ErrRecordNotFound
+	}
+
+	err := query.Take(&transaction).Error
+	if err != nil {
+		return domain.BankBookTransaction{}, err
+	}
+	return transaction, nil
+}
+
+func (repository *BankBookRepositoryImpl) IsMatched(ctx context.Context, tx *gorm.DB, sourceType string, sourceID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).
+		Model(&domain.BankStatementLine{}).
+		Where("matched_source_type = ? AND matched_source_id = ?", sourceType, sourceID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,25 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BankStatementRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, statement domain.BankStatement) (domain.BankStatement, error)
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankStatement, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, bankAccountID *uuid.UUID, page, limit int) ([]domain.BankStatement, int64, error)
+	ExistsForAccount(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID) (bool, error)
+	// FindExistingFingerprints mengembalikan fingerprint yang sudah tersimpan untuk rekening tersebut
+	FindExistingFingerprints(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, fingerprints []string) ([]string, error)
+
+	FindLineByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankStatementLine, error)
+	UpdateLine(ctx context.Context, tx *gorm.DB, line domain.BankStatementLine) error
+	// LockUnmatchedLines mengunci mutasi yang belum dicocokkan, opsional dibatasi satu rekening koran
+	LockUnmatchedLines(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, statementID *uuid.UUID) ([]domain.BankStatementLine, error)
+	FindLines(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, dateFrom, dateTo time.Time, status string) ([]domain.BankStatementLine, error)
+}
@@ -0,0 +1,143 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BankStatementRepositoryImpl struct{}
+
+func NewBankStatementRepository() BankStatementRepository {
+	return &BankStatementRepositoryImpl{}
+}
+
+func (repository *BankStatementRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, statement domain.BankStatement) (domain.BankStatement, error) {
+	err := tx.WithContext(ctx).Omit("BankAccount").Create(&statement).Error
+	if err != nil {
+		return domain.BankStatement{}, err
+	}
+	return statement, nil
+}
+
+func (repository *BankStatementRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankStatement, error) {
+	var statement domain.BankStatement
+
+	err := tx.WithContext(ctx).
+		Preload("BankAccount").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&statement).Error
+	if err != nil {
+		return domain.BankStatement{}, err
+	}
+	return statement, nil
+}
+
+func (repository *BankStatementRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, bankAccountID *uuid.UUID, page, limit int) ([]domain.BankStatement, int64, error) {
+	var statements []domain.BankStatement
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.BankStatement{})
+	if bankAccountID != nil {
+		query = query.Where("bank_account_id = ?", *bankAccountID)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("BankAccount").Order("period_end DESC, created_at DESC").Offset(offset).Limit(limit).Find(&statements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return statements, totalItems, nil
+}
+
+func (repository *BankStatementRepositoryImpl) ExistsForAccount(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).Model(&domain.BankStatement{}).Where("bank_account_id = ?", bankAccountID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *BankStatementRepositoryImpl) FindExistingFingerprints(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, fingerprints []string) ([]string, error) {
+	var existing []string
+	if len(fingerprints) == 0 {
+		return existing, nil
+	}
+
+	err := tx.WithContext(ctx).
+		Model(&domain.BankStatementLine{}).
+		Where("bank_account_id = ? AND fingerprint IN ?", bankAccountID, fingerprints).
+		Pluck("fingerprint", &existing).Error
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (repository *BankStatementRepositoryImpl) FindLineByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BankStatementLine, error) {
+	var line domain.BankStatementLine
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&line).Error
+	if err != nil {
+		return domain.BankStatementLine{}, err
+	}
+	return line, nil
+}
+
+func (repository *BankStatementRepositoryImpl) UpdateLine(ctx context.Context, tx *gorm.DB, line domain.BankStatementLine) error {
+	return tx.WithContext(ctx).Save(&line).Error
+}
+
+func (repository *BankStatementRepositoryImpl) LockUnmatchedLines(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, statementID *uuid.UUID) ([]domain.BankStatementLine, error) {
+	var lines []domain.BankStatementLine
+
+	query := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bank_account_id = ? AND status = ?", bankAccountID, domain.BankStatementLineStatusUnmatched)
+	if statementID != nil {
+		query = query.Where("bank_statement_id = ?", *statementID)
+	}
+
+	err := query.Order("transaction_date ASC, line_no ASC").Find(&lines).Error
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func (repository *BankStatementRepositoryImpl) FindLines(ctx context.Context, tx *gorm.DB, bankAccountID uuid.UUID, dateFrom, dateTo time.Time, status string) ([]domain.BankStatementLine, error) {
+	var lines []domain.BankStatementLine
+
+	query := tx.WithContext(ctx).
+		Where("bank_account_id = ?", bankAccountID).
+		Where("transaction_date BETWEEN ? AND ?", dateFrom, dateTo)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("transaction_date ASC, line_no ASC").Find(&lines).Error
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
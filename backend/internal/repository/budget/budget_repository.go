@@ -0,0 +1,28 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BudgetRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, budget domain.Budget) (domain.Budget, error)
+	Update(ctx context.Context, tx *gorm.DB, budget domain.Budget) error
+	// ReplaceLines menghapus seluruh baris budget lalu menyimpan baris yang baru
+	ReplaceLines(ctx context.Context, tx *gorm.DB, budgetID uuid.UUID, lines []domain.BudgetLine) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Budget, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Budget, error)
+	ExistsForCostCenter(ctx context.Context, tx *gorm.DB, costCenterID uuid.UUID, fiscalYear int, excludeID *uuid.UUID) (bool, error)
+	// FindActive mengembalikan budget berstatus Active untuk cost center dan tahun tersebut
+	FindActive(ctx context.Context, tx *gorm.DB, costCenterID uuid.UUID, fiscalYear int) (domain.Budget, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID *uuid.UUID, status string, page, limit int) ([]domain.Budget, int64, error)
+
+	// FindBudgetAmounts menjumlahkan anggaran budget Active dan Closed per cost center, akun dan bulan
+	FindBudgetAmounts(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID, accountID *uuid.UUID) ([]domain.BudgetAmount, error)
+	// FindActualAmounts menjumlahkan debit dikurangi kredit jurnal yang sudah diposting dan memiliki
+	// cost center, per cost center, akun dan bulan
+	FindActualAmounts(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID, accountID *uuid.UUID) ([]domain.BudgetAmount, error)
+}
@@ -0,0 +1,178 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BudgetRepositoryImpl struct{}
+
+func NewBudgetRepository() BudgetRepository {
+	return &BudgetRepositoryImpl{}
+}
+
+func (repository *BudgetRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, budget domain.Budget) (domain.Budget, error) {
+	err := tx.WithContext(ctx).Omit("CostCenter", "Lines.Account").Create(&budget).Error
+	if err != nil {
+		return domain.Budget{}, err
+	}
+	return budget, nil
+}
+
+func (repository *BudgetRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, budget domain.Budget) error {
+	return tx.WithContext(ctx).Model(&budget).Select("*").Omit("CreatedAt", "CostCenter", "Lines").Updates(budget).Error
+}
+
+func (repository *BudgetRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, budgetID uuid.UUID, lines []domain.BudgetLine) error {
+	err := tx.WithContext(ctx).Where("budget_id = ?", budgetID).Delete(&domain.BudgetLine{}).Error
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Omit("Account").Create(&lines).Error
+}
+
+func (repository *BudgetRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Budget, error) {
+	var budget domain.Budget
+
+	err := tx.WithContext(ctx).
+		Preload("CostCenter").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("month ASC")
+		}).
+		Preload("Lines.Account").
+		Where("id = ?", id).
+		First(&budget).Error
+	if err != nil {
+		return domain.Budget{}, err
+	}
+	return budget, nil
+}
+
+func (repository *BudgetRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Budget, error) {
+	var budget domain.Budget
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&budget).Error
+	if err != nil {
+		return domain.Budget{}, err
+	}
+	return budget, nil
+}
+
+func (repository *BudgetRepositoryImpl) ExistsForCostCenter(ctx context.Context, tx *gorm.DB, costCenterID uuid.UUID, fiscalYear int, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.Budget{}).
+		Where("cost_center_id = ? AND fiscal_year = ?", costCenterID, fiscalYear)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *BudgetRepositoryImpl) FindActive(ctx context.Context, tx *gorm.DB, costCenterID uuid.UUID, fiscalYear int) (domain.Budget, error) {
+	var budget domain.Budget
+
+	err := tx.WithContext(ctx).
+		Preload("CostCenter").
+		Where("cost_center_id = ? AND fiscal_year = ? AND status = ?", costCenterID, fiscalYear, domain.BudgetStatusActive).
+		First(&budget).Error
+	if err != nil {
+		return domain.Budget{}, err
+	}
+	return budget, nil
+}
+
+func (repository *BudgetRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID *uuid.UUID, status string, page, limit int) ([]domain.Budget, int64, error) {
+	var budgets []domain.Budget
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Budget{})
+	if fiscalYear > 0 {
+		query = query.Where("fiscal_year = ?", fiscalYear)
+	}
+	if costCenterID != nil {
+		query = query.Where("cost_center_id = ?", *costCenterID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("CostCenter").Order("fiscal_year DESC, number ASC").Offset(offset).Limit(limit).Find(&budgets).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return budgets, totalItems, nil
+}
+
+func (repository *BudgetRepositoryImpl) FindBudgetAmounts(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID, accountID *uuid.UUID) ([]domain.BudgetAmount, error) {
+	var amounts []domain.BudgetAmount
+
+	query := tx.WithContext(ctx).
+		Table("budget_lines AS l").
+		Select("b.cost_center_id, l.account_id, l.month, SUM(l.amount) AS amount").
+		Joins("JOIN budgets AS b ON b.id = l.budget_id").
+		Where("b.fiscal_year = ?", fiscalYear).
+		Where("b.status IN ?", []domain.BudgetStatus{domain.BudgetStatusActive, domain.BudgetStatusClosed})
+	if costCenterID != nil {
+		query = query.Where("b.cost_center_id = ?", *costCenterID)
+	}
+	if accountID != nil {
+		query = query.Where("l.account_id = ?", *accountID)
+	}
+
+	err := query.Group("b.cost_center_id, l.account_id, l.month").Scan(&amounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return amounts, nil
+}
+
+func (repository *BudgetRepositoryImpl) FindActualAmounts(ctx context.Context, tx *gorm.DB, fiscalYear int, costCenterID, accountID *uuid.UUID) ([]domain.BudgetAmount, error) {
+	var amounts []domain.BudgetAmount
+
+	yearStart := time.Date(fiscalYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	query := tx.WithContext(ctx).
+		Table("journal_lines AS l").
+		Select("l.cost_center_id, l.account_id, CAST(EXTRACT(MONTH FROM e.entry_date) AS INTEGER) AS month, SUM(l.debit - l.credit) AS amount").
+		Joins("JOIN journal_entries AS e ON e.id = l.journal_entry_id").
+		Where("e.status IN ?", []domain.JournalStatus{domain.JournalStatusPosted, domain.JournalStatusReversed}).
+		Where("e.entry_date >= ? AND e.entry_date < ?", yearStart, yearStart.AddDate(1, 0, 0)).
+		Where("l.cost_center_id IS NOT NULL")
+	if costCenterID != nil {
+		query = query.Where("l.cost_center_id = ?", *costCenterID)
+	}
+	if accountID != nil {
+		query = query.Where("l.account_id = ?", *accountID)
+	}
+
+	err := query.Group("l.cost_center_id, l.account_id, CAST(EXTRACT(MONTH FROM e.entry_date) AS INTEGER)").Scan(&amounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return amounts, nil
+}
@@ -0,0 +1,19 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CostCenterRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, costCenter domain.CostCenter) (domain.CostCenter, error)
+	Update(ctx context.Context, tx *gorm.DB, costCenter domain.CostCenter) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CostCenter, error)
+	FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.CostCenter, error)
+	ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error)
+	HasActiveChildren(ctx context.Context, tx *gorm.DB, id uuid.UUID) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, parentID *uuid.UUID, activeOnly bool, search string, page, limit int) ([]domain.CostCenter, int64, error)
+}
@@ -0,0 +1,106 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CostCenterRepositoryImpl struct{}
+
+func NewCostCenterRepository() CostCenterRepository {
+	return &CostCenterRepositoryImpl{}
+}
+
+func (repository *CostCenterRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, costCenter domain.CostCenter) (domain.CostCenter, error) {
+	err := tx.WithContext(ctx).Omit("Parent").Create(&costCenter).Error
+	if err != nil {
+		return domain.CostCenter{}, err
+	}
+	return costCenter, nil
+}
+
+func (repository *CostCenterRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, costCenter domain.CostCenter) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&costCenter).Select("*").Omit("CreatedAt", "Parent").Updates(costCenter).Error
+}
+
+func (repository *CostCenterRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CostCenter, error) {
+	var costCenter domain.CostCenter
+
+	err := tx.WithContext(ctx).Preload("Parent").Where("id = ?", id).First(&costCenter).Error
+	if err != nil {
+		return domain.CostCenter{}, err
+	}
+	return costCenter, nil
+}
+
+func (repository *CostCenterRepositoryImpl) FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.CostCenter, error) {
+	var costCenters []domain.CostCenter
+
+	err := tx.WithContext(ctx).Where("id IN ?", ids).Find(&costCenters).Error
+	if err != nil {
+		return nil, err
+	}
+	return costCenters, nil
+}
+
+func (repository *CostCenterRepositoryImpl) ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.CostCenter{}).Where("code = ?", code)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *CostCenterRepositoryImpl) HasActiveChildren(ctx context.Context, tx *gorm.DB, id uuid.UUID) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).Model(&domain.CostCenter{}).
+		Where("parent_id = ? AND is_active = ?", id, true).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *CostCenterRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, parentID *uuid.UUID, activeOnly bool, search string, page, limit int) ([]domain.CostCenter, int64, error) {
+	var costCenters []domain.CostCenter
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.CostCenter{})
+	if parentID != nil {
+		query = query.Where("parent_id = ?", *parentID)
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ? OR manager_name ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Parent").Order("code ASC").Offset(offset).Limit(limit).Find(&costCenters).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return costCenters, totalItems, nil
+}
@@ -0,0 +1,15 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type CurrencyRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, currency domain.Currency) (domain.Currency, error)
+	Update(ctx context.Context, tx *gorm.DB, currency domain.Currency) error
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Currency, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, activeOnly bool, search string, page, limit int) ([]domain.Currency, int64, error)
+}
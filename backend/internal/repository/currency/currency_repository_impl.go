@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type CurrencyRepositoryImpl struct{}
+
+func NewCurrencyRepository() CurrencyRepository {
+	return &CurrencyRepositoryImpl{}
+}
+
+func (repository *CurrencyRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, currency domain.Currency) (domain.Currency, error) {
+	err := tx.WithContext(ctx).Create(&currency).Error
+	if err != nil {
+		return domain.Currency{}, err
+	}
+	return currency, nil
+}
+
+func (repository *CurrencyRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, currency domain.Currency) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&currency).Select("*").Omit("CreatedAt").Updates(currency).Error
+}
+
+func (repository *CurrencyRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Currency, error) {
+	var currency domain.Currency
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&currency).Error
+	if err != nil {
+		return domain.Currency{}, err
+	}
+	return currency, nil
+}
+
+func (repository *CurrencyRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, activeOnly bool, search string, page, limit int) ([]domain.Currency, int64, error) {
+	var currencies []domain.Currency
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Currency{})
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&currencies).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return currencies, totalItems, nil
+}
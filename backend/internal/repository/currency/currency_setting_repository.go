@@ -0,0 +1,13 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type CurrencySettingRepository interface {
+	Find(ctx context.Context, tx *gorm.DB) (domain.CurrencySetting, error)
+	Save(ctx context.Context, tx *gorm.DB, setting domain.CurrencySetting) error
+}
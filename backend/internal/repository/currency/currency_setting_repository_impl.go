@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type CurrencySettingRepositoryImpl struct{}
+
+func NewCurrencySettingRepository() CurrencySettingRepository {
+	return &CurrencySettingRepositoryImpl{}
+}
+
+func (repository *CurrencySettingRepositoryImpl) Find(ctx context.Context, tx *gorm.DB) (domain.CurrencySetting, error) {
+	var setting domain.CurrencySetting
+
+	err := tx.WithContext(ctx).Where("id = ?", domain.CurrencySettingID).First(&setting).Error
+	if err != nil {
+		return domain.CurrencySetting{}, err
+	}
+	return setting, nil
+}
+
+func (repository *CurrencySettingRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, setting domain.CurrencySetting) error {
+	setting.ID = domain.CurrencySettingID
+	return tx.WithContext(ctx).Save(&setting).Error
+}
@@ -0,0 +1,16 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ExchangeRateRepository interface {
+	Upsert(ctx context.Context, tx *gorm.DB, rates []domain.ExchangeRate) error
+	FindByCurrencyAndDate(ctx context.Context, tx *gorm.DB, currencyCode string, rateDate time.Time) (domain.ExchangeRate, error)
+	FindLatestOn(ctx context.Context, tx *gorm.DB, currencyCode string, date time.Time) (domain.ExchangeRate, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, currencyCode string, dateFrom, dateTo *time.Time, page, limit int) ([]domain.ExchangeRate, int64, error)
+}
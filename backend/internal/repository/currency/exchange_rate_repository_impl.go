@@ -0,0 +1,80 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExchangeRateRepositoryImpl struct{}
+
+func NewExchangeRateRepository() ExchangeRateRepository {
+	return &ExchangeRateRepositoryImpl{}
+}
+
+func (repository *ExchangeRateRepositoryImpl) Upsert(ctx context.Context, tx *gorm.DB, rates []domain.ExchangeRate) error {
+	// Kurs untuk mata uang dan tanggal yang sama ditimpa dengan nilai terbaru
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "currency_code"}, {Name: "rate_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "source", "created_by", "updated_at"}),
+	}).Create(&rates).Error
+}
+
+func (repository *ExchangeRateRepositoryImpl) FindByCurrencyAndDate(ctx context.Context, tx *gorm.DB, currencyCode string, rateDate time.Time) (domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+
+	err := tx.WithContext(ctx).
+		Where("currency_code = ? AND rate_date = ?", currencyCode, rateDate).
+		First(&rate).Error
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (repository *ExchangeRateRepositoryImpl) FindLatestOn(ctx context.Context, tx *gorm.DB, currencyCode string, date time.Time) (domain.ExchangeRate, error) {
+	var rate domain.ExchangeRate
+
+	err := tx.WithContext(ctx).
+		Where("currency_code = ? AND rate_date <= ?", currencyCode, date).
+		Order("rate_date DESC").
+		First(&rate).Error
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (repository *ExchangeRateRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, currencyCode string, dateFrom, dateTo *time.Time, page, limit int) ([]domain.ExchangeRate, int64, error) {
+	var rates []domain.ExchangeRate
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.ExchangeRate{})
+	if currencyCode != "" {
+		query = query.Where("currency_code = ?", currencyCode)
+	}
+	if dateFrom != nil {
+		query = query.Where("rate_date >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		query = query.Where("rate_date <= ?", *dateTo)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("rate_date DESC, currency_code ASC").Offset(offset).Limit(limit).Find(&rates).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rates, totalItems, nil
+}
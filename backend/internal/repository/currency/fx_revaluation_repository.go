@@ -0,0 +1,18 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FXRevaluationRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, revaluation domain.FXRevaluation) (domain.FXRevaluation, error)
+	Update(ctx context.Context, tx *gorm.DB, revaluation domain.FXRevaluation) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FXRevaluation, error)
+	ExistsOnDate(ctx context.Context, tx *gorm.DB, revaluationDate time.Time) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, dateFrom, dateTo *time.Time, page, limit int) ([]domain.FXRevaluation, int64, error)
+}
@@ -0,0 +1,85 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FXRevaluationRepositoryImpl struct{}
+
+func NewFXRevaluationRepository() FXRevaluationRepository {
+	return &FXRevaluationRepositoryImpl{}
+}
+
+func (repository *FXRevaluationRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, revaluation domain.FXRevaluation) (domain.FXRevaluation, error) {
+	err := tx.WithContext(ctx).Create(&revaluation).Error
+	if err != nil {
+		return domain.FXRevaluation{}, err
+	}
+	return revaluation, nil
+}
+
+func (repository *FXRevaluationRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, revaluation domain.FXRevaluation) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&revaluation).Error
+}
+
+func (repository *FXRevaluationRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FXRevaluation, error) {
+	var revaluation domain.FXRevaluation
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("source_type ASC, currency ASC, number ASC")
+		}).
+		Where("id = ?", id).
+		First(&revaluation).Error
+	if err != nil {
+		return domain.FXRevaluation{}, err
+	}
+	return revaluation, nil
+}
+
+func (repository *FXRevaluationRepositoryImpl) ExistsOnDate(ctx context.Context, tx *gorm.DB, revaluationDate time.Time) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).
+		Model(&domain.FXRevaluation{}).
+		Where("revaluation_date = ?", revaluationDate).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *FXRevaluationRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, dateFrom, dateTo *time.Time, page, limit int) ([]domain.FXRevaluation, int64, error) {
+	var revaluations []domain.FXRevaluation
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.FXRevaluation{})
+	if dateFrom != nil {
+		query = query.Where("revaluation_date >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		query = query.Where("revaluation_date <= ?", *dateTo)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("revaluation_date DESC").Offset(offset).Limit(limit).Find(&revaluations).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return revaluations, totalItems, nil
+}
@@ -0,0 +1,23 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ItemRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, item domain.Item) (domain.Item, error)
+	Update(ctx context.Context, tx *gorm.DB, item domain.Item) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Item, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Item, error)
+	FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Item, error)
+
+	// LockByIds mengunci row item (SELECT ... FOR UPDATE) dengan urutan id yang tetap
+	// agar mutasi stok keluar untuk item yang sama berjalan bergantian tanpa deadlock
+	LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Item, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Item, int64, error)
+}
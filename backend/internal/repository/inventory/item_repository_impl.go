@@ -0,0 +1,98 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ItemRepositoryImpl struct{}
+
+func NewItemRepository() ItemRepository {
+	return &ItemRepositoryImpl{}
+}
+
+func (repository *ItemRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, item domain.Item) (domain.Item, error) {
+	err := tx.WithContext(ctx).Create(&item).Error
+	if err != nil {
+		return domain.Item{}, err
+	}
+	return item, nil
+}
+
+func (repository *ItemRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, item domain.Item) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&item).Select("*").Omit("CreatedAt").Updates(item).Error
+}
+
+func (repository *ItemRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Item, error) {
+	var item domain.Item
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&item).Error
+	if err != nil {
+		return domain.Item{}, err
+	}
+	return item, nil
+}
+
+func (repository *ItemRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Item, error) {
+	var item domain.Item
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&item).Error
+	if err != nil {
+		return domain.Item{}, err
+	}
+	return item, nil
+}
+
+func (repository *ItemRepositoryImpl) FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Item, error) {
+	var items []domain.Item
+
+	err := tx.WithContext(ctx).Where("id IN ?", ids).Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (repository *ItemRepositoryImpl) LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Item, error) {
+	var items []domain.Item
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (repository *ItemRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Item, int64, error) {
+	var items []domain.Item
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Item{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&items).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, totalItems, nil
+}
@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StockMovementRepository sengaja tidak menyediakan Update maupun Delete karena
+// mutasi stok bersifat append-only; koreksi dilakukan dengan mutasi Adjustment.
+type StockMovementRepository interface {
+	CreateMany(ctx context.Context, tx *gorm.DB, movements []domain.StockMovement) error
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, itemID, warehouseID *uuid.UUID, movementType string, dateFrom, dateTo *time.Time, page, limit int) ([]domain.StockMovement, int64, error)
+
+	// SumOnHand menghitung saldo on-hand satu item di satu lokasi. binID nil berarti
+	// stok yang tidak ditempatkan di bin manapun.
+	SumOnHand(ctx context.Context, tx *gorm.DB, itemID, warehouseID uuid.UUID, binID *uuid.UUID) (float64, error)
+
+	FindBalances(ctx context.Context, tx *gorm.DB, itemID, warehouseID *uuid.UUID) ([]domain.StockBalance, error)
+}
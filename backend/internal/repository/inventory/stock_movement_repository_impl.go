@@ -0,0 +1,109 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type StockMovementRepositoryImpl struct{}
+
+func NewStockMovementRepository() StockMovementRepository {
+	return &StockMovementRepositoryImpl{}
+}
+
+func (repository *StockMovementRepositoryImpl) CreateMany(ctx context.Context, tx *gorm.DB, movements []domain.StockMovement) error {
+	return tx.WithContext(ctx).Omit("Item", "Warehouse", "Bin").Create(&movements).Error
+}
+
+func (repository *StockMovementRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, itemID, warehouseID *uuid.UUID, movementType string, dateFrom, dateTo *time.Time, page, limit int) ([]domain.StockMovement, int64, error) {
+	var movements []domain.StockMovement
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.StockMovement{})
+	if itemID != nil {
+		query = query.Where("item_id = ?", *itemID)
+	}
+	if warehouseID != nil {
+		query = query.Where("warehouse_id = ?", *warehouseID)
+	}
+	if movementType != "" {
+		query = query.Where("movement_type = ?", movementType)
+	}
+	if dateFrom != nil {
+		query = query.Where("movement_date >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		query = query.Where("movement_date <= ?", *dateTo)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Preload("Item").
+		Preload("Warehouse").
+		Preload("Bin").
+		Order("movement_date DESC, created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&movements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return movements, totalItems, nil
+}
+
+func (repository *StockMovementRepositoryImpl) SumOnHand(ctx context.Context, tx *gorm.DB, itemID, warehouseID uuid.UUID, binID *uuid.UUID) (float64, error) {
+	var total float64
+
+	query := tx.WithContext(ctx).Model(&domain.StockMovement{}).
+		Where("item_id = ? AND warehouse_id = ?", itemID, warehouseID)
+	if binID != nil {
+		query = query.Where("bin_id = ?", *binID)
+	} else {
+		query = query.Where("bin_id IS NULL")
+	}
+
+	err := query.Select("COALESCE(SUM(quantity), 0)").Scan(&total).Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (repository *StockMovementRepositoryImpl) FindBalances(ctx context.Context, tx *gorm.DB, itemID, warehouseID *uuid.UUID) ([]domain.StockBalance, error) {
+	var balances []domain.StockBalance
+
+	query := tx.WithContext(ctx).Table("stock_movements AS m").
+		Select(`m.item_id, i.code AS item_code, i.name AS item_name, i.uom,
+			m.warehouse_id, w.code AS warehouse_code, m.bin_id, b.code AS bin_code,
+			SUM(m.quantity) AS quantity`).
+		Joins("JOIN items i ON i.id = m.item_id").
+		Joins("JOIN warehouses w ON w.id = m.warehouse_id").
+		Joins("LEFT JOIN warehouse_bins b ON b.id = m.bin_id")
+	if itemID != nil {
+		query = query.Where("m.item_id = ?", *itemID)
+	}
+	if warehouseID != nil {
+		query = query.Where("m.warehouse_id = ?", *warehouseID)
+	}
+
+	err := query.
+		Group("m.item_id, i.code, i.name, i.uom, m.warehouse_id, w.code, m.bin_id, b.code").
+		Having("SUM(m.quantity) <> 0").
+		Order("i.code, w.code, b.code").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, err
+	}
+	return balances, nil
+}
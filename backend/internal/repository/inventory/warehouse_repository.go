@@ -0,0 +1,20 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WarehouseRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, warehouse domain.Warehouse) (domain.Warehouse, error)
+	Update(ctx context.Context, tx *gorm.DB, warehouse domain.Warehouse) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Warehouse, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Warehouse, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Warehouse, int64, error)
+
+	CreateBin(ctx context.Context, tx *gorm.DB, bin domain.WarehouseBin) (domain.WarehouseBin, error)
+	FindBinByCode(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID, code string) (domain.WarehouseBin, error)
+}
@@ -0,0 +1,96 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WarehouseRepositoryImpl struct{}
+
+func NewWarehouseRepository() WarehouseRepository {
+	return &WarehouseRepositoryImpl{}
+}
+
+func (repository *WarehouseRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, warehouse domain.Warehouse) (domain.Warehouse, error) {
+	err := tx.WithContext(ctx).Create(&warehouse).Error
+	if err != nil {
+		return domain.Warehouse{}, err
+	}
+	return warehouse, nil
+}
+
+func (repository *WarehouseRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, warehouse domain.Warehouse) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&warehouse).Select("*").Omit("CreatedAt", "Bins").Updates(warehouse).Error
+}
+
+func (repository *WarehouseRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Warehouse, error) {
+	var warehouse domain.Warehouse
+
+	err := tx.WithContext(ctx).
+		Preload("Bins", func(db *gorm.DB) *gorm.DB {
+			return db.Order("code ASC")
+		}).
+		Where("id = ?", id).
+		First(&warehouse).Error
+	if err != nil {
+		return domain.Warehouse{}, err
+	}
+	return warehouse, nil
+}
+
+func (repository *WarehouseRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Warehouse, error) {
+	var warehouse domain.Warehouse
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&warehouse).Error
+	if err != nil {
+		return domain.Warehouse{}, err
+	}
+	return warehouse, nil
+}
+
+func (repository *WarehouseRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Warehouse, int64, error) {
+	var warehouses []domain.Warehouse
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Warehouse{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&warehouses).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return warehouses, totalItems, nil
+}
+
+func (repository *WarehouseRepositoryImpl) CreateBin(ctx context.Context, tx *gorm.DB, bin domain.WarehouseBin) (domain.WarehouseBin, error) {
+	err := tx.WithContext(ctx).Create(&bin).Error
+	if err != nil {
+		return domain.WarehouseBin{}, err
+	}
+	return bin, nil
+}
+
+func (repository *WarehouseRepositoryImpl) FindBinByCode(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID, code string) (domain.WarehouseBin, error) {
+	var bin domain.WarehouseBin
+
+	err := tx.WithContext(ctx).Where("warehouse_id = ? AND code = ?", warehouseID, code).First(&bin).Error
+	if err != nil {
+		return domain.WarehouseBin{}, err
+	}
+	return bin, nil
+}
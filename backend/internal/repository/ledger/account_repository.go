@@ -0,0 +1,20 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AccountRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, account domain.Account) (domain.Account, error)
+	Update(ctx context.Context, tx *gorm.DB, account domain.Account) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Account, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Account, error)
+	FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Account, error)
+	FindAll(ctx context.Context, tx *gorm.DB) ([]domain.Account, error)
+	CountChildren(ctx context.Context, tx *gorm.DB, id uuid.UUID) (int64, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, accountType domain.AccountType, page, limit int) ([]domain.Account, int64, error)
+}
@@ -0,0 +1,106 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AccountRepositoryImpl struct{}
+
+func NewAccountRepository() AccountRepository {
+	return &AccountRepositoryImpl{}
+}
+
+func (repository *AccountRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, account domain.Account) (domain.Account, error) {
+	err := tx.WithContext(ctx).Create(&account).Error
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return account, nil
+}
+
+func (repository *AccountRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, account domain.Account) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&account).Select("*").Omit("CreatedAt").Updates(account).Error
+}
+
+func (repository *AccountRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Account, error) {
+	var account domain.Account
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&account).Error
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return account, nil
+}
+
+func (repository *AccountRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Account, error) {
+	var account domain.Account
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&account).Error
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return account, nil
+}
+
+func (repository *AccountRepositoryImpl) FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Account, error) {
+	var accounts []domain.Account
+
+	err := tx.WithContext(ctx).Where("id IN ?", ids).Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (repository *AccountRepositoryImpl) FindAll(ctx context.Context, tx *gorm.DB) ([]domain.Account, error) {
+	var accounts []domain.Account
+
+	err := tx.WithContext(ctx).Order("code ASC").Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (repository *AccountRepositoryImpl) CountChildren(ctx context.Context, tx *gorm.DB, id uuid.UUID) (int64, error) {
+	var total int64
+
+	err := tx.WithContext(ctx).Model(&domain.Account{}).Where("parent_id = ?", id).Count(&total).Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (repository *AccountRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, accountType domain.AccountType, page, limit int) ([]domain.Account, int64, error) {
+	var accounts []domain.Account
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Account{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+	if accountType != "" {
+		query = query.Where("type = ?", accountType)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&accounts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, totalItems, nil
+}
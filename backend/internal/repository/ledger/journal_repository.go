@@ -0,0 +1,27 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type JournalRepository interface {
+	// Create menyimpan header jurnal beserta baris-barisnya
+	Create(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) (domain.JournalEntry, error)
+
+	// UpdateStatus mengubah status dan informasi posting jurnal
+	UpdateStatus(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) error
+
+	// FindById mencari jurnal beserta baris dan akunnya
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.JournalEntry, error)
+
+	// FindByIdForUpdate sama seperti FindById tetapi mengunci row jurnal (SELECT ... FOR UPDATE)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.JournalEntry, error)
+
+	// FindAllWithPagination mencari jurnal dengan filter status dan rentang tanggal
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status domain.JournalStatus, dateFrom, dateTo *time.Time, page, limit int) ([]domain.JournalEntry, int64, error)
+}
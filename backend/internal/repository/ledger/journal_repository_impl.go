@@ -0,0 +1,109 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JournalRepositoryImpl struct{}
+
+func NewJournalRepository() JournalRepository {
+	return &JournalRepositoryImpl{}
+}
+
+func (repository *JournalRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) (domain.JournalEntry, error) {
+	err := tx.WithContext(ctx).Omit("Lines.Account").Create(&entry).Error
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	return entry, nil
+}
+
+func (repository *JournalRepositoryImpl) UpdateStatus(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) error {
+	return tx.WithContext(ctx).Model(&domain.JournalEntry{}).
+		Where("id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"status":    entry.Status,
+			"posted_by": entry.PostedBy,
+			"posted_at": entry.PostedAt,
+		}).Error
+}
+
+func (repository *JournalRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.JournalEntry, error) {
+	var entry domain.JournalEntry
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Lines.Account").
+		Preload("Lines.CostCenter").
+		Where("id = ?", id).
+		First(&entry).Error
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	return entry, nil
+}
+
+func (repository *JournalRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.JournalEntry, error) {
+	var entry domain.JournalEntry
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&entry).Error
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+
+	err = tx.WithContext(ctx).
+		Preload("Account").
+		Where("journal_entry_id = ?", id).
+		Order("line_no ASC").
+		Find(&entry.Lines).Error
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	return entry, nil
+}
+
+func (repository *JournalRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status domain.JournalStatus, dateFrom, dateTo *time.Time, page, limit int) ([]domain.JournalEntry, int64, error) {
+	var entries []domain.JournalEntry
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.JournalEntry{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if dateFrom != nil {
+		query = query.Where("entry_date >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		query = query.Where("entry_date <= ?", *dateTo)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination, baris jurnal ikut dimuat untuk menghitung total
+	offset := (page - 1) * limit
+	err = query.
+		Preload("Lines").
+		Order("entry_date DESC, entry_number DESC").
+		Offset(offset).Limit(limit).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalItems, nil
+}
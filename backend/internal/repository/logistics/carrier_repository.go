@@ -0,0 +1,17 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CarrierRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, carrier domain.Carrier) (domain.Carrier, error)
+	Update(ctx context.Context, tx *gorm.DB, carrier domain.Carrier) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Carrier, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Carrier, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Carrier, int64, error)
+}
@@ -0,0 +1,73 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CarrierRepositoryImpl struct{}
+
+func NewCarrierRepository() CarrierRepository {
+	return &CarrierRepositoryImpl{}
+}
+
+func (repository *CarrierRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, carrier domain.Carrier) (domain.Carrier, error) {
+	err := tx.WithContext(ctx).Create(&carrier).Error
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+	return carrier, nil
+}
+
+func (repository *CarrierRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, carrier domain.Carrier) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&carrier).Select("*").Omit("CreatedAt").Updates(carrier).Error
+}
+
+func (repository *CarrierRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Carrier, error) {
+	var carrier domain.Carrier
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&carrier).Error
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+	return carrier, nil
+}
+
+func (repository *CarrierRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Carrier, error) {
+	var carrier domain.Carrier
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&carrier).Error
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+	return carrier, nil
+}
+
+func (repository *CarrierRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.Carrier, int64, error) {
+	var carriers []domain.Carrier
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Carrier{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&carriers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return carriers, totalItems, nil
+}
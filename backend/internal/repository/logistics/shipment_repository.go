@@ -0,0 +1,26 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ShipmentRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, shipment domain.Shipment) (domain.Shipment, error)
+	Update(ctx context.Context, tx *gorm.DB, shipment domain.Shipment) error
+
+	// ReplaceLines mengganti seluruh baris pengiriman (hanya saat status Planned)
+	ReplaceLines(ctx context.Context, tx *gorm.DB, shipmentID uuid.UUID, lines []domain.ShipmentLine) error
+	// ReplacePackages mengganti seluruh koli pengiriman
+	ReplacePackages(ctx context.Context, tx *gorm.DB, shipmentID uuid.UUID, packages []domain.ShipmentPackage) error
+	UpdateLinePackages(ctx context.Context, tx *gorm.DB, lines []domain.ShipmentLine) error
+	CreateEvent(ctx context.Context, tx *gorm.DB, event domain.ShipmentEvent) error
+	CreateAttachments(ctx context.Context, tx *gorm.DB, attachments []domain.ShipmentAttachment) error
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Shipment, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Shipment, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, carrierID, customerID *uuid.UUID, search string, page, limit int) ([]domain.Shipment, int64, error)
+}
@@ -0,0 +1,152 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ShipmentRepositoryImpl struct{}
+
+func NewShipmentRepository() ShipmentRepository {
+	return &ShipmentRepositoryImpl{}
+}
+
+func (repository *ShipmentRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, shipment domain.Shipment) (domain.Shipment, error) {
+	err := tx.WithContext(ctx).Create(&shipment).Error
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+func (repository *ShipmentRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, shipment domain.Shipment) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&shipment).Error
+}
+
+func (repository *ShipmentRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, shipmentID uuid.UUID, lines []domain.ShipmentLine) error {
+	err := tx.WithContext(ctx).Where("shipment_id = ?", shipmentID).Delete(&domain.ShipmentLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *ShipmentRepositoryImpl) ReplacePackages(ctx context.Context, tx *gorm.DB, shipmentID uuid.UUID, packages []domain.ShipmentPackage) error {
+	err := tx.WithContext(ctx).Where("shipment_id = ?", shipmentID).Delete(&domain.ShipmentPackage{}).Error
+	if err != nil {
+		return err
+	}
+	if len(packages) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&packages).Error
+}
+
+func (repository *ShipmentRepositoryImpl) UpdateLinePackages(ctx context.Context, tx *gorm.DB, lines []domain.ShipmentLine) error {
+	for _, line := range lines {
+		err := tx.WithContext(ctx).Model(&domain.ShipmentLine{}).
+			Where("id = ?", line.ID).
+			Update("package_no", line.PackageNo).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *ShipmentRepositoryImpl) CreateEvent(ctx context.Context, tx *gorm.DB, event domain.ShipmentEvent) error {
+	return tx.WithContext(ctx).Create(&event).Error
+}
+
+func (repository *ShipmentRepositoryImpl) CreateAttachments(ctx context.Context, tx *gorm.DB, attachments []domain.ShipmentAttachment) error {
+	return tx.WithContext(ctx).Create(&attachments).Error
+}
+
+func (repository *ShipmentRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Shipment, error) {
+	var shipment domain.Shipment
+
+	err := tx.WithContext(ctx).
+		Preload("Warehouse").
+		Preload("Carrier").
+		Preload("Customer").
+		Preload("SalesOrder").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Lines.Item").
+		Preload("Packages", func(db *gorm.DB) *gorm.DB {
+			return db.Order("package_no ASC")
+		}).
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("event_at ASC, created_at ASC")
+		}).
+		Preload("Attachments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&shipment).Error
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+func (repository *ShipmentRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Shipment, error) {
+	var shipment domain.Shipment
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&shipment).Error
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("shipment_id = ?", id).Order("line_no ASC").Find(&shipment.Lines).Error
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+func (repository *ShipmentRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, carrierID, customerID *uuid.UUID, search string, page, limit int) ([]domain.Shipment, int64, error) {
+	var shipments []domain.Shipment
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Shipment{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if carrierID != nil {
+		query = query.Where("carrier_id = ?", *carrierID)
+	}
+	if customerID != nil {
+		query = query.Where("customer_id = ?", *customerID)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR tracking_number ILIKE ? OR recipient_name ILIKE ? OR reference ILIKE ?",
+			"%"+search+"%", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Warehouse").Preload("Carrier").Preload("Customer").Preload("SalesOrder").
+		Order("shipment_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&shipments).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return shipments, totalItems, nil
+}
@@ -0,0 +1,13 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type MatchToleranceRepository interface {
+	Find(ctx context.Context, tx *gorm.DB) (domain.MatchTolerance, error)
+	Save(ctx context.Context, tx *gorm.DB, tolerance domain.MatchTolerance) error
+}
@@ -0,0 +1,29 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type MatchToleranceRepositoryImpl struct{}
+
+func NewMatchToleranceRepository() MatchToleranceRepository {
+	return &MatchToleranceRepositoryImpl{}
+}
+
+func (repository *MatchToleranceRepositoryImpl) Find(ctx context.Context, tx *gorm.DB) (domain.MatchTolerance, error) {
+	var tolerance domain.MatchTolerance
+
+	err := tx.WithContext(ctx).Where("id = ?", domain.MatchToleranceID).First(&tolerance).Error
+	if err != nil {
+		return domain.MatchTolerance{}, err
+	}
+	return tolerance, nil
+}
+
+func (repository *MatchToleranceRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, tolerance domain.MatchTolerance) error {
+	tolerance.ID = domain.MatchToleranceID
+	return tx.WithContext(ctx).Save(&tolerance).Error
+}
@@ -0,0 +1,13 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type PayableSettingRepository interface {
+	Find(ctx context.Context, tx *gorm.DB) (domain.PayableSetting, error)
+	Save(ctx context.Context, tx *gorm.DB, setting domain.PayableSetting) error
+}
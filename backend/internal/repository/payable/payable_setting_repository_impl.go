@@ -0,0 +1,29 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type PayableSettingRepositoryImpl struct{}
+
+func NewPayableSettingRepository() PayableSettingRepository {
+	return &PayableSettingRepositoryImpl{}
+}
+
+func (repository *PayableSettingRepositoryImpl) Find(ctx context.Context, tx *gorm.DB) (domain.PayableSetting, error) {
+	var setting domain.PayableSetting
+
+	err := tx.WithContext(ctx).Where("id = ?", domain.PayableSettingID).First(&setting).Error
+	if err != nil {
+		return domain.PayableSetting{}, err
+	}
+	return setting, nil
+}
+
+func (repository *PayableSettingRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, setting domain.PayableSetting) error {
+	setting.ID = domain.PayableSettingID
+	return tx.WithContext(ctx).Save(&setting).Error
+}
@@ -0,0 +1,18 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PaymentRunRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, run domain.PaymentRun) (domain.PaymentRun, error)
+	Update(ctx context.Context, tx *gorm.DB, run domain.PaymentRun) error
+	SetBatchJournal(ctx context.Context, tx *gorm.DB, batchID uuid.UUID, journalEntryID uuid.UUID) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PaymentRun, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PaymentRun, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, page, limit int) ([]domain.PaymentRun, int64, error)
+}
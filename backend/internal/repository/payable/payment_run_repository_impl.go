@@ -0,0 +1,104 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentRunRepositoryImpl struct{}
+
+func NewPaymentRunRepository() PaymentRunRepository {
+	return &PaymentRunRepositoryImpl{}
+}
+
+func (repository *PaymentRunRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, run domain.PaymentRun) (domain.PaymentRun, error) {
+	err := tx.WithContext(ctx).Create(&run).Error
+	if err != nil {
+		return domain.PaymentRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *PaymentRunRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, run domain.PaymentRun) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&run).Error
+}
+
+func (repository *PaymentRunRepositoryImpl) SetBatchJournal(ctx context.Context, tx *gorm.DB, batchID uuid.UUID, journalEntryID uuid.UUID) error {
+	return tx.WithContext(ctx).Model(&domain.PaymentBatch{}).
+		Where("id = ?", batchID).
+		Update("journal_entry_id", journalEntryID).Error
+}
+
+func (repository *PaymentRunRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PaymentRun, error) {
+	var run domain.PaymentRun
+
+	err := tx.WithContext(ctx).
+		Preload("Batches", func(db *gorm.DB) *gorm.DB {
+			return db.Order("batch_no ASC")
+		}).
+		Preload("Batches.Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("due_date ASC, invoice_number ASC")
+		}).
+		Where("id = ?", id).
+		First(&run).Error
+	if err != nil {
+		return domain.PaymentRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *PaymentRunRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PaymentRun, error) {
+	var run domain.PaymentRun
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&run).Error
+	if err != nil {
+		return domain.PaymentRun{}, err
+	}
+
+	err = tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("due_date ASC, invoice_number ASC")
+		}).
+		Where("payment_run_id = ?", id).
+		Order("batch_no ASC").
+		Find(&run.Batches).Error
+	if err != nil {
+		return domain.PaymentRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *PaymentRunRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, page, limit int) ([]domain.PaymentRun, int64, error) {
+	var runs []domain.PaymentRun
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.PaymentRun{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Order("payment_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&runs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return runs, totalItems, nil
+}
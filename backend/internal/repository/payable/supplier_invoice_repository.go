@@ -0,0 +1,39 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SupplierInvoiceRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, invoice domain.SupplierInvoice) (domain.SupplierInvoice, error)
+	Update(ctx context.Context, tx *gorm.DB, invoice domain.SupplierInvoice) error
+	ReplaceLines(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, lines []domain.SupplierInvoiceLine) error
+	ReplaceTaxes(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, taxes []domain.SupplierInvoiceTax) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SupplierInvoice, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SupplierInvoice, error)
+
+	// LockByIds mengunci row invoice (SELECT ... FOR UPDATE) dengan urutan id yang tetap
+	LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.SupplierInvoice, error)
+
+	// ExistsSupplierInvoiceNo memeriksa nomor invoice supplier yang sama pada invoice yang belum dibatalkan
+	ExistsSupplierInvoiceNo(ctx context.Context, tx *gorm.DB, supplierID uuid.UUID, supplierInvoiceNo string, excludeID *uuid.UUID) (bool, error)
+
+	// SumApprovedQuantityByOrderLine menjumlahkan kuantitas yang sudah ditagih per baris purchase order
+	// pada invoice Approved, tidak termasuk invoice excludeID
+	SumApprovedQuantityByOrderLine(ctx context.Context, tx *gorm.DB, purchaseOrderID uuid.UUID, excludeID uuid.UUID) (map[uuid.UUID]float64, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, matchStatus string, search string, page, limit int) ([]domain.SupplierInvoice, int64, error)
+
+	// FindDueForPayment mengambil invoice Approved milik supplier aktif yang jatuh tempo paling lambat
+	// dueUntil dan belum masuk payment run lain yang masih Draft
+	FindDueForPayment(ctx context.Context, tx *gorm.DB, currency string, dueUntil time.Time, supplierIDs []uuid.UUID) ([]domain.SupplierInvoice, error)
+
+	// FindOutstandingAsOf menghitung sisa hutang per invoice berdasarkan pembayaran yang sudah
+	// diposting sampai tanggal asOf
+	FindOutstandingAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, supplierID *uuid.UUID, currency string) ([]domain.PayableOutstanding, error)
+}
@@ -0,0 +1,240 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SupplierInvoiceRepositoryImpl struct{}
+
+func NewSupplierInvoiceRepository() SupplierInvoiceRepository {
+	return &SupplierInvoiceRepositoryImpl{}
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, invoice domain.SupplierInvoice) (domain.SupplierInvoice, error) {
+	err := tx.WithContext(ctx).Omit("PurchaseOrder").Create(&invoice).Error
+	if err != nil {
+		return domain.SupplierInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, invoice domain.SupplierInvoice) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&invoice).Error
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, lines []domain.SupplierInvoiceLine) error {
+	err := tx.WithContext(ctx).Where("supplier_invoice_id = ?", invoiceID).Delete(&domain.SupplierInvoiceLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) ReplaceTaxes(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, taxes []domain.SupplierInvoiceTax) error {
+	err := tx.WithContext(ctx).Where("supplier_invoice_id = ?", invoiceID).Delete(&domain.SupplierInvoiceTax{}).Error
+	if err != nil {
+		return err
+	}
+	if len(taxes) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&taxes).Error
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SupplierInvoice, error) {
+	var invoice domain.SupplierInvoice
+
+	err := tx.WithContext(ctx).
+		Preload("PurchaseOrder").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Taxes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		return domain.SupplierInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SupplierInvoice, error) {
+	var invoice domain.SupplierInvoice
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		return domain.SupplierInvoice{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("supplier_invoice_id = ?", id).Order("line_no ASC").Find(&invoice.Lines).Error
+	if err != nil {
+		return domain.SupplierInvoice{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("supplier_invoice_id = ?", id).Order("line_no ASC").Find(&invoice.Taxes).Error
+	if err != nil {
+		return domain.SupplierInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.SupplierInvoice, error) {
+	var invoices []domain.SupplierInvoice
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) ExistsSupplierInvoiceNo(ctx context.Context, tx *gorm.DB, supplierID uuid.UUID, supplierInvoiceNo string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.SupplierInvoice{}).
+		Where("supplier_id = ? AND supplier_invoice_no = ? AND status <> ?", supplierID, supplierInvoiceNo, domain.SupplierInvoiceStatusCancelled)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) SumApprovedQuantityByOrderLine(ctx context.Context, tx *gorm.DB, purchaseOrderID uuid.UUID, excludeID uuid.UUID) (map[uuid.UUID]float64, error) {
+	var rows []struct {
+		PurchaseOrderLineID uuid.UUID
+		Quantity            float64
+	}
+
+	err := tx.WithContext(ctx).
+		Table("supplier_invoice_lines AS l").
+		Select("l.purchase_order_line_id, SUM(l.quantity) AS quantity").
+		Joins("JOIN supplier_invoices AS i ON i.id = l.supplier_invoice_id").
+		Where("i.purchase_order_id = ? AND i.status = ? AND i.id <> ?", purchaseOrderID, domain.SupplierInvoiceStatusApproved, excludeID).
+		Group("l.purchase_order_line_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	invoiced := make(map[uuid.UUID]float64, len(rows))
+	for _, row := range rows {
+		invoiced[row.PurchaseOrderLineID] = row.Quantity
+	}
+	return invoiced, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, matchStatus string, search string, page, limit int) ([]domain.SupplierInvoice, int64, error) {
+	var invoices []domain.SupplierInvoice
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.SupplierInvoice{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if matchStatus != "" {
+		query = query.Where("match_status = ?", matchStatus)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR supplier_invoice_no ILIKE ? OR supplier_name ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Preload("PurchaseOrder").
+		Order("invoice_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&invoices).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return invoices, totalItems, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) FindDueForPayment(ctx context.Context, tx *gorm.DB, currency string, dueUntil time.Time, supplierIDs []uuid.UUID) ([]domain.SupplierInvoice, error) {
+	var invoices []domain.SupplierInvoice
+
+	inDraftRun := tx.Table("payment_batch_lines AS l").
+		Select("l.supplier_invoice_id").
+		Joins("JOIN payment_batches AS b ON b.id = l.payment_batch_id").
+		Joins("JOIN payment_runs AS r ON r.id = b.payment_run_id").
+		Where("r.status = ?", domain.PaymentRunStatusDraft)
+
+	query := tx.WithContext(ctx).
+		Joins("JOIN suppliers ON suppliers.id = supplier_invoices.supplier_id").
+		Where("supplier_invoices.status = ?", domain.SupplierInvoiceStatusApproved).
+		Where("suppliers.status = ?", domain.SupplierStatusActive).
+		Where("supplier_invoices.currency = ?", currency).
+		Where("supplier_invoices.due_date <= ?", dueUntil).
+		Where("supplier_invoices.total_amount > supplier_invoices.paid_amount").
+		Where("supplier_invoices.id NOT IN (?)", inDraftRun)
+	if len(supplierIDs) > 0 {
+		query = query.Where("supplier_invoices.supplier_id IN ?", supplierIDs)
+	}
+
+	err := query.
+		Order("supplier_invoices.supplier_name ASC, supplier_invoices.due_date ASC, supplier_invoices.number ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (repository *SupplierInvoiceRepositoryImpl) FindOutstandingAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, supplierID *uuid.UUID, currency string) ([]domain.PayableOutstanding, error) {
+	var rows []domain.PayableOutstanding
+
+	paidAsOf := tx.Table("payment_batch_lines AS l").
+		Select("COALESCE(SUM(l.amount), 0)").
+		Joins("JOIN payment_batches AS b ON b.id = l.payment_batch_id").
+		Joins("JOIN payment_runs AS r ON r.id = b.payment_run_id").
+		Where("l.supplier_invoice_id = i.id").
+		Where("r.status = ? AND r.payment_date <= ?", domain.PaymentRunStatusPosted, asOf)
+
+	query := tx.WithContext(ctx).
+		Table("supplier_invoices AS i").
+		Select("i.id AS invoice_id, i.number, i.supplier_invoice_no, i.supplier_id, i.supplier_name, i.currency, i.exchange_rate, i.invoice_date, i.due_date, i.total_amount, (?) AS paid_amount", paidAsOf).
+		Where("i.status IN ?", []domain.SupplierInvoiceStatus{domain.SupplierInvoiceStatusApproved, domain.SupplierInvoiceStatusPaid}).
+		Where("i.invoice_date <= ?", asOf)
+	if supplierID != nil {
+		query = query.Where("i.supplier_id = ?", *supplierID)
+	}
+	if currency != "" {
+		query = query.Where("i.currency = ?", currency)
+	}
+
+	err := query.
+		Order("i.supplier_name ASC, i.currency ASC, i.due_date ASC, i.number ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
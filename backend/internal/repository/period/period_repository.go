@@ -0,0 +1,38 @@
+package period
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PeriodRepository interface {
+	// CreateFiscalYear menyimpan tahun buku beserta periode bulanannya
+	CreateFiscalYear(ctx context.Context, tx *gorm.DB, fiscalYear domain.FiscalYear) (domain.FiscalYear, error)
+
+	FindFiscalYearById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FiscalYear, error)
+	FindFiscalYearByCode(ctx context.Context, tx *gorm.DB, code string) (domain.FiscalYear, error)
+	FindAllFiscalYearsWithPagination(ctx context.Context, tx *gorm.DB, page, limit int) ([]domain.FiscalYear, int64, error)
+
+	// CountOverlappingFiscalYears menghitung tahun buku yang beririsan dengan rentang tanggal
+	CountOverlappingFiscalYears(ctx context.Context, tx *gorm.DB, startDate, endDate time.Time) (int64, error)
+
+	FindPeriodById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AccountingPeriod, error)
+
+	// FindPeriodByIdForUpdate mengunci row periode (SELECT ... FOR UPDATE) sebelum status diubah
+	FindPeriodByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AccountingPeriod, error)
+
+	// FindPeriodByDateForShare mencari periode yang mencakup tanggal tertentu dan menahannya
+	// dengan FOR SHARE sehingga periode tidak bisa ditutup selama transaksi posting berjalan
+	FindPeriodByDateForShare(ctx context.Context, tx *gorm.DB, date time.Time) (domain.AccountingPeriod, error)
+
+	// CountEarlierNotLocked menghitung periode sebelum tanggal tertentu yang belum dikunci
+	CountEarlierNotLocked(ctx context.Context, tx *gorm.DB, before time.Time) (int64, error)
+
+	UpdatePeriodStatus(ctx context.Context, tx *gorm.DB, id uuid.UUID, status domain.PeriodStatus) error
+	CreateStatusLog(ctx context.Context, tx *gorm.DB, log domain.PeriodStatusLog) error
+	FindStatusLogsByPeriodId(ctx context.Context, tx *gorm.DB, periodID uuid.UUID) ([]domain.PeriodStatusLog, error)
+}
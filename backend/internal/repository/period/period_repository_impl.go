@@ -0,0 +1,156 @@
+package period
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PeriodRepositoryImpl struct{}
+
+func NewPeriodRepository() PeriodRepository {
+	return &PeriodRepositoryImpl{}
+}
+
+func (repository *PeriodRepositoryImpl) CreateFiscalYear(ctx context.Context, tx *gorm.DB, fiscalYear domain.FiscalYear) (domain.FiscalYear, error) {
+	err := tx.WithContext(ctx).Create(&fiscalYear).Error
+	if err != nil {
+		return domain.FiscalYear{}, err
+	}
+	return fiscalYear, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindFiscalYearById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.FiscalYear, error) {
+	var fiscalYear domain.FiscalYear
+
+	err := tx.WithContext(ctx).
+		Preload("Periods", func(db *gorm.DB) *gorm.DB {
+			return db.Order("period_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&fiscalYear).Error
+	if err != nil {
+		return domain.FiscalYear{}, err
+	}
+	return fiscalYear, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindFiscalYearByCode(ctx context.Context, tx *gorm.DB, code string) (domain.FiscalYear, error) {
+	var fiscalYear domain.FiscalYear
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&fiscalYear).Error
+	if err != nil {
+		return domain.FiscalYear{}, err
+	}
+	return fiscalYear, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindAllFiscalYearsWithPagination(ctx context.Context, tx *gorm.DB, page, limit int) ([]domain.FiscalYear, int64, error) {
+	var fiscalYears []domain.FiscalYear
+	var totalItems int64
+
+	// Hitung total items
+	err := tx.WithContext(ctx).Model(&domain.FiscalYear{}).Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = tx.WithContext(ctx).
+		Preload("Periods", func(db *gorm.DB) *gorm.DB {
+			return db.Order("period_no ASC")
+		}).
+		Order("start_date DESC").
+		Offset(offset).Limit(limit).
+		Find(&fiscalYears).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fiscalYears, totalItems, nil
+}
+
+func (repository *PeriodRepositoryImpl) CountOverlappingFiscalYears(ctx context.Context, tx *gorm.DB, startDate, endDate time.Time) (int64, error) {
+	var total int64
+
+	err := tx.WithContext(ctx).Model(&domain.FiscalYear{}).
+		Where("start_date <= ? AND end_date >= ?", endDate, startDate).
+		Count(&total).Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindPeriodById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AccountingPeriod, error) {
+	var period domain.AccountingPeriod
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&period).Error
+	if err != nil {
+		return domain.AccountingPeriod{}, err
+	}
+	return period, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindPeriodByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.AccountingPeriod, error) {
+	var period domain.AccountingPeriod
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&period).Error
+	if err != nil {
+		return domain.AccountingPeriod{}, err
+	}
+	return period, nil
+}
+
+func (repository *PeriodRepositoryImpl) FindPeriodByDateForShare(ctx context.Context, tx *gorm.DB, date time.Time) (domain.AccountingPeriod, error) {
+	var period domain.AccountingPeriod
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("start_date <= ? AND end_date >= ?", date, date).
+		First(&period).Error
+	if err != nil {
+		return domain.AccountingPeriod{}, err
+	}
+	return period, nil
+}
+
+func (repository *PeriodRepositoryImpl) CountEarlierNotLocked(ctx context.Context, tx *gorm.DB, before time.Time) (int64, error) {
+	var total int64
+
+	err := tx.WithContext(ctx).Model(&domain.AccountingPeriod{}).
+		Where("end_date < ? AND status <> ?", before, domain.PeriodStatusLocked).
+		Count(&total).Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (repository *PeriodRepositoryImpl) UpdatePeriodStatus(ctx context.Context, tx *gorm.DB, id uuid.UUID, status domain.PeriodStatus) error {
+	return tx.WithContext(ctx).Model(&domain.AccountingPeriod{}).
+		Where("id = ?", id).
+		Update("status", status).Error
+}
+
+func (repository *PeriodRepositoryImpl) CreateStatusLog(ctx context.Context, tx *gorm.DB, log domain.PeriodStatusLog) error {
+	return tx.WithContext(ctx).Omit("Period").Create(&log).Error
+}
+
+func (repository *PeriodRepositoryImpl) FindStatusLogsByPeriodId(ctx context.Context, tx *gorm.DB, periodID uuid.UUID) ([]domain.PeriodStatusLog, error) {
+	var logs []domain.PeriodStatusLog
+
+	err := tx.WithContext(ctx).Where("period_id = ?", periodID).Order("created_at DESC").Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,23 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BillOfMaterialRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, bom domain.BillOfMaterial) (domain.BillOfMaterial, error)
+	Update(ctx context.Context, tx *gorm.DB, bom domain.BillOfMaterial) error
+	ReplaceLines(ctx context.Context, tx *gorm.DB, bomID uuid.UUID, lines []domain.BillOfMaterialLine) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BillOfMaterial, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, itemID *uuid.UUID, page, limit int) ([]domain.BillOfMaterial, int64, error)
+
+	// FindDefaultByItems mengembalikan BOM default yang aktif per item beserta komponennya
+	FindDefaultByItems(ctx context.Context, tx *gorm.DB, itemIDs []uuid.UUID) (map[uuid.UUID]domain.BillOfMaterial, error)
+	FindAllDefault(ctx context.Context, tx *gorm.DB) (map[uuid.UUID]domain.BillOfMaterial, error)
+	NextVersion(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (int, error)
+	ClearDefault(ctx context.Context, tx *gorm.DB, itemID uuid.UUID, exceptID uuid.UUID) error
+}
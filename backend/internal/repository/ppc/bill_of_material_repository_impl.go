@@ -0,0 +1,144 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BillOfMaterialRepositoryImpl struct{}
+
+func NewBillOfMaterialRepository() BillOfMaterialRepository {
+	return &BillOfMaterialRepositoryImpl{}
+}
+
+func (repository *BillOfMaterialRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, bom domain.BillOfMaterial) (domain.BillOfMaterial, error) {
+	err := tx.WithContext(ctx).Create(&bom).Error
+	if err != nil {
+		return domain.BillOfMaterial{}, err
+	}
+	return bom, nil
+}
+
+func (repository *BillOfMaterialRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, bom domain.BillOfMaterial) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&bom).Error
+}
+
+func (repository *BillOfMaterialRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, bomID uuid.UUID, lines []domain.BillOfMaterialLine) error {
+	err := tx.WithContext(ctx).Where("bill_of_material_id = ?", bomID).Delete(&domain.BillOfMaterialLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *BillOfMaterialRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.BillOfMaterial, error) {
+	var bom domain.BillOfMaterial
+
+	err := tx.WithContext(ctx).
+		Preload("Item").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Lines.ComponentItem").
+		Where("id = ?", id).
+		First(&bom).Error
+	if err != nil {
+		return domain.BillOfMaterial{}, err
+	}
+	return bom, nil
+}
+
+func (repository *BillOfMaterialRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, itemID *uuid.UUID, page, limit int) ([]domain.BillOfMaterial, int64, error) {
+	var boms []domain.BillOfMaterial
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.BillOfMaterial{})
+	if itemID != nil {
+		query = query.Where("bills_of_material.item_id = ?", *itemID)
+	}
+	if search != "" {
+		query = query.Joins("JOIN items ON items.id = bills_of_material.item_id").
+			Where("items.code ILIKE ? OR items.name ILIKE ? OR bills_of_material.description ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Item").
+		Order("bills_of_material.item_id ASC, bills_of_material.version DESC").
+		Offset(offset).Limit(limit).
+		Find(&boms).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return boms, totalItems, nil
+}
+
+func (repository *BillOfMaterialRepositoryImpl) FindDefaultByItems(ctx context.Context, tx *gorm.DB, itemIDs []uuid.UUID) (map[uuid.UUID]domain.BillOfMaterial, error) {
+	var boms []domain.BillOfMaterial
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("item_id IN ? AND is_default = ? AND is_active = ?", itemIDs, true, true).
+		Find(&boms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]domain.BillOfMaterial, len(boms))
+	for _, bom := range boms {
+		result[bom.ItemID] = bom
+	}
+	return result, nil
+}
+
+func (repository *BillOfMaterialRepositoryImpl) NextVersion(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (int, error) {
+	var version int
+
+	err := tx.WithContext(ctx).Model(&domain.BillOfMaterial{}).
+		Where("item_id = ?", itemID).
+		Select("COALESCE(MAX(version), 0) + 1").
+		Scan(&version).Error
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (repository *BillOfMaterialRepositoryImpl) ClearDefault(ctx context.Context, tx *gorm.DB, itemID uuid.UUID, exceptID uuid.UUID) error {
+	return tx.WithContext(ctx).Model(&domain.BillOfMaterial{}).
+		Where("item_id = ? AND id <> ? AND is_default = ?", itemID, exceptID, true).
+		Update("is_default", false).Error
+}
+
+func (repository *BillOfMaterialRepositoryImpl) FindAllDefault(ctx context.Context, tx *gorm.DB) (map[uuid.UUID]domain.BillOfMaterial, error) {
+	var boms []domain.BillOfMaterial
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("is_default = ? AND is_active = ?", true, true).
+		Find(&boms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]domain.BillOfMaterial, len(boms))
+	for _, bom := range boms {
+		result[bom.ItemID] = bom
+	}
+	return result, nil
+}
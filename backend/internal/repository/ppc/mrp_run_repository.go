@@ -0,0 +1,34 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MRPRunRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, run domain.MRPRun) (domain.MRPRun, error)
+	Update(ctx context.Context, tx *gorm.DB, run domain.MRPRun) error
+
+	// UpdateProgress hanya mengubah kolom progress dan message agar bisa dipanggil berulang
+	// selama run berjalan tanpa menimpa kolom lain
+	UpdateProgress(ctx context.Context, tx *gorm.DB, id uuid.UUID, progress int, message string) error
+
+	// FailUnfinished menandai semua run Queued atau Running sebagai Failed dengan message tersebut
+	// dan mengembalikan jumlah run yang diubah
+	FailUnfinished(ctx context.Context, tx *gorm.DB, message string) (int64, error)
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.MRPRun, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, page, limit int) ([]domain.MRPRun, int64, error)
+
+	// SaveResults mengganti hasil run (ringkasan item dan saran order) sekaligus
+	SaveResults(ctx context.Context, tx *gorm.DB, runID uuid.UUID, results []domain.MRPItemResult, suggestions []domain.MRPSuggestion) error
+	FindItemResults(ctx context.Context, tx *gorm.DB, runID uuid.UUID) ([]domain.MRPItemResult, error)
+	FindSuggestions(ctx context.Context, tx *gorm.DB, runID uuid.UUID, suggestionType string, status string) ([]domain.MRPSuggestion, error)
+
+	// LockOpenSuggestions mengunci saran berstatus Open milik run; ids kosong berarti semua saran Open
+	LockOpenSuggestions(ctx context.Context, tx *gorm.DB, runID uuid.UUID, ids []uuid.UUID) ([]domain.MRPSuggestion, error)
+	UpdateSuggestion(ctx context.Context, tx *gorm.DB, suggestion domain.MRPSuggestion) error
+}
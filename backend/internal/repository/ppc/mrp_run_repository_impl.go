@@ -0,0 +1,171 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// mrpResultBatchSize membatasi jumlah row per INSERT saat menyimpan hasil run yang besar
+const mrpResultBatchSize = 500
+
+type MRPRunRepositoryImpl struct{}
+
+func NewMRPRunRepository() MRPRunRepository {
+	return &MRPRunRepositoryImpl{}
+}
+
+func (repository *MRPRunRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, run domain.MRPRun) (domain.MRPRun, error) {
+	err := tx.WithContext(ctx).Create(&run).Error
+	if err != nil {
+		return domain.MRPRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *MRPRunRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, run domain.MRPRun) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&run).Error
+}
+
+func (repository *MRPRunRepositoryImpl) UpdateProgress(ctx context.Context, tx *gorm.DB, id uuid.UUID, progress int, message string) error {
+	return tx.WithContext(ctx).Model(&domain.MRPRun{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"progress": progress,
+			"message":  message,
+		}).Error
+}
+
+func (repository *MRPRunRepositoryImpl) FailUnfinished(ctx context.Context, tx *gorm.DB, message string) (int64, error) {
+	result := tx.WithContext(ctx).Model(&domain.MRPRun{}).
+		Where("status IN ?", []domain.MRPRunStatus{domain.MRPRunStatusQueued, domain.MRPRunStatusRunning}).
+		Updates(map[string]interface{}{
+			"status":      domain.MRPRunStatusFailed,
+			"message":     message,
+			"finished_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (repository *MRPRunRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.MRPRun, error) {
+	var run domain.MRPRun
+
+	err := tx.WithContext(ctx).
+		Preload("Warehouse").
+		Preload("Demands", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Demands.Item").
+		Where("id = ?", id).
+		First(&run).Error
+	if err != nil {
+		return domain.MRPRun{}, err
+	}
+	return run, nil
+}
+
+func (repository *MRPRunRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, page, limit int) ([]domain.MRPRun, int64, error) {
+	var runs []domain.MRPRun
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.MRPRun{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Warehouse").Order("created_at DESC").Offset(offset).Limit(limit).Find(&runs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return runs, totalItems, nil
+}
+
+func (repository *MRPRunRepositoryImpl) SaveResults(ctx context.Context, tx *gorm.DB, runID uuid.UUID, results []domain.MRPItemResult, suggestions []domain.MRPSuggestion) error {
+	err := tx.WithContext(ctx).Where("mrp_run_id = ?", runID).Delete(&domain.MRPItemResult{}).Error
+	if err != nil {
+		return err
+	}
+	err = tx.WithContext(ctx).Where("mrp_run_id = ?", runID).Delete(&domain.MRPSuggestion{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(results) > 0 {
+		if err := tx.WithContext(ctx).CreateInBatches(&results, mrpResultBatchSize).Error; err != nil {
+			return err
+		}
+	}
+	if len(suggestions) > 0 {
+		if err := tx.WithContext(ctx).CreateInBatches(&suggestions, mrpResultBatchSize).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *MRPRunRepositoryImpl) FindItemResults(ctx context.Context, tx *gorm.DB, runID uuid.UUID) ([]domain.MRPItemResult, error) {
+	var results []domain.MRPItemResult
+
+	err := tx.WithContext(ctx).
+		Preload("Item").
+		Joins("JOIN items ON items.id = mrp_item_results.item_id").
+		Where("mrp_item_results.mrp_run_id = ?", runID).
+		Order("mrp_item_results.low_level_code ASC, items.code ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repository *MRPRunRepositoryImpl) FindSuggestions(ctx context.Context, tx *gorm.DB, runID uuid.UUID, suggestionType string, status string) ([]domain.MRPSuggestion, error) {
+	var suggestions []domain.MRPSuggestion
+
+	query := tx.WithContext(ctx).Preload("Item").Where("mrp_run_id = ?", runID)
+	if suggestionType != "" {
+		query = query.Where("suggestion_type = ?", suggestionType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("release_date ASC, due_date ASC").Find(&suggestions).Error
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (repository *MRPRunRepositoryImpl) LockOpenSuggestions(ctx context.Context, tx *gorm.DB, runID uuid.UUID, ids []uuid.UUID) ([]domain.MRPSuggestion, error) {
+	var suggestions []domain.MRPSuggestion
+
+	query := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("mrp_run_id = ? AND status = ?", runID, domain.MRPSuggestionStatusOpen)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+
+	err := query.Order("release_date ASC, id ASC").Find(&suggestions).Error
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (repository *MRPRunRepositoryImpl) UpdateSuggestion(ctx context.Context, tx *gorm.DB, suggestion domain.MRPSuggestion) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&suggestion).Error
+}
@@ -0,0 +1,20 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RoutingRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, routing domain.Routing) (domain.Routing, error)
+	Update(ctx context.Context, tx *gorm.DB, routing domain.Routing) error
+	ReplaceOperations(ctx context.Context, tx *gorm.DB, routingID uuid.UUID, operations []domain.RoutingOperation) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Routing, error)
+	FindDefaultByItem(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (domain.Routing, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, itemID *uuid.UUID, page, limit int) ([]domain.Routing, int64, error)
+	NextVersion(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (int, error)
+	ClearDefault(ctx context.Context, tx *gorm.DB, itemID uuid.UUID, exceptID uuid.UUID) error
+}
@@ -0,0 +1,120 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RoutingRepositoryImpl struct{}
+
+func NewRoutingRepository() RoutingRepository {
+	return &RoutingRepositoryImpl{}
+}
+
+func (repository *RoutingRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, routing domain.Routing) (domain.Routing, error) {
+	err := tx.WithContext(ctx).Create(&routing).Error
+	if err != nil {
+		return domain.Routing{}, err
+	}
+	return routing, nil
+}
+
+func (repository *RoutingRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, routing domain.Routing) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&routing).Error
+}
+
+func (repository *RoutingRepositoryImpl) ReplaceOperations(ctx context.Context, tx *gorm.DB, routingID uuid.UUID, operations []domain.RoutingOperation) error {
+	err := tx.WithContext(ctx).Where("routing_id = ?", routingID).Delete(&domain.RoutingOperation{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&operations).Error
+}
+
+func (repository *RoutingRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Routing, error) {
+	var routing domain.Routing
+
+	err := tx.WithContext(ctx).
+		Preload("Item").
+		Preload("Operations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence ASC")
+		}).
+		Preload("Operations.WorkCenter").
+		Where("id = ?", id).
+		First(&routing).Error
+	if err != nil {
+		return domain.Routing{}, err
+	}
+	return routing, nil
+}
+
+func (repository *RoutingRepositoryImpl) FindDefaultByItem(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (domain.Routing, error) {
+	var routing domain.Routing
+
+	err := tx.WithContext(ctx).
+		Preload("Operations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence ASC")
+		}).
+		Preload("Operations.WorkCenter").
+		Where("item_id = ? AND is_default = ? AND is_active = ?", itemID, true, true).
+		First(&routing).Error
+	if err != nil {
+		return domain.Routing{}, err
+	}
+	return routing, nil
+}
+
+func (repository *RoutingRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, itemID *uuid.UUID, page, limit int) ([]domain.Routing, int64, error) {
+	var routings []domain.Routing
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Routing{})
+	if itemID != nil {
+		query = query.Where("routings.item_id = ?", *itemID)
+	}
+	if search != "" {
+		query = query.Joins("JOIN items ON items.id = routings.item_id").
+			Where("items.code ILIKE ? OR items.name ILIKE ? OR routings.description ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Item").
+		Order("routings.item_id ASC, routings.version DESC").
+		Offset(offset).Limit(limit).
+		Find(&routings).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return routings, totalItems, nil
+}
+
+func (repository *RoutingRepositoryImpl) NextVersion(ctx context.Context, tx *gorm.DB, itemID uuid.UUID) (int, error) {
+	var version int
+
+	err := tx.WithContext(ctx).Model(&domain.Routing{}).
+		Where("item_id = ?", itemID).
+		Select("COALESCE(MAX(version), 0) + 1").
+		Scan(&version).Error
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (repository *RoutingRepositoryImpl) ClearDefault(ctx context.Context, tx *gorm.DB, itemID uuid.UUID, exceptID uuid.UUID) error {
+	return tx.WithContext(ctx).Model(&domain.Routing{}).
+		Where("item_id = ? AND id <> ? AND is_default = ?", itemID, exceptID, true).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,18 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WorkCenterRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, workCenter domain.WorkCenter) (domain.WorkCenter, error)
+	Update(ctx context.Context, tx *gorm.DB, workCenter domain.WorkCenter) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkCenter, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.WorkCenter, error)
+	FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.WorkCenter, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.WorkCenter, int64, error)
+}
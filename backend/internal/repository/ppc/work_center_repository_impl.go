@@ -0,0 +1,83 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WorkCenterRepositoryImpl struct{}
+
+func NewWorkCenterRepository() WorkCenterRepository {
+	return &WorkCenterRepositoryImpl{}
+}
+
+func (repository *WorkCenterRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, workCenter domain.WorkCenter) (domain.WorkCenter, error) {
+	err := tx.WithContext(ctx).Create(&workCenter).Error
+	if err != nil {
+		return domain.WorkCenter{}, err
+	}
+	return workCenter, nil
+}
+
+func (repository *WorkCenterRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, workCenter domain.WorkCenter) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&workCenter).Select("*").Omit("CreatedAt").Updates(workCenter).Error
+}
+
+func (repository *WorkCenterRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkCenter, error) {
+	var workCenter domain.WorkCenter
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&workCenter).Error
+	if err != nil {
+		return domain.WorkCenter{}, err
+	}
+	return workCenter, nil
+}
+
+func (repository *WorkCenterRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.WorkCenter, error) {
+	var workCenter domain.WorkCenter
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&workCenter).Error
+	if err != nil {
+		return domain.WorkCenter{}, err
+	}
+	return workCenter, nil
+}
+
+func (repository *WorkCenterRepositoryImpl) FindByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.WorkCenter, error) {
+	var workCenters []domain.WorkCenter
+
+	err := tx.WithContext(ctx).Where("id IN ?", ids).Find(&workCenters).Error
+	if err != nil {
+		return nil, err
+	}
+	return workCenters, nil
+}
+
+func (repository *WorkCenterRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, page, limit int) ([]domain.WorkCenter, int64, error) {
+	var workCenters []domain.WorkCenter
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.WorkCenter{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&workCenters).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return workCenters, totalItems, nil
+}
@@ -0,0 +1,27 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WorkOrderRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) (domain.WorkOrder, error)
+	Update(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) error
+	UpdateMaterials(ctx context.Context, tx *gorm.DB, materials []domain.WorkOrderMaterial) error
+	UpdateOperations(ctx context.Context, tx *gorm.DB, operations []domain.WorkOrderOperation) error
+	CreateOutput(ctx context.Context, tx *gorm.DB, output domain.WorkOrderOutput) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkOrder, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkOrder, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, itemID *uuid.UUID, search string, page, limit int) ([]domain.WorkOrder, int64, error)
+
+	// FindUnfinishedByWarehouse mengembalikan work order Draft/Released/InProgress di satu gudang
+	// beserta komponennya, dipakai MRP sebagai pasokan dan kebutuhan yang sudah pasti
+	FindUnfinishedByWarehouse(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID) ([]domain.WorkOrder, error)
+
+	// SumOpenReservations menjumlahkan sisa reservasi work order Released/InProgress lain per item di satu gudang
+	SumOpenReservations(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID, itemIDs []uuid.UUID, excludeWorkOrderID uuid.UUID) (map[uuid.UUID]float64, error)
+}
@@ -0,0 +1,182 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// openWorkOrderStatuses adalah status work order yang masih memegang reservasi komponen
+var openWorkOrderStatuses = []domain.WorkOrderStatus{domain.WorkOrderStatusReleased, domain.WorkOrderStatusInProgress}
+
+type WorkOrderRepositoryImpl struct{}
+
+func NewWorkOrderRepository() WorkOrderRepository {
+	return &WorkOrderRepositoryImpl{}
+}
+
+func (repository *WorkOrderRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) (domain.WorkOrder, error) {
+	err := tx.WithContext(ctx).Create(&workOrder).Error
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+	return workOrder, nil
+}
+
+func (repository *WorkOrderRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&workOrder).Error
+}
+
+func (repository *WorkOrderRepositoryImpl) UpdateMaterials(ctx context.Context, tx *gorm.DB, materials []domain.WorkOrderMaterial) error {
+	for _, material := range materials {
+		err := tx.WithContext(ctx).Model(&domain.WorkOrderMaterial{}).
+			Where("id = ?", material.ID).
+			Updates(map[string]interface{}{
+				"reserved_quantity": material.ReservedQuantity,
+				"issued_quantity":   material.IssuedQuantity,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *WorkOrderRepositoryImpl) UpdateOperations(ctx context.Context, tx *gorm.DB, operations []domain.WorkOrderOperation) error {
+	for _, operation := range operations {
+		err := tx.WithContext(ctx).Model(&domain.WorkOrderOperation{}).
+			Where("id = ?", operation.ID).
+			Update("actual_minutes", operation.ActualMinutes).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *WorkOrderRepositoryImpl) CreateOutput(ctx context.Context, tx *gorm.DB, output domain.WorkOrderOutput) error {
+	return tx.WithContext(ctx).Create(&output).Error
+}
+
+func (repository *WorkOrderRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkOrder, error) {
+	var workOrder domain.WorkOrder
+
+	err := tx.WithContext(ctx).
+		Preload("Item").
+		Preload("Warehouse").
+		Preload("Materials", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Materials.Item").
+		Preload("Operations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence ASC")
+		}).
+		Preload("Outputs", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&workOrder).Error
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+	return workOrder, nil
+}
+
+func (repository *WorkOrderRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.WorkOrder, error) {
+	var workOrder domain.WorkOrder
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&workOrder).Error
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+
+	err = tx.WithContext(ctx).Preload("Item").Where("work_order_id = ?", id).Order("line_no ASC").Find(&workOrder.Materials).Error
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("work_order_id = ?", id).Order("sequence ASC").Find(&workOrder.Operations).Error
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+	return workOrder, nil
+}
+
+func (repository *WorkOrderRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, itemID *uuid.UUID, search string, page, limit int) ([]domain.WorkOrder, int64, error) {
+	var workOrders []domain.WorkOrder
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.WorkOrder{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if itemID != nil {
+		query = query.Where("item_id = ?", *itemID)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR notes ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Preload("Item").Preload("Warehouse").
+		Order("planned_start_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&workOrders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return workOrders, totalItems, nil
+}
+
+func (repository *WorkOrderRepositoryImpl) SumOpenReservations(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID, itemIDs []uuid.UUID, excludeWorkOrderID uuid.UUID) (map[uuid.UUID]float64, error) {
+	var rows []struct {
+		ItemID   uuid.UUID
+		Quantity float64
+	}
+
+	err := tx.WithContext(ctx).
+		Table("work_order_materials").
+		Select("work_order_materials.item_id, SUM(GREATEST(work_order_materials.reserved_quantity - work_order_materials.issued_quantity, 0)) AS quantity").
+		Joins("JOIN work_orders ON work_orders.id = work_order_materials.work_order_id").
+		Where("work_orders.warehouse_id = ? AND work_orders.status IN ? AND work_orders.id <> ?", warehouseID, openWorkOrderStatuses, excludeWorkOrderID).
+		Where("work_order_materials.item_id IN ?", itemIDs).
+		Group("work_order_materials.item_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]float64, len(rows))
+	for _, row := range rows {
+		result[row.ItemID] = row.Quantity
+	}
+	return result, nil
+}
+
+func (repository *WorkOrderRepositoryImpl) FindUnfinishedByWarehouse(ctx context.Context, tx *gorm.DB, warehouseID uuid.UUID) ([]domain.WorkOrder, error) {
+	var workOrders []domain.WorkOrder
+
+	statuses := append([]domain.WorkOrderStatus{domain.WorkOrderStatusDraft}, openWorkOrderStatuses...)
+	err := tx.WithContext(ctx).
+		Preload("Materials").
+		Where("warehouse_id = ? AND status IN ?", warehouseID, statuses).
+		Find(&workOrders).Error
+	if err != nil {
+		return nil, err
+	}
+	return workOrders, nil
+}
@@ -0,0 +1,34 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PurchaseOrderRepository interface {
+	// Create menyimpan purchase order beserta baris-barisnya
+	Create(ctx context.Context, tx *gorm.DB, order domain.PurchaseOrder) (domain.PurchaseOrder, error)
+
+	// Update menyimpan perubahan header purchase order (baris tidak ikut disimpan)
+	Update(ctx context.Context, tx *gorm.DB, order domain.PurchaseOrder) error
+
+	// ReplaceLines menghapus semua baris lama dan menyimpan baris baru
+	ReplaceLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, lines []domain.PurchaseOrderLine) error
+
+	// UpdateLineReceivedQuantity menyimpan kuantitas yang sudah diterima untuk satu baris
+	UpdateLineReceivedQuantity(ctx context.Context, tx *gorm.DB, lineID uuid.UUID, receivedQuantity float64) error
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseOrder, error)
+
+	// FindByIdForUpdate mengunci row purchase order (SELECT ... FOR UPDATE) beserta barisnya
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseOrder, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, search string, page, limit int) ([]domain.PurchaseOrder, int64, error)
+
+	// FindOpenSupply mengembalikan sisa kuantitas baris ber-item dari purchase order yang masih
+	// ditunggu kedatangannya, bertanggal expected_date baris (atau header jika kosong)
+	FindOpenSupply(ctx context.Context, tx *gorm.DB) ([]domain.PlanningSupply, error)
+}
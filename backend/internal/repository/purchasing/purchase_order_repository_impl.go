@@ -0,0 +1,122 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PurchaseOrderRepositoryImpl struct{}
+
+func NewPurchaseOrderRepository() PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryImpl{}
+}
+
+func (repository *PurchaseOrderRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, order domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	err := tx.WithContext(ctx).Create(&order).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *PurchaseOrderRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, order domain.PurchaseOrder) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&order).Error
+}
+
+func (repository *PurchaseOrderRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, lines []domain.PurchaseOrderLine) error {
+	err := tx.WithContext(ctx).Where("purchase_order_id = ?", orderID).Delete(&domain.PurchaseOrderLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *PurchaseOrderRepositoryImpl) UpdateLineReceivedQuantity(ctx context.Context, tx *gorm.DB, lineID uuid.UUID, receivedQuantity float64) error {
+	return tx.WithContext(ctx).Model(&domain.PurchaseOrderLine{}).
+		Where("id = ?", lineID).
+		Update("received_quantity", receivedQuantity).Error
+}
+
+func (repository *PurchaseOrderRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseOrder, error) {
+	var order domain.PurchaseOrder
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *PurchaseOrderRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseOrder, error) {
+	var order domain.PurchaseOrder
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("purchase_order_id = ?", id).Order("line_no ASC").Find(&order.Lines).Error
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *PurchaseOrderRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, search string, page, limit int) ([]domain.PurchaseOrder, int64, error) {
+	var orders []domain.PurchaseOrder
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.PurchaseOrder{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR supplier_name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Order("order_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, totalItems, nil
+}
+
+func (repository *PurchaseOrderRepositoryImpl) FindOpenSupply(ctx context.Context, tx *gorm.DB) ([]domain.PlanningSupply, error) {
+	var supplies []domain.PlanningSupply
+
+	err := tx.WithContext(ctx).
+		Table("purchase_order_lines").
+		Select("purchase_order_lines.item_id, purchase_order_lines.quantity - purchase_order_lines.received_quantity AS quantity, COALESCE(purchase_order_lines.expected_date, purchase_orders.expected_date) AS date").
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_lines.purchase_order_id").
+		Where("purchase_order_lines.item_id IS NOT NULL AND purchase_order_lines.quantity > purchase_order_lines.received_quantity").
+		Where("purchase_orders.status IN ?", []domain.PurchaseOrderStatus{domain.PurchaseOrderStatusDraft, domain.PurchaseOrderStatusApproved, domain.PurchaseOrderStatusSent, domain.PurchaseOrderStatusPartiallyReceived}).
+		Scan(&supplies).Error
+	if err != nil {
+		return nil, err
+	}
+	return supplies, nil
+}
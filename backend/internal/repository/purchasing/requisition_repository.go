@@ -0,0 +1,31 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RequisitionRepository interface {
+	// Create menyimpan requisition beserta baris-barisnya
+	Create(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) (domain.PurchaseRequisition, error)
+
+	// Update menyimpan perubahan header requisition (baris tidak ikut disimpan)
+	Update(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) error
+
+	// ReplaceLines menghapus semua baris lama dan menyimpan baris baru
+	ReplaceLines(ctx context.Context, tx *gorm.DB, requisitionID uuid.UUID, lines []domain.PurchaseRequisitionLine) error
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseRequisition, error)
+
+	// FindByIdForUpdate mengunci row requisition (SELECT ... FOR UPDATE) beserta barisnya
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseRequisition, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, search string, page, limit int) ([]domain.PurchaseRequisition, int64, error)
+
+	// FindOpenSupply mengembalikan baris ber-item dari requisition yang belum dikonversi/ditolak
+	// sebagai pasokan yang sudah direncanakan, bertanggal required_date
+	FindOpenSupply(ctx context.Context, tx *gorm.DB) ([]domain.PlanningSupply, error)
+}
@@ -0,0 +1,117 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RequisitionRepositoryImpl struct{}
+
+func NewRequisitionRepository() RequisitionRepository {
+	return &RequisitionRepositoryImpl{}
+}
+
+func (repository *RequisitionRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) (domain.PurchaseRequisition, error) {
+	err := tx.WithContext(ctx).Create(&requisition).Error
+	if err != nil {
+		return domain.PurchaseRequisition{}, err
+	}
+	return requisition, nil
+}
+
+func (repository *RequisitionRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&requisition).Error
+}
+
+func (repository *RequisitionRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, requisitionID uuid.UUID, lines []domain.PurchaseRequisitionLine) error {
+	err := tx.WithContext(ctx).Where("requisition_id = ?", requisitionID).Delete(&domain.PurchaseRequisitionLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *RequisitionRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseRequisition, error) {
+	var requisition domain.PurchaseRequisition
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&requisition).Error
+	if err != nil {
+		return domain.PurchaseRequisition{}, err
+	}
+	return requisition, nil
+}
+
+func (repository *RequisitionRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.PurchaseRequisition, error) {
+	var requisition domain.PurchaseRequisition
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&requisition).Error
+	if err != nil {
+		return domain.PurchaseRequisition{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("requisition_id = ?", id).Order("line_no ASC").Find(&requisition.Lines).Error
+	if err != nil {
+		return domain.PurchaseRequisition{}, err
+	}
+	return requisition, nil
+}
+
+func (repository *RequisitionRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, search string, page, limit int) ([]domain.PurchaseRequisition, int64, error) {
+	var requisitions []domain.PurchaseRequisition
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.PurchaseRequisition{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR notes ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Preload("Lines").
+		Order("request_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&requisitions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requisitions, totalItems, nil
+}
+
+func (repository *RequisitionRepositoryImpl) FindOpenSupply(ctx context.Context, tx *gorm.DB) ([]domain.PlanningSupply, error) {
+	var supplies []domain.PlanningSupply
+
+	err := tx.WithContext(ctx).
+		Table("purchase_requisition_lines").
+		Select("purchase_requisition_lines.item_id, purchase_requisition_lines.quantity, purchase_requisitions.required_date AS date").
+		Joins("JOIN purchase_requisitions ON purchase_requisitions.id = purchase_requisition_lines.requisition_id").
+		Where("purchase_requisition_lines.item_id IS NOT NULL").
+		Where("purchase_requisitions.status IN ?", []domain.RequisitionStatus{domain.RequisitionStatusDraft, domain.RequisitionStatusSubmitted, domain.RequisitionStatusApproved}).
+		Scan(&supplies).Error
+	if err != nil {
+		return nil, err
+	}
+	return supplies, nil
+}
@@ -0,0 +1,34 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type CustomerReceiptRepository interface {
+	// Create menyimpan penerimaan beserta rencana alokasinya
+	Create(ctx context.Context, tx *gorm.DB, receipt domain.CustomerReceipt) (domain.CustomerReceipt, error)
+	Update(ctx context.Context, tx *gorm.DB, receipt domain.CustomerReceipt) error
+	CreateAllocations(ctx context.Context, tx *gorm.DB, allocations []domain.ReceiptAllocation) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CustomerReceipt, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CustomerReceipt, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, unapplied bool, search string, page, limit int) ([]domain.CustomerReceipt, int64, error)
+
+	// FindUnappliedAsOf menghitung kredit yang belum dialokasikan per penerimaan yang sudah
+	// diposting, berdasarkan alokasi dengan tanggal paling lambat asOf
+	FindUnappliedAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, customerID *uuid.UUID, currency string) ([]domain.ReceivableCredit, error)
+
+	// SumUnappliedByCustomer menjumlahkan kredit customer yang saat ini belum dialokasikan
+	SumUnappliedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (decimal.Decimal, error)
+
+	// FindPostedByCustomer mengambil penerimaan yang sudah diposting dalam rentang tanggal penerimaan
+	FindPostedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, dateFrom, dateTo time.Time) ([]domain.CustomerReceipt, error)
+
+	// SumPostedByCustomerBefore menjumlahkan penerimaan yang sudah diposting sebelum tanggal before
+	SumPostedByCustomerBefore(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, before time.Time) (decimal.Decimal, error)
+}
@@ -0,0 +1,173 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustomerReceiptRepositoryImpl struct{}
+
+func NewCustomerReceiptRepository() CustomerReceiptRepository {
+	return &CustomerReceiptRepositoryImpl{}
+}
+
+func (repository *CustomerReceiptRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, receipt domain.CustomerReceipt) (domain.CustomerReceipt, error) {
+	err := tx.WithContext(ctx).Create(&receipt).Error
+	if err != nil {
+		return domain.CustomerReceipt{}, err
+	}
+	return receipt, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, receipt domain.CustomerReceipt) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&receipt).Error
+}
+
+func (repository *CustomerReceiptRepositoryImpl) CreateAllocations(ctx context.Context, tx *gorm.DB, allocations []domain.ReceiptAllocation) error {
+	return tx.WithContext(ctx).Create(&allocations).Error
+}
+
+func (repository *CustomerReceiptRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CustomerReceipt, error) {
+	var receipt domain.CustomerReceipt
+
+	err := tx.WithContext(ctx).
+		Preload("Allocations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("allocation_date ASC, created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&receipt).Error
+	if err != nil {
+		return domain.CustomerReceipt{}, err
+	}
+	return receipt, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.CustomerReceipt, error) {
+	var receipt domain.CustomerReceipt
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&receipt).Error
+	if err != nil {
+		return domain.CustomerReceipt{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("customer_receipt_id = ?", id).Order("allocation_date ASC, created_at ASC").Find(&receipt.Allocations).Error
+	if err != nil {
+		return domain.CustomerReceipt{}, err
+	}
+	return receipt, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, unapplied bool, search string, page, limit int) ([]domain.CustomerReceipt, int64, error) {
+	var receipts []domain.CustomerReceipt
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.CustomerReceipt{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if customerID != nil {
+		query = query.Where("customer_id = ?", *customerID)
+	}
+	if unapplied {
+		query = query.Where("status = ? AND amount > allocated_amount", domain.CustomerReceiptStatusPosted)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR reference ILIKE ? OR customer_name ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Order("receipt_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&receipts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return receipts, totalItems, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) FindUnappliedAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, customerID *uuid.UUID, currency string) ([]domain.ReceivableCredit, error) {
+	var rows []domain.ReceivableCredit
+
+	allocatedAsOf := tx.Table("receipt_allocations AS a").
+		Select("COALESCE(SUM(a.amount), 0)").
+		Where("a.customer_receipt_id = r.id AND a.allocation_date <= ?", asOf)
+
+	query := tx.WithContext(ctx).
+		Table("customer_receipts AS r").
+		Select("r.id AS receipt_id, r.number, r.customer_id, r.customer_name, r.currency, r.exchange_rate, r.receipt_date, r.amount, (?) AS allocated_amount", allocatedAsOf).
+		Where("r.status = ? AND r.receipt_date <= ?", domain.CustomerReceiptStatusPosted, asOf)
+	if customerID != nil {
+		query = query.Where("r.customer_id = ?", *customerID)
+	}
+	if currency != "" {
+		query = query.Where("r.currency = ?", currency)
+	}
+
+	err := query.
+		Order("r.customer_name ASC, r.currency ASC, r.receipt_date ASC, r.number ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) FindPostedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, dateFrom, dateTo time.Time) ([]domain.CustomerReceipt, error) {
+	var receipts []domain.CustomerReceipt
+
+	err := tx.WithContext(ctx).
+		Where("customer_id = ? AND status = ?", customerID, domain.CustomerReceiptStatusPosted).
+		Where("receipt_date BETWEEN ? AND ?", dateFrom, dateTo).
+		Order("receipt_date ASC, number ASC").
+		Find(&receipts).Error
+	if err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) SumPostedByCustomerBefore(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, before time.Time) (decimal.Decimal, error) {
+	var total decimal.Decimal
+
+	err := tx.WithContext(ctx).
+		Model(&domain.CustomerReceipt{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("customer_id = ? AND status = ? AND receipt_date < ?", customerID, domain.CustomerReceiptStatusPosted, before).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return total, nil
+}
+
+func (repository *CustomerReceiptRepositoryImpl) SumUnappliedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (decimal.Decimal, error) {
+	var total decimal.Decimal
+
+	err := tx.WithContext(ctx).
+		Model(&domain.CustomerReceipt{}).
+		Select("COALESCE(SUM(amount - allocated_amount), 0)").
+		Where("customer_id = ? AND status = ?", customerID, domain.CustomerReceiptStatusPosted).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return total, nil
+}
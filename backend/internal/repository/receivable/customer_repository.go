@@ -0,0 +1,20 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CustomerRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, customer domain.Customer) (domain.Customer, error)
+	Update(ctx context.Context, tx *gorm.DB, customer domain.Customer) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Customer, error)
+
+	// FindByIdForUpdate mengunci row customer, dipakai untuk menyerialkan pemeriksaan plafon kredit
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Customer, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Customer, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, isActive *bool, page, limit int) ([]domain.Customer, int64, error)
+}
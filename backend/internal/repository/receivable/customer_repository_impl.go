@@ -0,0 +1,89 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustomerRepositoryImpl struct{}
+
+func NewCustomerRepository() CustomerRepository {
+	return &CustomerRepositoryImpl{}
+}
+
+func (repository *CustomerRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, customer domain.Customer) (domain.Customer, error) {
+	err := tx.WithContext(ctx).Create(&customer).Error
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
+
+func (repository *CustomerRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, customer domain.Customer) error {
+	return tx.WithContext(ctx).Save(&customer).Error
+}
+
+func (repository *CustomerRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Customer, error) {
+	var customer domain.Customer
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&customer).Error
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
+
+func (repository *CustomerRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Customer, error) {
+	var customer domain.Customer
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&customer).Error
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
+
+func (repository *CustomerRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Customer, error) {
+	var customer domain.Customer
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&customer).Error
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
+
+func (repository *CustomerRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, isActive *bool, page, limit int) ([]domain.Customer, int64, error) {
+	var customers []domain.Customer
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Customer{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ? OR npwp ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+	if isActive != nil {
+		query = query.Where("is_active = ?", *isActive)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("name ASC").Offset(offset).Limit(limit).Find(&customers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return customers, totalItems, nil
+}
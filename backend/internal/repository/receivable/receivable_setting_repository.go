@@ -0,0 +1,13 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type ReceivableSettingRepository interface {
+	Find(ctx context.Context, tx *gorm.DB) (domain.ReceivableSetting, error)
+	Save(ctx context.Context, tx *gorm.DB, setting domain.ReceivableSetting) error
+}
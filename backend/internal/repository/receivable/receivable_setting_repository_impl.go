@@ -0,0 +1,29 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+type ReceivableSettingRepositoryImpl struct{}
+
+func NewReceivableSettingRepository() ReceivableSettingRepository {
+	return &ReceivableSettingRepositoryImpl{}
+}
+
+func (repository *ReceivableSettingRepositoryImpl) Find(ctx context.Context, tx *gorm.DB) (domain.ReceivableSetting, error) {
+	var setting domain.ReceivableSetting
+
+	err := tx.WithContext(ctx).Where("id = ?", domain.ReceivableSettingID).First(&setting).Error
+	if err != nil {
+		return domain.ReceivableSetting{}, err
+	}
+	return setting, nil
+}
+
+func (repository *ReceivableSettingRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, setting domain.ReceivableSetting) error {
+	setting.ID = domain.ReceivableSettingID
+	return tx.WithContext(ctx).Save(&setting).Error
+}
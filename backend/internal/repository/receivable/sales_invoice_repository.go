@@ -0,0 +1,41 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type SalesInvoiceRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, invoice domain.SalesInvoice) (domain.SalesInvoice, error)
+	Update(ctx context.Context, tx *gorm.DB, invoice domain.SalesInvoice) error
+	ReplaceLines(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, lines []domain.SalesInvoiceLine) error
+	ReplaceTaxes(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, taxes []domain.SalesInvoiceTax) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesInvoice, error)
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesInvoice, error)
+
+	// LockByIds mengunci row invoice (SELECT ... FOR UPDATE) dengan urutan id yang tetap
+	LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.SalesInvoice, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, search string, page, limit int) ([]domain.SalesInvoice, int64, error)
+
+	// ExistsByCustomer memeriksa apakah customer sudah punya invoice yang belum dibatalkan
+	ExistsByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (bool, error)
+
+	// FindOutstandingAsOf menghitung sisa piutang per invoice berdasarkan alokasi penerimaan
+	// yang sudah diposting dengan tanggal alokasi paling lambat asOf
+	FindOutstandingAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, customerID *uuid.UUID, currency string) ([]domain.ReceivableOutstanding, error)
+
+	// SumOutstandingByCustomer menjumlahkan sisa piutang saat ini dari invoice yang sudah diposting
+	SumOutstandingByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (decimal.Decimal, error)
+
+	// FindPostedByCustomer mengambil invoice yang sudah diposting dalam rentang tanggal invoice
+	FindPostedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, dateFrom, dateTo time.Time) ([]domain.SalesInvoice, error)
+
+	// SumPostedByCustomerBefore menjumlahkan total invoice yang sudah diposting sebelum tanggal before
+	SumPostedByCustomerBefore(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, before time.Time) (decimal.Decimal, error)
+}
@@ -0,0 +1,225 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// postedInvoiceStatuses adalah status invoice yang sudah membentuk piutang
+var postedInvoiceStatuses = []domain.SalesInvoiceStatus{domain.SalesInvoiceStatusPosted, domain.SalesInvoiceStatusPaid}
+
+type SalesInvoiceRepositoryImpl struct{}
+
+func NewSalesInvoiceRepository() SalesInvoiceRepository {
+	return &SalesInvoiceRepositoryImpl{}
+}
+
+func (repository *SalesInvoiceRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, invoice domain.SalesInvoice) (domain.SalesInvoice, error) {
+	err := tx.WithContext(ctx).Create(&invoice).Error
+	if err != nil {
+		return domain.SalesInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, invoice domain.SalesInvoice) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&invoice).Error
+}
+
+func (repository *SalesInvoiceRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, lines []domain.SalesInvoiceLine) error {
+	err := tx.WithContext(ctx).Where("sales_invoice_id = ?", invoiceID).Delete(&domain.SalesInvoiceLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *SalesInvoiceRepositoryImpl) ReplaceTaxes(ctx context.Context, tx *gorm.DB, invoiceID uuid.UUID, taxes []domain.SalesInvoiceTax) error {
+	err := tx.WithContext(ctx).Where("sales_invoice_id = ?", invoiceID).Delete(&domain.SalesInvoiceTax{}).Error
+	if err != nil {
+		return err
+	}
+	if len(taxes) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&taxes).Error
+}
+
+func (repository *SalesInvoiceRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesInvoice, error) {
+	var invoice domain.SalesInvoice
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Taxes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		return domain.SalesInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesInvoice, error) {
+	var invoice domain.SalesInvoice
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		return domain.SalesInvoice{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("sales_invoice_id = ?", id).Order("line_no ASC").Find(&invoice.Lines).Error
+	if err != nil {
+		return domain.SalesInvoice{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("sales_invoice_id = ?", id).Order("line_no ASC").Find(&invoice.Taxes).Error
+	if err != nil {
+		return domain.SalesInvoice{}, err
+	}
+	return invoice, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) LockByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.SalesInvoice, error) {
+	var invoices []domain.SalesInvoice
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, search string, page, limit int) ([]domain.SalesInvoice, int64, error) {
+	var invoices []domain.SalesInvoice
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.SalesInvoice{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if customerID != nil {
+		query = query.Where("customer_id = ?", *customerID)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR reference ILIKE ? OR customer_name ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Order("invoice_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&invoices).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return invoices, totalItems, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) ExistsByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := tx.WithContext(ctx).Model(&domain.SalesInvoice{}).
+		Where("customer_id = ? AND status <> ?", customerID, domain.SalesInvoiceStatusCancelled).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) FindOutstandingAsOf(ctx context.Context, tx *gorm.DB, asOf time.Time, customerID *uuid.UUID, currency string) ([]domain.ReceivableOutstanding, error) {
+	var rows []domain.ReceivableOutstanding
+
+	paidAsOf := tx.Table("receipt_allocations AS a").
+		Select("COALESCE(SUM(a.amount), 0)").
+		Joins("JOIN customer_receipts AS r ON r.id = a.customer_receipt_id").
+		Where("a.sales_invoice_id = i.id").
+		Where("r.status = ? AND a.allocation_date <= ?", domain.CustomerReceiptStatusPosted, asOf)
+
+	query := tx.WithContext(ctx).
+		Table("sales_invoices AS i").
+		Select("i.id AS invoice_id, i.number, i.customer_id, i.customer_name, i.currency, i.exchange_rate, i.invoice_date, i.due_date, i.total_amount, (?) AS paid_amount", paidAsOf).
+		Where("i.status IN ?", postedInvoiceStatuses).
+		Where("i.invoice_date <= ?", asOf)
+	if customerID != nil {
+		query = query.Where("i.customer_id = ?", *customerID)
+	}
+	if currency != "" {
+		query = query.Where("i.currency = ?", currency)
+	}
+
+	err := query.
+		Order("i.customer_name ASC, i.currency ASC, i.due_date ASC, i.number ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) FindPostedByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, dateFrom, dateTo time.Time) ([]domain.SalesInvoice, error) {
+	var invoices []domain.SalesInvoice
+
+	err := tx.WithContext(ctx).
+		Where("customer_id = ? AND status IN ?", customerID, postedInvoiceStatuses).
+		Where("invoice_date BETWEEN ? AND ?", dateFrom, dateTo).
+		Order("invoice_date ASC, number ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) SumPostedByCustomerBefore(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, before time.Time) (decimal.Decimal, error) {
+	var total decimal.Decimal
+
+	err := tx.WithContext(ctx).
+		Model(&domain.SalesInvoice{}).
+		Select("COALESCE(SUM(total_amount), 0)").
+		Where("customer_id = ? AND status IN ? AND invoice_date < ?", customerID, postedInvoiceStatuses, before).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return total, nil
+}
+
+func (repository *SalesInvoiceRepositoryImpl) SumOutstandingByCustomer(ctx context.Context, tx *gorm.DB, customerID uuid.UUID) (decimal.Decimal, error) {
+	var total decimal.Decimal
+
+	err := tx.WithContext(ctx).
+		Model(&domain.SalesInvoice{}).
+		Select("COALESCE(SUM(total_amount - paid_amount), 0)").
+		Where("customer_id = ? AND status IN ?", customerID, postedInvoiceStatuses).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return total, nil
+}
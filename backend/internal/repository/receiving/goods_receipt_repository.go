@@ -0,0 +1,15 @@
+package receiving
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GoodsReceiptRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, receipt domain.GoodsReceipt) (domain.GoodsReceipt, error)
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.GoodsReceipt, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, purchaseOrderID *uuid.UUID, search string, page, limit int) ([]domain.GoodsReceipt, int64, error)
+}
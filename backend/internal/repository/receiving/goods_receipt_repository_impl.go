@@ -0,0 +1,73 @@
+package receiving
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GoodsReceiptRepositoryImpl struct{}
+
+func NewGoodsReceiptRepository() GoodsReceiptRepository {
+	return &GoodsReceiptRepositoryImpl{}
+}
+
+func (repository *GoodsReceiptRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, receipt domain.GoodsReceipt) (domain.GoodsReceipt, error) {
+	err := tx.WithContext(ctx).Omit("PurchaseOrder", "Warehouse").Create(&receipt).Error
+	if err != nil {
+		return domain.GoodsReceipt{}, err
+	}
+	return receipt, nil
+}
+
+func (repository *GoodsReceiptRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.GoodsReceipt, error) {
+	var receipt domain.GoodsReceipt
+
+	err := tx.WithContext(ctx).
+		Preload("PurchaseOrder").
+		Preload("Warehouse").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("id = ?", id).
+		First(&receipt).Error
+	if err != nil {
+		return domain.GoodsReceipt{}, err
+	}
+	return receipt, nil
+}
+
+func (repository *GoodsReceiptRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, purchaseOrderID *uuid.UUID, search string, page, limit int) ([]domain.GoodsReceipt, int64, error) {
+	var receipts []domain.GoodsReceipt
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.GoodsReceipt{})
+	if purchaseOrderID != nil {
+		query = query.Where("purchase_order_id = ?", *purchaseOrderID)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR delivery_note ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.
+		Preload("PurchaseOrder").
+		Preload("Warehouse").
+		Order("receipt_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&receipts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return receipts, totalItems, nil
+}
@@ -0,0 +1,18 @@
+package report
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FinancialReportRepository interface {
+	// FindAccountMovements menjumlahkan baris jurnal terposting per akun sampai dateTo, mulai
+	// dateFrom bila diisi
+	FindAccountMovements(ctx context.Context, tx *gorm.DB, dateFrom *time.Time, dateTo time.Time) ([]domain.AccountMovement, error)
+	// FindBankGLAccountIDs mengembalikan akun buku besar yang terhubung ke rekening bank/kas
+	FindBankGLAccountIDs(ctx context.Context, tx *gorm.DB) ([]uuid.UUID, error)
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FinancialReportRepositoryImpl struct{}
+
+func NewFinancialReportRepository() FinancialReportRepository {
+	return &FinancialReportRepositoryImpl{}
+}
+
+func (repository *FinancialReportRepositoryImpl) FindAccountMovements(ctx context.Context, tx *gorm.DB, dateFrom *time.Time, dateTo time.Time) ([]domain.AccountMovement, error) {
+	var movements []domain.AccountMovement
+
+	query := tx.WithContext(ctx).
+		Table("journal_lines AS l").
+		Select("l.account_id, SUM(l.debit) AS debit, SUM(l.credit) AS credit").
+		Joins("JOIN journal_entries AS e ON e.id = l.journal_entry_id").
+		Where("e.status IN ?", []domain.JournalStatus{domain.JournalStatusPosted, domain.JournalStatusReversed}).
+		Where("e.entry_date <= ?", dateTo)
+	if dateFrom != nil {
+		query = query.Where("e.entry_date >= ?", *dateFrom)
+	}
+
+	err := query.Group("l.account_id").Scan(&movements).Error
+	if err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+func (repository *FinancialReportRepositoryImpl) FindBankGLAccountIDs(ctx context.Context, tx *gorm.DB) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	err := tx.WithContext(ctx).Model(&domain.BankAccount{}).Pluck("gl_account_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,35 @@
+package sales
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type SalesOrderRepository interface {
+	// Create menyimpan sales order beserta baris-barisnya
+	Create(ctx context.Context, tx *gorm.DB, order domain.SalesOrder) (domain.SalesOrder, error)
+
+	// Update menyimpan perubahan header sales order (baris tidak ikut disimpan)
+	Update(ctx context.Context, tx *gorm.DB, order domain.SalesOrder) error
+
+	// ReplaceLines menghapus semua baris lama dan menyimpan baris baru
+	ReplaceLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, lines []domain.SalesOrderLine) error
+
+	// UpdateLineFulfilment menyimpan kuantitas terkirim dan status pemenuhan setiap baris
+	UpdateLineFulfilment(ctx context.Context, tx *gorm.DB, lines []domain.SalesOrderLine) error
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesOrder, error)
+
+	// FindByIdForUpdate mengunci row sales order (SELECT ... FOR UPDATE) beserta barisnya
+	FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesOrder, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, search string, page, limit int) ([]domain.SalesOrder, int64, error)
+
+	// SumOpenOrderValue menjumlahkan nilai bagian yang belum dikirim dari order terbuka milik
+	// customer, proporsional terhadap kuantitas sisa; excludeID tidak ikut dihitung
+	SumOpenOrderValue(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, excludeID *uuid.UUID) (decimal.Decimal, error)
+}
@@ -0,0 +1,143 @@
+package sales
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// openOrderStatuses adalah status order yang sudah dikonfirmasi namun belum selesai dikirim
+var openOrderStatuses = []domain.SalesOrderStatus{domain.SalesOrderStatusConfirmed, domain.SalesOrderStatusPartiallyFulfilled}
+
+type SalesOrderRepositoryImpl struct{}
+
+func NewSalesOrderRepository() SalesOrderRepository {
+	return &SalesOrderRepositoryImpl{}
+}
+
+func (repository *SalesOrderRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, order domain.SalesOrder) (domain.SalesOrder, error) {
+	err := tx.WithContext(ctx).Create(&order).Error
+	if err != nil {
+		return domain.SalesOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *SalesOrderRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, order domain.SalesOrder) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&order).Error
+}
+
+func (repository *SalesOrderRepositoryImpl) ReplaceLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, lines []domain.SalesOrderLine) error {
+	err := tx.WithContext(ctx).Where("sales_order_id = ?", orderID).Delete(&domain.SalesOrderLine{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&lines).Error
+}
+
+func (repository *SalesOrderRepositoryImpl) UpdateLineFulfilment(ctx context.Context, tx *gorm.DB, lines []domain.SalesOrderLine) error {
+	for _, line := range lines {
+		err := tx.WithContext(ctx).Model(&domain.SalesOrderLine{}).
+			Where("id = ?", line.ID).
+			Updates(map[string]interface{}{
+				"shipped_quantity":  line.ShippedQuantity,
+				"fulfilment_status": line.FulfilmentStatus,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *SalesOrderRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesOrder, error) {
+	var order domain.SalesOrder
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Lines.Item").
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		return domain.SalesOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *SalesOrderRepositoryImpl) FindByIdForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.SalesOrder, error) {
+	var order domain.SalesOrder
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		return domain.SalesOrder{}, err
+	}
+
+	err = tx.WithContext(ctx).Where("sales_order_id = ?", id).Order("line_no ASC").Find(&order.Lines).Error
+	if err != nil {
+		return domain.SalesOrder{}, err
+	}
+	return order, nil
+}
+
+func (repository *SalesOrderRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, status string, customerID *uuid.UUID, search string, page, limit int) ([]domain.SalesOrder, int64, error) {
+	var orders []domain.SalesOrder
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.SalesOrder{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if customerID != nil {
+		query = query.Where("customer_id = ?", *customerID)
+	}
+	if search != "" {
+		query = query.Where("number ILIKE ? OR customer_name ILIKE ? OR reference ILIKE ?",
+			"%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("order_date DESC, number DESC").
+		Offset(offset).Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, totalItems, nil
+}
+
+func (repository *SalesOrderRepositoryImpl) SumOpenOrderValue(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, excludeID *uuid.UUID) (decimal.Decimal, error) {
+	var total decimal.Decimal
+
+	query := tx.WithContext(ctx).
+		Table("sales_order_lines AS l").
+		Joins("JOIN sales_orders AS o ON o.id = l.sales_order_id").
+		Select("COALESCE(SUM(l.line_total * (l.quantity - l.shipped_quantity) / l.quantity), 0)").
+		Where("o.customer_id = ? AND o.status IN ?", customerID, openOrderStatuses).
+		Where("l.quantity > l.shipped_quantity")
+	if excludeID != nil {
+		query = query.Where("o.id <> ?", *excludeID)
+	}
+
+	err := query.Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return total, nil
+}
@@ -0,0 +1,14 @@
+package sequence
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SequenceRepository interface {
+	// Next mengembalikan nomor dokumen berikutnya, contoh: JE-202507-00001.
+	// Harus dipanggil di dalam transaksi agar row sequence terkunci sampai commit.
+	Next(ctx context.Context, tx *gorm.DB, prefix string, date time.Time) (string, error)
+}
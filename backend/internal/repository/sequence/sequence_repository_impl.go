@@ -0,0 +1,47 @@
+package sequence
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SequenceRepositoryImpl struct{}
+
+func NewSequenceRepository() SequenceRepository {
+	return &SequenceRepositoryImpl{}
+}
+
+func (repository *SequenceRepositoryImpl) Next(ctx context.Context, tx *gorm.DB, prefix string, date time.Time) (string, error) {
+	period := date.Format("200601")
+
+	// Pastikan row sequence sudah ada, lalu kunci dengan SELECT ... FOR UPDATE
+	seed := domain.DocumentSequence{Prefix: prefix, Period: period}
+	err := tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&seed).Error
+	if err != nil {
+		return "", err
+	}
+
+	var sequence domain.DocumentSequence
+	err = tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("prefix = ? AND period = ?", prefix, period).
+		First(&sequence).Error
+	if err != nil {
+		return "", err
+	}
+
+	sequence.LastNumber++
+	err = tx.WithContext(ctx).Model(&domain.DocumentSequence{}).
+		Where("prefix = ? AND period = ?", prefix, period).
+		Update("last_number", sequence.LastNumber).Error
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s-%05d", prefix, period, sequence.LastNumber), nil
+}
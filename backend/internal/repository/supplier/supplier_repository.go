@@ -0,0 +1,28 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SupplierRepository interface {
+	// Create menyimpan supplier beserta alamat, kontak dan rekening banknya
+	Create(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) (domain.Supplier, error)
+
+	// Update menyimpan perubahan header supplier (detail tidak ikut disimpan)
+	Update(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) error
+
+	// ReplaceDetails menghapus alamat, kontak dan rekening bank lama lalu menyimpan yang baru
+	ReplaceDetails(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) error
+
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Supplier, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Supplier, error)
+
+	// FindByIdForShare menahan row supplier (SELECT ... FOR SHARE) sampai transaksi selesai
+	FindByIdForShare(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Supplier, error)
+
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, status string, page, limit int) ([]domain.Supplier, int64, error)
+}
@@ -0,0 +1,126 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SupplierRepositoryImpl struct{}
+
+func NewSupplierRepository() SupplierRepository {
+	return &SupplierRepositoryImpl{}
+}
+
+func (repository *SupplierRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) (domain.Supplier, error) {
+	err := tx.WithContext(ctx).Create(&supplier).Error
+	if err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+func (repository *SupplierRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) error {
+	return tx.WithContext(ctx).Omit(clause.Associations).Save(&supplier).Error
+}
+
+func (repository *SupplierRepositoryImpl) ReplaceDetails(ctx context.Context, tx *gorm.DB, supplier domain.Supplier) error {
+	db := tx.WithContext(ctx)
+
+	if err := db.Where("supplier_id = ?", supplier.ID).Delete(&domain.SupplierAddress{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("supplier_id = ?", supplier.ID).Delete(&domain.SupplierContact{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("supplier_id = ?", supplier.ID).Delete(&domain.SupplierBankAccount{}).Error; err != nil {
+		return err
+	}
+
+	// Create dengan slice kosong akan error, jadi hanya simpan yang ada isinya
+	if len(supplier.Addresses) > 0 {
+		if err := db.Create(&supplier.Addresses).Error; err != nil {
+			return err
+		}
+	}
+	if len(supplier.Contacts) > 0 {
+		if err := db.Create(&supplier.Contacts).Error; err != nil {
+			return err
+		}
+	}
+	if len(supplier.BankAccounts) > 0 {
+		if err := db.Create(&supplier.BankAccounts).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *SupplierRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Supplier, error) {
+	var supplier domain.Supplier
+
+	err := tx.WithContext(ctx).
+		Preload("Addresses").
+		Preload("Contacts").
+		Preload("BankAccounts").
+		Where("id = ?", id).
+		First(&supplier).Error
+	if err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+func (repository *SupplierRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.Supplier, error) {
+	var supplier domain.Supplier
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&supplier).Error
+	if err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+func (repository *SupplierRepositoryImpl) FindByIdForShare(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Supplier, error) {
+	var supplier domain.Supplier
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id = ?", id).
+		First(&supplier).Error
+	if err != nil {
+		return domain.Supplier{}, err
+	}
+	return supplier, nil
+}
+
+func (repository *SupplierRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, search string, status string, page, limit int) ([]domain.Supplier, int64, error) {
+	var suppliers []domain.Supplier
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.Supplier{})
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ? OR npwp ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("name ASC").Offset(offset).Limit(limit).Find(&suppliers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return suppliers, totalItems, nil
+}
@@ -0,0 +1,18 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaxCodeRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, taxCode domain.TaxCode) (domain.TaxCode, error)
+	Update(ctx context.Context, tx *gorm.DB, taxCode domain.TaxCode) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.TaxCode, error)
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.TaxCode, error)
+	ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, taxType string, activeOnly bool, search string, page, limit int) ([]domain.TaxCode, int64, error)
+}
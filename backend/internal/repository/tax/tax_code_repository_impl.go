@@ -0,0 +1,94 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaxCodeRepositoryImpl struct{}
+
+func NewTaxCodeRepository() TaxCodeRepository {
+	return &TaxCodeRepositoryImpl{}
+}
+
+func (repository *TaxCodeRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, taxCode domain.TaxCode) (domain.TaxCode, error) {
+	err := tx.WithContext(ctx).Create(&taxCode).Error
+	if err != nil {
+		return domain.TaxCode{}, err
+	}
+	return taxCode, nil
+}
+
+func (repository *TaxCodeRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, taxCode domain.TaxCode) error {
+	// Select("*") agar field bool bernilai false dan akun yang dikosongkan tetap ikut di-update
+	return tx.WithContext(ctx).Model(&taxCode).Select("*").Omit("CreatedAt").Updates(taxCode).Error
+}
+
+func (repository *TaxCodeRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.TaxCode, error) {
+	var taxCode domain.TaxCode
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&taxCode).Error
+	if err != nil {
+		return domain.TaxCode{}, err
+	}
+	return taxCode, nil
+}
+
+func (repository *TaxCodeRepositoryImpl) FindByCode(ctx context.Context, tx *gorm.DB, code string) (domain.TaxCode, error) {
+	var taxCode domain.TaxCode
+
+	err := tx.WithContext(ctx).Where("code = ?", code).First(&taxCode).Error
+	if err != nil {
+		return domain.TaxCode{}, err
+	}
+	return taxCode, nil
+}
+
+func (repository *TaxCodeRepositoryImpl) ExistsByCode(ctx context.Context, tx *gorm.DB, code string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).Model(&domain.TaxCode{}).Where("code = ?", code)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *TaxCodeRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, taxType string, activeOnly bool, search string, page, limit int) ([]domain.TaxCode, int64, error) {
+	var taxCodes []domain.TaxCode
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.TaxCode{})
+	if taxType != "" {
+		query = query.Where("type = ?", taxType)
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if search != "" {
+		query = query.Where("code ILIKE ? OR name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("code ASC").Offset(offset).Limit(limit).Find(&taxCodes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return taxCodes, totalItems, nil
+}
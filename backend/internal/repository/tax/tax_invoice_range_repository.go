@@ -0,0 +1,20 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaxInvoiceRangeRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, invoiceRange domain.TaxInvoiceRange) (domain.TaxInvoiceRange, error)
+	Update(ctx context.Context, tx *gorm.DB, invoiceRange domain.TaxInvoiceRange) error
+	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.TaxInvoiceRange, error)
+	// LockAvailableForYear mengunci range aktif tertua pada tahun pajak yang masih memiliki sisa nomor
+	LockAvailableForYear(ctx context.Context, tx *gorm.DB, year int) (domain.TaxInvoiceRange, error)
+	// ExistsOverlap memeriksa apakah ada range lain dengan prefix dan tahun sama yang nomornya beririsan
+	ExistsOverlap(ctx context.Context, tx *gorm.DB, year int, prefix string, startSerial, endSerial int64, excludeID *uuid.UUID) (bool, error)
+	FindAllWithPagination(ctx context.Context, tx *gorm.DB, year int, page, limit int) ([]domain.TaxInvoiceRange, int64, error)
+}
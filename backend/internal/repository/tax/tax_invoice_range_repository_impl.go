@@ -0,0 +1,96 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TaxInvoiceRangeRepositoryImpl struct{}
+
+func NewTaxInvoiceRangeRepository() TaxInvoiceRangeRepository {
+	return &TaxInvoiceRangeRepositoryImpl{}
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, invoiceRange domain.TaxInvoiceRange) (domain.TaxInvoiceRange, error) {
+	err := tx.WithContext(ctx).Create(&invoiceRange).Error
+	if err != nil {
+		return domain.TaxInvoiceRange{}, err
+	}
+	return invoiceRange, nil
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, invoiceRange domain.TaxInvoiceRange) error {
+	// Select("*") agar field bool bernilai false tetap ikut di-update
+	return tx.WithContext(ctx).Model(&invoiceRange).Select("*").Omit("CreatedAt").Updates(invoiceRange).Error
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.TaxInvoiceRange, error) {
+	var invoiceRange domain.TaxInvoiceRange
+
+	err := tx.WithContext(ctx).Where("id = ?", id).First(&invoiceRange).Error
+	if err != nil {
+		return domain.TaxInvoiceRange{}, err
+	}
+	return invoiceRange, nil
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) LockAvailableForYear(ctx context.Context, tx *gorm.DB, year int) (domain.TaxInvoiceRange, error) {
+	var invoiceRange domain.TaxInvoiceRange
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("year = ? AND is_active = ? AND next_serial <= end_serial", year, true).
+		Order("created_at ASC").
+		First(&invoiceRange).Error
+	if err != nil {
+		return domain.TaxInvoiceRange{}, err
+	}
+	return invoiceRange, nil
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) ExistsOverlap(ctx context.Context, tx *gorm.DB, year int, prefix string, startSerial, endSerial int64, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+
+	query := tx.WithContext(ctx).
+		Model(&domain.TaxInvoiceRange{}).
+		Where("year = ? AND prefix = ?", year, prefix).
+		Where("start_serial <= ? AND end_serial >= ?", endSerial, startSerial)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repository *TaxInvoiceRangeRepositoryImpl) FindAllWithPagination(ctx context.Context, tx *gorm.DB, year int, page, limit int) ([]domain.TaxInvoiceRange, int64, error) {
+	var ranges []domain.TaxInvoiceRange
+	var totalItems int64
+
+	query := tx.WithContext(ctx).Model(&domain.TaxInvoiceRange{})
+	if year > 0 {
+		query = query.Where("year = ?", year)
+	}
+
+	// Hitung total items
+	err := query.Count(&totalItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data dengan pagination
+	offset := (page - 1) * limit
+	err = query.Order("year DESC, created_at ASC").Offset(offset).Limit(limit).Find(&ranges).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ranges, totalItems, nil
+}
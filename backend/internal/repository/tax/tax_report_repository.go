@@ -0,0 +1,18 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaxReportRepository interface {
+	// FindTaxInvoices mengambil sales invoice terposting yang memiliki nomor faktur pajak beserta baris dan pajaknya
+	FindTaxInvoices(ctx context.Context, tx *gorm.DB, dateFrom, dateTo time.Time) ([]domain.SalesInvoice, error)
+	FindCustomersByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Customer, error)
+	// FindWithholdings mengambil baris PPh yang dipotong atas supplier invoice yang sudah di-approve
+	FindWithholdings(ctx context.Context, tx *gorm.DB, dateFrom, dateTo time.Time, taxType string) ([]domain.WithholdingTaxRow, error)
+}
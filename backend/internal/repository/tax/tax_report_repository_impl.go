@@ -0,0 +1,74 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaxReportRepositoryImpl struct{}
+
+func NewTaxReportRepository() TaxReportRepository {
+	return &TaxReportRepositoryImpl{}
+}
+
+func (repository *TaxReportRepositoryImpl) FindTaxInvoices(ctx context.Context, tx *gorm.DB, dateFrom, dateTo time.Time) ([]domain.SalesInvoice, error) {
+	var invoices []domain.SalesInvoice
+
+	err := tx.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Preload("Taxes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_no ASC")
+		}).
+		Where("status IN ?", []domain.SalesInvoiceStatus{domain.SalesInvoiceStatusPosted, domain.SalesInvoiceStatusPaid}).
+		Where("tax_invoice_no <> ''").
+		Where("invoice_date BETWEEN ? AND ?", dateFrom, dateTo).
+		Order("tax_invoice_no ASC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (repository *TaxReportRepositoryImpl) FindCustomersByIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]domain.Customer, error) {
+	var customers []domain.Customer
+	if len(ids) == 0 {
+		return customers, nil
+	}
+
+	err := tx.WithContext(ctx).Where("id IN ?", ids).Find(&customers).Error
+	if err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
+func (repository *TaxReportRepositoryImpl) FindWithholdings(ctx context.Context, tx *gorm.DB, dateFrom, dateTo time.Time, taxType string) ([]domain.WithholdingTaxRow, error) {
+	var rows []domain.WithholdingTaxRow
+
+	query := tx.WithContext(ctx).
+		Table("supplier_invoice_taxes AS t").
+		Select("i.id AS supplier_invoice_id, i.number AS invoice_number, i.supplier_invoice_no, i.invoice_date, i.supplier_id, i.supplier_name, s.npwp AS supplier_npwp, i.currency, i.exchange_rate, t.tax_code, t.tax_type, t.base_amount, t.rate, t.amount").
+		Joins("JOIN supplier_invoices AS i ON i.id = t.supplier_invoice_id").
+		Joins("LEFT JOIN suppliers AS s ON s.id = i.supplier_id").
+		Where("t.is_withholding = ?", true).
+		Where("i.status IN ?", []domain.SupplierInvoiceStatus{domain.SupplierInvoiceStatusApproved, domain.SupplierInvoiceStatusPaid}).
+		Where("i.invoice_date BETWEEN ? AND ?", dateFrom, dateTo)
+	if taxType != "" {
+		query = query.Where("t.tax_type = ?", taxType)
+	}
+
+	err := query.
+		Order("t.tax_type ASC, i.supplier_name ASC, i.invoice_date ASC, i.number ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,27 @@
+package token
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RevokedTokenRepository interface {
+	// Create menambahkan entri denylist; entri yang sudah ada diabaikan
+	Create(ctx context.Context, tx *gorm.DB, revokedToken domain.RevokedToken) error
+
+	// FindActive mengambil semua entri denylist yang belum kedaluwarsa
+	FindActive(ctx context.Context, tx *gorm.DB) ([]domain.RevokedToken, error)
+
+	// DeleteExpired menghapus entri denylist yang tokennya sudah kedaluwarsa
+	DeleteExpired(ctx context.Context, tx *gorm.DB) error
+
+	// FindUserTokenState mengambil status aktif dan watermark token milik user
+	FindUserTokenState(ctx context.Context, tx *gorm.DB, userID uuid.UUID) (domain.UserTokenState, error)
+
+	// UpdateTokensValidAfter menolak semua token user yang diterbitkan sebelum validAfter
+	UpdateTokensValidAfter(ctx context.Context, tx *gorm.DB, userID uuid.UUID, validAfter time.Time) error
+}
@@ -0,0 +1,54 @@
+package token
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RevokedTokenRepositoryImpl struct{}
+
+func NewRevokedTokenRepository() RevokedTokenRepository {
+	return &RevokedTokenRepositoryImpl{}
+}
+
+func (repository *RevokedTokenRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, revokedToken domain.RevokedToken) error {
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&revokedToken).Error
+}
+
+func (repository *RevokedTokenRepositoryImpl) FindActive(ctx context.Context, tx *gorm.DB) ([]domain.RevokedToken, error) {
+	var revokedTokens []domain.RevokedToken
+
+	err := tx.WithContext(ctx).Where("expires_at > ?", time.Now()).Find(&revokedTokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return revokedTokens, nil
+}
+
+func (repository *RevokedTokenRepositoryImpl) DeleteExpired(ctx context.Context, tx *gorm.DB) error {
+	return tx.WithContext(ctx).Where("expires_at <= ?", time.Now()).Delete(&domain.RevokedToken{}).Error
+}
+
+func (repository *RevokedTokenRepositoryImpl) FindUserTokenState(ctx context.Context, tx *gorm.DB, userID uuid.UUID) (domain.UserTokenState, error) {
+	var state domain.UserTokenState
+
+	err := tx.WithContext(ctx).Model(&domain.Users{}).
+		Select("id, is_active, tokens_valid_after").
+		Where("id = ?", userID).
+		Take(&state).Error
+	if err != nil {
+		return domain.UserTokenState{}, err
+	}
+	return state, nil
+}
+
+func (repository *RevokedTokenRepositoryImpl) UpdateTokensValidAfter(ctx context.Context, tx *gorm.DB, userID uuid.UUID, validAfter time.Time) error {
+	return tx.WithContext(ctx).Model(&domain.Users{}).
+		Where("id = ?", userID).
+		Update("tokens_valid_after", validAfter).Error
+}
@@ -3,18 +3,36 @@ package token
 import (
 	"context"
 	"erpfinance/internal/model/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type TokenRepository interface {
-	// Create menyimpan refresh token baru ke database
-	Create(ctx context.Context, tx *gorm.DB, userID uuid.UUID, token string) error
+	// Create menyimpan refresh token baru ke database. FamilyID harus diisi: family baru saat login,
+	// family token lama saat rotasi.
+	Create(ctx context.Context, tx *gorm.DB, refreshToken domain.RefreshToken) error
 	
 	// FindByToken mencari refresh token berdasarkan token string
 	FindByToken(ctx context.Context, tx *gorm.DB, token string) (domain.RefreshToken, error)
 	
+	// FindByTokenForUpdate mengunci refresh token yang belum expired, termasuk yang sudah dirotasi
+	// atau dicabut, agar pemakaian ulang bisa dideteksi
+	FindByTokenForUpdate(ctx context.Context, tx *gorm.DB, token string) (domain.RefreshToken, error)
+	
+	// MarkRotated menandai refresh token sudah ditukar dengan token baru
+	MarkRotated(ctx context.Context, tx *gorm.DB, tokenID uuid.UUID, rotatedAt time.Time) error
+	
+	// RevokeFamily mencabut semua refresh token yang belum dicabut dalam satu family
+	RevokeFamily(ctx context.Context, tx *gorm.DB, familyID uuid.UUID, reason string) error
+	
+	// RevokeOtherFamilies mencabut semua sesi user kecuali family exceptFamilyID
+	RevokeOtherFamilies(ctx context.Context, tx *gorm.DB, userID uuid.UUID, exceptFamilyID uuid.UUID, reason string) error
+	
+	// FindActiveByFamilyID mencari token aktif (sesi) dalam satu family
+	FindActiveByFamilyID(ctx context.Context, tx *gorm.DB, familyID uuid.UUID) (domain.RefreshToken, error)
+	
 	// Delete menghapus refresh token berdasarkan ID
 	Delete(ctx context.Context, tx *gorm.DB, tokenID uuid.UUID) error
 	
@@ -24,6 +42,9 @@ type TokenRepository interface {
 	// DeleteExpired menghapus semua refresh token yang sudah expired
 	DeleteExpired(ctx context.Context, tx *gorm.DB) error
 	
-	// FindByUserID mencari semua refresh token milik user tertentu
+	// DeleteExpiredByUserID menghapus refresh token expired milik user tertentu
+	DeleteExpiredByUserID(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error
+	
+	// FindByUserID mencari semua refresh token aktif (belum dirotasi atau dicabut) milik user tertentu
 	FindByUserID(ctx context.Context, tx *gorm.DB, userID uuid.UUID) ([]domain.RefreshToken, error)
 }
\ No newline at end of file
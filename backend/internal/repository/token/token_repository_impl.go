@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TokenRepositoryImpl struct{}
@@ -15,13 +16,9 @@ func NewTokenRepository() TokenRepository {
 	return &TokenRepositoryImpl{}
 }
 
-func (repository *TokenRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, userID uuid.UUID, token string) error {
-	refreshToken := domain.RefreshToken{
-		ID:        uuid.New(),
-		UserID:    userID,
-		Token:     token,
-		ExpiresAt: time.Now().Add(7 * 24 * time.Hour), // 7 hari
-	}
+func (repository *TokenRepositoryImpl) Create(ctx context.Context, tx *gorm.DB, refreshToken domain.RefreshToken) error {
+	refreshToken.ID = uuid.New()
+	refreshToken.ExpiresAt = time.Now().Add(7 * 24 * time.Hour) // 7 hari
 
 	err := tx.WithContext(ctx).Create(&refreshToken).Error
 	if err != nil {
@@ -40,6 +37,55 @@ func (repository *TokenRepositoryImpl) FindByToken(ctx context.Context, tx *gorm
 	return refreshToken, nil
 }
 
+func (repository *TokenRepositoryImpl) FindByTokenForUpdate(ctx context.Context, tx *gorm.DB, token string) (domain.RefreshToken, error) {
+	var refreshToken domain.RefreshToken
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token = ? AND expires_at > ?", token, time.Now()).
+		First(&refreshToken).Error
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+	return refreshToken, nil
+}
+
+func (repository *TokenRepositoryImpl) MarkRotated(ctx context.Context, tx *gorm.DB, tokenID uuid.UUID, rotatedAt time.Time) error {
+	return tx.WithContext(ctx).Model(&domain.RefreshToken{}).
+		Where("id = ?", tokenID).
+		Update("rotated_at", rotatedAt).Error
+}
+
+func (repository *TokenRepositoryImpl) RevokeFamily(ctx context.Context, tx *gorm.DB, familyID uuid.UUID, reason string) error {
+	return tx.WithContext(ctx).Model(&domain.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error
+}
+
+func (repository *TokenRepositoryImpl) RevokeOtherFamilies(ctx context.Context, tx *gorm.DB, userID uuid.UUID, exceptFamilyID uuid.UUID, reason string) error {
+	return tx.WithContext(ctx).Model(&domain.RefreshToken{}).
+		Where("user_id = ? AND family_id <> ? AND revoked_at IS NULL", userID, exceptFamilyID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error
+}
+
+func (repository *TokenRepositoryImpl) FindActiveByFamilyID(ctx context.Context, tx *gorm.DB, familyID uuid.UUID) (domain.RefreshToken, error) {
+	var refreshToken domain.RefreshToken
+
+	err := tx.WithContext(ctx).
+		Where("family_id = ? AND expires_at > ? AND rotated_at IS NULL AND revoked_at IS NULL", familyID, time.Now()).
+		First(&refreshToken).Error
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+	return refreshToken, nil
+}
+
 func (repository *TokenRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB, tokenID uuid.UUID) error {
 	err := tx.WithContext(ctx).Where("id = ?", tokenID).Delete(&domain.RefreshToken{}).Error
 	if err != nil {
@@ -64,10 +110,14 @@ func (repository *TokenRepositoryImpl) DeleteExpired(ctx context.Context, tx *go
 	return nil
 }
 
+func (repository *TokenRepositoryImpl) DeleteExpiredByUserID(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error {
+	return tx.WithContext(ctx).Where("user_id = ? AND expires_at <= ?", userID, time.Now()).Delete(&domain.RefreshToken{}).Error
+}
+
 func (repository *TokenRepositoryImpl) FindByUserID(ctx context.Context, tx *gorm.DB, userID uuid.UUID) ([]domain.RefreshToken, error) {
 	var refreshTokens []domain.RefreshToken
 
-	err := tx.WithContext(ctx).Where("user_id = ? AND expires_at > ?", userID, time.Now()).Find(&refreshTokens).Error
+	err := tx.WithContext(ctx).Where("user_id = ? AND expires_at > ? AND rotated_at IS NULL AND revoked_at IS NULL", userID, time.Now()).Order("last_used_at DESC").Find(&refreshTokens).Error
 	if err != nil {
 		return nil, err
 	}
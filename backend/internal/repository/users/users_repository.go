@@ -13,6 +13,8 @@ type UsersRepository interface {
 	FindById(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Users, error)
 	Update(ctx context.Context, tx *gorm.DB, users domain.Users) error
 	Delete(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+	// UpdateActive mengubah status aktif user (Update mengabaikan nilai false)
+	UpdateActive(ctx context.Context, tx *gorm.DB, id uuid.UUID, isActive bool) error
 	FindByEmail(ctx context.Context, tx *gorm.DB, email string) (domain.Users, error)
 	FindByEmailAndNotID(ctx context.Context, tx *gorm.DB, email string, id uuid.UUID) (domain.Users, error)
 }
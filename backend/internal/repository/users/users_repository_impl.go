@@ -55,6 +55,10 @@ func (repository *UsersRepositoryImpl) Update(ctx context.Context, tx *gorm.DB,
 	return nil
 }
 
+func (repository *UsersRepositoryImpl) UpdateActive(ctx context.Context, tx *gorm.DB, id uuid.UUID, isActive bool) error {
+	return tx.WithContext(ctx).Model(&domain.Users{}).Where("id = ?", id).Update("is_active", isActive).Error
+}
+
 func (repository *UsersRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
 	err := tx.WithContext(ctx).Where("id = ?", id).Delete(&domain.Users{}).Error
 	if err != nil {
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/approval"
+	"erpfinance/internal/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ApprovalRouter mendaftarkan workflow approval. Rule hanya dikelola admin; inbox, keputusan dan
+// delegasi terbuka untuk semua user yang login karena wewenangnya diperiksa per task di service.
+func ApprovalRouter(router *fiber.App, approvalRuleHandler approval.ApprovalRuleHandler, approvalHandler approval.ApprovalHandler) {
+	approvals := router.Group("/api/v1/approvals", middleware.AuthMiddleware())
+
+	rules := approvals.Group("/rules", middleware.IsAdmin())
+	rules.Get("/", approvalRuleHandler.FindAll)
+	rules.Get("/:id", approvalRuleHandler.FindById)
+	rules.Post("/", approvalRuleHandler.Create)
+	rules.Put("/:id", approvalRuleHandler.Update)
+
+	approvals.Get("/inbox", approvalHandler.Inbox)
+
+	approvals.Get("/requests", approvalHandler.FindAllRequests)
+	approvals.Get("/requests/:id", approvalHandler.FindRequestById)
+	approvals.Post("/requests/:id/approve", approvalHandler.Approve)
+	approvals.Post("/requests/:id/reject", approvalHandler.Reject)
+
+	approvals.Get("/delegations", approvalHandler.FindAllDelegations)
+	approvals.Post("/delegations", approvalHandler.CreateDelegation)
+	approvals.Post("/delegations/:id/revoke", approvalHandler.RevokeDelegation)
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/asset"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AssetRouter mendaftarkan kategori aset, register aset tetap dan depreciation run dalam satu
+// group /api/v1/fixed-assets yang hanya bisa diakses finance
+func AssetRouter(router *fiber.App, fixedAssetHandler asset.FixedAssetHandler, depreciationRunHandler asset.DepreciationRunHandler) {
+	app := router.Group("/api/v1/fixed-assets", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	// Path statis didaftarkan sebelum /:id agar tidak tertangkap sebagai ID aset
+	app.Get("/categories", fixedAssetHandler.FindAllCategories)
+	app.Get("/categories/:id", fixedAssetHandler.FindCategoryById)
+	app.Post("/categories", fixedAssetHandler.CreateCategory)
+	app.Put("/categories/:id", fixedAssetHandler.UpdateCategory)
+
+	app.Get("/depreciation-runs", depreciationRunHandler.FindAll)
+	app.Get("/depreciation-runs/:id", depreciationRunHandler.FindById)
+	app.Post("/depreciation-runs", depreciationRunHandler.Run)
+
+	app.Get("/", fixedAssetHandler.FindAll)
+	app.Get("/:id", fixedAssetHandler.FindById)
+	app.Get("/:id/schedule", fixedAssetHandler.Schedule)
+	app.Post("/", fixedAssetHandler.Create)
+	app.Put("/:id", fixedAssetHandler.Update)
+	app.Post("/:id/activate", fixedAssetHandler.Activate)
+	app.Post("/:id/transfer", fixedAssetHandler.Transfer)
+	app.Post("/:id/dispose", fixedAssetHandler.Dispose)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/audit"
+	"erpfinance/internal/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AuditRouter mendaftarkan pembacaan audit log, hanya untuk admin. Tidak ada endpoint ubah atau
+// hapus karena audit log bersifat append-only.
+func AuditRouter(router *fiber.App, auditLogHandler audit.AuditLogHandler) {
+	auditLogs := router.Group("/api/v1/audit-logs", middleware.AuthMiddleware(), middleware.IsAdmin())
+
+	auditLogs.Get("/", auditLogHandler.FindAll)
+	auditLogs.Get("/:id", auditLogHandler.FindById)
+}
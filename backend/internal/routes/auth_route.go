@@ -7,12 +7,34 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func AuthRouter(router *fiber.App, authHandler auth.AuthHandler) {
+func AuthRouter(router *fiber.App, authHandler auth.AuthHandler, twoFactorHandler auth.TwoFactorHandler, sessionHandler auth.SessionHandler) {
+	// Public key JWT di lokasi standar agar service lain bisa memverifikasi token tanpa berbagi secret
+	router.Get("/.well-known/jwks.json", authHandler.JWKS)
+
 	app := router.Group("/api/v1/auth")
 
 	app.Post("/login", authHandler.Login)
+	app.Post("/login/2fa", authHandler.LoginTwoFactor)
+	app.Post("/login/2fa/setup", authHandler.LoginTwoFactorSetup)
 	app.Post("/register", authHandler.Register)
 	app.Post("/refresh-token", authHandler.RefreshToken)
 	app.Post("/logout", middleware.AuthMiddleware(), authHandler.Logout)
 	app.Post("/change-password", middleware.AuthMiddleware(), authHandler.ChangePassword)
+
+	// 2FA milik user yang sedang login; policy per role dan reset hanya untuk admin
+	twoFactor := app.Group("/2fa", middleware.AuthMiddleware())
+	twoFactor.Get("/", twoFactorHandler.Status)
+	twoFactor.Post("/setup", twoFactorHandler.Setup)
+	twoFactor.Post("/enable", twoFactorHandler.Enable)
+	twoFactor.Post("/disable", twoFactorHandler.Disable)
+	twoFactor.Post("/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes)
+	twoFactor.Get("/policies", middleware.IsAdmin(), twoFactorHandler.FindAllPolicies)
+	twoFactor.Put("/policies/:role", middleware.IsAdmin(), twoFactorHandler.UpdatePolicy)
+	twoFactor.Post("/users/:id/reset", middleware.IsAdmin(), twoFactorHandler.Reset)
+
+	// Sesi login per perangkat milik user yang sedang login
+	sessions := app.Group("/sessions", middleware.AuthMiddleware())
+	sessions.Get("/", sessionHandler.FindAll)
+	sessions.Post("/revoke-others", sessionHandler.RevokeOthers)
+	sessions.Post("/:id/revoke", sessionHandler.Revoke)
 }
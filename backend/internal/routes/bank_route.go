@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/bank"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func BankRouter(router *fiber.App, bankAccountHandler bank.BankAccountHandler, bankReconciliationHandler bank.BankReconciliationHandler) {
+	accounts := router.Group("/api/v1/bank-accounts")
+
+	accounts.Get("/", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankAccountHandler.FindAll)
+	accounts.Post("/", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankAccountHandler.Create)
+	accounts.Get("/:id", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankAccountHandler.FindById)
+	accounts.Put("/:id", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankAccountHandler.Update)
+	accounts.Get("/:id/reconciliation", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.Workspace)
+	accounts.Post("/:id/reconciliation/auto-match", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.AutoMatch)
+
+	statements := router.Group("/api/v1/bank-statements")
+
+	// Import rekening koran hanya untuk admin
+	statements.Post("/import", middleware.AuthMiddleware(), middleware.IsAdmin(), bankReconciliationHandler.ImportStatement)
+	statements.Get("/", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.FindAllStatements)
+	statements.Post("/lines/:id/match", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.Match)
+	statements.Post("/lines/:id/unmatch", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.Unmatch)
+	statements.Get("/:id", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance), bankReconciliationHandler.FindStatementById)
+}
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/budget"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BudgetRouter mendaftarkan cost center dan budget. Pemeliharaan hanya untuk finance, sedangkan
+// purchasing boleh membaca cost center dan melakukan budget check atas rencana pembelian.
+func BudgetRouter(router *fiber.App, costCenterHandler budget.CostCenterHandler, budgetHandler budget.BudgetHandler) {
+	readRoles := middleware.RequireRoles(domain.RoleFinance, domain.RolePurchasing)
+	financeOnly := middleware.RequireRoles(domain.RoleFinance)
+
+	costCenters := router.Group("/api/v1/cost-centers", middleware.AuthMiddleware())
+
+	costCenters.Get("/", readRoles, costCenterHandler.FindAll)
+	costCenters.Get("/:id", readRoles, costCenterHandler.FindById)
+	costCenters.Post("/", financeOnly, costCenterHandler.Create)
+	costCenters.Put("/:id", financeOnly, costCenterHandler.Update)
+
+	budgets := router.Group("/api/v1/budgets", middleware.AuthMiddleware())
+
+	// Path statis didaftarkan sebelum /:id agar tidak tertangkap sebagai ID budget
+	budgets.Post("/check", readRoles, budgetHandler.Check)
+	budgets.Get("/report/budget-vs-actual", financeOnly, budgetHandler.BudgetVsActual)
+
+	budgets.Get("/", financeOnly, budgetHandler.FindAll)
+	budgets.Get("/:id", financeOnly, budgetHandler.FindById)
+	budgets.Post("/", financeOnly, budgetHandler.Create)
+	budgets.Put("/:id", financeOnly, budgetHandler.Update)
+	budgets.Post("/:id/activate", financeOnly, budgetHandler.Activate)
+	budgets.Post("/:id/close", financeOnly, budgetHandler.Close)
+}
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/currency"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CurrencyRouter mendaftarkan mata uang, kurs dan revaluasi dalam satu group /api/v1/currencies.
+// Pemeliharaan kurs dan revaluasi hanya untuk finance, sedangkan purchasing dan sales cukup membaca.
+func CurrencyRouter(router *fiber.App, currencyHandler currency.CurrencyHandler, fxRevaluationHandler currency.FXRevaluationHandler) {
+	app := router.Group("/api/v1/currencies", middleware.AuthMiddleware())
+
+	readRoles := middleware.RequireRoles(domain.RoleFinance, domain.RolePurchasing, domain.RoleSales)
+	financeOnly := middleware.RequireRoles(domain.RoleFinance)
+
+	// Path statis didaftarkan sebelum /:code agar tidak tertangkap sebagai kode mata uang
+	app.Get("/rates", readRoles, currencyHandler.FindAllRates)
+	app.Get("/rates/lookup", readRoles, currencyHandler.LookupRate)
+	app.Post("/rates", financeOnly, currencyHandler.SaveRate)
+	app.Post("/rates/import", financeOnly, currencyHandler.ImportRates)
+
+	app.Get("/settings", financeOnly, currencyHandler.GetSetting)
+	app.Put("/settings", financeOnly, currencyHandler.UpdateSetting)
+
+	app.Get("/revaluations", financeOnly, fxRevaluationHandler.FindAll)
+	app.Get("/revaluations/:id", financeOnly, fxRevaluationHandler.FindById)
+	app.Post("/revaluations", financeOnly, fxRevaluationHandler.Run)
+
+	app.Get("/", readRoles, currencyHandler.FindAll)
+	app.Get("/:code", readRoles, currencyHandler.FindByCode)
+	app.Post("/", financeOnly, currencyHandler.Create)
+	app.Put("/:code", financeOnly, currencyHandler.Update)
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/receivable"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CustomerReceiptRouter(router *fiber.App, customerReceiptHandler receivable.CustomerReceiptHandler) {
+	app := router.Group("/api/v1/customer-receipts", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/", customerReceiptHandler.FindAll)
+	app.Get("/:id", customerReceiptHandler.FindById)
+	app.Post("/", customerReceiptHandler.Create)
+	app.Post("/:id/post", customerReceiptHandler.Post)
+	app.Post("/:id/cancel", customerReceiptHandler.Cancel)
+	app.Post("/:id/allocate", customerReceiptHandler.Allocate)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/receivable"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CustomerRouter(router *fiber.App, customerHandler receivable.CustomerHandler) {
+	app := router.Group("/api/v1/customers", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/", customerHandler.FindAll)
+	app.Get("/:id", customerHandler.FindById)
+	app.Get("/:id/statement", customerHandler.Statement)
+	app.Post("/", customerHandler.Create)
+	app.Put("/:id", customerHandler.Update)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/receiving"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func GoodsReceiptRouter(router *fiber.App, goodsReceiptHandler receiving.GoodsReceiptHandler) {
+	app := router.Group("/api/v1/goods-receipts", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleWarehouse))
+
+	app.Get("/", goodsReceiptHandler.FindAll)
+	app.Get("/purchase-orders/:id", goodsReceiptHandler.FindPurchaseOrder)
+	app.Get("/:id", goodsReceiptHandler.FindById)
+	app.Post("/", goodsReceiptHandler.Create)
+}
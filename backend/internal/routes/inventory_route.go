@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/inventory"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func InventoryRouter(router *fiber.App, inventoryHandler inventory.InventoryHandler) {
+	app := router.Group("/api/v1/inventory", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleWarehouse))
+
+	app.Get("/items", inventoryHandler.FindAllItems)
+	app.Get("/items/:id", inventoryHandler.FindItemById)
+	app.Post("/items", inventoryHandler.CreateItem)
+	app.Put("/items/:id", inventoryHandler.UpdateItem)
+
+	app.Get("/warehouses", inventoryHandler.FindAllWarehouses)
+	app.Get("/warehouses/:id", inventoryHandler.FindWarehouseById)
+	app.Post("/warehouses", inventoryHandler.CreateWarehouse)
+	app.Put("/warehouses/:id", inventoryHandler.UpdateWarehouse)
+	app.Post("/warehouses/:id/bins", inventoryHandler.CreateBin)
+
+	app.Get("/movements", inventoryHandler.FindAllMovements)
+	app.Post("/movements", inventoryHandler.CreateMovement)
+	app.Get("/balances", inventoryHandler.FindBalances)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/ledger"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func LedgerRouter(router *fiber.App, ledgerHandler ledger.LedgerHandler) {
+	app := router.Group("/api/v1/ledger", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/accounts", ledgerHandler.FindAllAccounts)
+	app.Get("/accounts/tree", ledgerHandler.FindAccountTree)
+	app.Get("/accounts/:id", ledgerHandler.FindAccountById)
+	app.Post("/accounts", ledgerHandler.CreateAccount)
+	app.Put("/accounts/:id", ledgerHandler.UpdateAccount)
+
+	app.Get("/journals", ledgerHandler.FindAllJournals)
+	app.Get("/journals/:id", ledgerHandler.FindJournalById)
+	app.Post("/journals", ledgerHandler.CreateJournal)
+	app.Post("/journals/:id/post", ledgerHandler.PostJournal)
+	app.Post("/journals/:id/reverse", ledgerHandler.ReverseJournal)
+}
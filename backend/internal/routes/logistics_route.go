@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/logistics"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LogisticsRouter mendaftarkan carrier dan pengiriman dalam satu group /api/v1/logistics
+func LogisticsRouter(router *fiber.App, carrierHandler logistics.CarrierHandler, shipmentHandler logistics.ShipmentHandler) {
+	app := router.Group("/api/v1/logistics", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleLogistics))
+
+	app.Get("/carriers", carrierHandler.FindAll)
+	app.Get("/carriers/:id", carrierHandler.FindById)
+	app.Post("/carriers", carrierHandler.Create)
+	app.Put("/carriers/:id", carrierHandler.Update)
+
+	app.Get("/shipments", shipmentHandler.FindAll)
+	app.Get("/shipments/:id", shipmentHandler.FindById)
+	app.Get("/shipments/:id/packing-list", shipmentHandler.PackingList)
+	app.Post("/shipments", shipmentHandler.Create)
+	app.Put("/shipments/:id", shipmentHandler.Update)
+	app.Put("/shipments/:id/packing", shipmentHandler.UpdatePacking)
+	app.Post("/shipments/:id/pick", shipmentHandler.Pick)
+	app.Post("/shipments/:id/ship", shipmentHandler.Ship)
+	app.Post("/shipments/:id/deliver", shipmentHandler.Deliver)
+	app.Post("/shipments/:id/return", shipmentHandler.Return)
+	app.Post("/shipments/:id/tracking", shipmentHandler.AddTracking)
+	app.Post("/shipments/:id/attachments", shipmentHandler.AddAttachment)
+}
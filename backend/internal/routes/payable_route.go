@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/payable"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func PayableRouter(router *fiber.App, payableHandler payable.PayableHandler) {
+	app := router.Group("/api/v1/supplier-invoices", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/tolerances", payableHandler.GetTolerance)
+	app.Put("/tolerances", payableHandler.UpdateTolerance)
+	app.Get("/settings", payableHandler.GetSetting)
+	app.Put("/settings", payableHandler.UpdateSetting)
+	app.Get("/aging", payableHandler.AgingReport)
+
+	app.Get("/", payableHandler.FindAllInvoices)
+	app.Get("/:id", payableHandler.FindInvoiceById)
+	app.Get("/:id/match", payableHandler.MatchInvoice)
+	app.Post("/", payableHandler.CreateInvoice)
+	app.Put("/:id", payableHandler.UpdateInvoice)
+	app.Post("/:id/approve", payableHandler.ApproveInvoice)
+	app.Post("/:id/cancel", payableHandler.CancelInvoice)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/payable"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func PaymentRunRouter(router *fiber.App, paymentRunHandler payable.PaymentRunHandler) {
+	app := router.Group("/api/v1/payment-runs", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/", paymentRunHandler.FindAll)
+	app.Get("/:id", paymentRunHandler.FindById)
+	app.Post("/", paymentRunHandler.Create)
+	app.Post("/:id/post", paymentRunHandler.Post)
+	app.Post("/:id/cancel", paymentRunHandler.Cancel)
+}
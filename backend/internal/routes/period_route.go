@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/period"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func PeriodRouter(router *fiber.App, periodHandler period.PeriodHandler) {
+	app := router.Group("/api/v1/periods", middleware.AuthMiddleware())
+
+	app.Get("/fiscal-years", middleware.RequireRoles(domain.RoleFinance), periodHandler.FindAllFiscalYears)
+	app.Get("/fiscal-years/:id", middleware.RequireRoles(domain.RoleFinance), periodHandler.FindFiscalYearById)
+	app.Get("/:id/history", middleware.RequireRoles(domain.RoleFinance), periodHandler.FindStatusHistory)
+
+	// Perubahan struktur dan status periode hanya boleh dilakukan Admin
+	app.Post("/fiscal-years", middleware.RequireRoles(domain.RoleSuperAdmin), periodHandler.CreateFiscalYear)
+	app.Patch("/:id/status", middleware.RequireRoles(domain.RoleSuperAdmin), periodHandler.ChangeStatus)
+}
@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/ppc"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PPCRouter mendaftarkan master data produksi, work order dan MRP dalam satu group agar
+// middleware auth /api/v1/ppc tidak dijalankan berulang untuk setiap sub-path
+func PPCRouter(router *fiber.App, ppcHandler ppc.PPCHandler, workOrderHandler ppc.WorkOrderHandler, mrpHandler ppc.MRPHandler) {
+	app := router.Group("/api/v1/ppc", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RolePPC))
+
+	app.Get("/work-centers", ppcHandler.FindAllWorkCenters)
+	app.Get("/work-centers/:id", ppcHandler.FindWorkCenterById)
+	app.Post("/work-centers", ppcHandler.CreateWorkCenter)
+	app.Put("/work-centers/:id", ppcHandler.UpdateWorkCenter)
+
+	app.Get("/boms", ppcHandler.FindAllBOMs)
+	app.Get("/boms/:id", ppcHandler.FindBOMById)
+	app.Get("/boms/:id/explode", ppcHandler.ExplodeBOM)
+	app.Post("/boms", ppcHandler.CreateBOM)
+	app.Put("/boms/:id", ppcHandler.UpdateBOM)
+
+	app.Get("/routings", ppcHandler.FindAllRoutings)
+	app.Get("/routings/:id", ppcHandler.FindRoutingById)
+	app.Post("/routings", ppcHandler.CreateRouting)
+	app.Put("/routings/:id", ppcHandler.UpdateRouting)
+
+	app.Get("/work-orders", workOrderHandler.FindAll)
+	app.Get("/work-orders/:id", workOrderHandler.FindById)
+	app.Get("/work-orders/:id/cost", workOrderHandler.Cost)
+	app.Post("/work-orders", workOrderHandler.Create)
+	app.Post("/work-orders/:id/release", workOrderHandler.Release)
+	app.Post("/work-orders/:id/issue", workOrderHandler.Issue)
+	app.Post("/work-orders/:id/output", workOrderHandler.RecordOutput)
+	app.Post("/work-orders/:id/complete", workOrderHandler.Complete)
+	app.Post("/work-orders/:id/cancel", workOrderHandler.Cancel)
+
+	app.Get("/mrp/runs", mrpHandler.FindAllRuns)
+	app.Get("/mrp/runs/:id", mrpHandler.FindRunById)
+	app.Get("/mrp/runs/:id/results", mrpHandler.FindRunResults)
+	app.Get("/mrp/runs/:id/suggestions", mrpHandler.FindRunSuggestions)
+	app.Post("/mrp/runs", mrpHandler.StartRun)
+	app.Post("/mrp/runs/:id/convert", mrpHandler.ConvertSuggestions)
+}
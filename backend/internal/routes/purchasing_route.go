@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/purchasing"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func PurchasingRouter(router *fiber.App, purchasingHandler purchasing.PurchasingHandler) {
+	app := router.Group("/api/v1/purchasing", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RolePurchasing))
+
+	app.Get("/requisitions", purchasingHandler.FindAllRequisitions)
+	app.Get("/requisitions/:id", purchasingHandler.FindRequisitionById)
+	app.Post("/requisitions", purchasingHandler.CreateRequisition)
+	app.Put("/requisitions/:id", purchasingHandler.UpdateRequisition)
+	app.Post("/requisitions/:id/submit", purchasingHandler.SubmitRequisition)
+	app.Post("/requisitions/:id/approve", purchasingHandler.ApproveRequisition)
+	app.Post("/requisitions/:id/reject", purchasingHandler.RejectRequisition)
+	app.Post("/requisitions/:id/convert", purchasingHandler.ConvertRequisition)
+
+	app.Get("/orders", purchasingHandler.FindAllPurchaseOrders)
+	app.Get("/orders/:id", purchasingHandler.FindPurchaseOrderById)
+	app.Post("/orders", purchasingHandler.CreatePurchaseOrder)
+	app.Put("/orders/:id", purchasingHandler.UpdatePurchaseOrder)
+	app.Post("/orders/:id/approve", purchasingHandler.ApprovePurchaseOrder)
+	app.Post("/orders/:id/send", purchasingHandler.SendPurchaseOrder)
+	app.Post("/orders/:id/receive", purchasingHandler.ReceivePurchaseOrder)
+	app.Post("/orders/:id/close", purchasingHandler.ClosePurchaseOrder)
+	app.Post("/orders/:id/cancel", purchasingHandler.CancelPurchaseOrder)
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/receivable"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ReceivableRouter(router *fiber.App, receivableHandler receivable.ReceivableHandler) {
+	app := router.Group("/api/v1/sales-invoices", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/settings", receivableHandler.GetSetting)
+	app.Put("/settings", receivableHandler.UpdateSetting)
+	app.Get("/aging", receivableHandler.AgingReport)
+
+	app.Get("/", receivableHandler.FindAllInvoices)
+	app.Get("/:id", receivableHandler.FindInvoiceById)
+	app.Post("/", receivableHandler.CreateInvoice)
+	app.Put("/:id", receivableHandler.UpdateInvoice)
+	app.Post("/:id/post", receivableHandler.PostInvoice)
+	app.Post("/:id/cancel", receivableHandler.CancelInvoice)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/report"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ReportRouter(router *fiber.App, financialReportHandler report.FinancialReportHandler) {
+	app := router.Group("/api/v1/reports/financial", middleware.AuthMiddleware(), middleware.RequireRoles(domain.RoleFinance))
+
+	app.Get("/trial-balance", financialReportHandler.TrialBalance)
+	app.Get("/balance-sheet", financialReportHandler.BalanceSheet)
+	app.Get("/income-statement", financialReportHandler.IncomeStatement)
+	app.Get("/cash-flow", financialReportHandler.CashFlow)
+}
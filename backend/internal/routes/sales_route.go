@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/sales"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SalesRouter(router *fiber.App, salesOrderHandler sales.SalesOrderHandler) {
+	app := router.Group("/api/v1/sales", middleware.AuthMiddleware())
+
+	app.Get("/orders", middleware.RequireRoles(domain.RoleSales, domain.RoleFinance), salesOrderHandler.FindAll)
+	app.Get("/orders/:id", middleware.RequireRoles(domain.RoleSales, domain.RoleFinance), salesOrderHandler.FindById)
+	app.Get("/customers/:id/credit", middleware.RequireRoles(domain.RoleSales, domain.RoleFinance), salesOrderHandler.CustomerCredit)
+
+	app.Post("/orders", middleware.RequireRoles(domain.RoleSales), salesOrderHandler.Create)
+	app.Put("/orders/:id", middleware.RequireRoles(domain.RoleSales), salesOrderHandler.Update)
+	app.Post("/orders/:id/confirm", middleware.RequireRoles(domain.RoleSales), salesOrderHandler.Confirm)
+	app.Post("/orders/:id/cancel", middleware.RequireRoles(domain.RoleSales), salesOrderHandler.Cancel)
+
+	// Order yang melebihi plafon kredit hanya bisa dilepas oleh Finance
+	app.Post("/orders/:id/credit-override", middleware.RequireRoles(domain.RoleFinance), salesOrderHandler.OverrideCredit)
+}
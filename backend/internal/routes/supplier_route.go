@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/supplier"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SupplierRouter(router *fiber.App, supplierHandler supplier.SupplierHandler) {
+	app := router.Group("/api/v1/suppliers", middleware.AuthMiddleware())
+
+	// Finance membutuhkan data supplier (NPWP, rekening bank) untuk hutang dan pajak
+	app.Get("/", middleware.RequireRoles(domain.RolePurchasing, domain.RoleFinance), supplierHandler.FindAll)
+	app.Get("/:id", middleware.RequireRoles(domain.RolePurchasing, domain.RoleFinance), supplierHandler.FindById)
+
+	app.Post("/", middleware.RequireRoles(domain.RolePurchasing), supplierHandler.Create)
+	app.Put("/:id", middleware.RequireRoles(domain.RolePurchasing), supplierHandler.Update)
+	app.Patch("/:id/status", middleware.RequireRoles(domain.RolePurchasing), supplierHandler.ChangeStatus)
+}
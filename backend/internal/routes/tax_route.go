@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"erpfinance/internal/handler/tax"
+	"erpfinance/internal/middleware"
+	"erpfinance/internal/model/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TaxRouter mendaftarkan kode pajak, range nomor faktur pajak, export e-Faktur dan laporan PPh.
+// Kode pajak boleh dibaca purchasing dan sales untuk mengisi invoice, sisanya hanya untuk finance.
+func TaxRouter(router *fiber.App, taxHandler tax.TaxHandler) {
+	app := router.Group("/api/v1/taxes", middleware.AuthMiddleware())
+
+	readRoles := middleware.RequireRoles(domain.RoleFinance, domain.RolePurchasing, domain.RoleSales)
+	financeOnly := middleware.RequireRoles(domain.RoleFinance)
+
+	app.Get("/codes", readRoles, taxHandler.FindAllTaxCodes)
+	app.Get("/codes/:id", readRoles, taxHandler.FindTaxCodeById)
+	app.Post("/codes", financeOnly, taxHandler.CreateTaxCode)
+	app.Put("/codes/:id", financeOnly, taxHandler.UpdateTaxCode)
+
+	app.Get("/invoice-ranges", financeOnly, taxHandler.FindAllInvoiceRanges)
+	app.Post("/invoice-ranges", financeOnly, taxHandler.CreateInvoiceRange)
+	app.Put("/invoice-ranges/:id", financeOnly, taxHandler.UpdateInvoiceRange)
+
+	app.Get("/e-faktur/export", financeOnly, taxHandler.ExportEFaktur)
+	app.Get("/withholdings", financeOnly, taxHandler.WithholdingReport)
+}
@@ -14,5 +14,7 @@ func UsersRouter(router *fiber.App, usersHandler users.UsersHandler) {
 	app.Get("/:id", middleware.AuthMiddleware(), middleware.IsAdmin(), usersHandler.FindById)
 	app.Put("/:id", middleware.AuthMiddleware(), middleware.IsAdmin(), usersHandler.Update)
 	app.Delete("/:id", middleware.AuthMiddleware(), middleware.IsAdmin(), usersHandler.Delete)
+	app.Post("/:id/deactivate", middleware.AuthMiddleware(), middleware.IsAdmin(), usersHandler.Deactivate)
+	app.Post("/:id/activate", middleware.AuthMiddleware(), middleware.IsAdmin(), usersHandler.Activate)
 
 }
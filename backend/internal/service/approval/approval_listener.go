@@ -0,0 +1,16 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"gorm.io/gorm"
+)
+
+// ApprovalListener diimplementasikan oleh modul pemilik dokumen untuk menerima hasil akhir approval.
+// OnApprovalCompleted dipanggil di dalam transaksi keputusan terakhir sehingga perubahan status
+// dokumen dan status request tersimpan bersamaan.
+type ApprovalListener interface {
+	DocumentType() string
+	OnApprovalCompleted(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) error
+}
@@ -0,0 +1,17 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+
+	"github.com/google/uuid"
+)
+
+type ApprovalRuleService interface {
+	Create(ctx context.Context, request approval.ApprovalRuleRequest) (*approval.ApprovalRuleResponse, error)
+	// Update mengganti seluruh step rule; request yang sedang berjalan tetap memakai task lamanya
+	Update(ctx context.Context, id uuid.UUID, request approval.ApprovalRuleUpdateRequest) (*approval.ApprovalRuleResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*approval.ApprovalRuleResponse, error)
+	FindAll(ctx context.Context, filter approval.ApprovalRuleFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
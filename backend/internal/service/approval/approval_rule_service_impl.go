@@ -0,0 +1,164 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+	repo "erpfinance/internal/repository/approval"
+	usersRepo "erpfinance/internal/repository/users"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalRuleServiceImpl struct {
+	ApprovalRuleRepository repo.ApprovalRuleRepository
+	UsersRepository        usersRepo.UsersRepository
+	DB                     *gorm.DB
+	Validate               *validator.Validate
+}
+
+func NewApprovalRuleService(approvalRuleRepository repo.ApprovalRuleRepository, usersRepository usersRepo.UsersRepository, db *gorm.DB, validate *validator.Validate) ApprovalRuleService {
+	return &ApprovalRuleServiceImpl{
+		ApprovalRuleRepository: approvalRuleRepository,
+		UsersRepository:        usersRepository,
+		DB:                     db,
+		Validate:               validate,
+	}
+}
+
+func (service *ApprovalRuleServiceImpl) Create(ctx context.Context, request approval.ApprovalRuleRequest) (*approval.ApprovalRuleResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	rule := domain.ApprovalRule{ID: uuid.New(), IsActive: true}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyRequest(ctx, tx, &rule, request); err != nil {
+			return err
+		}
+
+		_, err := service.ApprovalRuleRepository.Create(ctx, tx, rule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, rule.ID)
+}
+
+func (service *ApprovalRuleServiceImpl) Update(ctx context.Context, id uuid.UUID, request approval.ApprovalRuleUpdateRequest) (*approval.ApprovalRuleResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rule, err := service.ApprovalRuleRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("approval rule not found")
+		}
+
+		if err := service.applyRequest(ctx, tx, &rule, request.ApprovalRuleRequest); err != nil {
+			return err
+		}
+		rule.IsActive = request.IsActive
+
+		if err := service.ApprovalRuleRepository.Update(ctx, tx, rule); err != nil {
+			return err
+		}
+		return service.ApprovalRuleRepository.ReplaceSteps(ctx, tx, rule.ID, rule.Steps)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *ApprovalRuleServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*approval.ApprovalRuleResponse, error) {
+	rule, err := service.ApprovalRuleRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("approval rule not found")
+	}
+
+	return mapper.ToApprovalRuleResponse(rule), nil
+}
+
+func (service *ApprovalRuleServiceImpl) FindAll(ctx context.Context, filter approval.ApprovalRuleFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	rules, totalItems, err := service.ApprovalRuleRepository.FindAllWithPagination(ctx, service.DB, filter.DocumentType, filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToApprovalRuleResponses(rules)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+// applyRequest mengisi rule dan step-nya dari request setelah memastikan kode belum dipakai,
+// rentang nominal valid dan setiap step memiliki tepat satu approver
+func (service *ApprovalRuleServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, rule *domain.ApprovalRule, request approval.ApprovalRuleRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	var excludeID *uuid.UUID
+	if rule.Code != "" {
+		excludeID = &rule.ID
+	}
+	exists, err := service.ApprovalRuleRepository.ExistsByCode(ctx, tx, code, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("approval rule %s already exists", code))
+	}
+
+	if request.MaxAmount != nil && request.MaxAmount.LessThan(request.MinAmount) {
+		return exception.NewError("max amount cannot be less than min amount")
+	}
+
+	steps := make([]domain.ApprovalRuleStep, 0, len(request.Steps))
+	for i, stepRequest := range request.Steps {
+		if (stepRequest.ApproverRole == "") == (stepRequest.ApproverUserID == nil) {
+			return exception.NewError(fmt.Sprintf("step %d must have exactly one of approver role or approver user", i+1))
+		}
+
+		step := domain.ApprovalRuleStep{
+			ID:        uuid.New(),
+			RuleID:    rule.ID,
+			Sequence:  stepRequest.Sequence,
+			Name:      stepRequest.Name,
+			MinAmount: helper.RoundAmount(stepRequest.MinAmount),
+		}
+		if stepRequest.ApproverRole != "" {
+			role := domain.Role(stepRequest.ApproverRole)
+			step.ApproverRole = &role
+		} else {
+			if _, err := service.UsersRepository.FindById(ctx, tx, *stepRequest.ApproverUserID); err != nil {
+				return exception.NewError(fmt.Sprintf("approver user on step %d not found", i+1))
+			}
+			step.ApproverUserID = stepRequest.ApproverUserID
+		}
+		steps = append(steps, step)
+	}
+
+	rule.Code = code
+	rule.Name = request.Name
+	rule.DocumentType = request.DocumentType
+	rule.MinAmount = helper.RoundAmount(request.MinAmount)
+	rule.MaxAmount = nil
+	if request.MaxAmount != nil {
+		maxAmount := helper.RoundAmount(*request.MaxAmount)
+		rule.MaxAmount = &maxAmount
+	}
+	rule.Priority = request.Priority
+	rule.Description = request.Description
+	rule.Steps = steps
+	return nil
+}
@@ -0,0 +1,31 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalService interface {
+	// Submit harus dipanggil di dalam DB.Transaction milik modul pemilik dokumen. Rule aktif pertama
+	// yang cocok dengan nominal dipakai; request tanpa step yang berlaku langsung Approved tanpa
+	// memanggil listener. Tanpa rule yang cocok dikembalikan request kosong (RuleID nil).
+	Submit(ctx context.Context, tx *gorm.DB, submission domain.ApprovalSubmission) (domain.ApprovalRequest, error)
+	// HasPendingRequest dipakai modul pemilik dokumen untuk menolak approval manual selama dokumen
+	// masih berada dalam workflow
+	HasPendingRequest(ctx context.Context, tx *gorm.DB, documentType string, documentID uuid.UUID) (bool, error)
+	FindRequestById(ctx context.Context, id uuid.UUID) (*approval.ApprovalRequestResponse, error)
+	FindAllRequests(ctx context.Context, userID uuid.UUID, filter approval.ApprovalRequestFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	// Inbox mengembalikan task yang menunggu keputusan user, termasuk task milik user lain yang
+	// didelegasikan kepadanya
+	Inbox(ctx context.Context, userID uuid.UUID, role domain.Role, filter approval.ApprovalInboxFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	Approve(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role, request approval.ApprovalDecisionRequest) (*approval.ApprovalRequestResponse, error)
+	Reject(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role, request approval.ApprovalRejectRequest) (*approval.ApprovalRequestResponse, error)
+	CreateDelegation(ctx context.Context, userID uuid.UUID, role domain.Role, request approval.ApprovalDelegationRequest) (*approval.ApprovalDelegationResponse, error)
+	RevokeDelegation(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role) (*approval.ApprovalDelegationResponse, error)
+	FindAllDelegations(ctx context.Context, userID uuid.UUID, role domain.Role, filter approval.ApprovalDelegationFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
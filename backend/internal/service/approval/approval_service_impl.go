@@ -0,0 +1,444 @@
+package approval
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/approval"
+	repo "erpfinance/internal/repository/approval"
+	usersRepo "erpfinance/internal/repository/users"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApprovalServiceImpl struct {
+	ApprovalRuleRepository       repo.ApprovalRuleRepository
+	ApprovalRequestRepository    repo.ApprovalRequestRepository
+	ApprovalDelegationRepository repo.ApprovalDelegationRepository
+	UsersRepository              usersRepo.UsersRepository
+	Listeners                    map[string]ApprovalListener
+	DB                           *gorm.DB
+	Validate                     *validator.Validate
+}
+
+func NewApprovalService(approvalRuleRepository repo.ApprovalRuleRepository, approvalRequestRepository repo.ApprovalRequestRepository, approvalDelegationRepository repo.ApprovalDelegationRepository, usersRepository usersRepo.UsersRepository, listeners []ApprovalListener, db *gorm.DB, validate *validator.Validate) ApprovalService {
+	listenerMap := make(map[string]ApprovalListener, len(listeners))
+	for _, listener := range listeners {
+		listenerMap[listener.DocumentType()] = listener
+	}
+
+	return &ApprovalServiceImpl{
+		ApprovalRuleRepository:       approvalRuleRepository,
+		ApprovalRequestRepository:    approvalRequestRepository,
+		ApprovalDelegationRepository: approvalDelegationRepository,
+		UsersRepository:              usersRepository,
+		Listeners:                    listenerMap,
+		DB:                           db,
+		Validate:                     validate,
+	}
+}
+
+func (service *ApprovalServiceImpl) Submit(ctx context.Context, tx *gorm.DB, submission domain.ApprovalSubmission) (domain.ApprovalRequest, error) {
+	pending, err := service.HasPendingRequest(ctx, tx, submission.DocumentType, submission.DocumentID)
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+	if pending {
+		return domain.ApprovalRequest{}, exception.NewError(fmt.Sprintf("%s is already waiting for approval", submission.DocumentNumber))
+	}
+
+	rules, err := service.ApprovalRuleRepository.FindActiveByDocumentType(ctx, tx, submission.DocumentType)
+	if err != nil {
+		return domain.ApprovalRequest{}, err
+	}
+
+	amount := helper.RoundAmount(submission.Amount)
+	var rule *domain.ApprovalRule
+	for i := range rules {
+		if rules[i].Matches(amount) {
+			rule = &rules[i]
+			break
+		}
+	}
+	if rule == nil {
+		return domain.ApprovalRequest{}, nil
+	}
+
+	request := domain.ApprovalRequest{
+		ID:             uuid.New(),
+		DocumentType:   submission.DocumentType,
+		DocumentID:     submission.DocumentID,
+		DocumentNumber: submission.DocumentNumber,
+		Amount:         amount,
+		RuleID:         &rule.ID,
+		Status:         domain.ApprovalRequestStatusPending,
+		RequestedBy:    submission.RequestedBy,
+	}
+
+	for _, step := range rule.Steps {
+		if amount.LessThan(step.MinAmount) {
+			continue
+		}
+		request.Tasks = append(request.Tasks, domain.ApprovalTask{
+			ID:             uuid.New(),
+			RequestID:      request.ID,
+			Sequence:       step.Sequence,
+			Name:           step.Name,
+			ApproverRole:   step.ApproverRole,
+			ApproverUserID: step.ApproverUserID,
+			Status:         domain.ApprovalTaskStatusWaiting,
+		})
+	}
+
+	if len(request.Tasks) == 0 {
+		now := time.Now()
+		request.Status = domain.ApprovalRequestStatusApproved
+		request.CompletedAt = &now
+	} else {
+		// Step rule sudah terurut menurut sequence sehingga task pertama berada di sequence terendah
+		request.CurrentSequence = request.Tasks[0].Sequence
+		for i := range request.Tasks {
+			if request.Tasks[i].Sequence == request.CurrentSequence {
+				request.Tasks[i].Status = domain.ApprovalTaskStatusPending
+			}
+		}
+	}
+
+	return service.ApprovalRequestRepository.Create(ctx, tx, request)
+}
+
+func (service *ApprovalServiceImpl) HasPendingRequest(ctx context.Context, tx *gorm.DB, documentType string, documentID uuid.UUID) (bool, error) {
+	_, err := service.ApprovalRequestRepository.FindPendingByDocument(ctx, tx, documentType, documentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (service *ApprovalServiceImpl) FindRequestById(ctx context.Context, id uuid.UUID) (*approval.ApprovalRequestResponse, error) {
+	request, err := service.ApprovalRequestRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("approval request not found")
+	}
+
+	return mapper.ToApprovalRequestResponse(request), nil
+}
+
+func (service *ApprovalServiceImpl) FindAllRequests(ctx context.Context, userID uuid.UUID, filter approval.ApprovalRequestFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	documentID, err := helper.ParseOptionalUUID(filter.DocumentID, "document_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	var requestedBy *uuid.UUID
+	if filter.Mine {
+		requestedBy = &userID
+	}
+
+	requests, totalItems, err := service.ApprovalRequestRepository.FindAllWithPagination(ctx, service.DB, filter.DocumentType, filter.Status, documentID, requestedBy, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToApprovalRequestResponses(requests)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *ApprovalServiceImpl) Inbox(ctx context.Context, userID uuid.UUID, role domain.Role, filter approval.ApprovalInboxFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	tasks, totalItems, err := service.ApprovalRequestRepository.FindInbox(ctx, service.DB, userID, role, role == domain.RoleSuperAdmin, helper.Today(), filter.DocumentType, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToApprovalInboxResponses(tasks)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *ApprovalServiceImpl) Approve(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role, request approval.ApprovalDecisionRequest) (*approval.ApprovalRequestResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	return service.decide(ctx, id, userID, role, domain.ApprovalDecisionApprove, request.Comment)
+}
+
+func (service *ApprovalServiceImpl) Reject(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role, request approval.ApprovalRejectRequest) (*approval.ApprovalRequestResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	return service.decide(ctx, id, userID, role, domain.ApprovalDecisionReject, request.Comment)
+}
+
+// decide mencatat keputusan user pada satu task Pending yang menjadi wewenangnya. Approve membuka
+// sequence berikutnya setelah semua task paralel di sequence aktif disetujui; reject langsung
+// menutup request dan membatalkan task lainnya.
+func (service *ApprovalServiceImpl) decide(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role, decision domain.ApprovalDecision, comment string) (*approval.ApprovalRequestResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		request, err := service.ApprovalRequestRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("approval request not found")
+		}
+
+		if request.Status != domain.ApprovalRequestStatusPending {
+			return exception.NewError("only pending approval requests can be decided")
+		}
+		if request.RequestedBy == userID {
+			return exception.NewError("you cannot decide your own approval request")
+		}
+		for _, task := range request.Tasks {
+			if task.ActedBy != nil && *task.ActedBy == userID {
+				return exception.NewError("you have already decided on this approval request")
+			}
+		}
+
+		now := time.Now()
+		taskIndex, onBehalfOf, err := service.findActionableTask(ctx, tx, request, userID, role, helper.Today())
+		if err != nil {
+			return err
+		}
+
+		task := &request.Tasks[taskIndex]
+		task.ActedBy = &userID
+		task.OnBehalfOf = onBehalfOf
+		task.ActedAt = &now
+		task.Comment = comment
+
+		var changed []int
+		if decision == domain.ApprovalDecisionReject {
+			task.Status = domain.ApprovalTaskStatusRejected
+			for i := range request.Tasks {
+				if i != taskIndex && (request.Tasks[i].Status == domain.ApprovalTaskStatusPending || request.Tasks[i].Status == domain.ApprovalTaskStatusWaiting) {
+					request.Tasks[i].Status = domain.ApprovalTaskStatusCancelled
+					changed = append(changed, i)
+				}
+			}
+			request.Status = domain.ApprovalRequestStatusRejected
+			request.DecidedBy = &userID
+			request.CompletedAt = &now
+			request.Comment = comment
+		} else {
+			task.Status = domain.ApprovalTaskStatusApproved
+			changed = advanceSequence(&request)
+			if request.Status == domain.ApprovalRequestStatusApproved {
+				request.DecidedBy = &userID
+				request.CompletedAt = &now
+				request.Comment = comment
+			}
+		}
+
+		for _, i := range append(changed, taskIndex) {
+			if err := service.ApprovalRequestRepository.UpdateTask(ctx, tx, request.Tasks[i]); err != nil {
+				return err
+			}
+		}
+		if err := service.ApprovalRequestRepository.Update(ctx, tx, request); err != nil {
+			return err
+		}
+
+		if request.Status == domain.ApprovalRequestStatusPending {
+			return nil
+		}
+		listener, ok := service.Listeners[request.DocumentType]
+		if !ok {
+			return nil
+		}
+		return listener.OnApprovalCompleted(ctx, tx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequestById(ctx, id)
+}
+
+// findActionableTask mencari task Pending yang boleh diputuskan user: admin boleh memutuskan task
+// apa pun, user lain melalui user atau role approver, atau melalui delegasi yang berlaku hari ini.
+// Untuk keputusan lewat delegasi dikembalikan ID user yang mendelegasikan. today adalah tanggal tanpa jam
+// (helper.Today) agar delegasi tetap berlaku sepanjang hari EndDate.
+func (service *ApprovalServiceImpl) findActionableTask(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest, userID uuid.UUID, role domain.Role, today time.Time) (int, *uuid.UUID, error) {
+	for i, task := range request.Tasks {
+		if task.Status != domain.ApprovalTaskStatusPending {
+			continue
+		}
+		if role == domain.RoleSuperAdmin || isApprover(task, userID, role) {
+			return i, nil, nil
+		}
+	}
+
+	delegations, err := service.ApprovalDelegationRepository.FindActiveForDelegate(ctx, tx, userID, today)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i, task := range request.Tasks {
+		if task.Status != domain.ApprovalTaskStatusPending {
+			continue
+		}
+		for _, delegation := range delegations {
+			if delegation.DelegatorID == request.RequestedBy || !delegation.Covers(request.DocumentType, today) {
+				continue
+			}
+			if isApprover(task, delegation.DelegatorID, delegation.Delegator.Role) {
+				delegatorID := delegation.DelegatorID
+				return i, &delegatorID, nil
+			}
+		}
+	}
+
+	return 0, nil, exception.NewError("you are not an approver for the current step of this request")
+}
+
+// isApprover memeriksa apakah task ditujukan ke user tersebut, atau ke role-nya bila task tidak
+// menunjuk user tertentu
+func isApprover(task domain.ApprovalTask, userID uuid.UUID, role domain.Role) bool {
+	if task.ApproverUserID != nil {
+		return *task.ApproverUserID == userID
+	}
+	return task.ApproverRole != nil && *task.ApproverRole == role
+}
+
+// advanceSequence membuka sequence berikutnya bila semua task di sequence aktif sudah disetujui,
+// atau menandai request Approved bila tidak ada sequence tersisa. Mengembalikan index task yang
+// statusnya berubah.
+func advanceSequence(request *domain.ApprovalRequest) []int {
+	nextSequence := 0
+	for _, task := range request.Tasks {
+		if task.Sequence == request.CurrentSequence && task.Status != domain.ApprovalTaskStatusApproved {
+			return nil
+		}
+		if task.Status == domain.ApprovalTaskStatusWaiting && (nextSequence == 0 || task.Sequence < nextSequence) {
+			nextSequence = task.Sequence
+		}
+	}
+
+	if nextSequence == 0 {
+		request.Status = domain.ApprovalRequestStatusApproved
+		return nil
+	}
+
+	var changed []int
+	request.CurrentSequence = nextSequence
+	for i := range request.Tasks {
+		if request.Tasks[i].Sequence == nextSequence && request.Tasks[i].Status == domain.ApprovalTaskStatusWaiting {
+			request.Tasks[i].Status = domain.ApprovalTaskStatusPending
+			changed = append(changed, i)
+		}
+	}
+	return changed
+}
+
+func (service *ApprovalServiceImpl) CreateDelegation(ctx context.Context, userID uuid.UUID, role domain.Role, request approval.ApprovalDelegationRequest) (*approval.ApprovalDelegationResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	startDate, err := helper.ParseDate(request.StartDate)
+	if err != nil {
+		return nil, exception.NewError("invalid start date")
+	}
+	endDate, err := helper.ParseDate(request.EndDate)
+	if err != nil {
+		return nil, exception.NewError("invalid end date")
+	}
+	if endDate.Before(startDate) {
+		return nil, exception.NewError("end date cannot be before start date")
+	}
+
+	delegatorID := userID
+	if request.DelegatorID != nil && *request.DelegatorID != userID {
+		if role != domain.RoleSuperAdmin {
+			return nil, exception.NewError("only admin can create delegations on behalf of other users")
+		}
+		delegatorID = *request.DelegatorID
+	}
+	if delegatorID == request.DelegateID {
+		return nil, exception.NewError("cannot delegate approvals to yourself")
+	}
+
+	delegation := domain.ApprovalDelegation{
+		ID:           uuid.New(),
+		DelegatorID:  delegatorID,
+		DelegateID:   request.DelegateID,
+		DocumentType: request.DocumentType,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Reason:       request.Reason,
+		IsActive:     true,
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := service.UsersRepository.FindById(ctx, tx, delegatorID); err != nil {
+			return exception.NewError("delegator user not found")
+		}
+		if _, err := service.UsersRepository.FindById(ctx, tx, request.DelegateID); err != nil {
+			return exception.NewError("delegate user not found")
+		}
+
+		_, err := service.ApprovalDelegationRepository.Create(ctx, tx, delegation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.findDelegationById(ctx, delegation.ID)
+}
+
+func (service *ApprovalServiceImpl) RevokeDelegation(ctx context.Context, id uuid.UUID, userID uuid.UUID, role domain.Role) (*approval.ApprovalDelegationResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		delegation, err := service.ApprovalDelegationRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("approval delegation not found")
+		}
+
+		if role != domain.RoleSuperAdmin && delegation.DelegatorID != userID {
+			return exception.NewError("only the delegator can revoke this delegation")
+		}
+		if !delegation.IsActive {
+			return exception.NewError("approval delegation is already revoked")
+		}
+
+		delegation.IsActive = false
+		return service.ApprovalDelegationRepository.Update(ctx, tx, delegation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.findDelegationById(ctx, id)
+}
+
+func (service *ApprovalServiceImpl) FindAllDelegations(ctx context.Context, userID uuid.UUID, role domain.Role, filter approval.ApprovalDelegationFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var ownerID *uuid.UUID
+	if role != domain.RoleSuperAdmin {
+		ownerID = &userID
+	}
+
+	delegations, totalItems, err := service.ApprovalDelegationRepository.FindAllWithPagination(ctx, service.DB, ownerID, filter.ActiveOnly, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToApprovalDelegationResponses(delegations)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *ApprovalServiceImpl) findDelegationById(ctx context.Context, id uuid.UUID) (*approval.ApprovalDelegationResponse, error) {
+	delegation, err := service.ApprovalDelegationRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("approval delegation not found")
+	}
+
+	return mapper.ToApprovalDelegationResponse(delegation), nil
+}
@@ -0,0 +1,111 @@
+package asset
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/asset"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// monthStart mengembalikan tanggal 1 pada bulan date
+func monthStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
+
+// monthEnd mengembalikan tanggal terakhir pada bulan date
+func monthEnd(date time.Time) time.Time {
+	return monthStart(date).AddDate(0, 1, -1)
+}
+
+// monthlyDepreciation menghitung penyusutan bulan berikutnya dari posisi aset saat ini. Bulan
+// terakhir umur manfaat menyerap sisa nilai sehingga nilai buku akhir sama dengan nilai sisa.
+func monthlyDepreciation(fixedAsset domain.FixedAsset) decimal.Decimal {
+	remaining := helper.RoundAmount(fixedAsset.DepreciableAmount().Sub(fixedAsset.AccumulatedDepreciation))
+	monthsLeft := fixedAsset.UsefulLifeMonths - fixedAsset.DepreciatedMonths
+	if !remaining.IsPositive() || monthsLeft <= 0 {
+		return decimal.Zero
+	}
+	if monthsLeft == 1 {
+		return remaining
+	}
+
+	var amount decimal.Decimal
+	switch fixedAsset.Method {
+	case domain.DepreciationMethodDecliningBalance:
+		amount = helper.DivideAmount(fixedAsset.BookValue().Mul(decimal.NewFromFloat(fixedAsset.DecliningFactor)), float64(fixedAsset.UsefulLifeMonths))
+		// Beralih ke garis lurus bila sisa nilai dibagi sisa umur sudah lebih besar
+		if straight := helper.DivideAmount(remaining, float64(monthsLeft)); straight.GreaterThan(amount) {
+			amount = straight
+		}
+	default:
+		amount = helper.DivideAmount(fixedAsset.DepreciableAmount(), float64(fixedAsset.UsefulLifeMonths))
+	}
+
+	if amount.GreaterThan(remaining) {
+		amount = remaining
+	}
+	return amount
+}
+
+// applyDepreciationMonth membukukan penyusutan satu bulan ke aset dan mengembalikan nilainya
+func applyDepreciationMonth(fixedAsset *domain.FixedAsset) decimal.Decimal {
+	period := fixedAsset.NextDepreciationPeriod()
+	amount := monthlyDepreciation(*fixedAsset)
+
+	fixedAsset.AccumulatedDepreciation = helper.RoundAmount(fixedAsset.AccumulatedDepreciation.Add(amount))
+	fixedAsset.DepreciatedMonths++
+	fixedAsset.LastDepreciationDate = &period
+	if fixedAsset.DepreciatedMonths >= fixedAsset.UsefulLifeMonths || helper.IsZeroAmount(fixedAsset.DepreciableAmount().Sub(fixedAsset.AccumulatedDepreciation)) {
+		fixedAsset.Status = domain.FixedAssetStatusFullyDepreciated
+	}
+	return amount
+}
+
+// buildDepreciationSchedule menyusun jadwal penyusutan sepanjang umur manfaat. Bulan yang sudah
+// dijurnal ditandai Posted; perhitungannya deterministik sehingga sama dengan yang sudah diposting.
+func buildDepreciationSchedule(fixedAsset domain.FixedAsset) *asset.DepreciationScheduleResponse {
+	schedule := &asset.DepreciationScheduleResponse{
+		FixedAssetID: fixedAsset.ID,
+		Number:       fixedAsset.Number,
+		Method:       fixedAsset.Method,
+		Lines:        []asset.DepreciationScheduleLineResponse{},
+	}
+
+	projection := fixedAsset
+	projection.AccumulatedDepreciation = decimal.Zero
+	projection.DepreciatedMonths = 0
+	projection.Status = domain.FixedAssetStatusActive
+	for projection.DepreciatedMonths < projection.UsefulLifeMonths {
+		period := projection.NextDepreciationPeriod()
+		amount := applyDepreciationMonth(&projection)
+		schedule.Lines = append(schedule.Lines, asset.DepreciationScheduleLineResponse{
+			MonthNo:     projection.DepreciatedMonths,
+			PeriodEnd:   helper.FormatDate(period),
+			Amount:      amount,
+			Accumulated: projection.AccumulatedDepreciation,
+			BookValue:   helper.RoundAmount(projection.BookValue()),
+			Posted:      projection.DepreciatedMonths <= fixedAsset.DepreciatedMonths,
+		})
+	}
+	return schedule
+}
+
+// accountAmounts menjumlahkan nilai per akun dengan urutan kemunculan pertama
+type accountAmounts struct {
+	order   []uuid.UUID
+	amounts map[uuid.UUID]decimal.Decimal
+}
+
+func newAccountAmounts() *accountAmounts {
+	return &accountAmounts{amounts: make(map[uuid.UUID]decimal.Decimal)}
+}
+
+func (a *accountAmounts) add(accountID uuid.UUID, amount decimal.Decimal) {
+	if _, ok := a.amounts[accountID]; !ok {
+		a.order = append(a.order, accountID)
+	}
+	a.amounts[accountID] = helper.RoundAmount(a.amounts[accountID].Add(amount))
+}
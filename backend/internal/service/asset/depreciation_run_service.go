@@ -0,0 +1,15 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+
+	"github.com/google/uuid"
+)
+
+type DepreciationRunService interface {
+	Run(ctx context.Context, userID uuid.UUID, request asset.DepreciationRunRequest) (*asset.DepreciationRunResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*asset.DepreciationRunResponse, error)
+	FindAll(ctx context.Context, filter asset.DepreciationRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
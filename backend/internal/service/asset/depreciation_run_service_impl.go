@@ -0,0 +1,203 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+	repo "erpfinance/internal/repository/asset"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// DepreciationRunNumberPrefix adalah prefix penomoran depreciation run, contoh: DEP-202507-00001
+const DepreciationRunNumberPrefix = "DEP"
+
+type DepreciationRunServiceImpl struct {
+	DepreciationRunRepository repo.DepreciationRunRepository
+	FixedAssetRepository      repo.FixedAssetRepository
+	SequenceRepository        sequenceRepo.SequenceRepository
+	LedgerService             ledgerService.LedgerService
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewDepreciationRunService(depreciationRunRepository repo.DepreciationRunRepository, fixedAssetRepository repo.FixedAssetRepository, sequenceRepository sequenceRepo.SequenceRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) DepreciationRunService {
+	return &DepreciationRunServiceImpl{
+		DepreciationRunRepository: depreciationRunRepository,
+		FixedAssetRepository:      fixedAssetRepository,
+		SequenceRepository:        sequenceRepository,
+		LedgerService:             ledgerService,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+// Run menyusutkan seluruh aset aktif sampai akhir bulan period_end. Aset yang tertinggal
+// (misal didaftarkan mundur) menyusul semua bulan yang belum disusutkan dalam run ini.
+// Jurnal dibuat satu per run: debit beban penyusutan dan kredit akumulasi penyusutan per akun.
+func (service *DepreciationRunServiceImpl) Run(ctx context.Context, userID uuid.UUID, request asset.DepreciationRunRequest) (*asset.DepreciationRunResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	periodEnd, err := helper.ParseDate(request.PeriodEnd)
+	if err != nil {
+		return nil, exception.NewError("invalid period end")
+	}
+	if !periodEnd.Equal(monthEnd(periodEnd)) {
+		return nil, exception.NewError("period end must be the last day of a month")
+	}
+
+	var runID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exists, err := service.DepreciationRunRepository.ExistsOnOrAfter(ctx, tx, periodEnd)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exception.NewError(fmt.Sprintf("depreciation has already been run for %s or a later period", helper.FormatDate(periodEnd)))
+		}
+
+		assets, err := service.FixedAssetRepository.LockDepreciable(ctx, tx, periodEnd)
+		if err != nil {
+			return err
+		}
+
+		run := domain.DepreciationRun{
+			ID:        uuid.New(),
+			PeriodEnd: periodEnd,
+			Notes:     request.Notes,
+			CreatedBy: userID,
+		}
+		expenses := newAccountAmounts()
+		accumulated := newAccountAmounts()
+		for _, fixedAsset := range assets {
+			var amount decimal.Decimal
+			months := 0
+			for fixedAsset.Status == domain.FixedAssetStatusActive && !fixedAsset.NextDepreciationPeriod().After(periodEnd) {
+				amount = amount.Add(applyDepreciationMonth(&fixedAsset))
+				months++
+			}
+			if months == 0 {
+				continue
+			}
+			amount = helper.RoundAmount(amount)
+
+			if err := service.FixedAssetRepository.Update(ctx, tx, fixedAsset); err != nil {
+				return err
+			}
+			run.Lines = append(run.Lines, domain.DepreciationRunLine{
+				ID:                uuid.New(),
+				DepreciationRunID: run.ID,
+				FixedAssetID:      fixedAsset.ID,
+				AssetNumber:       fixedAsset.Number,
+				AssetName:         fixedAsset.Name,
+				Months:            months,
+				Amount:            amount,
+				AccumulatedAfter:  fixedAsset.AccumulatedDepreciation,
+				BookValueAfter:    helper.RoundAmount(fixedAsset.BookValue()),
+			})
+			run.TotalAmount = helper.RoundAmount(run.TotalAmount.Add(amount))
+			expenses.add(fixedAsset.Category.DepreciationExpenseAccountID, amount)
+			accumulated.add(fixedAsset.Category.AccumulatedDepreciationAccountID, amount)
+		}
+		if len(run.Lines) == 0 {
+			return exception.NewError(fmt.Sprintf("no fixed assets are due for depreciation up to %s", helper.FormatDate(periodEnd)))
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, DepreciationRunNumberPrefix, periodEnd)
+		if err != nil {
+			return err
+		}
+		run.Number = number
+
+		created, err := service.DepreciationRunRepository.Create(ctx, tx, run)
+		if err != nil {
+			return err
+		}
+		runID = created.ID
+
+		// Aset yang sudah habis nilainya tetap tercatat di run dengan nilai nol, tanpa baris jurnal
+		if !run.TotalAmount.IsPositive() {
+			return nil
+		}
+
+		journal := domain.JournalEntry{
+			EntryDate:   periodEnd,
+			Description: fmt.Sprintf("Depreciation for %s", periodEnd.Format("2006-01")),
+			Reference:   run.Number,
+			SourceType:  domain.JournalSourceDepreciation,
+			SourceID:    &created.ID,
+			CreatedBy:   userID,
+		}
+		for _, accountID := range expenses.order {
+			if amount := expenses.amounts[accountID]; amount.IsPositive() {
+				journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: accountID, Description: "Depreciation expense", Debit: amount})
+			}
+		}
+		for _, accountID := range accumulated.order {
+			if amount := accumulated.amounts[accountID]; amount.IsPositive() {
+				journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: accountID, Description: "Accumulated depreciation", Credit: amount})
+			}
+		}
+
+		entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+		if err != nil {
+			return err
+		}
+		created.JournalEntryID = &entry.ID
+		return service.DepreciationRunRepository.Update(ctx, tx, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, runID)
+}
+
+func (service *DepreciationRunServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*asset.DepreciationRunResponse, error) {
+	run, err := service.DepreciationRunRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("depreciation run not found")
+	}
+
+	return mapper.ToDepreciationRunResponse(run), nil
+}
+
+func (service *DepreciationRunServiceImpl) FindAll(ctx context.Context, filter asset.DepreciationRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		dateFrom = &parsed
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		dateTo = &parsed
+	}
+
+	runs, totalItems, err := service.DepreciationRunRepository.FindAllWithPagination(ctx, service.DB, dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToDepreciationRunResponses(runs)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
@@ -0,0 +1,28 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+
+	"github.com/google/uuid"
+)
+
+type FixedAssetService interface {
+	CreateCategory(ctx context.Context, request asset.AssetCategoryRequest) (*asset.AssetCategoryResponse, error)
+	UpdateCategory(ctx context.Context, id uuid.UUID, request asset.AssetCategoryUpdateRequest) (*asset.AssetCategoryResponse, error)
+	FindCategoryById(ctx context.Context, id uuid.UUID) (*asset.AssetCategoryResponse, error)
+	FindAllCategories(ctx context.Context, filter asset.AssetCategoryFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	Create(ctx context.Context, userID uuid.UUID, request asset.FixedAssetRequest) (*asset.FixedAssetResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request asset.FixedAssetRequest) (*asset.FixedAssetResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*asset.FixedAssetResponse, error)
+	FindAll(ctx context.Context, filter asset.FixedAssetFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	Schedule(ctx context.Context, id uuid.UUID) (*asset.DepreciationScheduleResponse, error)
+
+	// Activate menjurnal perolehan aset (debit akun aset, kredit akun lawan) dan memulai penyusutan
+	Activate(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetActivateRequest) (*asset.FixedAssetResponse, error)
+	Transfer(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetTransferRequest) (*asset.FixedAssetResponse, error)
+	// Dispose menghapus aset dari pembukuan dan menjurnal laba/rugi pelepasan (hasil dikurangi nilai buku)
+	Dispose(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetDisposalRequest) (*asset.FixedAssetResponse, error)
+}
@@ -0,0 +1,519 @@
+package asset
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/asset"
+	repo "erpfinance/internal/repository/asset"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FixedAssetNumberPrefix adalah prefix penomoran aset tetap, contoh: FA-202507-00001
+const FixedAssetNumberPrefix = "FA"
+
+// defaultDecliningFactor adalah faktor double declining balance
+const defaultDecliningFactor = 2
+
+type FixedAssetServiceImpl struct {
+	AssetCategoryRepository repo.AssetCategoryRepository
+	FixedAssetRepository    repo.FixedAssetRepository
+	SequenceRepository      sequenceRepo.SequenceRepository
+	LedgerService           ledgerService.LedgerService
+	DB                      *gorm.DB
+	Validate                *validator.Validate
+}
+
+func NewFixedAssetService(assetCategoryRepository repo.AssetCategoryRepository, fixedAssetRepository repo.FixedAssetRepository, sequenceRepository sequenceRepo.SequenceRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) FixedAssetService {
+	return &FixedAssetServiceImpl{
+		AssetCategoryRepository: assetCategoryRepository,
+		FixedAssetRepository:    fixedAssetRepository,
+		SequenceRepository:      sequenceRepository,
+		LedgerService:           ledgerService,
+		DB:                      db,
+		Validate:                validate,
+	}
+}
+
+func (service *FixedAssetServiceImpl) CreateCategory(ctx context.Context, request asset.AssetCategoryRequest) (*asset.AssetCategoryResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	category := domain.AssetCategory{ID: uuid.New(), IsActive: true}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyCategoryRequest(ctx, tx, &category, request); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = service.AssetCategoryRepository.Create(ctx, tx, category)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAssetCategoryResponse(category), nil
+}
+
+func (service *FixedAssetServiceImpl) UpdateCategory(ctx context.Context, id uuid.UUID, request asset.AssetCategoryUpdateRequest) (*asset.AssetCategoryResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var category domain.AssetCategory
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		category, err = service.AssetCategoryRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("asset category not found")
+		}
+
+		if err := service.applyCategoryRequest(ctx, tx, &category, request.AssetCategoryRequest); err != nil {
+			return err
+		}
+		category.IsActive = request.IsActive
+		return service.AssetCategoryRepository.Update(ctx, tx, category)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindCategoryById(ctx, id)
+}
+
+func (service *FixedAssetServiceImpl) FindCategoryById(ctx context.Context, id uuid.UUID) (*asset.AssetCategoryResponse, error) {
+	category, err := service.AssetCategoryRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("asset category not found")
+	}
+
+	return mapper.ToAssetCategoryResponse(category), nil
+}
+
+func (service *FixedAssetServiceImpl) FindAllCategories(ctx context.Context, filter asset.AssetCategoryFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	categories, totalItems, err := service.AssetCategoryRepository.FindAllWithPagination(ctx, service.DB, filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToAssetCategoryResponses(categories)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *FixedAssetServiceImpl) Create(ctx context.Context, userID uuid.UUID, request asset.FixedAssetRequest) (*asset.FixedAssetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	fixedAsset := domain.FixedAsset{
+		ID:        uuid.New(),
+		Status:    domain.FixedAssetStatusDraft,
+		CreatedBy: userID,
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyAssetRequest(ctx, tx, &fixedAsset, request); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, FixedAssetNumberPrefix, fixedAsset.AcquisitionDate)
+		if err != nil {
+			return err
+		}
+		fixedAsset.Number = number
+
+		_, err = service.FixedAssetRepository.Create(ctx, tx, fixedAsset)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, fixedAsset.ID)
+}
+
+func (service *FixedAssetServiceImpl) Update(ctx context.Context, id uuid.UUID, request asset.FixedAssetRequest) (*asset.FixedAssetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fixedAsset, err := service.FixedAssetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("fixed asset not found")
+		}
+
+		if fixedAsset.Status != domain.FixedAssetStatusDraft {
+			return exception.NewError("only draft fixed assets can be updated")
+		}
+
+		if err := service.applyAssetRequest(ctx, tx, &fixedAsset, request); err != nil {
+			return err
+		}
+		return service.FixedAssetRepository.Update(ctx, tx, fixedAsset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *FixedAssetServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*asset.FixedAssetResponse, error) {
+	fixedAsset, err := service.FixedAssetRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("fixed asset not found")
+	}
+
+	return mapper.ToFixedAssetResponse(fixedAsset), nil
+}
+
+func (service *FixedAssetServiceImpl) FindAll(ctx context.Context, filter asset.FixedAssetFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	categoryID, err := helper.ParseOptionalUUID(filter.CategoryID, "category_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	assets, totalItems, err := service.FixedAssetRepository.FindAllWithPagination(ctx, service.DB, filter.Status, categoryID, filter.Location, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToFixedAssetResponses(assets)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *FixedAssetServiceImpl) Schedule(ctx context.Context, id uuid.UUID) (*asset.DepreciationScheduleResponse, error) {
+	fixedAsset, err := service.FixedAssetRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("fixed asset not found")
+	}
+
+	return buildDepreciationSchedule(fixedAsset), nil
+}
+
+func (service *FixedAssetServiceImpl) Activate(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetActivateRequest) (*asset.FixedAssetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fixedAsset, err := service.FixedAssetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("fixed asset not found")
+		}
+
+		if fixedAsset.Status != domain.FixedAssetStatusDraft {
+			return exception.NewError("only draft fixed assets can be activated")
+		}
+		if !fixedAsset.Category.IsActive {
+			return exception.NewError(fmt.Sprintf("asset category %s is inactive", fixedAsset.Category.Code))
+		}
+
+		offsetAccount, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.OffsetAccountID, "offset account")
+		if err != nil {
+			return err
+		}
+
+		entry, err := service.LedgerService.PostEntry(ctx, tx, domain.JournalEntry{
+			EntryDate:   fixedAsset.AcquisitionDate,
+			Description: fmt.Sprintf("Acquisition of fixed asset %s %s", fixedAsset.Number, fixedAsset.Name),
+			Reference:   fixedAsset.Number,
+			SourceType:  domain.JournalSourceAssetAcquisition,
+			SourceID:    &fixedAsset.ID,
+			CreatedBy:   userID,
+			Lines: []domain.JournalLine{
+				{AccountID: fixedAsset.Category.AssetAccountID, Description: "Fixed asset " + fixedAsset.Number, Debit: fixedAsset.AcquisitionCost},
+				{AccountID: offsetAccount.ID, Description: "Acquisition " + fixedAsset.Number, Credit: fixedAsset.AcquisitionCost},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		fixedAsset.Status = domain.FixedAssetStatusActive
+		fixedAsset.AcquisitionEntryID = &entry.ID
+		return service.FixedAssetRepository.Update(ctx, tx, fixedAsset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *FixedAssetServiceImpl) Transfer(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetTransferRequest) (*asset.FixedAssetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	transferDate, err := helper.ParseDate(request.TransferDate)
+	if err != nil {
+		return nil, exception.NewError("invalid transfer date")
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fixedAsset, err := service.FixedAssetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("fixed asset not found")
+		}
+
+		if !isInService(fixedAsset) {
+			return exception.NewError(fmt.Sprintf("fixed asset with status %s cannot be transferred", fixedAsset.Status))
+		}
+		if transferDate.Before(fixedAsset.AcquisitionDate) {
+			return exception.NewError("transfer date cannot be before acquisition date")
+		}
+
+		toLocation := strings.TrimSpace(request.ToLocation)
+		if strings.EqualFold(toLocation, fixedAsset.Location) {
+			return exception.NewError(fmt.Sprintf("fixed asset is already at %s", fixedAsset.Location))
+		}
+
+		err = service.FixedAssetRepository.CreateTransfer(ctx, tx, domain.FixedAssetTransfer{
+			ID:           uuid.New(),
+			FixedAssetID: fixedAsset.ID,
+			TransferDate: transferDate,
+			FromLocation: fixedAsset.Location,
+			ToLocation:   toLocation,
+			Notes:        request.Notes,
+			CreatedBy:    userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		fixedAsset.Location = toLocation
+		return service.FixedAssetRepository.Update(ctx, tx, fixedAsset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *FixedAssetServiceImpl) Dispose(ctx context.Context, id uuid.UUID, userID uuid.UUID, request asset.FixedAssetDisposalRequest) (*asset.FixedAssetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	disposalDate, err := helper.ParseDate(request.DisposalDate)
+	if err != nil {
+		return nil, exception.NewError("invalid disposal date")
+	}
+	proceeds := helper.RoundAmount(request.Proceeds)
+	if proceeds.IsPositive() && request.ProceedsAccountID == nil {
+		return nil, exception.NewError("proceeds_account_id is required when proceeds are recorded")
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fixedAsset, err := service.FixedAssetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("fixed asset not found")
+		}
+
+		if !isInService(fixedAsset) {
+			return exception.NewError(fmt.Sprintf("fixed asset with status %s cannot be disposed", fixedAsset.Status))
+		}
+		if disposalDate.Before(fixedAsset.AcquisitionDate) {
+			return exception.NewError("disposal date cannot be before acquisition date")
+		}
+		if err := ensureDepreciatedUntil(fixedAsset, disposalDate); err != nil {
+			return err
+		}
+
+		bookValue := helper.RoundAmount(fixedAsset.BookValue())
+		gainLoss := helper.RoundAmount(proceeds.Sub(bookValue))
+
+		journal := domain.JournalEntry{
+			EntryDate:   disposalDate,
+			Description: fmt.Sprintf("Disposal of fixed asset %s %s", fixedAsset.Number, fixedAsset.Name),
+			Reference:   fixedAsset.Number,
+			SourceType:  domain.JournalSourceAssetDisposal,
+			SourceID:    &fixedAsset.ID,
+			CreatedBy:   userID,
+		}
+		if fixedAsset.AccumulatedDepreciation.IsPositive() {
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: fixedAsset.Category.AccumulatedDepreciationAccountID, Description: "Accumulated depreciation " + fixedAsset.Number, Debit: fixedAsset.AccumulatedDepreciation})
+		}
+		if proceeds.IsPositive() {
+			proceedsAccount, err := service.LedgerService.EnsurePostableAccount(ctx, tx, *request.ProceedsAccountID, "proceeds account", domain.AccountTypeAsset)
+			if err != nil {
+				return err
+			}
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: proceedsAccount.ID, Description: "Disposal proceeds " + fixedAsset.Number, Debit: proceeds})
+		}
+		journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: fixedAsset.Category.AssetAccountID, Description: "Fixed asset " + fixedAsset.Number, Credit: fixedAsset.AcquisitionCost})
+		if gainLoss.IsPositive() {
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: fixedAsset.Category.DisposalGainLossAccountID, Description: "Gain on disposal " + fixedAsset.Number, Credit: gainLoss})
+		} else if gainLoss.IsNegative() {
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: fixedAsset.Category.DisposalGainLossAccountID, Description: "Loss on disposal " + fixedAsset.Number, Debit: gainLoss.Neg()})
+		}
+
+		entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+		if err != nil {
+			return err
+		}
+
+		fixedAsset.Status = domain.FixedAssetStatusDisposed
+		fixedAsset.DisposalDate = &disposalDate
+		fixedAsset.DisposalProceeds = proceeds
+		fixedAsset.DisposalGainLoss = gainLoss
+		fixedAsset.DisposalEntryID = &entry.ID
+		if request.Notes != "" {
+			fixedAsset.Notes = strings.TrimSpace(fixedAsset.Notes + "\n" + request.Notes)
+		}
+		return service.FixedAssetRepository.Update(ctx, tx, fixedAsset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// applyCategoryRequest mengisi kategori dari request setelah memastikan kode unik dan akun valid
+func (service *FixedAssetServiceImpl) applyCategoryRequest(ctx context.Context, tx *gorm.DB, category *domain.AssetCategory, request asset.AssetCategoryRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	var excludeID *uuid.UUID
+	if category.Code != "" {
+		excludeID = &category.ID
+	}
+	exists, err := service.AssetCategoryRepository.ExistsByCode(ctx, tx, code, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("asset category %s already exists", code))
+	}
+
+	if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.AssetAccountID, "asset account", domain.AccountTypeAsset); err != nil {
+		return err
+	}
+	if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.AccumulatedDepreciationAccountID, "accumulated depreciation account", domain.AccountTypeAsset); err != nil {
+		return err
+	}
+	if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.DepreciationExpenseAccountID, "depreciation expense account", domain.AccountTypeExpense); err != nil {
+		return err
+	}
+	if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.DisposalGainLossAccountID, "disposal gain/loss account", domain.AccountTypeRevenue, domain.AccountTypeExpense); err != nil {
+		return err
+	}
+
+	decliningFactor := request.DecliningFactor
+	if decliningFactor == 0 {
+		decliningFactor = defaultDecliningFactor
+	}
+
+	category.Code = code
+	category.Name = request.Name
+	category.Method = domain.DepreciationMethod(request.Method)
+	category.UsefulLifeMonths = request.UsefulLifeMonths
+	category.DecliningFactor = decliningFactor
+	category.AssetAccountID = request.AssetAccountID
+	category.AccumulatedDepreciationAccountID = request.AccumulatedDepreciationAccountID
+	category.DepreciationExpenseAccountID = request.DepreciationExpenseAccountID
+	category.DisposalGainLossAccountID = request.DisposalGainLossAccountID
+	return nil
+}
+
+// applyAssetRequest mengisi aset dari request; metode, umur dan faktor yang kosong mengikuti kategori
+func (service *FixedAssetServiceImpl) applyAssetRequest(ctx context.Context, tx *gorm.DB, fixedAsset *domain.FixedAsset, request asset.FixedAssetRequest) error {
+	categoryID, err := uuid.Parse(request.CategoryID)
+	if err != nil {
+		return exception.NewError("category_id must be a valid UUID")
+	}
+	category, err := service.AssetCategoryRepository.FindById(ctx, tx, categoryID)
+	if err != nil {
+		return exception.NewNotFoundError("asset category not found")
+	}
+	if !category.IsActive {
+		return exception.NewError(fmt.Sprintf("asset category %s is inactive", category.Code))
+	}
+
+	acquisitionDate, err := helper.ParseDate(request.AcquisitionDate)
+	if err != nil {
+		return exception.NewError("invalid acquisition date")
+	}
+	depreciationStart := monthStart(acquisitionDate)
+	if request.DepreciationStartDate != "" {
+		startDate, err := helper.ParseDate(request.DepreciationStartDate)
+		if err != nil {
+			return exception.NewError("invalid depreciation start date")
+		}
+		if startDate.Before(depreciationStart) {
+			return exception.NewError("depreciation cannot start before the acquisition month")
+		}
+		depreciationStart = monthStart(startDate)
+	}
+
+	acquisitionCost := helper.RoundAmount(request.AcquisitionCost)
+	salvageValue := helper.RoundAmount(request.SalvageValue)
+	if salvageValue.GreaterThanOrEqual(acquisitionCost) {
+		return exception.NewError("salvage value must be less than acquisition cost")
+	}
+
+	method := category.Method
+	if request.Method != "" {
+		method = domain.DepreciationMethod(request.Method)
+	}
+	usefulLifeMonths := category.UsefulLifeMonths
+	if request.UsefulLifeMonths > 0 {
+		usefulLifeMonths = request.UsefulLifeMonths
+	}
+	decliningFactor := category.DecliningFactor
+	if request.DecliningFactor > 0 {
+		decliningFactor = request.DecliningFactor
+	}
+
+	fixedAsset.Name = request.Name
+	fixedAsset.Description = request.Description
+	fixedAsset.SerialNumber = request.SerialNumber
+	fixedAsset.CategoryID = category.ID
+	fixedAsset.Category = category
+	fixedAsset.Location = strings.TrimSpace(request.Location)
+	fixedAsset.AcquisitionDate = acquisitionDate
+	fixedAsset.DepreciationStartDate = depreciationStart
+	fixedAsset.AcquisitionCost = acquisitionCost
+	fixedAsset.SalvageValue = salvageValue
+	fixedAsset.UsefulLifeMonths = usefulLifeMonths
+	fixedAsset.Method = method
+	fixedAsset.DecliningFactor = decliningFactor
+	fixedAsset.Notes = request.Notes
+	return nil
+}
+
+// isInService menandakan aset masih tercatat di pembukuan (sudah aktif dan belum dilepas)
+func isInService(fixedAsset domain.FixedAsset) bool {
+	return fixedAsset.Status == domain.FixedAssetStatusActive || fixedAsset.Status == domain.FixedAssetStatusFullyDepreciated
+}
+
+// ensureDepreciatedUntil memastikan penyusutan sudah dijurnal sampai bulan sebelum tanggal
+// pelepasan dan belum ada penyusutan untuk bulan sesudah pelepasan
+func ensureDepreciatedUntil(fixedAsset domain.FixedAsset, disposalDate time.Time) error {
+	if fixedAsset.LastDepreciationDate != nil && fixedAsset.LastDepreciationDate.After(monthEnd(disposalDate)) {
+		return exception.NewError(fmt.Sprintf("fixed asset is already depreciated through %s, after the disposal date", helper.FormatDate(*fixedAsset.LastDepreciationDate)))
+	}
+
+	previousMonthEnd := monthStart(disposalDate).AddDate(0, 0, -1)
+	if fixedAsset.Status == domain.FixedAssetStatusActive && !fixedAsset.NextDepreciationPeriod().After(previousMonthEnd) {
+		return exception.NewError(fmt.Sprintf("run depreciation through %s before disposing this fixed asset", helper.FormatDate(previousMonthEnd)))
+	}
+	return nil
+}
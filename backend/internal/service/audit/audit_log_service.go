@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/audit"
+
+	"github.com/google/uuid"
+)
+
+// AuditLogService hanya menyediakan pembacaan; audit log ditulis oleh plugin gorm pada setiap
+// perubahan data dan tidak bisa diubah maupun dihapus
+type AuditLogService interface {
+	FindById(ctx context.Context, id uuid.UUID) (*audit.AuditLogResponse, error)
+	FindAll(ctx context.Context, filter audit.AuditLogFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
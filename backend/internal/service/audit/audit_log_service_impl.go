@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/audit"
+	repo "erpfinance/internal/repository/audit"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditLogServiceImpl struct {
+	AuditLogRepository repo.AuditLogRepository
+	DB                 *gorm.DB
+}
+
+func NewAuditLogService(auditLogRepository repo.AuditLogRepository, db *gorm.DB) AuditLogService {
+	return &AuditLogServiceImpl{
+		AuditLogRepository: auditLogRepository,
+		DB:                 db,
+	}
+}
+
+func (service *AuditLogServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*audit.AuditLogResponse, error) {
+	auditLog, err := service.AuditLogRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("audit log not found")
+	}
+
+	return mapper.ToAuditLogResponse(auditLog), nil
+}
+
+func (service *AuditLogServiceImpl) FindAll(ctx context.Context, filter audit.AuditLogFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	actorID, err := helper.ParseOptionalUUID(filter.UserID, "user_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	action := strings.ToUpper(strings.TrimSpace(filter.Action))
+	switch domain.AuditAction(action) {
+	case "", domain.AuditActionCreate, domain.AuditActionUpdate, domain.AuditActionDelete:
+	default:
+		return dto.PaginationResponse{}, exception.NewError("action must be one of CREATE, UPDATE, DELETE")
+	}
+
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		from := helper.StartOfDayJakarta(parsed)
+		dateFrom = &from
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		// date_to inklusif: ambil sampai awal hari berikutnya
+		to := helper.StartOfDayJakarta(parsed).AddDate(0, 0, 1)
+		dateTo = &to
+	}
+	if dateFrom != nil && dateTo != nil && !dateTo.After(*dateFrom) {
+		return dto.PaginationResponse{}, exception.NewError("date_to cannot be before date_from")
+	}
+
+	auditLogs, totalItems, err := service.AuditLogRepository.FindAllWithPagination(ctx, service.DB, strings.TrimSpace(filter.EntityType), strings.TrimSpace(filter.EntityID), actorID, action, dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToAuditLogResponses(auditLogs)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
@@ -9,7 +9,12 @@ import (
 
 type AuthService interface {
 	Register(ctx context.Context, request auth.AuthRegisterRequest) (*auth.AuthResponse, error)
+	// Login mengembalikan challenge token (TwoFactorRequired) bila user harus melalui 2FA
 	Login(ctx context.Context, request auth.AuthLoginRequest) (*auth.TokenResponse, error)
+	// LoginTwoFactor menyelesaikan login tahap kedua dengan kode TOTP atau kode pemulihan
+	LoginTwoFactor(ctx context.Context, request auth.TwoFactorLoginRequest) (*auth.TokenResponse, error)
+	// LoginTwoFactorSetup membuat secret authenticator bagi user yang wajib 2FA tetapi belum mendaftar
+	LoginTwoFactorSetup(ctx context.Context, request auth.TwoFactorChallengeRequest) (*auth.TwoFactorSetupResponse, error)
 	RefreshToken(ctx context.Context, request auth.RefreshTokenRequest) (*auth.TokenResponse, error)
 	Logout(ctx context.Context, request auth.LogoutRequest) error
 	ChangePassword(ctx context.Context, userID uuid.UUID, request auth.ChangePasswordRequest) error
@@ -9,7 +9,9 @@ import (
 	repo "erpfinance/internal/repository/auth"
 	tokenRepo "erpfinance/internal/repository/token"
 	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -17,18 +19,24 @@ import (
 )
 
 type AuthServiceImpl struct {
-	AuthRepository  repo.AuthRepository
-	TokenRepository tokenRepo.TokenRepository
-	DB              *gorm.DB
-	Validate        *validator.Validate
+	AuthRepository          repo.AuthRepository
+	TokenRepository         tokenRepo.TokenRepository
+	TwoFactorRepository     repo.TwoFactorRepository
+	SecurityEventRepository repo.SecurityEventRepository
+	TokenRevocationService  TokenRevocationService
+	DB                      *gorm.DB
+	Validate                *validator.Validate
 }
 
-func NewAuthService(authRepository repo.AuthRepository, tokenRepository tokenRepo.TokenRepository, db *gorm.DB, validate *validator.Validate) AuthService {
+func NewAuthService(authRepository repo.AuthRepository, tokenRepository tokenRepo.TokenRepository, twoFactorRepository repo.TwoFactorRepository, securityEventRepository repo.SecurityEventRepository, tokenRevocationService TokenRevocationService, db *gorm.DB, validate *validator.Validate) AuthService {
 	return &AuthServiceImpl{
-		AuthRepository:  authRepository,
-		TokenRepository: tokenRepository,
-		DB:              db,
-		Validate:        validate,
+		AuthRepository:          authRepository,
+		TokenRepository:         tokenRepository,
+		TwoFactorRepository:     twoFactorRepository,
+		SecurityEventRepository: securityEventRepository,
+		TokenRevocationService:  tokenRevocationService,
+		DB:                      db,
+		Validate:                validate,
 	}
 }
 
@@ -90,26 +98,35 @@ func (service *AuthServiceImpl) Login(ctx context.Context, request auth.AuthLogi
 			return errors.New("invalid email or password")
 		}
 
-		// Generate JWT tokens
-		accessToken, refreshToken, err := helper.GenerateJWT(user)
+		// User dengan 2FA aktif, atau yang role-nya mewajibkan 2FA, belum mendapat token sampai
+		// login tahap kedua diselesaikan di /auth/login/2fa
+		required, err := service.TwoFactorRepository.IsRequiredForRole(ctx, tx, user.Role)
 		if err != nil {
 			return err
 		}
-
-		// Simpan refresh token baru ke database untuk sesi yang aman
-		if err := service.TokenRepository.Create(ctx, tx, user.ID, refreshToken); err != nil {
-			return errors.New("failed to create user session")
+		if user.TwoFactorEnabled || required {
+			challengeToken, err := service.createLoginChallenge(ctx, tx, user, request.DeviceName)
+			if errors.Is(err, errTooManyTwoFactorAttempts) {
+				return err
+			}
+			if err != nil {
+				return errors.New("failed to create login challenge")
+			}
+
+			result = auth.TokenResponse{
+				TwoFactorRequired:  true,
+				EnrollmentRequired: !user.TwoFactorEnabled,
+				ChallengeToken:     challengeToken,
+				ChallengeExpiresIn: int(loginChallengeTTL.Seconds()),
+			}
+			return nil
 		}
 
-		result = auth.TokenResponse{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			User: &auth.UserResponse{
-				ID:    user.ID,
-				Name:  user.Name,
-				Email: user.Email,
-			},
+		tokens, err := service.issueTokens(ctx, tx, user, request.DeviceName)
+		if err != nil {
+			return err
 		}
+		result = *tokens
 
 		return nil
 	})
@@ -127,6 +144,7 @@ func (service *AuthServiceImpl) RefreshToken(ctx context.Context, request auth.R
 	}
 
 	var result auth.TokenResponse
+	var reuseErr error
 
 	// 1. Validasi format refresh token
 	claims, err := helper.ValidateToken(request.RefreshToken, true)
@@ -135,32 +153,64 @@ func (service *AuthServiceImpl) RefreshToken(ctx context.Context, request auth.R
 	}
 
 	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 2. Cari refresh token di database untuk memastikan token itu valid dan belum digunakan
-		storedToken, err := service.TokenRepository.FindByToken(ctx, tx, request.RefreshToken)
+		// 2. Cari dan kunci refresh token di database, termasuk yang sudah dirotasi atau dicabut
+		storedToken, err := service.TokenRepository.FindByTokenForUpdate(ctx, tx, request.RefreshToken)
 		if err != nil {
-			// Jika token tidak ada di DB, berarti token sudah tidak valid (mungkin sudah digunakan atau dicuri)
 			return errors.New("refresh token not found or has been invalidated")
 		}
+		if storedToken.RevokedAt != nil {
+			return errors.New("refresh token has been revoked, please login again")
+		}
+
+		// 3. Token yang sudah dirotasi dipakai lagi: token ini bocor. Cabut seluruh family sehingga
+		// pencuri maupun pemilik sah harus login ulang di perangkat tersebut. Transaksi tetap di-commit.
+		if storedToken.RotatedAt != nil {
+			if err := service.TokenRepository.RevokeFamily(ctx, tx, storedToken.FamilyID, domain.RefreshTokenRevokedReuse); err != nil {
+				return err
+			}
+			if err := service.TokenRevocationService.RevokeSession(ctx, tx, storedToken.UserID, storedToken.FamilyID, domain.RefreshTokenRevokedReuse); err != nil {
+				return err
+			}
+			if err := service.recordRefreshTokenReuse(ctx, tx, storedToken); err != nil {
+				return err
+			}
+			reuseErr = errors.New("refresh token reuse detected, please login again")
+			return nil
+		}
 
-		// 3. Dapatkan data user
+		// 4. Dapatkan data user
 		user, err := service.AuthRepository.FindById(ctx, tx, claims.ID)
 		if err != nil {
 			return errors.New("user not found")
 		}
+		if !user.IsActive {
+			return errors.New("user account is deactivated")
+		}
 
-		// 4. HAPUS refresh token lama dari database. Ini adalah langkah kuncinya (ROTATION).
-		if err := service.TokenRepository.Delete(ctx, tx, storedToken.ID); err != nil {
+		// 5. Tandai refresh token lama sudah dirotasi. Token lama disimpan agar pemakaian ulang terdeteksi.
+		if err := service.TokenRepository.MarkRotated(ctx, tx, storedToken.ID, time.Now()); err != nil {
 			return errors.New("failed to invalidate old token")
 		}
 
-		// 5. Buat access token dan refresh token baru
-		accessToken, refreshToken, err := helper.GenerateJWT(user)
+		// 6. Buat access token dan refresh token baru
+		accessToken, refreshToken, err := helper.GenerateJWT(user, storedToken.FamilyID)
 		if err != nil {
 			return err
 		}
 
-		// 6. SIMPAN refresh token baru ke database
-		err = service.TokenRepository.Create(ctx, tx, user.ID, refreshToken)
+		// 7. SIMPAN refresh token baru ke database dalam family (sesi) yang sama
+		ipAddress, userAgent := requestClient(ctx)
+		err = service.TokenRepository.Create(ctx, tx, domain.RefreshToken{
+			UserID:     user.ID,
+			FamilyID:   storedToken.FamilyID,
+			ParentID:   &storedToken.ID,
+			Token:      refreshToken,
+			DeviceName: storedToken.DeviceName,
+			UserAgent:  userAgent,
+			IPAddress:  ipAddress,
+			SignedInAt: storedToken.SignedInAt,
+			LastUsedAt: time.Now(),
+		})
 		if err != nil {
 			return errors.New("failed to save new refresh token")
 		}
@@ -182,6 +232,9 @@ func (service *AuthServiceImpl) RefreshToken(ctx context.Context, request auth.R
 	if err != nil {
 		return nil, err
 	}
+	if reuseErr != nil {
+		return nil, reuseErr
+	}
 
 	return &result, nil
 }
@@ -198,7 +251,23 @@ func (service *AuthServiceImpl) Logout(ctx context.Context, request auth.LogoutR
 	}
 
 	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Hapus semua refresh token untuk user ini
+		// Access token yang dipakai logout langsung ditolak, tidak menunggu kedaluwarsa
+		if err := service.TokenRevocationService.RevokeAccessToken(ctx, tx, claims, domain.RefreshTokenRevokedLogout); err != nil {
+			return errors.New("failed to logout")
+		}
+
+		// Cabut hanya sesi tempat access token ini diterbitkan; sesi di perangkat lain tetap aktif
+		if claims.SessionID != uuid.Nil {
+			if err := service.TokenRepository.RevokeFamily(ctx, tx, claims.SessionID, domain.RefreshTokenRevokedLogout); err != nil {
+				return errors.New("failed to logout")
+			}
+			if err := service.TokenRevocationService.RevokeSession(ctx, tx, claims.ID, claims.SessionID, domain.RefreshTokenRevokedLogout); err != nil {
+				return errors.New("failed to logout")
+			}
+			return nil
+		}
+
+		// Token lama tanpa klaim sesi: hapus semua refresh token untuk user ini
 		err = service.TokenRepository.DeleteByUserID(ctx, tx, claims.ID)
 		if err != nil {
 			return errors.New("failed to logout")
@@ -258,8 +327,252 @@ func (service *AuthServiceImpl) ChangePassword(ctx context.Context, userID uuid.
             log.Printf("warning: failed to delete old sessions for user %s: %v", user.ID, err)
         }
 
+        // Access token yang sudah diterbitkan, termasuk milik perangkat lain, langsung ditolak
+        if err := service.TokenRevocationService.RevokeAllForUser(ctx, tx, user.ID); err != nil {
+            return errors.New("failed to revoke existing tokens")
+        }
+
         return nil
     })
 
     return err
 }
+
+func (service *AuthServiceImpl) LoginTwoFactor(ctx context.Context, request auth.TwoFactorLoginRequest) (*auth.TokenResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	if (request.Code == "") == (request.RecoveryCode == "") {
+		return nil, errors.New("provide either code or recovery_code")
+	}
+
+	var result auth.TokenResponse
+	var verifyErr error
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		challenge, err := service.findActiveChallenge(ctx, tx, request.ChallengeToken)
+		if err != nil {
+			return err
+		}
+
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, challenge.UserID)
+		if err != nil {
+			return errors.New("user not found")
+		}
+
+		// Row user sudah dikunci, jadi hitungan kode salah tidak bisa dilewati dengan request paralel
+		if err := checkTwoFactorLockout(ctx, tx, service.SecurityEventRepository, user.ID); err != nil {
+			return err
+		}
+
+		// User yang belum terdaftar menyelesaikan pendaftaran dengan kode pertamanya
+		enrolling := !user.TwoFactorEnabled
+		if enrolling && user.TOTPSecret == "" {
+			return errors.New("set up an authenticator app with /auth/login/2fa/setup first")
+		}
+		if enrolling && request.RecoveryCode != "" {
+			return errors.New("recovery codes are not available before two-factor enrolment is completed")
+		}
+
+		if request.RecoveryCode != "" {
+			verifyErr = useRecoveryCode(ctx, tx, service.TwoFactorRepository, user, request.RecoveryCode)
+		} else {
+			verifyErr = verifyTOTP(ctx, tx, service.TwoFactorRepository, &user, request.Code)
+		}
+		if verifyErr != nil {
+			// Percobaan gagal tetap disimpan (transaksi di-commit) agar batas percobaan berlaku
+			if !errors.Is(verifyErr, errInvalidTwoFactorCode) && !errors.Is(verifyErr, errInvalidRecoveryCode) {
+				return verifyErr
+			}
+			challenge.Attempts++
+			if err := service.TwoFactorRepository.UpdateChallenge(ctx, tx, challenge); err != nil {
+				return err
+			}
+			return recordTwoFactorFailure(ctx, tx, service.SecurityEventRepository, user.ID)
+		}
+
+		now := time.Now()
+		challenge.ConsumedAt = &now
+		if err := service.TwoFactorRepository.UpdateChallenge(ctx, tx, challenge); err != nil {
+			return err
+		}
+
+		var recoveryCodes []string
+		if enrolling {
+			user.TwoFactorEnabled = true
+			user.TwoFactorEnabledAt = &now
+			if err := service.TwoFactorRepository.UpdateUserTwoFactor(ctx, tx, user); err != nil {
+				return err
+			}
+			recoveryCodes, err = issueRecoveryCodes(ctx, tx, service.TwoFactorRepository, user)
+			if err != nil {
+				return err
+			}
+		}
+
+		tokens, err := service.issueTokens(ctx, tx, user, challenge.DeviceName)
+		if err != nil {
+			return err
+		}
+		result = *tokens
+		result.RecoveryCodes = recoveryCodes
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if verifyErr != nil {
+		return nil, verifyErr
+	}
+
+	return &result, nil
+}
+
+func (service *AuthServiceImpl) LoginTwoFactorSetup(ctx context.Context, request auth.TwoFactorChallengeRequest) (*auth.TwoFactorSetupResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var result *auth.TwoFactorSetupResponse
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		challenge, err := service.findActiveChallenge(ctx, tx, request.ChallengeToken)
+		if err != nil {
+			return err
+		}
+
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, challenge.UserID)
+		if err != nil {
+			return errors.New("user not found")
+		}
+		if user.TwoFactorEnabled {
+			return errors.New("two-factor authentication is already enabled")
+		}
+
+		result, err = newTwoFactorSetup(&user)
+		if err != nil {
+			return err
+		}
+		return service.TwoFactorRepository.UpdateUserTwoFactor(ctx, tx, user)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// createLoginChallenge menyimpan hash challenge token dan mengembalikan token aslinya. User yang
+// sedang terkunci karena terlalu banyak kode salah tidak mendapat challenge baru.
+func (service *AuthServiceImpl) createLoginChallenge(ctx context.Context, tx *gorm.DB, user domain.Users, deviceName string) (string, error) {
+	if err := checkTwoFactorLockout(ctx, tx, service.SecurityEventRepository, user.ID); err != nil {
+		return "", err
+	}
+	if err := service.TwoFactorRepository.DeleteExpiredChallenges(ctx, tx, user.ID); err != nil {
+		return "", err
+	}
+
+	token, err := helper.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	ipAddress, _ := requestClient(ctx)
+	challenge := domain.LoginChallenge{
+		ID:         uuid.New(),
+		UserID:     user.ID,
+		TokenHash:  helper.HashToken(token),
+		ExpiresAt:  time.Now().Add(loginChallengeTTL),
+		IPAddress:  ipAddress,
+		DeviceName: deviceName,
+	}
+	if err := service.TwoFactorRepository.CreateChallenge(ctx, tx, challenge); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// findActiveChallenge mengunci challenge yang belum dipakai, belum kedaluwarsa dan belum melewati
+// batas percobaan
+func (service *AuthServiceImpl) findActiveChallenge(ctx context.Context, tx *gorm.DB, token string) (domain.LoginChallenge, error) {
+	challenge, err := service.TwoFactorRepository.FindChallengeByTokenHashForUpdate(ctx, tx, helper.HashToken(token))
+	if err != nil || challenge.ConsumedAt != nil || time.Now().After(challenge.ExpiresAt) || challenge.Attempts >= maxChallengeAttempts {
+		return domain.LoginChallenge{}, errors.New("login challenge is invalid or expired, please login again")
+	}
+	return challenge, nil
+}
+
+// issueTokens menerbitkan access dan refresh token setelah seluruh tahap login berhasil. Setiap
+// login memulai sesi (family refresh token) baru.
+func (service *AuthServiceImpl) issueTokens(ctx context.Context, tx *gorm.DB, user domain.Users, deviceName string) (*auth.TokenResponse, error) {
+	if !user.IsActive {
+		return nil, errors.New("user account is deactivated")
+	}
+
+	sessionID := uuid.New()
+
+	// Generate JWT tokens
+	accessToken, refreshToken, err := helper.GenerateJWT(user, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bersihkan token expired milik user, termasuk token hasil rotasi yang disimpan untuk deteksi reuse
+	if err := service.TokenRepository.DeleteExpiredByUserID(ctx, tx, user.ID); err != nil {
+		return nil, err
+	}
+
+	// Simpan refresh token baru ke database untuk sesi yang aman
+	now := time.Now()
+	ipAddress, userAgent := requestClient(ctx)
+	err = service.TokenRepository.Create(ctx, tx, domain.RefreshToken{
+		UserID:     user.ID,
+		FamilyID:   sessionID,
+		Token:      refreshToken,
+		DeviceName: deviceName,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		SignedInAt: now,
+		LastUsedAt: now,
+	})
+	if err != nil {
+		return nil, errors.New("failed to create user session")
+	}
+
+	return &auth.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User: &auth.UserResponse{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+		},
+	}, nil
+}
+
+// recordRefreshTokenReuse mencatat security event pemakaian ulang refresh token yang sudah dirotasi
+func (service *AuthServiceImpl) recordRefreshTokenReuse(ctx context.Context, tx *gorm.DB, storedToken domain.RefreshToken) error {
+	ipAddress, _ := requestClient(ctx)
+	log.Printf("SECURITY: refresh token reuse detected for user %s (family %s, ip %s), family revoked", storedToken.UserID, storedToken.FamilyID, ipAddress)
+
+	return service.SecurityEventRepository.Create(ctx, tx, domain.SecurityEvent{
+		ID:          uuid.New(),
+		EventType:   domain.SecurityEventRefreshTokenReuse,
+		UserID:      &storedToken.UserID,
+		Description: fmt.Sprintf("Rotated refresh token %s was used again; all tokens in family %s were revoked", storedToken.ID, storedToken.FamilyID),
+		IPAddress:   ipAddress,
+	})
+}
+
+// requestClient membaca IP dan user agent yang di-set middleware RequestInfo
+func requestClient(ctx context.Context) (ipAddress string, userAgent string) {
+	ipAddress, _ = ctx.Value("requestIP").(string)
+	userAgent, _ = ctx.Value("requestUserAgent").(string)
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	return ipAddress, userAgent
+}
@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/dto/auth"
+
+	"github.com/google/uuid"
+)
+
+// SessionService mengelola sesi login (family refresh token) milik user yang sedang login.
+// currentSessionID adalah sesi access token yang dipakai, sehingga sesi itu tidak ikut dicabut.
+type SessionService interface {
+	FindAll(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) ([]auth.SessionResponse, error)
+	Revoke(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, sessionID uuid.UUID) error
+	RevokeOthers(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) (*auth.RevokeSessionsResponse, error)
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/auth"
+	tokenRepo "erpfinance/internal/repository/token"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SessionServiceImpl struct {
+	TokenRepository        tokenRepo.TokenRepository
+	TokenRevocationService TokenRevocationService
+	DB                     *gorm.DB
+}
+
+func NewSessionService(tokenRepository tokenRepo.TokenRepository, tokenRevocationService TokenRevocationService, db *gorm.DB) SessionService {
+	return &SessionServiceImpl{
+		TokenRepository:        tokenRepository,
+		TokenRevocationService: tokenRevocationService,
+		DB:                     db,
+	}
+}
+
+func (service *SessionServiceImpl) FindAll(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) ([]auth.SessionResponse, error) {
+	tokens, err := service.TokenRepository.FindByUserID(ctx, service.DB.WithContext(ctx), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToSessionResponses(tokens, currentSessionID), nil
+}
+
+func (service *SessionServiceImpl) Revoke(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, sessionID uuid.UUID) error {
+	if sessionID == currentSessionID {
+		return exception.NewError("cannot revoke the current session, use logout instead")
+	}
+
+	return service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token, err := service.TokenRepository.FindActiveByFamilyID(ctx, tx, sessionID)
+		if err != nil || token.UserID != userID {
+			return exception.NewNotFoundError("session not found")
+		}
+
+		if err := service.TokenRepository.RevokeFamily(ctx, tx, sessionID, domain.RefreshTokenRevokedByUser); err != nil {
+			return err
+		}
+		return service.TokenRevocationService.RevokeSession(ctx, tx, userID, sessionID, domain.RefreshTokenRevokedByUser)
+	})
+}
+
+func (service *SessionServiceImpl) RevokeOthers(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) (*auth.RevokeSessionsResponse, error) {
+	var response auth.RevokeSessionsResponse
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tokens, err := service.TokenRepository.FindByUserID(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			if token.FamilyID == currentSessionID {
+				continue
+			}
+			if err := service.TokenRevocationService.RevokeSession(ctx, tx, userID, token.FamilyID, domain.RefreshTokenRevokedByUser); err != nil {
+				return err
+			}
+			response.RevokedSessions++
+		}
+
+		return service.TokenRepository.RevokeOtherFamilies(ctx, tx, userID, currentSessionID, domain.RefreshTokenRevokedByUser)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
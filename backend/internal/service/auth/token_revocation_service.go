@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/helper"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TokenRevocationService mencabut access token sebelum masa berlakunya habis. Pencabutan disimpan
+// di database dan di-cache di memori proses sehingga AuthMiddleware tidak perlu query per request.
+type TokenRevocationService interface {
+	// IsRevoked dipanggil AuthMiddleware untuk setiap request
+	IsRevoked(ctx context.Context, claims *helper.JWTClaim) (bool, error)
+
+	// RevokeAccessToken mencabut satu access token berdasarkan jti
+	RevokeAccessToken(ctx context.Context, tx *gorm.DB, claims *helper.JWTClaim, reason string) error
+	// RevokeSession mencabut semua access token yang diterbitkan untuk satu sesi
+	RevokeSession(ctx context.Context, tx *gorm.DB, userID uuid.UUID, sessionID uuid.UUID, reason string) error
+	// RevokeAllForUser menolak semua token user yang diterbitkan sebelum detik berikutnya
+	RevokeAllForUser(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error
+	// ForgetUser membuang status user dari cache setelah status aktifnya berubah
+	ForgetUser(userID uuid.UUID)
+}
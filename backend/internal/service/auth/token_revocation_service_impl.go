@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	tokenRepo "erpfinance/internal/repository/token"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// revocationCacheTTL adalah batas umur cache. Pencabutan dari proses lain (instance server lain)
+// paling lambat terlihat setelah selang ini; pencabutan di proses yang sama langsung berlaku.
+const revocationCacheTTL = 30 * time.Second
+
+// revocationCache dipakai bersama oleh semua instance TokenRevocationServiceImpl, karena wire
+// membuat instance service terpisah untuk setiap handler
+type revocationCache struct {
+	mu sync.RWMutex
+	// revoked berisi jti/session id yang dicabut beserta waktu kedaluwarsanya
+	revoked  map[string]time.Time
+	loadedAt time.Time
+	users    map[uuid.UUID]cachedTokenState
+	// validAfter berisi watermark yang dibuat proses ini, berlaku walau status user di cache masih
+	// berasal dari sebelum watermark di-commit
+	validAfter map[uuid.UUID]time.Time
+}
+
+type cachedTokenState struct {
+	state    domain.UserTokenState
+	found    bool
+	cachedAt time.Time
+}
+
+var tokenRevocations = &revocationCache{
+	revoked:    make(map[string]time.Time),
+	users:      make(map[uuid.UUID]cachedTokenState),
+	validAfter: make(map[uuid.UUID]time.Time),
+}
+
+type TokenRevocationServiceImpl struct {
+	RevokedTokenRepository tokenRepo.RevokedTokenRepository
+	DB                     *gorm.DB
+}
+
+func NewTokenRevocationService(revokedTokenRepository tokenRepo.RevokedTokenRepository, db *gorm.DB) TokenRevocationService {
+	return &TokenRevocationServiceImpl{
+		RevokedTokenRepository: revokedTokenRepository,
+		DB:                     db,
+	}
+}
+
+func (service *TokenRevocationServiceImpl) IsRevoked(ctx context.Context, claims *helper.JWTClaim) (bool, error) {
+	if err := service.refreshDenylist(ctx); err != nil {
+		return false, err
+	}
+
+	if claims.IssuedAt == nil {
+		return true, nil
+	}
+	issuedAt := claims.IssuedAt.Time
+
+	now := time.Now()
+	tokenRevocations.mu.RLock()
+	revoked := false
+	for _, id := range []string{claims.RegisteredClaims.ID, claims.SessionID.String()} {
+		if expiresAt, ok := tokenRevocations.revoked[id]; ok && expiresAt.After(now) {
+			revoked = true
+		}
+	}
+	if validAfter, ok := tokenRevocations.validAfter[claims.ID]; ok && issuedAt.Before(validAfter) {
+		revoked = true
+	}
+	tokenRevocations.mu.RUnlock()
+	if revoked {
+		return true, nil
+	}
+
+	state, found, err := service.userTokenState(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+	// User yang sudah dihapus tidak punya token yang berlaku
+	if !found {
+		return true, nil
+	}
+	return !state.Accepts(issuedAt), nil
+}
+
+func (service *TokenRevocationServiceImpl) RevokeAccessToken(ctx context.Context, tx *gorm.DB, claims *helper.JWTClaim, reason string) error {
+	// Token lama tanpa jti tidak bisa dicabut satu per satu
+	if claims.RegisteredClaims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	return service.revoke(ctx, tx, domain.RevokedToken{
+		ID:        claims.RegisteredClaims.ID,
+		Type:      domain.RevokedTokenTypeAccess,
+		UserID:    claims.ID,
+		Reason:    reason,
+		ExpiresAt: claims.ExpiresAt.Time,
+	})
+}
+
+func (service *TokenRevocationServiceImpl) RevokeSession(ctx context.Context, tx *gorm.DB, userID uuid.UUID, sessionID uuid.UUID, reason string) error {
+	// Refresh token sesi sudah dicabut, jadi access token terakhirnya paling lama berlaku AccessTokenTTL lagi
+	return service.revoke(ctx, tx, domain.RevokedToken{
+		ID:        sessionID.String(),
+		Type:      domain.RevokedTokenTypeSession,
+		UserID:    userID,
+		Reason:    reason,
+		ExpiresAt: time.Now().Add(helper.AccessTokenTTL),
+	})
+}
+
+func (service *TokenRevocationServiceImpl) RevokeAllForUser(ctx context.Context, tx *gorm.DB, userID uuid.UUID) error {
+	// Klaim iat hanya presisi detik, jadi watermark dibulatkan ke atas: token yang diterbitkan pada
+	// detik yang sama dengan pencabutan ikut ditolak, termasuk yang terbit sesaat sebelumnya
+	validAfter := time.Now().Truncate(time.Second).Add(time.Second)
+	if err := service.RevokedTokenRepository.UpdateTokensValidAfter(ctx, tx, userID, validAfter); err != nil {
+		return err
+	}
+
+	tokenRevocations.mu.Lock()
+	tokenRevocations.validAfter[userID] = validAfter
+	delete(tokenRevocations.users, userID)
+	tokenRevocations.mu.Unlock()
+	return nil
+}
+
+func (service *TokenRevocationServiceImpl) ForgetUser(userID uuid.UUID) {
+	tokenRevocations.mu.Lock()
+	delete(tokenRevocations.users, userID)
+	tokenRevocations.mu.Unlock()
+}
+
+// revoke menyimpan entri denylist dan langsung memasukkannya ke cache. Bila transaksi pemanggil
+// gagal, entri cache hanya membuat token ditolak lebih awal.
+func (service *TokenRevocationServiceImpl) revoke(ctx context.Context, tx *gorm.DB, revokedToken domain.RevokedToken) error {
+	if err := service.RevokedTokenRepository.Create(ctx, tx, revokedToken); err != nil {
+		return err
+	}
+
+	tokenRevocations.mu.Lock()
+	tokenRevocations.revoked[revokedToken.ID] = revokedToken.ExpiresAt
+	tokenRevocations.mu.Unlock()
+	return nil
+}
+
+// refreshDenylist memuat ulang denylist dari database bila cache sudah lewat revocationCacheTTL.
+// Entri lokal yang belum kedaluwarsa dipertahankan walau belum ter-commit saat dimuat.
+func (service *TokenRevocationServiceImpl) refreshDenylist(ctx context.Context) error {
+	tokenRevocations.mu.RLock()
+	fresh := time.Since(tokenRevocations.loadedAt) < revocationCacheTTL
+	tokenRevocations.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	db := service.DB.WithContext(ctx)
+	if err := service.RevokedTokenRepository.DeleteExpired(ctx, db); err != nil {
+		return err
+	}
+	revokedTokens, err := service.RevokedTokenRepository.FindActive(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	tokenRevocations.mu.Lock()
+	defer tokenRevocations.mu.Unlock()
+
+	revoked := make(map[string]time.Time, len(revokedTokens))
+	for _, revokedToken := range revokedTokens {
+		revoked[revokedToken.ID] = revokedToken.ExpiresAt
+	}
+	for id, expiresAt := range tokenRevocations.revoked {
+		if _, ok := revoked[id]; !ok && expiresAt.After(now) {
+			revoked[id] = expiresAt
+		}
+	}
+	tokenRevocations.revoked = revoked
+	tokenRevocations.loadedAt = now
+
+	// Status user ikut dibuang agar perubahan dari proses lain terbaca. Watermark lokal cukup
+	// disimpan selama AccessTokenTTL karena token yang lebih tua sudah kedaluwarsa.
+	for userID, cached := range tokenRevocations.users {
+		if now.Sub(cached.cachedAt) >= revocationCacheTTL {
+			delete(tokenRevocations.users, userID)
+		}
+	}
+	for userID, validAfter := range tokenRevocations.validAfter {
+		if now.Sub(validAfter) >= helper.AccessTokenTTL {
+			delete(tokenRevocations.validAfter, userID)
+		}
+	}
+	return nil
+}
+
+// userTokenState membaca status aktif dan watermark user dari cache atau database
+func (service *TokenRevocationServiceImpl) userTokenState(ctx context.Context, userID uuid.UUID) (domain.UserTokenState, bool, error) {
+	tokenRevocations.mu.RLock()
+	cached, ok := tokenRevocations.users[userID]
+	tokenRevocations.mu.RUnlock()
+	if ok && time.Since(cached.cachedAt) < revocationCacheTTL {
+		return cached.state, cached.found, nil
+	}
+
+	state, err := service.RevokedTokenRepository.FindUserTokenState(ctx, service.DB.WithContext(ctx), userID)
+	found := err == nil
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.UserTokenState{}, false, err
+	}
+
+	tokenRevocations.mu.Lock()
+	tokenRevocations.users[userID] = cachedTokenState{state: state, found: found, cachedAt: time.Now()}
+	tokenRevocations.mu.Unlock()
+	return state, found, nil
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/auth"
+	repo "erpfinance/internal/repository/auth"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// twoFactorIssuer tampil sebagai nama akun di aplikasi authenticator
+	twoFactorIssuer = "ERP Finance"
+
+	// loginChallengeTTL adalah batas waktu menyelesaikan login tahap kedua
+	loginChallengeTTL = 5 * time.Minute
+
+	// maxChallengeAttempts membatasi tebakan kode 6 digit per challenge
+	maxChallengeAttempts = 5
+
+	// maxUserTwoFactorFailures membatasi kode salah per user dalam twoFactorFailureWindow di seluruh
+	// challenge, sehingga login ulang dengan password yang benar tidak memberi jatah tebakan baru
+	maxUserTwoFactorFailures = 10
+	twoFactorFailureWindow   = 15 * time.Minute
+
+	recoveryCodeCount = 10
+)
+
+var (
+	errInvalidTwoFactorCode = errors.New("invalid two-factor code")
+	errInvalidRecoveryCode  = errors.New("invalid recovery code")
+
+	errTooManyTwoFactorAttempts = errors.New("too many failed two-factor attempts, please try again later")
+)
+
+// newTwoFactorSetup membuat secret baru untuk user. Secret disimpan terenkripsi tetapi 2FA belum
+// aktif sampai kode pertama diverifikasi; plaintext hanya dikirim sekali di response.
+func newTwoFactorSetup(user *domain.Users) (*auth.TwoFactorSetupResponse, error) {
+	secret, err := helper.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret, err = sealTOTPSecret(*user, secret)
+	if err != nil {
+		return nil, err
+	}
+	user.TOTPLastStep = 0
+	return &auth.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: helper.TOTPProvisioningURI(twoFactorIssuer, user.Email, secret),
+		Issuer:          twoFactorIssuer,
+		Account:         user.Email,
+	}, nil
+}
+
+// verifyTOTP memeriksa kode authenticator dan mencatat step-nya sehingga kode yang sama tidak bisa
+// dipakai dua kali. user harus sudah dikunci (FindUserByIdForUpdate).
+func verifyTOTP(ctx context.Context, tx *gorm.DB, twoFactorRepository repo.TwoFactorRepository, user *domain.Users, code string) error {
+	if user.TOTPSecret == "" {
+		return errInvalidTwoFactorCode
+	}
+
+	secret, err := openTOTPSecret(*user)
+	if err != nil {
+		return err
+	}
+
+	step, ok := helper.ValidateTOTP(secret, code, time.Now())
+	if !ok || step <= user.TOTPLastStep {
+		return errInvalidTwoFactorCode
+	}
+
+	// Secret lama yang masih tersimpan plaintext dienkripsi saat pertama kali dipakai
+	if !helper.IsEncryptedSecret(user.TOTPSecret) {
+		user.TOTPSecret, err = sealTOTPSecret(*user, secret)
+		if err != nil {
+			return err
+		}
+	}
+
+	user.TOTPLastStep = step
+	return twoFactorRepository.UpdateUserTwoFactor(ctx, tx, *user)
+}
+
+// sealTOTPSecret mengenkripsi seed TOTP untuk disimpan di users.totp_secret. ID user menjadi
+// associated data sehingga secret tidak bisa disalin ke user lain.
+func sealTOTPSecret(user domain.Users, secret string) (string, error) {
+	sealed, err := helper.EncryptSecret(secret, user.ID.String())
+	if err != nil {
+		log.Printf("ERROR: Failed to encrypt TOTP secret for user %s: %v", user.ID, err)
+		return "", errors.New("failed to store two-factor secret")
+	}
+	return sealed, nil
+}
+
+// openTOTPSecret mendekripsi seed TOTP user. Nilai tanpa prefix enkripsi adalah secret plaintext
+// dari sebelum enkripsi diterapkan.
+func openTOTPSecret(user domain.Users) (string, error) {
+	if !helper.IsEncryptedSecret(user.TOTPSecret) {
+		return user.TOTPSecret, nil
+	}
+
+	secret, err := helper.DecryptSecret(user.TOTPSecret, user.ID.String())
+	if err != nil {
+		log.Printf("ERROR: Failed to decrypt TOTP secret for user %s: %v", user.ID, err)
+		return "", errors.New("failed to read two-factor secret")
+	}
+	return secret, nil
+}
+
+// checkTwoFactorLockout menolak login tahap kedua selama user memiliki maxUserTwoFactorFailures
+// kode salah dalam twoFactorFailureWindow terakhir
+func checkTwoFactorLockout(ctx context.Context, tx *gorm.DB, securityEventRepository repo.SecurityEventRepository, userID uuid.UUID) error {
+	failures, err := securityEventRepository.CountByUserSince(ctx, tx, userID, domain.SecurityEventTwoFactorFailed, time.Now().Add(-twoFactorFailureWindow))
+	if err != nil {
+		return err
+	}
+	if failures >= maxUserTwoFactorFailures {
+		return errTooManyTwoFactorAttempts
+	}
+	return nil
+}
+
+// recordTwoFactorFailure mencatat kode 2FA yang salah sebagai security event untuk
+// checkTwoFactorLockout
+func recordTwoFactorFailure(ctx context.Context, tx *gorm.DB, securityEventRepository repo.SecurityEventRepository, userID uuid.UUID) error {
+	ipAddress, _ := requestClient(ctx)
+	if err := securityEventRepository.Create(ctx, tx, domain.SecurityEvent{
+		ID:          uuid.New(),
+		EventType:   domain.SecurityEventTwoFactorFailed,
+		UserID:      &userID,
+		Description: "Invalid two-factor code during login",
+		IPAddress:   ipAddress,
+	}); err != nil {
+		return err
+	}
+
+	if err := checkTwoFactorLockout(ctx, tx, securityEventRepository, userID); errors.Is(err, errTooManyTwoFactorAttempts) {
+		log.Printf("SECURITY: two-factor login locked for user %s after repeated invalid codes (ip %s)", userID, ipAddress)
+	}
+	return nil
+}
+
+// useRecoveryCode menandai kode pemulihan sebagai terpakai
+func useRecoveryCode(ctx context.Context, tx *gorm.DB, twoFactorRepository repo.TwoFactorRepository, user domain.Users, recoveryCode string) error {
+	code, err := twoFactorRepository.FindUnusedRecoveryCode(ctx, tx, user.ID, helper.HashToken(helper.NormalizeRecoveryCode(recoveryCode)))
+	if err != nil {
+		return errInvalidRecoveryCode
+	}
+
+	now := time.Now()
+	code.UsedAt = &now
+	return twoFactorRepository.UpdateRecoveryCode(ctx, tx, code)
+}
+
+// issueRecoveryCodes mengganti seluruh kode pemulihan user dan mengembalikan kode dalam bentuk
+// asli untuk ditampilkan sekali
+func issueRecoveryCodes(ctx context.Context, tx *gorm.DB, twoFactorRepository repo.TwoFactorRepository, user domain.Users) ([]string, error) {
+	codes, err := helper.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, helper.HashToken(helper.NormalizeRecoveryCode(code)))
+	}
+	if err := twoFactorRepository.ReplaceRecoveryCodes(ctx, tx, user.ID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/model/dto/auth"
+
+	"github.com/google/uuid"
+)
+
+type TwoFactorService interface {
+	Status(ctx context.Context, userID uuid.UUID) (*auth.TwoFactorStatusResponse, error)
+	// Setup membuat secret baru; 2FA baru aktif setelah Enable dengan kode dari authenticator
+	Setup(ctx context.Context, userID uuid.UUID) (*auth.TwoFactorSetupResponse, error)
+	Enable(ctx context.Context, userID uuid.UUID, request auth.TwoFactorCodeRequest) (*auth.RecoveryCodesResponse, error)
+	Disable(ctx context.Context, userID uuid.UUID, request auth.TwoFactorDisableRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, request auth.TwoFactorCodeRequest) (*auth.RecoveryCodesResponse, error)
+
+	FindAllPolicies(ctx context.Context) ([]auth.TwoFactorPolicyResponse, error)
+	UpdatePolicy(ctx context.Context, role string, updatedBy uuid.UUID, request auth.TwoFactorPolicyRequest) (*auth.TwoFactorPolicyResponse, error)
+	// Reset menonaktifkan 2FA user yang kehilangan perangkat dan kode pemulihannya (admin)
+	Reset(ctx context.Context, userID uuid.UUID) error
+}
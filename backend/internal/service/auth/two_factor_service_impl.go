@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/auth"
+	repo "erpfinance/internal/repository/auth"
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TwoFactorServiceImpl struct {
+	TwoFactorRepository repo.TwoFactorRepository
+	DB                  *gorm.DB
+	Validate            *validator.Validate
+}
+
+func NewTwoFactorService(twoFactorRepository repo.TwoFactorRepository, db *gorm.DB, validate *validator.Validate) TwoFactorService {
+	return &TwoFactorServiceImpl{
+		TwoFactorRepository: twoFactorRepository,
+		DB:                  db,
+		Validate:            validate,
+	}
+}
+
+func (service *TwoFactorServiceImpl) Status(ctx context.Context, userID uuid.UUID) (*auth.TwoFactorStatusResponse, error) {
+	var response auth.TwoFactorStatusResponse
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+
+		required, err := service.TwoFactorRepository.IsRequiredForRole(ctx, tx, user.Role)
+		if err != nil {
+			return err
+		}
+
+		response = auth.TwoFactorStatusResponse{
+			Enabled:        user.TwoFactorEnabled,
+			RequiredByRole: required,
+		}
+		if user.TwoFactorEnabledAt != nil {
+			response.EnabledAt = helper.FormatTimeIndonesia(*user.TwoFactorEnabledAt)
+		}
+		if user.TwoFactorEnabled {
+			response.RemainingRecoveryCodes, err = service.TwoFactorRepository.CountUnusedRecoveryCodes(ctx, tx, user.ID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (service *TwoFactorServiceImpl) Setup(ctx context.Context, userID uuid.UUID) (*auth.TwoFactorSetupResponse, error) {
+	var response *auth.TwoFactorSetupResponse
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if user.TwoFactorEnabled {
+			return exception.NewError("two-factor authentication is already enabled")
+		}
+
+		response, err = newTwoFactorSetup(&user)
+		if err != nil {
+			return err
+		}
+		return service.TwoFactorRepository.UpdateUserTwoFactor(ctx, tx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *TwoFactorServiceImpl) Enable(ctx context.Context, userID uuid.UUID, request auth.TwoFactorCodeRequest) (*auth.RecoveryCodesResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var codes []string
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if user.TwoFactorEnabled {
+			return exception.NewError("two-factor authentication is already enabled")
+		}
+		if user.TOTPSecret == "" {
+			return exception.NewError("set up an authenticator app with /auth/2fa/setup first")
+		}
+
+		if err := service.verifyCode(ctx, tx, &user, request.Code); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		user.TwoFactorEnabled = true
+		user.TwoFactorEnabledAt = &now
+		if err := service.TwoFactorRepository.UpdateUserTwoFactor(ctx, tx, user); err != nil {
+			return err
+		}
+
+		codes, err = issueRecoveryCodes(ctx, tx, service.TwoFactorRepository, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (service *TwoFactorServiceImpl) Disable(ctx context.Context, userID uuid.UUID, request auth.TwoFactorDisableRequest) error {
+	if err := service.Validate.Struct(request); err != nil {
+		return helper.FormatValidationError(err)
+	}
+
+	return service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if !user.TwoFactorEnabled {
+			return exception.NewError("two-factor authentication is not enabled")
+		}
+
+		required, err := service.TwoFactorRepository.IsRequiredForRole(ctx, tx, user.Role)
+		if err != nil {
+			return err
+		}
+		if required {
+			return exception.NewError("two-factor authentication is required for your role and cannot be disabled")
+		}
+
+		if !helper.CheckPasswordHash(request.Password, user.Password) {
+			return exception.NewError("invalid password")
+		}
+		if err := service.verifyCode(ctx, tx, &user, request.Code); err != nil {
+			return err
+		}
+
+		return service.disable(ctx, tx, user)
+	})
+}
+
+func (service *TwoFactorServiceImpl) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, request auth.TwoFactorCodeRequest) (*auth.RecoveryCodesResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var codes []string
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if !user.TwoFactorEnabled {
+			return exception.NewError("two-factor authentication is not enabled")
+		}
+
+		if err := service.verifyCode(ctx, tx, &user, request.Code); err != nil {
+			return err
+		}
+
+		codes, err = issueRecoveryCodes(ctx, tx, service.TwoFactorRepository, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (service *TwoFactorServiceImpl) FindAllPolicies(ctx context.Context) ([]auth.TwoFactorPolicyResponse, error) {
+	policies, err := service.TwoFactorRepository.FindAllPolicies(ctx, service.DB.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Role tanpa policy ditampilkan sebagai tidak wajib
+	byRole := make(map[domain.Role]domain.TwoFactorPolicy, len(policies))
+	for _, policy := range policies {
+		byRole[policy.Role] = policy
+	}
+
+	responses := make([]auth.TwoFactorPolicyResponse, 0, len(domain.Roles))
+	for _, role := range domain.Roles {
+		policy, ok := byRole[role]
+		if !ok {
+			policy = domain.TwoFactorPolicy{Role: role}
+		}
+		responses = append(responses, mapper.ToTwoFactorPolicyResponse(policy))
+	}
+
+	return responses, nil
+}
+
+func (service *TwoFactorServiceImpl) UpdatePolicy(ctx context.Context, role string, updatedBy uuid.UUID, request auth.TwoFactorPolicyRequest) (*auth.TwoFactorPolicyResponse, error) {
+	if !isKnownRole(domain.Role(role)) {
+		return nil, exception.NewNotFoundError("role not found")
+	}
+
+	policy := domain.TwoFactorPolicy{
+		Role:      domain.Role(role),
+		Required:  request.Required,
+		UpdatedBy: &updatedBy,
+		UpdatedAt: time.Now(),
+	}
+	if err := service.TwoFactorRepository.SavePolicy(ctx, service.DB.WithContext(ctx), policy); err != nil {
+		return nil, err
+	}
+
+	response := mapper.ToTwoFactorPolicyResponse(policy)
+	return &response, nil
+}
+
+func (service *TwoFactorServiceImpl) Reset(ctx context.Context, userID uuid.UUID) error {
+	return service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.TwoFactorRepository.FindUserByIdForUpdate(ctx, tx, userID)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if !user.TwoFactorEnabled && user.TOTPSecret == "" {
+			return exception.NewError("two-factor authentication is not enabled for this user")
+		}
+
+		return service.disable(ctx, tx, user)
+	})
+}
+
+// verifyCode memverifikasi kode TOTP dan mengubah kode salah menjadi error bisnis
+func (service *TwoFactorServiceImpl) verifyCode(ctx context.Context, tx *gorm.DB, user *domain.Users, code string) error {
+	err := verifyTOTP(ctx, tx, service.TwoFactorRepository, user, code)
+	if errors.Is(err, errInvalidTwoFactorCode) {
+		return exception.NewError(err.Error())
+	}
+	return err
+}
+
+// disable menghapus secret dan kode pemulihan user
+func (service *TwoFactorServiceImpl) disable(ctx context.Context, tx *gorm.DB, user domain.Users) error {
+	user.TwoFactorEnabled = false
+	user.TwoFactorEnabledAt = nil
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	if err := service.TwoFactorRepository.UpdateUserTwoFactor(ctx, tx, user); err != nil {
+		return err
+	}
+	return service.TwoFactorRepository.DeleteRecoveryCodes(ctx, tx, user.ID)
+}
+
+func isKnownRole(role domain.Role) bool {
+	for _, known := range domain.Roles {
+		if known == role {
+			return true
+		}
+	}
+	return false
+}
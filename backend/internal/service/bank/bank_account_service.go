@@ -0,0 +1,16 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+
+	"github.com/google/uuid"
+)
+
+type BankAccountService interface {
+	Create(ctx context.Context, request bank.BankAccountRequest) (*bank.BankAccountResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request bank.BankAccountUpdateRequest) (*bank.BankAccountResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*bank.BankAccountResponse, error)
+	FindAll(ctx context.Context, filter bank.BankAccountFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
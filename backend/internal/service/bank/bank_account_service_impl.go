@@ -0,0 +1,153 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+	repo "erpfinance/internal/repository/bank"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BankAccountServiceImpl struct {
+	BankAccountRepository   repo.BankAccountRepository
+	BankStatementRepository repo.BankStatementRepository
+	LedgerService           ledgerService.LedgerService
+	DB                      *gorm.DB
+	Validate                *validator.Validate
+}
+
+func NewBankAccountService(bankAccountRepository repo.BankAccountRepository, bankStatementRepository repo.BankStatementRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) BankAccountService {
+	return &BankAccountServiceImpl{
+		BankAccountRepository:   bankAccountRepository,
+		BankStatementRepository: bankStatementRepository,
+		LedgerService:           ledgerService,
+		DB:                      db,
+		Validate:                validate,
+	}
+}
+
+func (service *BankAccountServiceImpl) Create(ctx context.Context, request bank.BankAccountRequest) (*bank.BankAccountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	account := domain.BankAccount{ID: uuid.New(), IsActive: true}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyRequest(ctx, tx, &account, request); err != nil {
+			return err
+		}
+
+		_, err := service.BankAccountRepository.Create(ctx, tx, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, account.ID)
+}
+
+func (service *BankAccountServiceImpl) Update(ctx context.Context, id uuid.UUID, request bank.BankAccountUpdateRequest) (*bank.BankAccountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account, err := service.BankAccountRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("bank account not found")
+		}
+
+		// Mutasi yang sudah diimport dicocokkan berdasarkan mata uang dan akun buku besar rekening
+		if !strings.EqualFold(request.Currency, account.Currency) || request.GLAccountID != account.GLAccountID {
+			hasStatements, err := service.BankStatementRepository.ExistsForAccount(ctx, tx, account.ID)
+			if err != nil {
+				return err
+			}
+			if hasStatements {
+				return exception.NewError("currency and GL account cannot be changed after statements have been imported")
+			}
+		}
+
+		if err := service.applyRequest(ctx, tx, &account, request.BankAccountRequest); err != nil {
+			return err
+		}
+		account.IsActive = request.IsActive
+		return service.BankAccountRepository.Update(ctx, tx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *BankAccountServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*bank.BankAccountResponse, error) {
+	account, err := service.BankAccountRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("bank account not found")
+	}
+
+	return mapper.ToBankAccountResponse(account), nil
+}
+
+func (service *BankAccountServiceImpl) FindAll(ctx context.Context, filter bank.BankAccountFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	accounts, totalItems, err := service.BankAccountRepository.FindAllWithPagination(ctx, service.DB, strings.ToUpper(filter.Type), filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToBankAccountResponses(accounts)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+// applyRequest mengisi rekening dari request setelah memastikan kode dan akun buku besar belum
+// dipakai rekening lain
+func (service *BankAccountServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, account *domain.BankAccount, request bank.BankAccountRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	var excludeID *uuid.UUID
+	if account.Code != "" {
+		excludeID = &account.ID
+	}
+	exists, err := service.BankAccountRepository.ExistsByCode(ctx, tx, code, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("bank account %s already exists", code))
+	}
+
+	glAccount, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.GLAccountID, "GL account", domain.AccountTypeAsset)
+	if err != nil {
+		return err
+	}
+	used, err := service.BankAccountRepository.ExistsByGLAccount(ctx, tx, glAccount.ID, excludeID)
+	if err != nil {
+		return err
+	}
+	if used {
+		return exception.NewError(fmt.Sprintf("GL account %s is already linked to another bank account", glAccount.Code))
+	}
+
+	account.Code = code
+	account.Name = request.Name
+	account.Type = domain.BankAccountType(request.Type)
+	account.BankName = request.BankName
+	account.AccountNumber = strings.TrimSpace(request.AccountNumber)
+	account.AccountHolder = request.AccountHolder
+	account.Currency = strings.ToUpper(request.Currency)
+	account.GLAccountID = glAccount.ID
+	account.Notes = request.Notes
+	return nil
+}
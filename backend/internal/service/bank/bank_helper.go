@@ -0,0 +1,134 @@
+package bank
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// defaultDateToleranceDays adalah selisih hari maksimum antara mutasi bank dan transaksi buku
+// saat pencocokan otomatis bila request tidak menentukan
+const defaultDateToleranceDays = 3
+
+// minReferenceLength mencegah referensi pendek (misal "1") dianggap cocok dengan keterangan bank
+const minReferenceLength = 4
+
+// bankMatch adalah pasangan mutasi rekening koran dan transaksi buku hasil pencocokan otomatis
+type bankMatch struct {
+	LineIndex   int
+	Transaction domain.BankBookTransaction
+}
+
+// autoMatchLines mencocokkan mutasi dengan transaksi buku yang nominalnya sama persis dan
+// tanggalnya dalam toleransi. Tahap pertama memakai referensi: nomor transaksi atau referensi
+// transaksi yang muncul di referensi/keterangan mutasi. Tahap kedua hanya memakai nominal dan
+// tanggal, dan hanya bila pasangannya tunggal dari kedua sisi. Sisanya dibiarkan untuk
+// pencocokan manual.
+func autoMatchLines(lines []domain.BankStatementLine, transactions []domain.BankBookTransaction, toleranceDays int) []bankMatch {
+	lineUsed := make([]bool, len(lines))
+	transactionUsed := make([]bool, len(transactions))
+
+	candidates := func(lineIndex int) []int {
+		var result []int
+		for j, transaction := range transactions {
+			if !transactionUsed[j] && isCandidate(lines[lineIndex], transaction, toleranceDays) {
+				result = append(result, j)
+			}
+		}
+		return result
+	}
+
+	var matches []bankMatch
+	match := func(lineIndex, transactionIndex int) {
+		lineUsed[lineIndex] = true
+		transactionUsed[transactionIndex] = true
+		matches = append(matches, bankMatch{LineIndex: lineIndex, Transaction: transactions[transactionIndex]})
+	}
+
+	for i := range lines {
+		var referenced []int
+		for _, j := range candidates(i) {
+			if referenceMatches(lines[i], transactions[j]) {
+				referenced = append(referenced, j)
+			}
+		}
+		if len(referenced) == 1 {
+			match(i, referenced[0])
+		}
+	}
+
+	for i := range lines {
+		if lineUsed[i] {
+			continue
+		}
+		found := candidates(i)
+		if len(found) != 1 {
+			continue
+		}
+		competing := 0
+		for k := range lines {
+			if !lineUsed[k] && isCandidate(lines[k], transactions[found[0]], toleranceDays) {
+				competing++
+			}
+		}
+		if competing == 1 {
+			match(i, found[0])
+		}
+	}
+
+	return matches
+}
+
+func isCandidate(line domain.BankStatementLine, transaction domain.BankBookTransaction, toleranceDays int) bool {
+	if !helper.IsZeroAmount(line.Amount.Sub(transaction.Amount)) {
+		return false
+	}
+	return daysBetween(line.TransactionDate, transaction.TransactionDate) <= toleranceDays
+}
+
+func daysBetween(a, b time.Time) int {
+	days := int(a.Sub(b).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+// referenceMatches membandingkan tanpa spasi dan tanda baca karena bank sering memotong atau
+// menghapus tanda hubung pada nomor referensi
+func referenceMatches(line domain.BankStatementLine, transaction domain.BankBookTransaction) bool {
+	text := normalizeReference(line.Reference + " " + line.Description)
+	if text == "" {
+		return false
+	}
+	for _, reference := range []string{transaction.Number, transaction.Reference} {
+		normalized := normalizeReference(reference)
+		if len(normalized) >= minReferenceLength && strings.Contains(text, normalized) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeReference(value string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToUpper(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// normalizeAccountNumber hanya menyisakan angka untuk membandingkan nomor rekening di file MT940
+func normalizeAccountNumber(value string) string {
+	var builder strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
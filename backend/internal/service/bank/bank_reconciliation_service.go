@@ -0,0 +1,23 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+type BankReconciliationService interface {
+	// ImportStatement menyimpan mutasi dari file CSV atau MT940. Mutasi yang sudah pernah
+	// diimport untuk rekening yang sama dilewati.
+	ImportStatement(ctx context.Context, userID uuid.UUID, request bank.BankStatementImportRequest, fileName string, file io.Reader) (*bank.BankStatementResponse, error)
+	FindStatementById(ctx context.Context, id uuid.UUID) (*bank.BankStatementResponse, error)
+	FindAllStatements(ctx context.Context, filter bank.BankStatementFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	Workspace(ctx context.Context, bankAccountID uuid.UUID, request bank.BankReconciliationRequest) (*bank.BankReconciliationResponse, error)
+	AutoMatch(ctx context.Context, bankAccountID uuid.UUID, userID uuid.UUID, request bank.BankAutoMatchRequest) (*bank.BankAutoMatchResponse, error)
+	Match(ctx context.Context, lineID uuid.UUID, userID uuid.UUID, request bank.BankManualMatchRequest) (*bank.BankStatementLineResponse, error)
+	Unmatch(ctx context.Context, lineID uuid.UUID) (*bank.BankStatementLineResponse, error)
+}
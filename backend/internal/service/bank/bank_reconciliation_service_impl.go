@@ -0,0 +1,394 @@
+package bank
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/bank"
+	repo "erpfinance/internal/repository/bank"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxStatementFileSize membatasi ukuran file rekening koran (10 MB)
+const maxStatementFileSize = 10 << 20
+
+type BankReconciliationServiceImpl struct {
+	BankAccountRepository   repo.BankAccountRepository
+	BankStatementRepository repo.BankStatementRepository
+	BankBookRepository      repo.BankBookRepository
+	DB                      *gorm.DB
+	Validate                *validator.Validate
+}
+
+func NewBankReconciliationService(bankAccountRepository repo.BankAccountRepository, bankStatementRepository repo.BankStatementRepository, bankBookRepository repo.BankBookRepository, db *gorm.DB, validate *validator.Validate) BankReconciliationService {
+	return &BankReconciliationServiceImpl{
+		BankAccountRepository:   bankAccountRepository,
+		BankStatementRepository: bankStatementRepository,
+		BankBookRepository:      bankBookRepository,
+		DB:                      db,
+		Validate:                validate,
+	}
+}
+
+func (service *BankReconciliationServiceImpl) ImportStatement(ctx context.Context, userID uuid.UUID, request bank.BankStatementImportRequest, fileName string, file io.Reader) (*bank.BankStatementResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	bankAccountID, err := uuid.Parse(request.BankAccountID)
+	if err != nil {
+		return nil, exception.NewError("invalid bank_account_id")
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file, maxStatementFileSize+1))
+	if err != nil {
+		return nil, exception.NewError("unable to read the statement file")
+	}
+	if len(content) > maxStatementFileSize {
+		return nil, exception.NewError("statement file exceeds the maximum size of 10 MB")
+	}
+
+	format := domain.BankStatementFormat(request.Format)
+	if format == "" {
+		format = detectStatementFormat(content)
+	}
+
+	var parsed parsedStatement
+	if format == domain.BankStatementFormatMT940 {
+		parsed, err = parseMT940Statement(content)
+	} else {
+		parsed, err = parseCSVStatement(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	statement := domain.BankStatement{
+		ID:             uuid.New(),
+		BankAccountID:  bankAccountID,
+		Format:         format,
+		FileName:       fileName,
+		StatementNo:    parsed.StatementNo,
+		OpeningBalance: parsed.OpeningBalance,
+		ClosingBalance: parsed.ClosingBalance,
+		ImportedBy:     userID,
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account, err := service.BankAccountRepository.FindById(ctx, tx, bankAccountID)
+		if err != nil {
+			return exception.NewNotFoundError("bank account not found")
+		}
+		if !account.IsActive {
+			return exception.NewError(fmt.Sprintf("bank account %s is inactive", account.Code))
+		}
+		if parsed.Currency != "" && !strings.EqualFold(parsed.Currency, account.Currency) {
+			return exception.NewError(fmt.Sprintf("statement currency %s does not match bank account currency %s", parsed.Currency, account.Currency))
+		}
+		if number := normalizeAccountNumber(account.AccountNumber); parsed.AccountNumber != "" && number != "" && !strings.Contains(normalizeAccountNumber(parsed.AccountNumber), number) {
+			return exception.NewError(fmt.Sprintf("statement account %s does not match bank account %s", parsed.AccountNumber, account.AccountNumber))
+		}
+
+		fingerprints := lineFingerprints(parsed.Lines)
+		existing, err := service.BankStatementRepository.FindExistingFingerprints(ctx, tx, account.ID, fingerprints)
+		if err != nil {
+			return err
+		}
+		imported := make(map[string]bool, len(existing))
+		for _, fingerprint := range existing {
+			imported[fingerprint] = true
+		}
+
+		for i, line := range parsed.Lines {
+			if imported[fingerprints[i]] {
+				statement.SkippedLines++
+				continue
+			}
+			if statement.PeriodStart.IsZero() || line.TransactionDate.Before(statement.PeriodStart) {
+				statement.PeriodStart = line.TransactionDate
+			}
+			if line.TransactionDate.After(statement.PeriodEnd) {
+				statement.PeriodEnd = line.TransactionDate
+			}
+			if line.Amount.IsPositive() {
+				statement.TotalCredit = helper.RoundAmount(statement.TotalCredit.Add(line.Amount))
+			} else {
+				statement.TotalDebit = helper.RoundAmount(statement.TotalDebit.Sub(line.Amount))
+			}
+
+			statement.Lines = append(statement.Lines, domain.BankStatementLine{
+				ID:              uuid.New(),
+				BankAccountID:   account.ID,
+				LineNo:          len(statement.Lines) + 1,
+				TransactionDate: line.TransactionDate,
+				Amount:          line.Amount,
+				Reference:       truncate(line.Reference, 100),
+				Description:     line.Description,
+				Fingerprint:     fingerprints[i],
+				Status:          domain.BankStatementLineStatusUnmatched,
+			})
+		}
+		if len(statement.Lines) == 0 {
+			return exception.NewError("all statement lines have already been imported")
+		}
+		statement.LineCount = len(statement.Lines)
+
+		_, err = service.BankStatementRepository.Create(ctx, tx, statement)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindStatementById(ctx, statement.ID)
+}
+
+func (service *BankReconciliationServiceImpl) FindStatementById(ctx context.Context, id uuid.UUID) (*bank.BankStatementResponse, error) {
+	statement, err := service.BankStatementRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("bank statement not found")
+	}
+
+	return mapper.ToBankStatementResponse(statement), nil
+}
+
+func (service *BankReconciliationServiceImpl) FindAllStatements(ctx context.Context, filter bank.BankStatementFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	bankAccountID, err := helper.ParseOptionalUUID(filter.BankAccountID, "bank_account_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	statements, totalItems, err := service.BankStatementRepository.FindAllWithPagination(ctx, service.DB, bankAccountID, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToBankStatementResponses(statements)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *BankReconciliationServiceImpl) Workspace(ctx context.Context, bankAccountID uuid.UUID, request bank.BankReconciliationRequest) (*bank.BankReconciliationResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	dateFrom, err := helper.ParseDate(request.DateFrom)
+	if err != nil {
+		return nil, exception.NewError("invalid date_from")
+	}
+	dateTo, err := helper.ParseDate(request.DateTo)
+	if err != nil {
+		return nil, exception.NewError("invalid date_to")
+	}
+	if dateTo.Before(dateFrom) {
+		return nil, exception.NewError("date_to cannot be before date_from")
+	}
+
+	account, err := service.BankAccountRepository.FindById(ctx, service.DB, bankAccountID)
+	if err != nil {
+		return nil, exception.NewNotFoundError("bank account not found")
+	}
+
+	lines, err := service.BankStatementRepository.FindLines(ctx, service.DB, account.ID, dateFrom, dateTo, "")
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := service.BankBookRepository.FindUnmatched(ctx, service.DB, account.GLAccountID, account.Currency, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &bank.BankReconciliationResponse{
+		BankAccount:           *mapper.ToBankAccountResponse(account),
+		DateFrom:              helper.FormatDate(dateFrom),
+		DateTo:                helper.FormatDate(dateTo),
+		StatementLines:        mapper.ToBankStatementLineResponses(lines),
+		UnmatchedTransactions: mapper.ToBankBookTransactionResponses(transactions),
+	}
+	for _, line := range lines {
+		if line.IsMatched() {
+			response.MatchedLines++
+			continue
+		}
+		response.UnmatchedLines++
+		response.UnmatchedStatementTotal = helper.RoundAmount(response.UnmatchedStatementTotal.Add(line.Amount))
+	}
+	for _, transaction := range transactions {
+		response.UnmatchedBookTotal = helper.RoundAmount(response.UnmatchedBookTotal.Add(transaction.Amount))
+	}
+	return response, nil
+}
+
+func (service *BankReconciliationServiceImpl) AutoMatch(ctx context.Context, bankAccountID uuid.UUID, userID uuid.UUID, request bank.BankAutoMatchRequest) (*bank.BankAutoMatchResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	statementID, err := helper.ParseOptionalUUID(request.BankStatementID, "bank_statement_id")
+	if err != nil {
+		return nil, err
+	}
+	tolerance := request.DateToleranceDays
+	if tolerance == 0 {
+		tolerance = defaultDateToleranceDays
+	}
+
+	response := &bank.BankAutoMatchResponse{Matches: []bank.BankStatementLineResponse{}}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account, err := service.BankAccountRepository.FindById(ctx, tx, bankAccountID)
+		if err != nil {
+			return exception.NewNotFoundError("bank account not found")
+		}
+
+		lines, err := service.BankStatementRepository.LockUnmatchedLines(ctx, tx, account.ID, statementID)
+		if err != nil {
+			return err
+		}
+		response.ExaminedLines = len(lines)
+		if len(lines) == 0 {
+			return nil
+		}
+
+		dateFrom, dateTo := lines[0].TransactionDate, lines[0].TransactionDate
+		for _, line := range lines {
+			if line.TransactionDate.Before(dateFrom) {
+				dateFrom = line.TransactionDate
+			}
+			if line.TransactionDate.After(dateTo) {
+				dateTo = line.TransactionDate
+			}
+		}
+		transactions, err := service.BankBookRepository.FindUnmatched(ctx, tx, account.GLAccountID, account.Currency, dateFrom.AddDate(0, 0, -tolerance), dateTo.AddDate(0, 0, tolerance))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, match := range autoMatchLines(lines, transactions, tolerance) {
+			line := lines[match.LineIndex]
+			applyMatch(&line, match.Transaction, domain.BankMatchMethodAuto, userID, now)
+			if err := service.BankStatementRepository.UpdateLine(ctx, tx, line); err != nil {
+				return err
+			}
+			response.Matches = append(response.Matches, *mapper.ToBankStatementLineResponse(line))
+		}
+		response.MatchedLines = len(response.Matches)
+		response.UnmatchedLines = response.ExaminedLines - response.MatchedLines
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *BankReconciliationServiceImpl) Match(ctx context.Context, lineID uuid.UUID, userID uuid.UUID, request bank.BankManualMatchRequest) (*bank.BankStatementLineResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var line domain.BankStatementLine
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		line, err = service.BankStatementRepository.FindLineByIdForUpdate(ctx, tx, lineID)
+		if err != nil {
+			return exception.NewNotFoundError("bank statement line not found")
+		}
+		if line.IsMatched() {
+			return exception.NewError("bank statement line is already matched")
+		}
+
+		account, err := service.BankAccountRepository.FindById(ctx, tx, line.BankAccountID)
+		if err != nil {
+			return exception.NewNotFoundError("bank account not found")
+		}
+
+		transaction, err := service.BankBookRepository.FindBySource(ctx, tx, request.SourceType, request.SourceID)
+		if err != nil {
+			return exception.NewNotFoundError("posted transaction not found")
+		}
+		if transaction.AccountID != account.GLAccountID {
+			return exception.NewError(fmt.Sprintf("transaction %s was not posted to the GL account of bank account %s", transaction.Number, account.Code))
+		}
+		if transaction.Currency != account.Currency {
+			return exception.NewError(fmt.Sprintf("transaction %s is in %s, bank account %s is in %s", transaction.Number, transaction.Currency, account.Code, account.Currency))
+		}
+		if !helper.IsZeroAmount(transaction.Amount.Sub(line.Amount)) {
+			return exception.NewError(fmt.Sprintf("transaction amount %s does not match statement line amount %s", transaction.Amount.StringFixed(2), line.Amount.StringFixed(2)))
+		}
+
+		matched, err := service.BankBookRepository.IsMatched(ctx, tx, transaction.SourceType, transaction.SourceID)
+		if err != nil {
+			return err
+		}
+		if matched {
+			return exception.NewError(fmt.Sprintf("transaction %s is already matched to another statement line", transaction.Number))
+		}
+
+		applyMatch(&line, transaction, domain.BankMatchMethodManual, userID, time.Now())
+		return service.BankStatementRepository.UpdateLine(ctx, tx, line)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToBankStatementLineResponse(line), nil
+}
+
+func (service *BankReconciliationServiceImpl) Unmatch(ctx context.Context, lineID uuid.UUID) (*bank.BankStatementLineResponse, error) {
+	var line domain.BankStatementLine
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		line, err = service.BankStatementRepository.FindLineByIdForUpdate(ctx, tx, lineID)
+		if err != nil {
+			return exception.NewNotFoundError("bank statement line not found")
+		}
+		if !line.IsMatched() {
+			return exception.NewError("bank statement line is not matched")
+		}
+
+		line.Status = domain.BankStatementLineStatusUnmatched
+		line.MatchedSourceType = ""
+		line.MatchedSourceID = nil
+		line.MatchMethod = ""
+		line.MatchedBy = nil
+		line.MatchedAt = nil
+		return service.BankStatementRepository.UpdateLine(ctx, tx, line)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToBankStatementLineResponse(line), nil
+}
+
+func applyMatch(line *domain.BankStatementLine, transaction domain.BankBookTransaction, method domain.BankMatchMethod, userID uuid.UUID, matchedAt time.Time) {
+	sourceID := transaction.SourceID
+	line.Status = domain.BankStatementLineStatusMatched
+	line.MatchedSourceType = transaction.SourceType
+	line.MatchedSourceID = &sourceID
+	line.MatchMethod = method
+	line.MatchedBy = &userID
+	line.MatchedAt = &matchedAt
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
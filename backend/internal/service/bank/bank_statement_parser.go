@@ -0,0 +1,348 @@
+package bank
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// maxStatementLines membatasi jumlah mutasi dalam satu file rekening koran
+const maxStatementLines = 10000
+
+// parsedStatement adalah hasil parsing file rekening koran sebelum disimpan
+type parsedStatement struct {
+	StatementNo    string
+	AccountNumber  string
+	Currency       string
+	OpeningBalance *decimal.Decimal
+	ClosingBalance *decimal.Decimal
+	Lines          []parsedStatementLine
+}
+
+type parsedStatementLine struct {
+	TransactionDate time.Time
+	Amount          decimal.Decimal
+	Reference       string
+	Description     string
+}
+
+// detectStatementFormat mengenali MT940 dari tag :20: atau blok SWIFT {4:, selain itu dianggap CSV
+func detectStatementFormat(content []byte) domain.BankStatementFormat {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(content, []byte("\ufeff")))
+	if bytes.HasPrefix(trimmed, []byte(":20:")) || bytes.HasPrefix(trimmed, []byte("{1:")) || bytes.Contains(trimmed, []byte("\n:61:")) {
+		return domain.BankStatementFormatMT940
+	}
+	return domain.BankStatementFormatCSV
+}
+
+// parseCSVStatement membaca CSV dengan header date, description, reference dan amount (bertanda,
+// positif untuk uang masuk) atau pasangan debit/credit dari sisi rekening koran
+func parseCSVStatement(content []byte) (parsedStatement, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return parsedStatement{}, exception.NewError(fmt.Sprintf("invalid CSV file: %v", err))
+	}
+	if len(records) < 2 {
+		return parsedStatement{}, exception.NewError("CSV file has no statement rows")
+	}
+	if len(records)-1 > maxStatementLines {
+		return parsedStatement{}, exception.NewError(fmt.Sprintf("CSV file exceeds the maximum of %d rows", maxStatementLines))
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	dateColumn, hasDate := columns["date"]
+	amountColumn, hasAmount := columns["amount"]
+	debitColumn, hasDebit := columns["debit"]
+	creditColumn, hasCredit := columns["credit"]
+	if !hasDate || (!hasAmount && !(hasDebit && hasCredit)) {
+		return parsedStatement{}, exception.NewError("CSV header must contain date and either amount or debit,credit columns")
+	}
+
+	cell := func(record []string, name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+	number := func(record []string, index int) (decimal.Decimal, error) {
+		if index >= len(record) || strings.TrimSpace(record[index]) == "" {
+			return decimal.Zero, nil
+		}
+		return decimal.NewFromString(strings.TrimSpace(record[index]))
+	}
+
+	var statement parsedStatement
+	for i, record := range records[1:] {
+		row := i + 2
+		if dateColumn >= len(record) || strings.TrimSpace(record[dateColumn]) == "" {
+			return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: date is required", row))
+		}
+		transactionDate, err := parseStatementDate(strings.TrimSpace(record[dateColumn]))
+		if err != nil {
+			return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: date must be in format 2006-01-02 or 02/01/2006", row))
+		}
+
+		var amount decimal.Decimal
+		if hasAmount {
+			amount, err = number(record, amountColumn)
+			if err != nil {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: amount must be a number", row))
+			}
+		} else {
+			debit, err := number(record, debitColumn)
+			if err != nil || debit.IsNegative() {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: debit must be a non-negative number", row))
+			}
+			credit, err := number(record, creditColumn)
+			if err != nil || credit.IsNegative() {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: credit must be a non-negative number", row))
+			}
+			amount = credit.Sub(debit)
+		}
+		amount = helper.RoundAmount(amount)
+		if helper.IsZeroAmount(amount) {
+			return parsedStatement{}, exception.NewError(fmt.Sprintf("row %d: amount cannot be zero", row))
+		}
+
+		statement.Lines = append(statement.Lines, parsedStatementLine{
+			TransactionDate: transactionDate,
+			Amount:          amount,
+			Reference:       cell(record, "reference"),
+			Description:     cell(record, "description"),
+		})
+	}
+	return statement, nil
+}
+
+func parseStatementDate(value string) (time.Time, error) {
+	if parsed, err := helper.ParseDate(value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse("02/01/2006", value)
+}
+
+var mt940TagPattern = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+
+// parseMT940Statement membaca rekening koran SWIFT MT940. Tag yang dipakai: :25: nomor rekening,
+// :28C: nomor rekening koran, :60F:/:60M: saldo awal, :61: mutasi, :86: keterangan mutasi dan
+// :62F:/:62M: saldo akhir. Bila file berisi beberapa halaman, saldo awal diambil dari halaman
+// pertama dan saldo akhir dari halaman terakhir.
+func parseMT940Statement(content []byte) (parsedStatement, error) {
+	text := strings.ReplaceAll(string(bytes.TrimPrefix(content, []byte("\ufeff"))), "\r", "")
+
+	type field struct {
+		tag   string
+		value string
+	}
+	var fields []field
+	for _, line := range strings.Split(text, "\n") {
+		// Pembungkus blok SWIFT ({1:...}{4: dan -}) tidak berisi data mutasi
+		if strings.HasPrefix(line, "{") {
+			if index := strings.Index(line, "{4:"); index >= 0 {
+				line = line[index+3:]
+			} else {
+				continue
+			}
+		}
+		if strings.TrimSpace(line) == "-}" || strings.TrimSpace(line) == "-" {
+			continue
+		}
+		if match := mt940TagPattern.FindStringSubmatch(line); match != nil {
+			fields = append(fields, field{tag: match[1], value: line[len(match[0]):]})
+			continue
+		}
+		if len(fields) > 0 && strings.TrimSpace(line) != "" {
+			fields[len(fields)-1].value += "\n" + line
+		}
+	}
+	if len(fields) == 0 {
+		return parsedStatement{}, exception.NewError("file is not a valid MT940 statement")
+	}
+
+	var statement parsedStatement
+	lastIsTransaction := false
+	for _, f := range fields {
+		switch f.tag {
+		case "25":
+			if statement.AccountNumber == "" {
+				statement.AccountNumber = strings.TrimSpace(f.value)
+			}
+		case "28C":
+			if statement.StatementNo == "" {
+				statement.StatementNo = strings.TrimSpace(f.value)
+			}
+		case "60F", "60M":
+			if statement.OpeningBalance == nil {
+				currency, balance, err := parseMT940Balance(f.value)
+				if err != nil {
+					return parsedStatement{}, exception.NewError(fmt.Sprintf("invalid opening balance :%s:%s", f.tag, f.value))
+				}
+				statement.Currency = currency
+				statement.OpeningBalance = &balance
+			}
+		case "62F", "62M":
+			currency, balance, err := parseMT940Balance(f.value)
+			if err != nil {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("invalid closing balance :%s:%s", f.tag, f.value))
+			}
+			if statement.Currency == "" {
+				statement.Currency = currency
+			}
+			statement.ClosingBalance = &balance
+		case "61":
+			line, err := parseMT940Transaction(f.value)
+			if err != nil {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("transaction %d: %v", len(statement.Lines)+1, err))
+			}
+			statement.Lines = append(statement.Lines, line)
+			if len(statement.Lines) > maxStatementLines {
+				return parsedStatement{}, exception.NewError(fmt.Sprintf("MT940 file exceeds the maximum of %d transactions", maxStatementLines))
+			}
+			lastIsTransaction = true
+			continue
+		case "86":
+			if lastIsTransaction {
+				last := &statement.Lines[len(statement.Lines)-1]
+				last.Description = strings.Join(strings.Fields(f.value), " ")
+			}
+		}
+		lastIsTransaction = false
+	}
+	if len(statement.Lines) == 0 {
+		return parsedStatement{}, exception.NewError("MT940 file has no transactions")
+	}
+	return statement, nil
+}
+
+// parseMT940Balance membaca saldo format C/D, YYMMDD, kode mata uang, nominal dengan koma desimal
+func parseMT940Balance(value string) (string, decimal.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 11 || (value[0] != 'C' && value[0] != 'D') {
+		return "", decimal.Zero, fmt.Errorf("invalid balance")
+	}
+	amount, err := parseMT940Amount(value[10:])
+	if err != nil {
+		return "", decimal.Zero, err
+	}
+	if value[0] == 'D' {
+		amount = amount.Neg()
+	}
+	return value[7:10], amount, nil
+}
+
+// parseMT940Transaction membaca tag :61: dengan struktur
+// 6!n[4!n]2a[1!a]15d1!a3!c16x[//16x][34x]: tanggal valuta, tanggal buku opsional, tanda
+// debit/kredit (C, D, RC, RD), kode dana opsional, nominal, kode transaksi, referensi nasabah,
+// referensi bank dan keterangan tambahan di baris berikutnya.
+func parseMT940Transaction(value string) (parsedStatementLine, error) {
+	firstLine, supplementary, _ := strings.Cut(value, "\n")
+	firstLine = strings.TrimSpace(firstLine)
+	if len(firstLine) < 6 {
+		return parsedStatementLine{}, fmt.Errorf("statement line is too short")
+	}
+
+	transactionDate, err := time.Parse("060102", firstLine[:6])
+	if err != nil {
+		return parsedStatementLine{}, fmt.Errorf("invalid value date %q", firstLine[:6])
+	}
+	rest := firstLine[6:]
+	if len(rest) >= 4 && isDigits(rest[:4]) {
+		rest = rest[4:]
+	}
+
+	var sign int64
+	switch {
+	case strings.HasPrefix(rest, "RC"):
+		sign, rest = -1, rest[2:]
+	case strings.HasPrefix(rest, "RD"):
+		sign, rest = 1, rest[2:]
+	case strings.HasPrefix(rest, "C"):
+		sign, rest = 1, rest[1:]
+	case strings.HasPrefix(rest, "D"):
+		sign, rest = -1, rest[1:]
+	default:
+		return parsedStatementLine{}, fmt.Errorf("invalid debit/credit mark")
+	}
+	if rest != "" && (rest[0] < '0' || rest[0] > '9') {
+		rest = rest[1:]
+	}
+
+	amountEnd := strings.IndexFunc(rest, func(r rune) bool {
+		return (r < '0' || r > '9') && r != ','
+	})
+	if amountEnd <= 0 {
+		return parsedStatementLine{}, fmt.Errorf("invalid amount")
+	}
+	amount, err := parseMT940Amount(rest[:amountEnd])
+	if err != nil {
+		return parsedStatementLine{}, err
+	}
+	rest = rest[amountEnd:]
+
+	// Kode transaksi 4 karakter (misal NTRF) lalu referensi nasabah//referensi bank
+	if len(rest) >= 4 {
+		rest = rest[4:]
+	}
+	customerReference, bankReference, _ := strings.Cut(rest, "//")
+	reference := strings.TrimSpace(customerReference)
+	if reference == "" || strings.EqualFold(reference, "NONREF") {
+		reference = strings.TrimSpace(bankReference)
+	}
+
+	return parsedStatementLine{
+		TransactionDate: transactionDate,
+		Amount:          helper.RoundAmount(amount.Mul(decimal.NewFromInt(sign))),
+		Reference:       reference,
+		Description:     strings.Join(strings.Fields(supplementary), " "),
+	}, nil
+}
+
+func parseMT940Amount(value string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(strings.Replace(strings.TrimSpace(value), ",", ".", 1))
+	if err != nil || amount.IsNegative() {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// lineFingerprints menghitung sidik jari tiap mutasi. Mutasi identik dalam satu file dibedakan
+// dengan urutan kemunculannya sehingga tetap bisa diimport, sedangkan file yang sama atau
+// periode yang tumpang tindih tidak menggandakan mutasi.
+func lineFingerprints(lines []parsedStatementLine) []string {
+	fingerprints := make([]string, len(lines))
+	occurrences := make(map[string]int, len(lines))
+	for i, line := range lines {
+		key := fmt.Sprintf("%s|%s|%s|%s", helper.FormatDate(line.TransactionDate), line.Amount.StringFixed(2), strings.ToUpper(line.Reference), strings.ToUpper(line.Description))
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrences[key])))
+		fingerprints[i] = hex.EncodeToString(sum[:])
+	}
+	return fingerprints
+}
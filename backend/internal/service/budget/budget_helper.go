@@ -0,0 +1,83 @@
+package budget
+
+import (
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// defaultWarnThresholdPercent adalah persentase pemakaian anggaran yang memicu peringatan
+const defaultWarnThresholdPercent = 90
+
+// budgetAccountTypes adalah tipe akun yang boleh dianggarkan: beban dan belanja modal (aset)
+var budgetAccountTypes = []domain.AccountType{domain.AccountTypeExpense, domain.AccountTypeAsset}
+
+// monthlyAmounts mengembalikan anggaran 12 bulan. Tanpa monthly_amounts, annual_amount dibagi rata
+// dan selisih pembulatan dimasukkan ke Desember sehingga totalnya tetap sama.
+func monthlyAmounts(lineNo int, monthly []decimal.Decimal, annual decimal.Decimal) ([]decimal.Decimal, error) {
+	amounts := make([]decimal.Decimal, 12)
+	if len(monthly) == 0 {
+		perMonth := helper.DivideAmount(annual, 12)
+		for i := 0; i < 11; i++ {
+			amounts[i] = perMonth
+		}
+		amounts[11] = helper.RoundAmount(annual.Sub(perMonth.Mul(decimal.NewFromInt(11))))
+		if amounts[11].IsNegative() {
+			amounts[11] = decimal.Zero
+		}
+		return amounts, nil
+	}
+
+	var total decimal.Decimal
+	for i, amount := range monthly {
+		amounts[i] = helper.RoundAmount(amount)
+		total = total.Add(amounts[i])
+	}
+	if annual.IsPositive() && !helper.IsZeroAmount(total.Sub(annual)) {
+		return nil, exception.NewError(fmt.Sprintf("line %d: annual amount %s does not match the sum of monthly amounts %s", lineNo, annual.StringFixed(2), total.StringFixed(2)))
+	}
+	return amounts, nil
+}
+
+// budgetKey mengelompokkan anggaran dan realisasi per cost center dan akun
+type budgetKey struct {
+	CostCenterID uuid.UUID
+	AccountID    uuid.UUID
+}
+
+// monthlyTotals menjumlahkan BudgetAmount menjadi 12 nilai bulanan per cost center dan akun
+func monthlyTotals(amounts []domain.BudgetAmount) map[budgetKey][]decimal.Decimal {
+	result := make(map[budgetKey][]decimal.Decimal)
+	for _, amount := range amounts {
+		if amount.Month < 1 || amount.Month > 12 {
+			continue
+		}
+		key := budgetKey{CostCenterID: amount.CostCenterID, AccountID: amount.AccountID}
+		if result[key] == nil {
+			result[key] = make([]decimal.Decimal, 12)
+		}
+		result[key][amount.Month-1] = result[key][amount.Month-1].Add(amount.Amount)
+	}
+	return result
+}
+
+// sumMonths menjumlahkan nilai bulan 1 sampai monthTo
+func sumMonths(values []decimal.Decimal, monthTo int) decimal.Decimal {
+	var total decimal.Decimal
+	for i := 0; i < monthTo && i < len(values); i++ {
+		total = total.Add(values[i])
+	}
+	return helper.RoundAmount(total)
+}
+
+// utilizationPercent adalah realisasi dibagi anggaran dalam persen, 0 bila anggarannya nol
+func utilizationPercent(actual, budget decimal.Decimal) float64 {
+	if helper.IsZeroAmount(budget) {
+		return 0
+	}
+	return actual.Mul(decimal.NewFromInt(100)).Div(budget).Round(2).InexactFloat64()
+}
@@ -0,0 +1,27 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+
+	"github.com/google/uuid"
+)
+
+type BudgetService interface {
+	Create(ctx context.Context, userID uuid.UUID, request budget.BudgetRequest) (*budget.BudgetResponse, error)
+	// Update mengganti header dan seluruh baris budget; hanya untuk budget berstatus Draft
+	Update(ctx context.Context, id uuid.UUID, request budget.BudgetRequest) (*budget.BudgetResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*budget.BudgetResponse, error)
+	FindAll(ctx context.Context, filter budget.BudgetFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	// Activate mengunci budget Draft sehingga dipakai oleh budget check dan laporan
+	Activate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*budget.BudgetResponse, error)
+	// Close mengakhiri budget Active; budget tetap tampil di laporan tetapi tidak lagi dipakai budget check
+	Close(ctx context.Context, id uuid.UUID) (*budget.BudgetResponse, error)
+
+	// BudgetVsActual membandingkan anggaran budget Active/Closed dengan realisasi jurnal yang sudah
+	// diposting per cost center dan akun
+	BudgetVsActual(ctx context.Context, request budget.BudgetVsActualRequest) (*budget.BudgetVsActualResponse, error)
+	// Check memberi verdict OK, WARN atau BLOCK untuk rencana pengeluaran terhadap sisa anggaran
+	Check(ctx context.Context, request budget.BudgetCheckRequest) (*budget.BudgetCheckResponse, error)
+}
@@ -0,0 +1,470 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+	repo "erpfinance/internal/repository/budget"
+	ledgerRepo "erpfinance/internal/repository/ledger"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	ledgerService "erpfinance/internal/service/ledger"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// BudgetNumberPrefix adalah prefix penomoran budget, contoh: BGT-202601-00001
+const BudgetNumberPrefix = "BGT"
+
+type BudgetServiceImpl struct {
+	BudgetRepository     repo.BudgetRepository
+	CostCenterRepository repo.CostCenterRepository
+	AccountRepository    ledgerRepo.AccountRepository
+	SequenceRepository   sequenceRepo.SequenceRepository
+	LedgerService        ledgerService.LedgerService
+	DB                   *gorm.DB
+	Validate             *validator.Validate
+}
+
+func NewBudgetService(budgetRepository repo.BudgetRepository, costCenterRepository repo.CostCenterRepository, accountRepository ledgerRepo.AccountRepository, sequenceRepository sequenceRepo.SequenceRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) BudgetService {
+	return &BudgetServiceImpl{
+		BudgetRepository:     budgetRepository,
+		CostCenterRepository: costCenterRepository,
+		AccountRepository:    accountRepository,
+		SequenceRepository:   sequenceRepository,
+		LedgerService:        ledgerService,
+		DB:                   db,
+		Validate:             validate,
+	}
+}
+
+func (service *BudgetServiceImpl) Create(ctx context.Context, userID uuid.UUID, request budget.BudgetRequest) (*budget.BudgetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	budgetEntity := domain.Budget{
+		ID:        uuid.New(),
+		Status:    domain.BudgetStatusDraft,
+		CreatedBy: userID,
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyRequest(ctx, tx, &budgetEntity, request); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, BudgetNumberPrefix, time.Date(request.FiscalYear, time.January, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			return err
+		}
+		budgetEntity.Number = number
+
+		_, err = service.BudgetRepository.Create(ctx, tx, budgetEntity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, budgetEntity.ID)
+}
+
+func (service *BudgetServiceImpl) Update(ctx context.Context, id uuid.UUID, request budget.BudgetRequest) (*budget.BudgetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		budgetEntity, err := service.BudgetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("budget not found")
+		}
+		if budgetEntity.Status != domain.BudgetStatusDraft {
+			return exception.NewError("only draft budgets can be updated")
+		}
+
+		if err := service.applyRequest(ctx, tx, &budgetEntity, request); err != nil {
+			return err
+		}
+		if err := service.BudgetRepository.ReplaceLines(ctx, tx, budgetEntity.ID, budgetEntity.Lines); err != nil {
+			return err
+		}
+		return service.BudgetRepository.Update(ctx, tx, budgetEntity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *BudgetServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*budget.BudgetResponse, error) {
+	budgetEntity, err := service.BudgetRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("budget not found")
+	}
+
+	return mapper.ToBudgetResponse(budgetEntity), nil
+}
+
+func (service *BudgetServiceImpl) FindAll(ctx context.Context, filter budget.BudgetFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	costCenterID, err := helper.ParseOptionalUUID(filter.CostCenterID, "cost_center_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	budgets, totalItems, err := service.BudgetRepository.FindAllWithPagination(ctx, service.DB, filter.FiscalYear, costCenterID, filter.Status, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToBudgetResponses(budgets)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *BudgetServiceImpl) Activate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*budget.BudgetResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		budgetEntity, err := service.BudgetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("budget not found")
+		}
+		if budgetEntity.Status != domain.BudgetStatusDraft {
+			return exception.NewError("only draft budgets can be activated")
+		}
+
+		costCenter, err := service.CostCenterRepository.FindById(ctx, tx, budgetEntity.CostCenterID)
+		if err != nil {
+			return exception.NewError("cost center not found")
+		}
+		if !costCenter.IsActive {
+			return exception.NewError(fmt.Sprintf("cost center %s is inactive", costCenter.Code))
+		}
+
+		now := time.Now()
+		budgetEntity.Status = domain.BudgetStatusActive
+		budgetEntity.ActivatedBy = &userID
+		budgetEntity.ActivatedAt = &now
+		return service.BudgetRepository.Update(ctx, tx, budgetEntity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *BudgetServiceImpl) Close(ctx context.Context, id uuid.UUID) (*budget.BudgetResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		budgetEntity, err := service.BudgetRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("budget not found")
+		}
+		if budgetEntity.Status != domain.BudgetStatusActive {
+			return exception.NewError("only active budgets can be closed")
+		}
+
+		budgetEntity.Status = domain.BudgetStatusClosed
+		return service.BudgetRepository.Update(ctx, tx, budgetEntity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *BudgetServiceImpl) BudgetVsActual(ctx context.Context, request budget.BudgetVsActualRequest) (*budget.BudgetVsActualResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	costCenterID, err := helper.ParseOptionalUUID(request.CostCenterID, "cost_center_id")
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := helper.ParseOptionalUUID(request.AccountID, "account_id")
+	if err != nil {
+		return nil, err
+	}
+	monthTo := request.MonthTo
+	if monthTo == 0 {
+		monthTo = 12
+	}
+
+	budgetAmounts, err := service.BudgetRepository.FindBudgetAmounts(ctx, service.DB, request.FiscalYear, costCenterID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	actualAmounts, err := service.BudgetRepository.FindActualAmounts(ctx, service.DB, request.FiscalYear, costCenterID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	budgets := monthlyTotals(budgetAmounts)
+	actuals := monthlyTotals(actualAmounts)
+
+	keys := make([]budgetKey, 0, len(budgets)+len(actuals))
+	costCenterIDs := make([]uuid.UUID, 0)
+	accountIDs := make([]uuid.UUID, 0)
+	seen := make(map[budgetKey]bool)
+	for _, source := range []map[budgetKey][]decimal.Decimal{budgets, actuals} {
+		for key := range source {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, key)
+			costCenterIDs = append(costCenterIDs, key.CostCenterID)
+			accountIDs = append(accountIDs, key.AccountID)
+		}
+	}
+
+	costCenters, err := service.CostCenterRepository.FindByIds(ctx, service.DB, costCenterIDs)
+	if err != nil {
+		return nil, err
+	}
+	costCenterByID := make(map[uuid.UUID]domain.CostCenter, len(costCenters))
+	for _, costCenter := range costCenters {
+		costCenterByID[costCenter.ID] = costCenter
+	}
+	accounts, err := service.AccountRepository.FindByIds(ctx, service.DB, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	accountByID := make(map[uuid.UUID]domain.Account, len(accounts))
+	for _, account := range accounts {
+		accountByID[account.ID] = account
+	}
+
+	response := &budget.BudgetVsActualResponse{
+		FiscalYear: request.FiscalYear,
+		MonthTo:    monthTo,
+		Lines:      make([]budget.BudgetVsActualLineResponse, 0, len(keys)),
+	}
+	for _, key := range keys {
+		account := accountByID[key.AccountID]
+		budgetMonths, budgeted := budgets[key]
+		// Realisasi tanpa anggaran hanya ditampilkan untuk akun beban; akun aset (misal kas) yang
+		// kebetulan diberi cost center tidak dianggap pengeluaran
+		if !budgeted && account.Type != domain.AccountTypeExpense {
+			continue
+		}
+		if budgetMonths == nil {
+			budgetMonths = make([]decimal.Decimal, 12)
+		}
+		actualMonths := actuals[key]
+		if actualMonths == nil {
+			actualMonths = make([]decimal.Decimal, 12)
+		}
+
+		costCenter := costCenterByID[key.CostCenterID]
+		line := budget.BudgetVsActualLineResponse{
+			CostCenterID:   key.CostCenterID,
+			CostCenterCode: costCenter.Code,
+			CostCenterName: costCenter.Name,
+			AccountID:      key.AccountID,
+			AccountCode:    account.Code,
+			AccountName:    account.Name,
+			AnnualBudget:   sumMonths(budgetMonths, 12),
+			Budget:         sumMonths(budgetMonths, monthTo),
+			Actual:         sumMonths(actualMonths, monthTo),
+			Months:         make([]budget.BudgetVsActualMonthResponse, 0, monthTo),
+		}
+		line.Variance = helper.RoundAmount(line.Budget.Sub(line.Actual))
+		line.UtilizationPercent = utilizationPercent(line.Actual, line.Budget)
+		for month := 1; month <= monthTo; month++ {
+			monthBudget := helper.RoundAmount(budgetMonths[month-1])
+			monthActual := helper.RoundAmount(actualMonths[month-1])
+			line.Months = append(line.Months, budget.BudgetVsActualMonthResponse{
+				Month:    month,
+				Budget:   monthBudget,
+				Actual:   monthActual,
+				Variance: helper.RoundAmount(monthBudget.Sub(monthActual)),
+			})
+		}
+
+		response.AnnualBudget = response.AnnualBudget.Add(line.AnnualBudget)
+		response.Budget = response.Budget.Add(line.Budget)
+		response.Actual = response.Actual.Add(line.Actual)
+		response.Lines = append(response.Lines, line)
+	}
+
+	sort.Slice(response.Lines, func(i, j int) bool {
+		if response.Lines[i].CostCenterCode != response.Lines[j].CostCenterCode {
+			return response.Lines[i].CostCenterCode < response.Lines[j].CostCenterCode
+		}
+		return response.Lines[i].AccountCode < response.Lines[j].AccountCode
+	})
+	response.AnnualBudget = helper.RoundAmount(response.AnnualBudget)
+	response.Budget = helper.RoundAmount(response.Budget)
+	response.Actual = helper.RoundAmount(response.Actual)
+	response.Variance = helper.RoundAmount(response.Budget.Sub(response.Actual))
+	response.UtilizationPercent = utilizationPercent(response.Actual, response.Budget)
+	return response, nil
+}
+
+func (service *BudgetServiceImpl) Check(ctx context.Context, request budget.BudgetCheckRequest) (*budget.BudgetCheckResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	date, err := helper.ParseDate(request.Date)
+	if err != nil {
+		return nil, exception.NewError("invalid date")
+	}
+
+	costCenter, err := service.CostCenterRepository.FindById(ctx, service.DB, request.CostCenterID)
+	if err != nil {
+		return nil, exception.NewNotFoundError("cost center not found")
+	}
+	if !costCenter.IsActive {
+		return nil, exception.NewError(fmt.Sprintf("cost center %s is inactive", costCenter.Code))
+	}
+	account, err := service.LedgerService.EnsurePostableAccount(ctx, service.DB, request.AccountID, "account", budgetAccountTypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := helper.RoundAmount(request.Amount)
+	response := &budget.BudgetCheckResponse{Amount: amount}
+
+	budgetEntity, err := service.BudgetRepository.FindActive(ctx, service.DB, costCenter.ID, date.Year())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		response.Verdict = domain.BudgetVerdictWarn
+		response.Message = fmt.Sprintf("cost center %s has no active budget for %d", costCenter.Code, date.Year())
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	budgetAmounts, err := service.BudgetRepository.FindBudgetAmounts(ctx, service.DB, budgetEntity.FiscalYear, &costCenter.ID, &account.ID)
+	if err != nil {
+		return nil, err
+	}
+	actualAmounts, err := service.BudgetRepository.FindActualAmounts(ctx, service.DB, budgetEntity.FiscalYear, &costCenter.ID, &account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anggaran tahunan dibandingkan dengan realisasi setahun; YTD hanya sampai bulan transaksi
+	monthTo := 12
+	if budgetEntity.ControlPeriod == domain.BudgetControlYearToDate {
+		monthTo = int(date.Month())
+	}
+	key := budgetKey{CostCenterID: costCenter.ID, AccountID: account.ID}
+
+	budgetID := budgetEntity.ID
+	response.BudgetID = &budgetID
+	response.BudgetNumber = budgetEntity.Number
+	response.ControlPeriod = budgetEntity.ControlPeriod
+	response.ControlAction = budgetEntity.ControlAction
+	response.WarnThresholdPercent = budgetEntity.WarnThresholdPercent
+	response.Available = sumMonths(monthlyTotals(budgetAmounts)[key], monthTo)
+	response.Actual = sumMonths(monthlyTotals(actualAmounts)[key], monthTo)
+	response.Remaining = helper.RoundAmount(response.Available.Sub(response.Actual))
+	response.RemainingAfter = helper.RoundAmount(response.Remaining.Sub(amount))
+	response.UtilizationAfterPercent = utilizationPercent(response.Actual.Add(amount), response.Available)
+
+	switch {
+	case response.RemainingAfter.IsNegative():
+		response.Verdict = domain.BudgetVerdictWarn
+		if budgetEntity.ControlAction == domain.BudgetControlBlock {
+			response.Verdict = domain.BudgetVerdictBlock
+		}
+		response.Message = fmt.Sprintf("amount exceeds the remaining budget of account %s by %s", account.Code, response.RemainingAfter.Neg().StringFixed(2))
+	case response.UtilizationAfterPercent >= budgetEntity.WarnThresholdPercent:
+		response.Verdict = domain.BudgetVerdictWarn
+		response.Message = fmt.Sprintf("budget utilization of account %s would reach %.2f%%", account.Code, response.UtilizationAfterPercent)
+	default:
+		response.Verdict = domain.BudgetVerdictOK
+		response.Message = "within budget"
+	}
+	return response, nil
+}
+
+// applyRequest mengisi header dan baris budget dari request. Cost center harus aktif dan belum
+// memiliki budget lain di tahun yang sama; akun harus akun beban atau aset yang bisa diposting.
+func (service *BudgetServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, budgetEntity *domain.Budget, request budget.BudgetRequest) error {
+	costCenter, err := service.CostCenterRepository.FindById(ctx, tx, request.CostCenterID)
+	if err != nil {
+		return exception.NewError("cost center not found")
+	}
+	if !costCenter.IsActive {
+		return exception.NewError(fmt.Sprintf("cost center %s is inactive", costCenter.Code))
+	}
+
+	var excludeID *uuid.UUID
+	if budgetEntity.Number != "" {
+		excludeID = &budgetEntity.ID
+	}
+	exists, err := service.BudgetRepository.ExistsForCostCenter(ctx, tx, costCenter.ID, request.FiscalYear, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("cost center %s already has a budget for %d", costCenter.Code, request.FiscalYear))
+	}
+
+	var lines []domain.BudgetLine
+	var total decimal.Decimal
+	seen := make(map[uuid.UUID]bool, len(request.Lines))
+	for i, lineRequest := range request.Lines {
+		if seen[lineRequest.AccountID] {
+			return exception.NewError(fmt.Sprintf("line %d: account is listed more than once", i+1))
+		}
+		seen[lineRequest.AccountID] = true
+
+		account, err := service.LedgerService.EnsurePostableAccount(ctx, tx, lineRequest.AccountID, fmt.Sprintf("line %d account", i+1), budgetAccountTypes...)
+		if err != nil {
+			return err
+		}
+		amounts, err := monthlyAmounts(i+1, lineRequest.MonthlyAmounts, lineRequest.AnnualAmount)
+		if err != nil {
+			return err
+		}
+		for month, amount := range amounts {
+			lines = append(lines, domain.BudgetLine{
+				ID:        uuid.New(),
+				BudgetID:  budgetEntity.ID,
+				AccountID: account.ID,
+				Month:     month + 1,
+				Amount:    amount,
+			})
+			total = total.Add(amount)
+		}
+	}
+
+	controlPeriod := domain.BudgetControlPeriod(strings.ToUpper(request.ControlPeriod))
+	if controlPeriod == "" {
+		controlPeriod = domain.BudgetControlYearToDate
+	}
+	controlAction := domain.BudgetControlAction(strings.ToUpper(request.ControlAction))
+	if controlAction == "" {
+		controlAction = domain.BudgetControlWarn
+	}
+	warnThreshold := request.WarnThresholdPercent
+	if warnThreshold == 0 {
+		warnThreshold = defaultWarnThresholdPercent
+	}
+
+	budgetEntity.Name = request.Name
+	budgetEntity.FiscalYear = request.FiscalYear
+	budgetEntity.CostCenterID = costCenter.ID
+	budgetEntity.ControlPeriod = controlPeriod
+	budgetEntity.ControlAction = controlAction
+	budgetEntity.WarnThresholdPercent = warnThreshold
+	budgetEntity.TotalAmount = helper.RoundAmount(total)
+	budgetEntity.Notes = request.Notes
+	budgetEntity.Lines = lines
+	return nil
+}
@@ -0,0 +1,17 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+
+	"github.com/google/uuid"
+)
+
+type CostCenterService interface {
+	Create(ctx context.Context, request budget.CostCenterRequest) (*budget.CostCenterResponse, error)
+	// Update menolak penonaktifan cost center yang masih memiliki cost center anak yang aktif
+	Update(ctx context.Context, id uuid.UUID, request budget.CostCenterUpdateRequest) (*budget.CostCenterResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*budget.CostCenterResponse, error)
+	FindAll(ctx context.Context, filter budget.CostCenterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
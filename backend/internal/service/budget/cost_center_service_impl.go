@@ -0,0 +1,171 @@
+package budget
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/budget"
+	repo "erpfinance/internal/repository/budget"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CostCenterServiceImpl struct {
+	CostCenterRepository repo.CostCenterRepository
+	DB                   *gorm.DB
+	Validate             *validator.Validate
+}
+
+func NewCostCenterService(costCenterRepository repo.CostCenterRepository, db *gorm.DB, validate *validator.Validate) CostCenterService {
+	return &CostCenterServiceImpl{
+		CostCenterRepository: costCenterRepository,
+		DB:                   db,
+		Validate:             validate,
+	}
+}
+
+func (service *CostCenterServiceImpl) Create(ctx context.Context, request budget.CostCenterRequest) (*budget.CostCenterResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	costCenter := domain.CostCenter{ID: uuid.New(), IsActive: true}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyRequest(ctx, tx, &costCenter, request); err != nil {
+			return err
+		}
+
+		_, err := service.CostCenterRepository.Create(ctx, tx, costCenter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, costCenter.ID)
+}
+
+func (service *CostCenterServiceImpl) Update(ctx context.Context, id uuid.UUID, request budget.CostCenterUpdateRequest) (*budget.CostCenterResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		costCenter, err := service.CostCenterRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("cost center not found")
+		}
+
+		if costCenter.IsActive && !request.IsActive {
+			hasChildren, err := service.CostCenterRepository.HasActiveChildren(ctx, tx, costCenter.ID)
+			if err != nil {
+				return err
+			}
+			if hasChildren {
+				return exception.NewError("cost center still has active child cost centers")
+			}
+		}
+
+		if err := service.applyRequest(ctx, tx, &costCenter, request.CostCenterRequest); err != nil {
+			return err
+		}
+		costCenter.IsActive = request.IsActive
+		return service.CostCenterRepository.Update(ctx, tx, costCenter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *CostCenterServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*budget.CostCenterResponse, error) {
+	costCenter, err := service.CostCenterRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("cost center not found")
+	}
+
+	return mapper.ToCostCenterResponse(costCenter), nil
+}
+
+func (service *CostCenterServiceImpl) FindAll(ctx context.Context, filter budget.CostCenterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	parentID, err := helper.ParseOptionalUUID(filter.ParentID, "parent_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	costCenters, totalItems, err := service.CostCenterRepository.FindAllWithPagination(ctx, service.DB, parentID, filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToCostCenterResponses(costCenters)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+// applyRequest mengisi cost center dari request setelah memastikan kode belum dipakai dan induknya valid
+func (service *CostCenterServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, costCenter *domain.CostCenter, request budget.CostCenterRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	var excludeID *uuid.UUID
+	if costCenter.Code != "" {
+		excludeID = &costCenter.ID
+	}
+	exists, err := service.CostCenterRepository.ExistsByCode(ctx, tx, code, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("cost center %s already exists", code))
+	}
+
+	if request.ParentID != nil {
+		if err := service.validateParent(ctx, tx, costCenter.ID, *request.ParentID); err != nil {
+			return err
+		}
+	}
+
+	costCenter.Code = code
+	costCenter.Name = request.Name
+	costCenter.ParentID = request.ParentID
+	costCenter.Parent = nil
+	costCenter.ManagerName = request.ManagerName
+	costCenter.Notes = request.Notes
+	return nil
+}
+
+// validateParent memastikan induk ada, aktif, dan tidak membentuk siklus pada hirarki cost center
+func (service *CostCenterServiceImpl) validateParent(ctx context.Context, tx *gorm.DB, costCenterID uuid.UUID, parentID uuid.UUID) error {
+	parent, err := service.CostCenterRepository.FindById(ctx, tx, parentID)
+	if err != nil {
+		return exception.NewError("parent cost center not found")
+	}
+	if !parent.IsActive {
+		return exception.NewError(fmt.Sprintf("parent cost center %s is inactive", parent.Code))
+	}
+
+	current := parent
+	for {
+		if current.ID == costCenterID {
+			return exception.NewError("cost center hierarchy cannot contain a cycle")
+		}
+		if current.ParentID == nil {
+			return nil
+		}
+		current, err = service.CostCenterRepository.FindById(ctx, tx, *current.ParentID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
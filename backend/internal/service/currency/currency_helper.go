@@ -0,0 +1,25 @@
+package currency
+
+import (
+	"erpfinance/internal/helper"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateAmount adalah nominal valas yang dibukukan pada satu kurs
+type RateAmount struct {
+	Rate   float64
+	Amount decimal.Decimal
+}
+
+// AddRateAmount menambahkan nominal ke kelompok kurs yang sama, kelompok baru ditambahkan sesuai
+// urutan kemunculan pertama. Dipakai untuk memecah baris pelunasan per kurs dokumen asal.
+func AddRateAmount(groups []RateAmount, rate float64, amount decimal.Decimal) []RateAmount {
+	for i := range groups {
+		if groups[i].Rate == rate {
+			groups[i].Amount = helper.RoundAmount(groups[i].Amount.Add(amount))
+			return groups
+		}
+	}
+	return append(groups, RateAmount{Rate: rate, Amount: helper.RoundAmount(amount)})
+}
@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CurrencyService interface {
+	Create(ctx context.Context, request currency.CurrencyCreateRequest) (*currency.CurrencyResponse, error)
+	Update(ctx context.Context, code string, request currency.CurrencyUpdateRequest) (*currency.CurrencyResponse, error)
+	FindByCode(ctx context.Context, code string) (*currency.CurrencyResponse, error)
+	FindAll(ctx context.Context, filter currency.CurrencyFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	SaveRate(ctx context.Context, userID uuid.UUID, request currency.ExchangeRateRequest) (*currency.ExchangeRateResponse, error)
+	ImportRates(ctx context.Context, userID uuid.UUID, file io.Reader) (*currency.ExchangeRateImportResponse, error)
+	FindAllRates(ctx context.Context, filter currency.ExchangeRateFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	LookupRate(ctx context.Context, request currency.ExchangeRateLookupRequest) (*currency.ExchangeRateLookupResponse, error)
+
+	GetSetting(ctx context.Context) (*currency.CurrencySettingResponse, error)
+	UpdateSetting(ctx context.Context, userID uuid.UUID, request currency.CurrencySettingRequest) (*currency.CurrencySettingResponse, error)
+
+	// RateOn mengembalikan kurs yang berlaku untuk tanggal tertentu: 1 untuk mata uang dasar,
+	// selain itu kurs terakhir pada atau sebelum tanggal tersebut.
+	RateOn(ctx context.Context, tx *gorm.DB, currencyCode string, date time.Time) (float64, error)
+
+	// ConvertEntry mengonversi jurnal yang nominalnya dalam currencyCode ke mata uang dasar.
+	// Baris yang ExchangeRate-nya sudah diisi (misal kurs invoice saat pelunasan) memakai kurs
+	// tersebut, sisanya memakai rate. Selisih karena kurs berbeda dijurnal ke akun selisih kurs
+	// terealisasi, sedangkan selisih pembulatan dengan satu kurs diserap baris terbesar.
+	ConvertEntry(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry, currencyCode string, rate float64) (domain.JournalEntry, error)
+}
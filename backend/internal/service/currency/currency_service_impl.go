@@ -0,0 +1,472 @@
+package currency
+
+import (
+	"context"
+	"encoding/csv"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+	repo "erpfinance/internal/repository/currency"
+	ledgerService "erpfinance/internal/service/ledger"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// maxImportRows membatasi jumlah baris satu file import kurs
+const maxImportRows = 5000
+
+type CurrencyServiceImpl struct {
+	CurrencyRepository        repo.CurrencyRepository
+	ExchangeRateRepository    repo.ExchangeRateRepository
+	CurrencySettingRepository repo.CurrencySettingRepository
+	LedgerService             ledgerService.LedgerService
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewCurrencyService(currencyRepository repo.CurrencyRepository, exchangeRateRepository repo.ExchangeRateRepository, currencySettingRepository repo.CurrencySettingRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) CurrencyService {
+	return &CurrencyServiceImpl{
+		CurrencyRepository:        currencyRepository,
+		ExchangeRateRepository:    exchangeRateRepository,
+		CurrencySettingRepository: currencySettingRepository,
+		LedgerService:             ledgerService,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *CurrencyServiceImpl) Create(ctx context.Context, request currency.CurrencyCreateRequest) (*currency.CurrencyResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var created domain.Currency
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		code := strings.ToUpper(request.Code)
+		if _, err := service.CurrencyRepository.FindByCode(ctx, tx, code); err == nil {
+			return exception.NewError("currency code already exists")
+		}
+
+		var err error
+		created, err = service.CurrencyRepository.Create(ctx, tx, domain.Currency{
+			Code:          code,
+			Name:          request.Name,
+			Symbol:        request.Symbol,
+			DecimalPlaces: request.DecimalPlaces,
+			IsActive:      true,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToCurrencyResponse(created), nil
+}
+
+func (service *CurrencyServiceImpl) Update(ctx context.Context, code string, request currency.CurrencyUpdateRequest) (*currency.CurrencyResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var current domain.Currency
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		current, err = service.CurrencyRepository.FindByCode(ctx, tx, strings.ToUpper(code))
+		if err != nil {
+			return exception.NewNotFoundError("currency not found")
+		}
+
+		if current.Code == domain.BaseCurrency && !request.IsActive {
+			return exception.NewError("base currency cannot be deactivated")
+		}
+
+		current.Name = request.Name
+		current.Symbol = request.Symbol
+		current.DecimalPlaces = request.DecimalPlaces
+		current.IsActive = request.IsActive
+		return service.CurrencyRepository.Update(ctx, tx, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToCurrencyResponse(current), nil
+}
+
+func (service *CurrencyServiceImpl) FindByCode(ctx context.Context, code string) (*currency.CurrencyResponse, error) {
+	current, err := service.CurrencyRepository.FindByCode(ctx, service.DB, strings.ToUpper(code))
+	if err != nil {
+		return nil, exception.NewNotFoundError("currency not found")
+	}
+
+	return mapper.ToCurrencyResponse(current), nil
+}
+
+func (service *CurrencyServiceImpl) FindAll(ctx context.Context, filter currency.CurrencyFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	currencies, totalItems, err := service.CurrencyRepository.FindAllWithPagination(ctx, service.DB, filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToCurrencyResponses(currencies)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *CurrencyServiceImpl) SaveRate(ctx context.Context, userID uuid.UUID, request currency.ExchangeRateRequest) (*currency.ExchangeRateResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	rateDate, err := helper.ParseDate(request.RateDate)
+	if err != nil {
+		return nil, exception.NewError("rate_date must be in format 2006-01-02")
+	}
+	code := strings.ToUpper(request.CurrencyCode)
+
+	var saved domain.ExchangeRate
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.ensureRateCurrency(ctx, tx, code); err != nil {
+			return err
+		}
+
+		rate := domain.ExchangeRate{
+			ID:           uuid.New(),
+			CurrencyCode: code,
+			RateDate:     rateDate,
+			Rate:         helper.RoundRate(request.Rate),
+			Source:       domain.ExchangeRateSourceManual,
+			CreatedBy:    userID,
+		}
+		if err := service.ExchangeRateRepository.Upsert(ctx, tx, []domain.ExchangeRate{rate}); err != nil {
+			return err
+		}
+
+		saved, err = service.ExchangeRateRepository.FindByCurrencyAndDate(ctx, tx, code, rateDate)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToExchangeRateResponse(saved), nil
+}
+
+// ImportRates membaca CSV dengan header currency_code,rate_date,rate. Semua baris divalidasi
+// terlebih dahulu; satu baris salah membatalkan seluruh import.
+func (service *CurrencyServiceImpl) ImportRates(ctx context.Context, userID uuid.UUID, file io.Reader) (*currency.ExchangeRateImportResponse, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, exception.NewError(fmt.Sprintf("invalid CSV file: %v", err))
+	}
+	if len(records) < 2 {
+		return nil, exception.NewError("CSV file has no exchange rate rows")
+	}
+	if len(records)-1 > maxImportRows {
+		return nil, exception.NewError(fmt.Sprintf("CSV file exceeds the maximum of %d rows", maxImportRows))
+	}
+
+	header := records[0]
+	if len(header) != 3 || strings.ToLower(strings.TrimPrefix(header[0], "\ufeff")) != "currency_code" || strings.ToLower(header[1]) != "rate_date" || strings.ToLower(header[2]) != "rate" {
+		return nil, exception.NewError("CSV header must be currency_code,rate_date,rate")
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(records)-1)
+	seen := make(map[string]int, len(records)-1)
+	for i, record := range records[1:] {
+		row := i + 2
+		code := strings.ToUpper(strings.TrimSpace(record[0]))
+		if len(code) != 3 {
+			return nil, exception.NewError(fmt.Sprintf("row %d: invalid currency code %q", row, record[0]))
+		}
+		rateDate, err := helper.ParseDate(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, exception.NewError(fmt.Sprintf("row %d: rate_date must be in format 2006-01-02", row))
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || value <= 0 {
+			return nil, exception.NewError(fmt.Sprintf("row %d: rate must be a positive number", row))
+		}
+
+		key := code + "|" + helper.FormatDate(rateDate)
+		if previous, ok := seen[key]; ok {
+			return nil, exception.NewError(fmt.Sprintf("row %d: duplicate rate for %s on %s (see row %d)", row, code, helper.FormatDate(rateDate), previous))
+		}
+		seen[key] = row
+
+		rates = append(rates, domain.ExchangeRate{
+			ID:           uuid.New(),
+			CurrencyCode: code,
+			RateDate:     rateDate,
+			Rate:         helper.RoundRate(value),
+			Source:       domain.ExchangeRateSourceImport,
+			CreatedBy:    userID,
+		})
+	}
+
+	response := &currency.ExchangeRateImportResponse{ImportedRows: len(rates)}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		checked := make(map[string]bool)
+		for _, rate := range rates {
+			if checked[rate.CurrencyCode] {
+				continue
+			}
+			if err := service.ensureRateCurrency(ctx, tx, rate.CurrencyCode); err != nil {
+				return err
+			}
+			checked[rate.CurrencyCode] = true
+			response.Currencies = append(response.Currencies, rate.CurrencyCode)
+		}
+
+		return service.ExchangeRateRepository.Upsert(ctx, tx, rates)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(response.Currencies)
+	dateFrom, dateTo := rates[0].RateDate, rates[0].RateDate
+	for _, rate := range rates {
+		if rate.RateDate.Before(dateFrom) {
+			dateFrom = rate.RateDate
+		}
+		if rate.RateDate.After(dateTo) {
+			dateTo = rate.RateDate
+		}
+	}
+	response.DateFrom = helper.FormatDate(dateFrom)
+	response.DateTo = helper.FormatDate(dateTo)
+	return response, nil
+}
+
+func (service *CurrencyServiceImpl) FindAllRates(ctx context.Context, filter currency.ExchangeRateFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		dateFrom = &parsed
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		dateTo = &parsed
+	}
+
+	rates, totalItems, err := service.ExchangeRateRepository.FindAllWithPagination(ctx, service.DB, strings.ToUpper(filter.CurrencyCode), dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToExchangeRateResponses(rates)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *CurrencyServiceImpl) LookupRate(ctx context.Context, request currency.ExchangeRateLookupRequest) (*currency.ExchangeRateLookupResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	date := helper.Today()
+	if request.Date != "" {
+		parsed, err := helper.ParseDate(request.Date)
+		if err != nil {
+			return nil, exception.NewError("date must be in format 2006-01-02")
+		}
+		date = parsed
+	}
+	code := strings.ToUpper(request.CurrencyCode)
+
+	response := &currency.ExchangeRateLookupResponse{
+		CurrencyCode: code,
+		Date:         helper.FormatDate(date),
+		RateDate:     helper.FormatDate(date),
+		Rate:         1,
+	}
+	if code == domain.BaseCurrency {
+		return response, nil
+	}
+
+	rate, err := service.ExchangeRateRepository.FindLatestOn(ctx, service.DB, code, date)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, exception.NewNotFoundError(fmt.Sprintf("no exchange rate for %s on or before %s", code, helper.FormatDate(date)))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response.RateDate = helper.FormatDate(rate.RateDate)
+	response.Rate = rate.Rate
+	return response, nil
+}
+
+func (service *CurrencyServiceImpl) GetSetting(ctx context.Context) (*currency.CurrencySettingResponse, error) {
+	setting, err := service.CurrencySettingRepository.Find(ctx, service.DB)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mapper.ToCurrencySettingResponse(domain.CurrencySetting{ID: domain.CurrencySettingID}), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToCurrencySettingResponse(setting), nil
+}
+
+func (service *CurrencyServiceImpl) UpdateSetting(ctx context.Context, userID uuid.UUID, request currency.CurrencySettingRequest) (*currency.CurrencySettingResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.RealisedFXAccountID, "realised FX account", domain.AccountTypeRevenue, domain.AccountTypeExpense); err != nil {
+			return err
+		}
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.UnrealisedFXAccountID, "unrealised FX account", domain.AccountTypeRevenue, domain.AccountTypeExpense); err != nil {
+			return err
+		}
+
+		return service.CurrencySettingRepository.Save(ctx, tx, domain.CurrencySetting{
+			RealisedFXAccountID:   &request.RealisedFXAccountID,
+			UnrealisedFXAccountID: &request.UnrealisedFXAccountID,
+			UpdatedBy:             &userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.GetSetting(ctx)
+}
+
+func (service *CurrencyServiceImpl) RateOn(ctx context.Context, tx *gorm.DB, currencyCode string, date time.Time) (float64, error) {
+	if currencyCode == domain.BaseCurrency {
+		return 1, nil
+	}
+
+	rate, err := service.ExchangeRateRepository.FindLatestOn(ctx, tx, currencyCode, date)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, exception.NewError(fmt.Sprintf("no exchange rate for %s on or before %s", currencyCode, helper.FormatDate(date)))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return rate.Rate, nil
+}
+
+func (service *CurrencyServiceImpl) ConvertEntry(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry, currencyCode string, rate float64) (domain.JournalEntry, error) {
+	if currencyCode == domain.BaseCurrency {
+		return entry, nil
+	}
+
+	var totalDebit, totalCredit decimal.Decimal
+	mixedRates := false
+	for i := range entry.Lines {
+		line := &entry.Lines[i]
+		if line.ExchangeRate <= 0 {
+			line.ExchangeRate = rate
+		}
+		if line.ExchangeRate != rate {
+			mixedRates = true
+		}
+
+		line.Currency = currencyCode
+		line.ForeignDebit = helper.RoundAmount(line.Debit)
+		line.ForeignCredit = helper.RoundAmount(line.Credit)
+		line.Debit = helper.MultiplyAmount(line.ForeignDebit, line.ExchangeRate)
+		line.Credit = helper.MultiplyAmount(line.ForeignCredit, line.ExchangeRate)
+		totalDebit = totalDebit.Add(line.Debit)
+		totalCredit = totalCredit.Add(line.Credit)
+	}
+
+	difference := helper.RoundAmount(totalDebit.Sub(totalCredit))
+	if helper.IsZeroAmount(difference) {
+		return entry, nil
+	}
+
+	if !mixedRates {
+		absorbRoundingDifference(entry.Lines, difference)
+		return entry, nil
+	}
+
+	setting, err := service.CurrencySettingRepository.Find(ctx, tx)
+	if err != nil || setting.RealisedFXAccountID == nil {
+		return domain.JournalEntry{}, exception.NewError("realised FX account is not configured")
+	}
+
+	// Debit lebih besar berarti laba selisih kurs (kredit), sebaliknya rugi (debit)
+	fxLine := domain.JournalLine{
+		AccountID:    *setting.RealisedFXAccountID,
+		Description:  "Realised exchange difference " + entry.Reference,
+		Currency:     domain.BaseCurrency,
+		ExchangeRate: 1,
+	}
+	if difference.IsPositive() {
+		fxLine.Credit = difference
+	} else {
+		fxLine.Debit = difference.Neg()
+	}
+	fxLine.ForeignDebit = fxLine.Debit
+	fxLine.ForeignCredit = fxLine.Credit
+	entry.Lines = append(entry.Lines, fxLine)
+	return entry, nil
+}
+
+// ensureRateCurrency memastikan kurs hanya dicatat untuk mata uang aktif selain mata uang dasar
+func (service *CurrencyServiceImpl) ensureRateCurrency(ctx context.Context, tx *gorm.DB, code string) error {
+	if code == domain.BaseCurrency {
+		return exception.NewError(fmt.Sprintf("exchange rates cannot be recorded for the base currency %s", domain.BaseCurrency))
+	}
+	current, err := service.CurrencyRepository.FindByCode(ctx, tx, code)
+	if err != nil {
+		return exception.NewNotFoundError(fmt.Sprintf("currency %s not found", code))
+	}
+	if !current.IsActive {
+		return exception.NewError(fmt.Sprintf("currency %s is inactive", code))
+	}
+	return nil
+}
+
+// absorbRoundingDifference membebankan selisih pembulatan konversi ke baris terbesar di sisi
+// yang lebih kecil sehingga jurnal kembali seimbang
+func absorbRoundingDifference(lines []domain.JournalLine, difference decimal.Decimal) {
+	target := -1
+	for i, line := range lines {
+		if difference.IsPositive() && line.Credit.IsPositive() && (target < 0 || line.Credit.GreaterThan(lines[target].Credit)) {
+			target = i
+		}
+		if difference.IsNegative() && line.Debit.IsPositive() && (target < 0 || line.Debit.GreaterThan(lines[target].Debit)) {
+			target = i
+		}
+	}
+	if target < 0 {
+		return
+	}
+	if difference.IsPositive() {
+		lines[target].Credit = helper.RoundAmount(lines[target].Credit.Add(difference))
+	} else {
+		lines[target].Debit = helper.RoundAmount(lines[target].Debit.Sub(difference))
+	}
+}
@@ -0,0 +1,15 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+
+	"github.com/google/uuid"
+)
+
+type FXRevaluationService interface {
+	Run(ctx context.Context, userID uuid.UUID, request currency.FXRevaluationRequest) (*currency.FXRevaluationResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*currency.FXRevaluationResponse, error)
+	FindAll(ctx context.Context, filter currency.FXRevaluationFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
@@ -0,0 +1,329 @@
+package currency
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/currency"
+	repo "erpfinance/internal/repository/currency"
+	payableRepo "erpfinance/internal/repository/payable"
+	receivableRepo "erpfinance/internal/repository/receivable"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// FXRevaluationNumberPrefix adalah prefix penomoran revaluasi kurs, contoh: FXR-202507-00001
+const FXRevaluationNumberPrefix = "FXR"
+
+type FXRevaluationServiceImpl struct {
+	FXRevaluationRepository     repo.FXRevaluationRepository
+	CurrencySettingRepository   repo.CurrencySettingRepository
+	SalesInvoiceRepository      receivableRepo.SalesInvoiceRepository
+	CustomerReceiptRepository   receivableRepo.CustomerReceiptRepository
+	ReceivableSettingRepository receivableRepo.ReceivableSettingRepository
+	SupplierInvoiceRepository   payableRepo.SupplierInvoiceRepository
+	PayableSettingRepository    payableRepo.PayableSettingRepository
+	SequenceRepository          sequenceRepo.SequenceRepository
+	CurrencyService             CurrencyService
+	LedgerService               ledgerService.LedgerService
+	DB                          *gorm.DB
+	Validate                    *validator.Validate
+}
+
+func NewFXRevaluationService(fxRevaluationRepository repo.FXRevaluationRepository, currencySettingRepository repo.CurrencySettingRepository, salesInvoiceRepository receivableRepo.SalesInvoiceRepository, customerReceiptRepository receivableRepo.CustomerReceiptRepository, receivableSettingRepository receivableRepo.ReceivableSettingRepository, supplierInvoiceRepository payableRepo.SupplierInvoiceRepository, payableSettingRepository payableRepo.PayableSettingRepository, sequenceRepository sequenceRepo.SequenceRepository, currencyService CurrencyService, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) FXRevaluationService {
+	return &FXRevaluationServiceImpl{
+		FXRevaluationRepository:     fxRevaluationRepository,
+		CurrencySettingRepository:   currencySettingRepository,
+		SalesInvoiceRepository:      salesInvoiceRepository,
+		CustomerReceiptRepository:   customerReceiptRepository,
+		ReceivableSettingRepository: receivableSettingRepository,
+		SupplierInvoiceRepository:   supplierInvoiceRepository,
+		PayableSettingRepository:    payableSettingRepository,
+		SequenceRepository:          sequenceRepository,
+		CurrencyService:             currencyService,
+		LedgerService:               ledgerService,
+		DB:                          db,
+		Validate:                    validate,
+	}
+}
+
+// Run merevaluasi saldo piutang, kredit customer dan hutang valas yang terbuka per akhir bulan.
+// Jurnal penyesuaian diposting pada tanggal revaluasi dan langsung dibalik pada hari berikutnya
+// sehingga pelunasan tetap dihitung terhadap kurs transaksi.
+func (service *FXRevaluationServiceImpl) Run(ctx context.Context, userID uuid.UUID, request currency.FXRevaluationRequest) (*currency.FXRevaluationResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	revaluationDate, err := helper.ParseDate(request.RevaluationDate)
+	if err != nil {
+		return nil, exception.NewError("revaluation_date must be in format 2006-01-02")
+	}
+	reversalDate := revaluationDate.AddDate(0, 0, 1)
+	if reversalDate.Day() != 1 {
+		return nil, exception.NewError("revaluation date must be the last day of a month")
+	}
+
+	var revaluationID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exists, err := service.FXRevaluationRepository.ExistsOnDate(ctx, tx, revaluationDate)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exception.NewError(fmt.Sprintf("FX revaluation for %s already exists", helper.FormatDate(revaluationDate)))
+		}
+
+		setting, err := service.CurrencySettingRepository.Find(ctx, tx)
+		if err != nil || setting.UnrealisedFXAccountID == nil {
+			return exception.NewError("unrealised FX account is not configured")
+		}
+
+		revaluation := domain.FXRevaluation{
+			ID:              uuid.New(),
+			RevaluationDate: revaluationDate,
+			ReversalDate:    reversalDate,
+			Notes:           request.Notes,
+			CreatedBy:       userID,
+		}
+		revaluation.Number, err = service.SequenceRepository.Next(ctx, tx, FXRevaluationNumberPrefix, revaluationDate)
+		if err != nil {
+			return err
+		}
+
+		lines, err := service.collectLines(ctx, tx, revaluation.ID, revaluationDate)
+		if err != nil {
+			return err
+		}
+		if len(lines) == 0 {
+			return exception.NewError(fmt.Sprintf("there are no open foreign currency balances as of %s", helper.FormatDate(revaluationDate)))
+		}
+		revaluation.Lines = lines
+
+		// Penyesuaian bersih per akun kontrol: piutang bertambah di debit, hutang dan kredit
+		// customer bertambah di kredit
+		var receivableAdjustment, payableAdjustment decimal.Decimal
+		for _, line := range lines {
+			switch line.SourceType {
+			case domain.FXRevaluationSourceSalesInvoice:
+				receivableAdjustment = receivableAdjustment.Add(line.Adjustment)
+			case domain.FXRevaluationSourceCustomerReceipt:
+				receivableAdjustment = receivableAdjustment.Sub(line.Adjustment)
+			case domain.FXRevaluationSourceSupplierInvoice:
+				payableAdjustment = payableAdjustment.Add(line.Adjustment)
+			}
+		}
+		receivableAdjustment = helper.RoundAmount(receivableAdjustment)
+		payableAdjustment = helper.RoundAmount(payableAdjustment)
+		revaluation.TotalAdjustment = helper.RoundAmount(receivableAdjustment.Sub(payableAdjustment))
+
+		if _, err := service.FXRevaluationRepository.Create(ctx, tx, revaluation); err != nil {
+			return err
+		}
+		revaluationID = revaluation.ID
+
+		if helper.IsZeroAmount(receivableAdjustment) && helper.IsZeroAmount(payableAdjustment) {
+			return nil
+		}
+
+		entry, err := service.buildJournal(ctx, tx, revaluation, *setting.UnrealisedFXAccountID, receivableAdjustment, payableAdjustment)
+		if err != nil {
+			return err
+		}
+		posted, err := service.LedgerService.PostEntry(ctx, tx, entry)
+		if err != nil {
+			return err
+		}
+		reversal, err := service.LedgerService.ReverseEntry(ctx, tx, posted.ID, reversalDate, fmt.Sprintf("Reversal of FX revaluation %s", revaluation.Number), userID)
+		if err != nil {
+			return err
+		}
+
+		revaluation.Lines = nil
+		revaluation.JournalEntryID = &posted.ID
+		revaluation.ReversalEntryID = &reversal.ID
+		return service.FXRevaluationRepository.Update(ctx, tx, revaluation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, revaluationID)
+}
+
+func (service *FXRevaluationServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*currency.FXRevaluationResponse, error) {
+	revaluation, err := service.FXRevaluationRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("FX revaluation not found")
+	}
+
+	return mapper.ToFXRevaluationResponse(revaluation), nil
+}
+
+func (service *FXRevaluationServiceImpl) FindAll(ctx context.Context, filter currency.FXRevaluationFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		dateFrom = &parsed
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		dateTo = &parsed
+	}
+
+	revaluations, totalItems, err := service.FXRevaluationRepository.FindAllWithPagination(ctx, service.DB, dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToFXRevaluationResponses(revaluations)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+// collectLines menghitung penyesuaian setiap dokumen valas yang masih terbuka per asOf
+func (service *FXRevaluationServiceImpl) collectLines(ctx context.Context, tx *gorm.DB, revaluationID uuid.UUID, asOf time.Time) ([]domain.FXRevaluationLine, error) {
+	closingRates := make(map[string]float64)
+	closingRate := func(code string) (float64, error) {
+		if rate, ok := closingRates[code]; ok {
+			return rate, nil
+		}
+		rate, err := service.CurrencyService.RateOn(ctx, tx, code, asOf)
+		if err != nil {
+			return 0, err
+		}
+		closingRates[code] = rate
+		return rate, nil
+	}
+
+	var lines []domain.FXRevaluationLine
+	addLine := func(sourceType domain.FXRevaluationSourceType, sourceID uuid.UUID, number, partyName, code string, openAmount decimal.Decimal, bookedRate float64) error {
+		openAmount = helper.RoundAmount(openAmount)
+		if code == domain.BaseCurrency || helper.IsZeroAmount(openAmount) {
+			return nil
+		}
+		rate, err := closingRate(code)
+		if err != nil {
+			return err
+		}
+
+		bookedBase := helper.MultiplyAmount(openAmount, bookedRate)
+		revaluedBase := helper.MultiplyAmount(openAmount, rate)
+		lines = append(lines, domain.FXRevaluationLine{
+			ID:              uuid.New(),
+			FXRevaluationID: revaluationID,
+			SourceType:      sourceType,
+			SourceID:        sourceID,
+			Number:          number,
+			PartyName:       partyName,
+			Currency:        code,
+			OpenAmount:      openAmount,
+			BookedRate:      bookedRate,
+			ClosingRate:     rate,
+			BookedBase:      bookedBase,
+			RevaluedBase:    revaluedBase,
+			Adjustment:      helper.RoundAmount(revaluedBase.Sub(bookedBase)),
+		})
+		return nil
+	}
+
+	invoices, err := service.SalesInvoiceRepository.FindOutstandingAsOf(ctx, tx, asOf, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range invoices {
+		if err := addLine(domain.FXRevaluationSourceSalesInvoice, row.InvoiceID, row.Number, row.CustomerName, row.Currency, row.TotalAmount.Sub(row.PaidAmount), row.ExchangeRate); err != nil {
+			return nil, err
+		}
+	}
+
+	credits, err := service.CustomerReceiptRepository.FindUnappliedAsOf(ctx, tx, asOf, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range credits {
+		if err := addLine(domain.FXRevaluationSourceCustomerReceipt, row.ReceiptID, row.Number, row.CustomerName, row.Currency, row.Amount.Sub(row.AllocatedAmount), row.ExchangeRate); err != nil {
+			return nil, err
+		}
+	}
+
+	payables, err := service.SupplierInvoiceRepository.FindOutstandingAsOf(ctx, tx, asOf, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range payables {
+		if err := addLine(domain.FXRevaluationSourceSupplierInvoice, row.InvoiceID, row.Number, row.SupplierName, row.Currency, row.TotalAmount.Sub(row.PaidAmount), row.ExchangeRate); err != nil {
+			return nil, err
+		}
+	}
+
+	return lines, nil
+}
+
+// buildJournal menyusun jurnal revaluasi dalam mata uang dasar: akun kontrol piutang dan hutang
+// disesuaikan, lawannya akun selisih kurs belum terealisasi
+func (service *FXRevaluationServiceImpl) buildJournal(ctx context.Context, tx *gorm.DB, revaluation domain.FXRevaluation, unrealisedAccountID uuid.UUID, receivableAdjustment, payableAdjustment decimal.Decimal) (domain.JournalEntry, error) {
+	entry := domain.JournalEntry{
+		EntryDate:   revaluation.RevaluationDate,
+		Description: fmt.Sprintf("Unrealised FX revaluation %s", helper.FormatDate(revaluation.RevaluationDate)),
+		Reference:   revaluation.Number,
+		SourceType:  domain.JournalSourceFXRevaluation,
+		SourceID:    &revaluation.ID,
+		CreatedBy:   revaluation.CreatedBy,
+	}
+
+	if !helper.IsZeroAmount(receivableAdjustment) {
+		setting, err := service.ReceivableSettingRepository.Find(ctx, tx)
+		if err != nil || setting.ReceivableAccountID == nil {
+			return domain.JournalEntry{}, exception.NewError("receivable account is not configured")
+		}
+		line := domain.JournalLine{AccountID: *setting.ReceivableAccountID, Description: "FX revaluation receivable"}
+		if receivableAdjustment.IsPositive() {
+			line.Debit = receivableAdjustment
+		} else {
+			line.Credit = receivableAdjustment.Neg()
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+
+	if !helper.IsZeroAmount(payableAdjustment) {
+		setting, err := service.PayableSettingRepository.Find(ctx, tx)
+		if err != nil || setting.PayableAccountID == nil {
+			return domain.JournalEntry{}, exception.NewError("payable account is not configured")
+		}
+		line := domain.JournalLine{AccountID: *setting.PayableAccountID, Description: "FX revaluation payable"}
+		if payableAdjustment.IsPositive() {
+			line.Credit = payableAdjustment
+		} else {
+			line.Debit = payableAdjustment.Neg()
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+
+	if !helper.IsZeroAmount(revaluation.TotalAdjustment) {
+		line := domain.JournalLine{AccountID: unrealisedAccountID, Description: "Unrealised exchange difference"}
+		if revaluation.TotalAdjustment.IsPositive() {
+			line.Credit = revaluation.TotalAdjustment
+		} else {
+			line.Debit = revaluation.TotalAdjustment.Neg()
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+	return entry, nil
+}
@@ -0,0 +1,32 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/inventory"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type InventoryService interface {
+	CreateItem(ctx context.Context, request inventory.ItemCreateRequest) (*inventory.ItemResponse, error)
+	UpdateItem(ctx context.Context, id uuid.UUID, request inventory.ItemUpdateRequest) (*inventory.ItemResponse, error)
+	FindItemById(ctx context.Context, id uuid.UUID) (*inventory.ItemResponse, error)
+	FindAllItems(ctx context.Context, filter inventory.MasterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	CreateWarehouse(ctx context.Context, request inventory.WarehouseCreateRequest) (*inventory.WarehouseResponse, error)
+	UpdateWarehouse(ctx context.Context, id uuid.UUID, request inventory.WarehouseUpdateRequest) (*inventory.WarehouseResponse, error)
+	FindWarehouseById(ctx context.Context, id uuid.UUID) (*inventory.WarehouseResponse, error)
+	FindAllWarehouses(ctx context.Context, filter inventory.MasterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	CreateBin(ctx context.Context, warehouseID uuid.UUID, request inventory.BinCreateRequest) (*inventory.WarehouseResponse, error)
+
+	CreateMovement(ctx context.Context, userID uuid.UUID, request inventory.StockMovementRequest) ([]inventory.StockMovementResponse, error)
+	FindAllMovements(ctx context.Context, filter inventory.StockMovementFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	FindBalances(ctx context.Context, filter inventory.StockBalanceFilterRequest) ([]inventory.StockBalanceResponse, error)
+
+	// PostMovements menyimpan satu dokumen mutasi stok di dalam transaksi milik pemanggil.
+	// Dipakai oleh modul lain (penerimaan barang, produksi, pengiriman) yang menggerakkan stok.
+	PostMovements(ctx context.Context, tx *gorm.DB, movements []domain.StockMovement) ([]domain.StockMovement, error)
+}
@@ -0,0 +1,492 @@
+package inventory
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/inventory"
+	repo "erpfinance/internal/repository/inventory"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StockMovementNumberPrefix adalah prefix penomoran mutasi stok, contoh: SM-202507-00001
+const StockMovementNumberPrefix = "SM"
+
+type InventoryServiceImpl struct {
+	ItemRepository          repo.ItemRepository
+	WarehouseRepository     repo.WarehouseRepository
+	StockMovementRepository repo.StockMovementRepository
+	SequenceRepository      sequenceRepo.SequenceRepository
+	DB                      *gorm.DB
+	Validate                *validator.Validate
+}
+
+func NewInventoryService(itemRepository repo.ItemRepository, warehouseRepository repo.WarehouseRepository, stockMovementRepository repo.StockMovementRepository, sequenceRepository sequenceRepo.SequenceRepository, db *gorm.DB, validate *validator.Validate) InventoryService {
+	return &InventoryServiceImpl{
+		ItemRepository:          itemRepository,
+		WarehouseRepository:     warehouseRepository,
+		StockMovementRepository: stockMovementRepository,
+		SequenceRepository:      sequenceRepository,
+		DB:                      db,
+		Validate:                validate,
+	}
+}
+
+func (service *InventoryServiceImpl) CreateItem(ctx context.Context, request inventory.ItemCreateRequest) (*inventory.ItemResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var createdItem domain.Item
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.ItemRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("item code already exists")
+		}
+
+		item := domain.Item{
+			ID:           uuid.New(),
+			Code:         request.Code,
+			Name:         request.Name,
+			Description:  request.Description,
+			Category:     request.Category,
+			UOM:          request.UOM,
+			StandardCost: helper.RoundAmount(request.StandardCost),
+			LeadTimeDays: request.LeadTimeDays,
+			MinOrderQty:  helper.RoundQuantity(request.MinOrderQty),
+			IsActive:     true,
+		}
+
+		createdItem, err = service.ItemRepository.Create(ctx, tx, item)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToItemResponse(createdItem), nil
+}
+
+func (service *InventoryServiceImpl) UpdateItem(ctx context.Context, id uuid.UUID, request inventory.ItemUpdateRequest) (*inventory.ItemResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var item domain.Item
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		item, err = service.ItemRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("item not found")
+		}
+
+		item.Name = request.Name
+		item.Description = request.Description
+		item.Category = request.Category
+		item.UOM = request.UOM
+		item.StandardCost = helper.RoundAmount(request.StandardCost)
+		item.LeadTimeDays = request.LeadTimeDays
+		item.MinOrderQty = helper.RoundQuantity(request.MinOrderQty)
+		item.IsActive = request.IsActive
+
+		return service.ItemRepository.Update(ctx, tx, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToItemResponse(item), nil
+}
+
+func (service *InventoryServiceImpl) FindItemById(ctx context.Context, id uuid.UUID) (*inventory.ItemResponse, error) {
+	item, err := service.ItemRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("item not found")
+	}
+
+	return mapper.ToItemResponse(item), nil
+}
+
+func (service *InventoryServiceImpl) FindAllItems(ctx context.Context, filter inventory.MasterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	items, totalItems, err := service.ItemRepository.FindAllWithPagination(ctx, service.DB, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToItemResponses(items)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *InventoryServiceImpl) CreateWarehouse(ctx context.Context, request inventory.WarehouseCreateRequest) (*inventory.WarehouseResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var createdWarehouse domain.Warehouse
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.WarehouseRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("warehouse code already exists")
+		}
+
+		warehouse := domain.Warehouse{
+			ID:       uuid.New(),
+			Code:     request.Code,
+			Name:     request.Name,
+			Address:  request.Address,
+			IsActive: true,
+		}
+
+		createdWarehouse, err = service.WarehouseRepository.Create(ctx, tx, warehouse)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToWarehouseResponse(createdWarehouse), nil
+}
+
+func (service *InventoryServiceImpl) UpdateWarehouse(ctx context.Context, id uuid.UUID, request inventory.WarehouseUpdateRequest) (*inventory.WarehouseResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		warehouse, err := service.WarehouseRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("warehouse not found")
+		}
+
+		warehouse.Name = request.Name
+		warehouse.Address = request.Address
+		warehouse.IsActive = request.IsActive
+
+		return service.WarehouseRepository.Update(ctx, tx, warehouse)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindWarehouseById(ctx, id)
+}
+
+func (service *InventoryServiceImpl) FindWarehouseById(ctx context.Context, id uuid.UUID) (*inventory.WarehouseResponse, error) {
+	warehouse, err := service.WarehouseRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("warehouse not found")
+	}
+
+	return mapper.ToWarehouseResponse(warehouse), nil
+}
+
+func (service *InventoryServiceImpl) FindAllWarehouses(ctx context.Context, filter inventory.MasterFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	warehouses, totalItems, err := service.WarehouseRepository.FindAllWithPagination(ctx, service.DB, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToWarehouseResponses(warehouses)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *InventoryServiceImpl) CreateBin(ctx context.Context, warehouseID uuid.UUID, request inventory.BinCreateRequest) (*inventory.WarehouseResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := service.WarehouseRepository.FindById(ctx, tx, warehouseID); err != nil {
+			return exception.NewNotFoundError("warehouse not found")
+		}
+
+		existing, err := service.WarehouseRepository.FindBinByCode(ctx, tx, warehouseID, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("bin code already exists in this warehouse")
+		}
+
+		_, err = service.WarehouseRepository.CreateBin(ctx, tx, domain.WarehouseBin{
+			ID:          uuid.New(),
+			WarehouseID: warehouseID,
+			Code:        request.Code,
+			Name:        request.Name,
+			IsActive:    true,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindWarehouseById(ctx, warehouseID)
+}
+
+func (service *InventoryServiceImpl) CreateMovement(ctx context.Context, userID uuid.UUID, request inventory.StockMovementRequest) ([]inventory.StockMovementResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	movementDate, err := helper.ParseDate(request.MovementDate)
+	if err != nil {
+		return nil, exception.NewError("invalid movement date")
+	}
+
+	if request.MovementType != domain.StockMovementAdjustment && request.Quantity < 0 {
+		return nil, exception.NewError("quantity must be greater than 0")
+	}
+
+	base := domain.StockMovement{
+		MovementType: request.MovementType,
+		MovementDate: movementDate,
+		ItemID:       request.ItemID,
+		WarehouseID:  request.WarehouseID,
+		BinID:        request.BinID,
+		Quantity:     request.Quantity,
+		Reference:    request.Reference,
+		SourceType:   domain.StockSourceManual,
+		Notes:        request.Notes,
+		CreatedBy:    userID,
+	}
+
+	var movements []domain.StockMovement
+	switch request.MovementType {
+	case domain.StockMovementReceipt, domain.StockMovementAdjustment:
+		movements = append(movements, base)
+	case domain.StockMovementIssue:
+		base.Quantity = -request.Quantity
+		movements = append(movements, base)
+	case domain.StockMovementTransfer:
+		if request.ToWarehouseID == nil {
+			return nil, exception.NewError("to_warehouse_id is required for transfer")
+		}
+		if *request.ToWarehouseID == request.WarehouseID && sameBin(request.BinID, request.ToBinID) {
+			return nil, exception.NewError("transfer source and destination must be different locations")
+		}
+		destination := base
+		destination.WarehouseID = *request.ToWarehouseID
+		destination.BinID = request.ToBinID
+		base.Quantity = -request.Quantity
+		movements = append(movements, base, destination)
+	}
+
+	var posted []domain.StockMovement
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		posted, err = service.PostMovements(ctx, tx, movements)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToStockMovementResponses(posted), nil
+}
+
+func (service *InventoryServiceImpl) FindAllMovements(ctx context.Context, filter inventory.StockMovementFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	itemID, err := helper.ParseOptionalUUID(filter.ItemID, "item_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+	warehouseID, err := helper.ParseOptionalUUID(filter.WarehouseID, "warehouse_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		dateFrom = &parsed
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		dateTo = &parsed
+	}
+
+	movements, totalItems, err := service.StockMovementRepository.FindAllWithPagination(ctx, service.DB, itemID, warehouseID, filter.MovementType, dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToStockMovementResponses(movements)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *InventoryServiceImpl) FindBalances(ctx context.Context, filter inventory.StockBalanceFilterRequest) ([]inventory.StockBalanceResponse, error) {
+	itemID, err := helper.ParseOptionalUUID(filter.ItemID, "item_id")
+	if err != nil {
+		return nil, err
+	}
+	warehouseID, err := helper.ParseOptionalUUID(filter.WarehouseID, "warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := service.StockMovementRepository.FindBalances(ctx, service.DB, itemID, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToStockBalanceResponses(balances), nil
+}
+
+// stockLocation adalah kunci saldo on-hand: item di gudang/bin tertentu
+type stockLocation struct {
+	ItemID      uuid.UUID
+	WarehouseID uuid.UUID
+	BinID       uuid.UUID
+}
+
+func (service *InventoryServiceImpl) PostMovements(ctx context.Context, tx *gorm.DB, movements []domain.StockMovement) ([]domain.StockMovement, error) {
+	if len(movements) == 0 {
+		return nil, exception.NewError("stock movement must have at least one line")
+	}
+
+	// Kunci semua item yang terlibat terlebih dahulu. Selama row item terkunci tidak ada
+	// transaksi lain yang bisa mengurangi stok item tersebut, sehingga pengecekan saldo
+	// di bawah tetap valid sampai commit.
+	itemIDs := make([]uuid.UUID, 0, len(movements))
+	seenItems := make(map[uuid.UUID]bool)
+	for _, movement := range movements {
+		if !seenItems[movement.ItemID] {
+			seenItems[movement.ItemID] = true
+			itemIDs = append(itemIDs, movement.ItemID)
+		}
+	}
+	items, err := service.ItemRepository.LockByIds(ctx, tx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	itemByID := make(map[uuid.UUID]domain.Item, len(items))
+	for _, item := range items {
+		itemByID[item.ID] = item
+	}
+
+	warehouseByID := make(map[uuid.UUID]domain.Warehouse)
+	deltaByLocation := make(map[stockLocation]float64)
+	var locations []stockLocation
+
+	for i := range movements {
+		movement := &movements[i]
+		movement.Quantity = helper.RoundQuantity(movement.Quantity)
+		if movement.Quantity == 0 {
+			return nil, exception.NewError(fmt.Sprintf("line %d: quantity cannot be zero", i+1))
+		}
+
+		item, ok := itemByID[movement.ItemID]
+		if !ok {
+			return nil, exception.NewError(fmt.Sprintf("line %d: item not found", i+1))
+		}
+		if !item.IsActive {
+			return nil, exception.NewError(fmt.Sprintf("line %d: item %s is inactive", i+1, item.Code))
+		}
+
+		if err := service.validateLocation(ctx, tx, warehouseByID, movement.WarehouseID, movement.BinID, i+1); err != nil {
+			return nil, err
+		}
+
+		location := stockLocation{ItemID: movement.ItemID, WarehouseID: movement.WarehouseID}
+		if movement.BinID != nil {
+			location.BinID = *movement.BinID
+		}
+		if _, ok := deltaByLocation[location]; !ok {
+			locations = append(locations, location)
+		}
+		deltaByLocation[location] += movement.Quantity
+	}
+
+	// Stok tidak boleh negatif di lokasi manapun setelah mutasi
+	for _, location := range locations {
+		delta := helper.RoundQuantity(deltaByLocation[location])
+		if delta >= 0 {
+			continue
+		}
+
+		var binID *uuid.UUID
+		if location.BinID != uuid.Nil {
+			binID = &location.BinID
+		}
+		onHand, err := service.StockMovementRepository.SumOnHand(ctx, tx, location.ItemID, location.WarehouseID, binID)
+		if err != nil {
+			return nil, err
+		}
+		if helper.RoundQuantity(onHand+delta) < 0 {
+			item := itemByID[location.ItemID]
+			warehouse := warehouseByID[location.WarehouseID]
+			return nil, exception.NewError(fmt.Sprintf("insufficient stock for item %s in warehouse %s: on hand %.4f, requested %.4f", item.Code, warehouse.Code, helper.RoundQuantity(onHand), -delta))
+		}
+	}
+
+	number, err := service.SequenceRepository.Next(ctx, tx, StockMovementNumberPrefix, movements[0].MovementDate)
+	if err != nil {
+		return nil, err
+	}
+	for i := range movements {
+		if movements[i].ID == uuid.Nil {
+			movements[i].ID = uuid.New()
+		}
+		if movements[i].SourceType == "" {
+			movements[i].SourceType = domain.StockSourceManual
+		}
+		movements[i].Number = number
+	}
+
+	if err := service.StockMovementRepository.CreateMany(ctx, tx, movements); err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+// validateLocation memastikan gudang aktif dan bin (jika diisi) aktif serta milik gudang tersebut
+func (service *InventoryServiceImpl) validateLocation(ctx context.Context, tx *gorm.DB, warehouseByID map[uuid.UUID]domain.Warehouse, warehouseID uuid.UUID, binID *uuid.UUID, lineNo int) error {
+	warehouse, ok := warehouseByID[warehouseID]
+	if !ok {
+		var err error
+		warehouse, err = service.WarehouseRepository.FindById(ctx, tx, warehouseID)
+		if err != nil {
+			return exception.NewError(fmt.Sprintf("line %d: warehouse not found", lineNo))
+		}
+		warehouseByID[warehouseID] = warehouse
+	}
+	if !warehouse.IsActive {
+		return exception.NewError(fmt.Sprintf("line %d: warehouse %s is inactive", lineNo, warehouse.Code))
+	}
+
+	if binID == nil {
+		return nil
+	}
+	for _, bin := range warehouse.Bins {
+		if bin.ID == *binID {
+			if !bin.IsActive {
+				return exception.NewError(fmt.Sprintf("line %d: bin %s is inactive", lineNo, bin.Code))
+			}
+			return nil
+		}
+	}
+	return exception.NewError(fmt.Sprintf("line %d: bin does not belong to warehouse %s", lineNo, warehouse.Code))
+}
+
+func sameBin(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,39 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ledger"
+
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type LedgerService interface {
+	CreateAccount(ctx context.Context, request ledger.AccountCreateRequest) (*ledger.AccountResponse, error)
+	UpdateAccount(ctx context.Context, id uuid.UUID, request ledger.AccountUpdateRequest) (*ledger.AccountResponse, error)
+	FindAccountById(ctx context.Context, id uuid.UUID) (*ledger.AccountResponse, error)
+	FindAllAccounts(ctx context.Context, filter ledger.AccountFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	FindAccountTree(ctx context.Context) ([]ledger.AccountTreeResponse, error)
+
+	CreateJournal(ctx context.Context, userID uuid.UUID, request ledger.JournalCreateRequest) (*ledger.JournalResponse, error)
+	FindJournalById(ctx context.Context, id uuid.UUID) (*ledger.JournalResponse, error)
+	FindAllJournals(ctx context.Context, filter ledger.JournalFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	PostJournal(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*ledger.JournalResponse, error)
+	ReverseJournal(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ledger.JournalReverseRequest) (*ledger.JournalResponse, error)
+
+	// PostEntry membuat dan langsung memposting jurnal di dalam transaksi milik pemanggil.
+	// Dipakai oleh modul lain (hutang, piutang, aset, dsb.) yang menghasilkan jurnal otomatis.
+	PostEntry(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) (domain.JournalEntry, error)
+
+	// ReverseEntry memposting jurnal balik dari jurnal yang sudah diposting di dalam transaksi
+	// milik pemanggil lalu menandai jurnal asal Reversed. description kosong memakai teks default.
+	ReverseEntry(ctx context.Context, tx *gorm.DB, id uuid.UUID, reversalDate time.Time, description string, userID uuid.UUID) (domain.JournalEntry, error)
+
+	// EnsurePostableAccount memastikan akun ada, aktif, bukan akun header dan (jika diisi) bertipe
+	// salah satu dari allowedTypes. Dipakai modul lain saat menyimpan pengaturan akun default.
+	EnsurePostableAccount(ctx context.Context, tx *gorm.DB, id uuid.UUID, name string, allowedTypes ...domain.AccountType) (domain.Account, error)
+}
@@ -0,0 +1,587 @@
+package ledger
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ledger"
+	budgetRepo "erpfinance/internal/repository/budget"
+	repo "erpfinance/internal/repository/ledger"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	periodService "erpfinance/internal/service/period"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// JournalNumberPrefix adalah prefix penomoran jurnal, contoh: JE-202507-00001
+const JournalNumberPrefix = "JE"
+
+type LedgerServiceImpl struct {
+	AccountRepository    repo.AccountRepository
+	JournalRepository    repo.JournalRepository
+	CostCenterRepository budgetRepo.CostCenterRepository
+	SequenceRepository   sequenceRepo.SequenceRepository
+	PeriodCheckService   periodService.PeriodCheckService
+	DB                   *gorm.DB
+	Validate             *validator.Validate
+}
+
+func NewLedgerService(accountRepository repo.AccountRepository, journalRepository repo.JournalRepository, costCenterRepository budgetRepo.CostCenterRepository, sequenceRepository sequenceRepo.SequenceRepository, periodCheckService periodService.PeriodCheckService, db *gorm.DB, validate *validator.Validate) LedgerService {
+	return &LedgerServiceImpl{
+		AccountRepository:    accountRepository,
+		JournalRepository:    journalRepository,
+		CostCenterRepository: costCenterRepository,
+		SequenceRepository:   sequenceRepository,
+		PeriodCheckService:   periodCheckService,
+		DB:                   db,
+		Validate:             validate,
+	}
+}
+
+func (service *LedgerServiceImpl) CreateAccount(ctx context.Context, request ledger.AccountCreateRequest) (*ledger.AccountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var createdAccount domain.Account
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.AccountRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("account code already exists")
+		}
+
+		if request.ParentID != nil {
+			if err := service.validateParent(ctx, tx, uuid.Nil, *request.ParentID, request.Type); err != nil {
+				return err
+			}
+		}
+		if err := validateCashFlowCategory(request.Type, request.CashFlowCategory); err != nil {
+			return err
+		}
+
+		account := domain.Account{
+			ID:               uuid.New(),
+			Code:             request.Code,
+			Name:             request.Name,
+			Type:             request.Type,
+			ParentID:         request.ParentID,
+			IsPostable:       request.IsPostable,
+			IsActive:         true,
+			CashFlowCategory: domain.CashFlowCategory(request.CashFlowCategory),
+		}
+
+		createdAccount, err = service.AccountRepository.Create(ctx, tx, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAccountResponse(createdAccount), nil
+}
+
+func (service *LedgerServiceImpl) UpdateAccount(ctx context.Context, id uuid.UUID, request ledger.AccountUpdateRequest) (*ledger.AccountResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var account domain.Account
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		account, err = service.AccountRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("account not found")
+		}
+
+		if request.ParentID != nil {
+			if err := service.validateParent(ctx, tx, account.ID, *request.ParentID, account.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := validateCashFlowCategory(account.Type, request.CashFlowCategory); err != nil {
+			return err
+		}
+
+		// Akun yang punya sub-akun adalah akun header dan tidak boleh dipakai di jurnal
+		if request.IsPostable {
+			children, err := service.AccountRepository.CountChildren(ctx, tx, account.ID)
+			if err != nil {
+				return err
+			}
+			if children > 0 {
+				return exception.NewError("account with sub-accounts cannot be postable")
+			}
+		}
+
+		account.Name = request.Name
+		account.ParentID = request.ParentID
+		account.IsPostable = request.IsPostable
+		account.IsActive = request.IsActive
+		account.CashFlowCategory = domain.CashFlowCategory(request.CashFlowCategory)
+
+		return service.AccountRepository.Update(ctx, tx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAccountResponse(account), nil
+}
+
+func (service *LedgerServiceImpl) FindAccountById(ctx context.Context, id uuid.UUID) (*ledger.AccountResponse, error) {
+	account, err := service.AccountRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("account not found")
+	}
+
+	return mapper.ToAccountResponse(account), nil
+}
+
+func (service *LedgerServiceImpl) FindAllAccounts(ctx context.Context, filter ledger.AccountFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	accounts, totalItems, err := service.AccountRepository.FindAllWithPagination(ctx, service.DB, filter.Search, filter.Type, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToAccountResponses(accounts)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *LedgerServiceImpl) FindAccountTree(ctx context.Context) ([]ledger.AccountTreeResponse, error) {
+	accounts, err := service.AccountRepository.FindAll(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToAccountTreeResponses(accounts), nil
+}
+
+func (service *LedgerServiceImpl) CreateJournal(ctx context.Context, userID uuid.UUID, request ledger.JournalCreateRequest) (*ledger.JournalResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	entryDate, err := helper.ParseDate(request.EntryDate)
+	if err != nil {
+		return nil, exception.NewError("invalid entry date")
+	}
+
+	var entryID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		entry := domain.JournalEntry{
+			ID:          uuid.New(),
+			EntryDate:   entryDate,
+			Description: request.Description,
+			Reference:   request.Reference,
+			SourceType:  domain.JournalSourceManual,
+			Status:      domain.JournalStatusDraft,
+			CreatedBy:   userID,
+		}
+		for i, line := range request.Lines {
+			entry.Lines = append(entry.Lines, domain.JournalLine{
+				ID:             uuid.New(),
+				JournalEntryID: entry.ID,
+				LineNo:         i + 1,
+				AccountID:      line.AccountID,
+				CostCenterID:   line.CostCenterID,
+				Description:    line.Description,
+				Debit:          helper.RoundAmount(line.Debit),
+				Credit:         helper.RoundAmount(line.Credit),
+				Currency:       domain.BaseCurrency,
+				ExchangeRate:   1,
+				ForeignDebit:   helper.RoundAmount(line.Debit),
+				ForeignCredit:  helper.RoundAmount(line.Credit),
+			})
+		}
+
+		// Draft boleh disimpan hanya jika sudah seimbang, sehingga posting tinggal mengunci status
+		if err := service.validateLines(ctx, tx, entry.Lines); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, JournalNumberPrefix, entryDate)
+		if err != nil {
+			return err
+		}
+		entry.EntryNumber = number
+
+		created, err := service.JournalRepository.Create(ctx, tx, entry)
+		if err != nil {
+			return err
+		}
+		entryID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindJournalById(ctx, entryID)
+}
+
+func (service *LedgerServiceImpl) FindJournalById(ctx context.Context, id uuid.UUID) (*ledger.JournalResponse, error) {
+	entry, err := service.JournalRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("journal entry not found")
+	}
+
+	return mapper.ToJournalResponse(entry), nil
+}
+
+func (service *LedgerServiceImpl) FindAllJournals(ctx context.Context, filter ledger.JournalFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var dateFrom, dateTo *time.Time
+	if filter.DateFrom != "" {
+		parsed, err := helper.ParseDate(filter.DateFrom)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_from must be in format 2006-01-02")
+		}
+		dateFrom = &parsed
+	}
+	if filter.DateTo != "" {
+		parsed, err := helper.ParseDate(filter.DateTo)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("date_to must be in format 2006-01-02")
+		}
+		dateTo = &parsed
+	}
+
+	entries, totalItems, err := service.JournalRepository.FindAllWithPagination(ctx, service.DB, filter.Status, dateFrom, dateTo, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToJournalSummaryResponses(entries)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *LedgerServiceImpl) PostJournal(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*ledger.JournalResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		entry, err := service.JournalRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("journal entry not found")
+		}
+
+		if entry.Status != domain.JournalStatusDraft {
+			return exception.NewError("only draft journal entries can be posted")
+		}
+
+		if err := service.PeriodCheckService.EnsureOpen(ctx, tx, entry.EntryDate); err != nil {
+			return err
+		}
+
+		// Validasi ulang karena akun bisa saja dinonaktifkan sejak draft dibuat
+		if err := service.validateLines(ctx, tx, entry.Lines); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		entry.Status = domain.JournalStatusPosted
+		entry.PostedBy = &userID
+		entry.PostedAt = &now
+		return service.JournalRepository.UpdateStatus(ctx, tx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindJournalById(ctx, id)
+}
+
+func (service *LedgerServiceImpl) ReverseJournal(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ledger.JournalReverseRequest) (*ledger.JournalResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	reversalDate, err := helper.ParseDate(request.ReversalDate)
+	if err != nil {
+		return nil, exception.NewError("invalid reversal date")
+	}
+
+	var reversalID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reversal, err := service.ReverseEntry(ctx, tx, id, reversalDate, request.Description, userID)
+		if err != nil {
+			return err
+		}
+		reversalID = reversal.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindJournalById(ctx, reversalID)
+}
+
+func (service *LedgerServiceImpl) ReverseEntry(ctx context.Context, tx *gorm.DB, id uuid.UUID, reversalDate time.Time, description string, userID uuid.UUID) (domain.JournalEntry, error) {
+	original, err := service.JournalRepository.FindByIdForUpdate(ctx, tx, id)
+	if err != nil {
+		return domain.JournalEntry{}, exception.NewNotFoundError("journal entry not found")
+	}
+
+	if original.Status != domain.JournalStatusPosted {
+		return domain.JournalEntry{}, exception.NewError("only posted journal entries can be reversed")
+	}
+	if reversalDate.Before(original.EntryDate) {
+		return domain.JournalEntry{}, exception.NewError("reversal date cannot be before the original entry date")
+	}
+
+	if description == "" {
+		description = fmt.Sprintf("Reversal of %s", original.EntryNumber)
+	}
+
+	// Jurnal balik: debit dan kredit ditukar, termasuk nilai valasnya
+	reversal := domain.JournalEntry{
+		EntryDate:    reversalDate,
+		Description:  description,
+		Reference:    original.EntryNumber,
+		SourceType:   original.SourceType,
+		SourceID:     original.SourceID,
+		ReversalOfID: &original.ID,
+		CreatedBy:    userID,
+	}
+	for _, line := range original.Lines {
+		reversal.Lines = append(reversal.Lines, domain.JournalLine{
+			AccountID:     line.AccountID,
+			CostCenterID:  line.CostCenterID,
+			Description:   line.Description,
+			Debit:         line.Credit,
+			Credit:        line.Debit,
+			Currency:      line.Currency,
+			ExchangeRate:  line.ExchangeRate,
+			ForeignDebit:  line.ForeignCredit,
+			ForeignCredit: line.ForeignDebit,
+		})
+	}
+
+	posted, err := service.PostEntry(ctx, tx, reversal)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+
+	original.Status = domain.JournalStatusReversed
+	if err := service.JournalRepository.UpdateStatus(ctx, tx, original); err != nil {
+		return domain.JournalEntry{}, err
+	}
+	return posted, nil
+}
+
+func (service *LedgerServiceImpl) PostEntry(ctx context.Context, tx *gorm.DB, entry domain.JournalEntry) (domain.JournalEntry, error) {
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+	if entry.SourceType == "" {
+		entry.SourceType = domain.JournalSourceManual
+	}
+
+	for i := range entry.Lines {
+		if entry.Lines[i].ID == uuid.Nil {
+			entry.Lines[i].ID = uuid.New()
+		}
+		entry.Lines[i].JournalEntryID = entry.ID
+		entry.Lines[i].LineNo = i + 1
+		entry.Lines[i].Debit = helper.RoundAmount(entry.Lines[i].Debit)
+		entry.Lines[i].Credit = helper.RoundAmount(entry.Lines[i].Credit)
+
+		// Baris tanpa mata uang dianggap sudah dalam mata uang dasar
+		if entry.Lines[i].Currency == "" {
+			entry.Lines[i].Currency = domain.BaseCurrency
+			entry.Lines[i].ExchangeRate = 1
+			entry.Lines[i].ForeignDebit = entry.Lines[i].Debit
+			entry.Lines[i].ForeignCredit = entry.Lines[i].Credit
+		}
+	}
+
+	if err := service.PeriodCheckService.EnsureOpen(ctx, tx, entry.EntryDate); err != nil {
+		return domain.JournalEntry{}, err
+	}
+
+	if err := service.validateLines(ctx, tx, entry.Lines); err != nil {
+		return domain.JournalEntry{}, err
+	}
+
+	number, err := service.SequenceRepository.Next(ctx, tx, JournalNumberPrefix, entry.EntryDate)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+
+	now := time.Now()
+	entry.EntryNumber = number
+	entry.Status = domain.JournalStatusPosted
+	entry.PostedBy = &entry.CreatedBy
+	entry.PostedAt = &now
+
+	return service.JournalRepository.Create(ctx, tx, entry)
+}
+
+func (service *LedgerServiceImpl) EnsurePostableAccount(ctx context.Context, tx *gorm.DB, id uuid.UUID, name string, allowedTypes ...domain.AccountType) (domain.Account, error) {
+	account, err := service.AccountRepository.FindById(ctx, tx, id)
+	if err != nil {
+		return domain.Account{}, exception.NewError(name + " not found")
+	}
+	if !account.IsActive {
+		return domain.Account{}, exception.NewError(fmt.Sprintf("%s %s is inactive", name, account.Code))
+	}
+	if !account.IsPostable {
+		return domain.Account{}, exception.NewError(fmt.Sprintf("%s %s is a header account and cannot be posted to", name, account.Code))
+	}
+	if len(allowedTypes) == 0 {
+		return account, nil
+	}
+	for _, allowedType := range allowedTypes {
+		if account.Type == allowedType {
+			return account, nil
+		}
+	}
+	return domain.Account{}, exception.NewError(fmt.Sprintf("%s %s must be of type %s", name, account.Code, joinAccountTypes(allowedTypes)))
+}
+
+// validateLines memastikan setiap baris hanya berisi debit atau kredit, akun yang dipakai
+// aktif dan bisa diposting, cost center yang diisi masih aktif, serta total debit sama dengan
+// total kredit.
+func (service *LedgerServiceImpl) validateLines(ctx context.Context, tx *gorm.DB, lines []domain.JournalLine) error {
+	if len(lines) < 2 {
+		return exception.NewError("journal entry must have at least two lines")
+	}
+
+	var totalDebit, totalCredit decimal.Decimal
+	accountIDs := make([]uuid.UUID, 0, len(lines))
+	var costCenterIDs []uuid.UUID
+	for _, line := range lines {
+		hasDebit := !helper.IsZeroAmount(line.Debit)
+		hasCredit := !helper.IsZeroAmount(line.Credit)
+		if line.Debit.IsNegative() || line.Credit.IsNegative() {
+			return exception.NewError(fmt.Sprintf("line %d: debit and credit cannot be negative", line.LineNo))
+		}
+		if hasDebit == hasCredit {
+			return exception.NewError(fmt.Sprintf("line %d: exactly one of debit or credit must be filled", line.LineNo))
+		}
+		totalDebit = totalDebit.Add(line.Debit)
+		totalCredit = totalCredit.Add(line.Credit)
+		accountIDs = append(accountIDs, line.AccountID)
+		if line.CostCenterID != nil {
+			costCenterIDs = append(costCenterIDs, *line.CostCenterID)
+		}
+	}
+
+	totalDebit = helper.RoundAmount(totalDebit)
+	totalCredit = helper.RoundAmount(totalCredit)
+	if !totalDebit.Equal(totalCredit) {
+		return exception.NewError(fmt.Sprintf("journal entry is not balanced: total debit %s does not equal total credit %s", totalDebit.StringFixed(2), totalCredit.StringFixed(2)))
+	}
+
+	accounts, err := service.AccountRepository.FindByIds(ctx, tx, accountIDs)
+	if err != nil {
+		return err
+	}
+	accountByID := make(map[uuid.UUID]domain.Account, len(accounts))
+	for _, account := range accounts {
+		accountByID[account.ID] = account
+	}
+
+	for _, line := range lines {
+		account, ok := accountByID[line.AccountID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("line %d: account not found", line.LineNo))
+		}
+		if !account.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: account %s is inactive", line.LineNo, account.Code))
+		}
+		if !account.IsPostable {
+			return exception.NewError(fmt.Sprintf("line %d: account %s is a header account and cannot be posted to", line.LineNo, account.Code))
+		}
+	}
+
+	if len(costCenterIDs) == 0 {
+		return nil
+	}
+	costCenters, err := service.CostCenterRepository.FindByIds(ctx, tx, costCenterIDs)
+	if err != nil {
+		return err
+	}
+	costCenterByID := make(map[uuid.UUID]domain.CostCenter, len(costCenters))
+	for _, costCenter := range costCenters {
+		costCenterByID[costCenter.ID] = costCenter
+	}
+	for _, line := range lines {
+		if line.CostCenterID == nil {
+			continue
+		}
+		costCenter, ok := costCenterByID[*line.CostCenterID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("line %d: cost center not found", line.LineNo))
+		}
+		if !costCenter.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: cost center %s is inactive", line.LineNo, costCenter.Code))
+		}
+	}
+
+	return nil
+}
+
+// validateParent memastikan akun induk ada, bertipe sama, berupa akun header,
+// dan tidak membentuk siklus pada hirarki akun.
+func (service *LedgerServiceImpl) validateParent(ctx context.Context, tx *gorm.DB, accountID uuid.UUID, parentID uuid.UUID, accountType domain.AccountType) error {
+	parent, err := service.AccountRepository.FindById(ctx, tx, parentID)
+	if err != nil {
+		return exception.NewError("parent account not found")
+	}
+	if parent.Type != accountType {
+		return exception.NewError("parent account must have the same account type")
+	}
+	if parent.IsPostable {
+		return exception.NewError("parent account must be a non-postable header account")
+	}
+
+	// Telusuri ke atas untuk mencegah siklus (akun menjadi induk dari leluhurnya sendiri)
+	current := parent
+	for {
+		if current.ID == accountID {
+			return exception.NewError("account hierarchy cannot contain a cycle")
+		}
+		if current.ParentID == nil {
+			return nil
+		}
+		current, err = service.AccountRepository.FindById(ctx, tx, *current.ParentID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func joinAccountTypes(types []domain.AccountType) string {
+	names := make([]string, 0, len(types))
+	for _, accountType := range types {
+		names = append(names, string(accountType))
+	}
+	return strings.Join(names, " or ")
+}
+
+// validateCashFlowCategory memastikan kategori arus kas hanya diisi untuk akun neraca
+func validateCashFlowCategory(accountType domain.AccountType, category string) error {
+	if category == "" {
+		return nil
+	}
+	if !(domain.Account{Type: accountType}).IsBalanceSheet() {
+		return exception.NewError("cash flow category only applies to Asset, Liability and Equity accounts")
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+
+	"github.com/google/uuid"
+)
+
+type CarrierService interface {
+	Create(ctx context.Context, request logistics.CarrierCreateRequest) (*logistics.CarrierResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request logistics.CarrierUpdateRequest) (*logistics.CarrierResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*logistics.CarrierResponse, error)
+	FindAll(ctx context.Context, filter logistics.CarrierFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
@@ -0,0 +1,117 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+	repo "erpfinance/internal/repository/logistics"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CarrierServiceImpl struct {
+	CarrierRepository repo.CarrierRepository
+	DB                *gorm.DB
+	Validate          *validator.Validate
+}
+
+func NewCarrierService(carrierRepository repo.CarrierRepository, db *gorm.DB, validate *validator.Validate) CarrierService {
+	return &CarrierServiceImpl{
+		CarrierRepository: carrierRepository,
+		DB:                db,
+		Validate:          validate,
+	}
+}
+
+func (service *CarrierServiceImpl) Create(ctx context.Context, request logistics.CarrierCreateRequest) (*logistics.CarrierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var createdCarrier domain.Carrier
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.CarrierRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("carrier code already exists")
+		}
+
+		carrier := domain.Carrier{
+			ID:          uuid.New(),
+			Code:        request.Code,
+			Name:        request.Name,
+			ServiceType: request.ServiceType,
+			ContactName: request.ContactName,
+			Phone:       request.Phone,
+			Email:       request.Email,
+			TrackingURL: request.TrackingURL,
+			Notes:       request.Notes,
+			IsActive:    true,
+		}
+
+		createdCarrier, err = service.CarrierRepository.Create(ctx, tx, carrier)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToCarrierResponse(createdCarrier), nil
+}
+
+func (service *CarrierServiceImpl) Update(ctx context.Context, id uuid.UUID, request logistics.CarrierUpdateRequest) (*logistics.CarrierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var carrier domain.Carrier
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		carrier, err = service.CarrierRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("carrier not found")
+		}
+
+		carrier.Name = request.Name
+		carrier.ServiceType = request.ServiceType
+		carrier.ContactName = request.ContactName
+		carrier.Phone = request.Phone
+		carrier.Email = request.Email
+		carrier.TrackingURL = request.TrackingURL
+		carrier.Notes = request.Notes
+		carrier.IsActive = request.IsActive
+
+		return service.CarrierRepository.Update(ctx, tx, carrier)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToCarrierResponse(carrier), nil
+}
+
+func (service *CarrierServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*logistics.CarrierResponse, error) {
+	carrier, err := service.CarrierRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("carrier not found")
+	}
+
+	return mapper.ToCarrierResponse(carrier), nil
+}
+
+func (service *CarrierServiceImpl) FindAll(ctx context.Context, filter logistics.CarrierFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	carriers, totalItems, err := service.CarrierRepository.FindAllWithPagination(ctx, service.DB, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToCarrierResponses(carriers)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
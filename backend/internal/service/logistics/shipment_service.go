@@ -0,0 +1,28 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+
+	"github.com/google/uuid"
+)
+
+type ShipmentService interface {
+	Create(ctx context.Context, userID uuid.UUID, request logistics.ShipmentRequest) (*logistics.ShipmentResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request logistics.ShipmentRequest) (*logistics.ShipmentResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*logistics.ShipmentResponse, error)
+	FindAll(ctx context.Context, filter logistics.ShipmentFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	PackingList(ctx context.Context, id uuid.UUID) (*logistics.PackingListResponse, error)
+	UpdatePacking(ctx context.Context, id uuid.UUID, request logistics.ShipmentPackingRequest) (*logistics.ShipmentResponse, error)
+
+	Pick(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*logistics.ShipmentResponse, error)
+	// Ship mengeluarkan stok seluruh baris dari gudang pengiriman dan memperbarui pemenuhan sales order
+	Ship(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentShipRequest) (*logistics.ShipmentResponse, error)
+	Deliver(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentDeliverRequest) (*logistics.ShipmentResponse, error)
+	// Return memasukkan kembali stok seluruh baris ke lokasi asalnya dan mengurangi kuantitas terkirim sales order
+	Return(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentReturnRequest) (*logistics.ShipmentResponse, error)
+
+	AddTracking(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentTrackingRequest) (*logistics.ShipmentResponse, error)
+	AddAttachment(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentAttachmentRequest) (*logistics.ShipmentResponse, error)
+}
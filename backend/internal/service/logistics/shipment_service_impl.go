@@ -0,0 +1,693 @@
+package logistics
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/logistics"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/logistics"
+	receivableRepo "erpfinance/internal/repository/receivable"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	inventoryService "erpfinance/internal/service/inventory"
+	salesService "erpfinance/internal/service/sales"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ShipmentNumberPrefix adalah prefix penomoran pengiriman, contoh: SH-202507-00001
+const ShipmentNumberPrefix = "SH"
+
+// shipmentTransitions berisi perubahan status pengiriman yang diizinkan. Barang yang sudah
+// diterima customer masih bisa diretur.
+var shipmentTransitions = map[domain.ShipmentStatus][]domain.ShipmentStatus{
+	domain.ShipmentStatusPlanned:   {domain.ShipmentStatusPicked},
+	domain.ShipmentStatusPicked:    {domain.ShipmentStatusShipped},
+	domain.ShipmentStatusShipped:   {domain.ShipmentStatusDelivered, domain.ShipmentStatusReturned},
+	domain.ShipmentStatusDelivered: {domain.ShipmentStatusReturned},
+}
+
+type ShipmentServiceImpl struct {
+	ShipmentRepository  repo.ShipmentRepository
+	CarrierRepository   repo.CarrierRepository
+	CustomerRepository  receivableRepo.CustomerRepository
+	ItemRepository      inventoryRepo.ItemRepository
+	WarehouseRepository inventoryRepo.WarehouseRepository
+	SequenceRepository  sequenceRepo.SequenceRepository
+	InventoryService    inventoryService.InventoryService
+	SalesOrderService   salesService.SalesOrderService
+	DB                  *gorm.DB
+	Validate            *validator.Validate
+}
+
+func NewShipmentService(shipmentRepository repo.ShipmentRepository, carrierRepository repo.CarrierRepository, customerRepository receivableRepo.CustomerRepository, itemRepository inventoryRepo.ItemRepository, warehouseRepository inventoryRepo.WarehouseRepository, sequenceRepository sequenceRepo.SequenceRepository, inventoryService inventoryService.InventoryService, salesOrderService salesService.SalesOrderService, db *gorm.DB, validate *validator.Validate) ShipmentService {
+	return &ShipmentServiceImpl{
+		ShipmentRepository:  shipmentRepository,
+		CarrierRepository:   carrierRepository,
+		CustomerRepository:  customerRepository,
+		ItemRepository:      itemRepository,
+		WarehouseRepository: warehouseRepository,
+		SequenceRepository:  sequenceRepository,
+		InventoryService:    inventoryService,
+		SalesOrderService:   salesOrderService,
+		DB:                  db,
+		Validate:            validate,
+	}
+}
+
+func (service *ShipmentServiceImpl) Create(ctx context.Context, userID uuid.UUID, request logistics.ShipmentRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	shipment := domain.Shipment{
+		ID:        uuid.New(),
+		Status:    domain.ShipmentStatusPlanned,
+		CreatedBy: userID,
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.buildShipment(ctx, tx, &shipment, request); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, ShipmentNumberPrefix, shipment.ShipmentDate)
+		if err != nil {
+			return err
+		}
+		shipment.Number = number
+		shipment.Events = []domain.ShipmentEvent{newShipmentEvent(shipment, userID, time.Now(), "", "Shipment planned")}
+
+		_, err = service.ShipmentRepository.Create(ctx, tx, shipment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, shipment.ID)
+}
+
+func (service *ShipmentServiceImpl) Update(ctx context.Context, id uuid.UUID, request logistics.ShipmentRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shipment, err := service.ShipmentRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("shipment not found")
+		}
+		if shipment.Status != domain.ShipmentStatusPlanned {
+			return exception.NewError("only planned shipments can be updated")
+		}
+
+		if err := service.buildShipment(ctx, tx, &shipment, request); err != nil {
+			return err
+		}
+		if err := service.ShipmentRepository.Update(ctx, tx, shipment); err != nil {
+			return err
+		}
+		if err := service.ShipmentRepository.ReplaceLines(ctx, tx, shipment.ID, shipment.Lines); err != nil {
+			return err
+		}
+		return service.ShipmentRepository.ReplacePackages(ctx, tx, shipment.ID, shipment.Packages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *ShipmentServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*logistics.ShipmentResponse, error) {
+	shipment, err := service.ShipmentRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("shipment not found")
+	}
+
+	return mapper.ToShipmentResponse(shipment), nil
+}
+
+func (service *ShipmentServiceImpl) FindAll(ctx context.Context, filter logistics.ShipmentFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	carrierID, err := helper.ParseOptionalUUID(filter.CarrierID, "carrier_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+	customerID, err := helper.ParseOptionalUUID(filter.CustomerID, "customer_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	shipments, totalItems, err := service.ShipmentRepository.FindAllWithPagination(ctx, service.DB, filter.Status, carrierID, customerID, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToShipmentResponses(shipments)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *ShipmentServiceImpl) PackingList(ctx context.Context, id uuid.UUID) (*logistics.PackingListResponse, error) {
+	shipment, err := service.ShipmentRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("shipment not found")
+	}
+
+	return mapper.ToPackingListResponse(shipment), nil
+}
+
+func (service *ShipmentServiceImpl) UpdatePacking(ctx context.Context, id uuid.UUID, request logistics.ShipmentPackingRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shipment, err := service.ShipmentRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("shipment not found")
+		}
+		if shipment.Status != domain.ShipmentStatusPlanned && shipment.Status != domain.ShipmentStatusPicked {
+			return exception.NewError("packing can only be changed before the shipment is shipped")
+		}
+
+		packages, packageNos, err := buildPackages(shipment.ID, request.Packages)
+		if err != nil {
+			return err
+		}
+
+		lineIndex := make(map[uuid.UUID]int, len(shipment.Lines))
+		for i := range shipment.Lines {
+			lineIndex[shipment.Lines[i].ID] = i
+			shipment.Lines[i].PackageNo = 0
+		}
+		assigned := make(map[uuid.UUID]bool, len(request.Lines))
+		for i, lineRequest := range request.Lines {
+			index, ok := lineIndex[lineRequest.LineID]
+			if !ok {
+				return exception.NewError(fmt.Sprintf("line %d: line does not belong to this shipment", i+1))
+			}
+			if assigned[lineRequest.LineID] {
+				return exception.NewError(fmt.Sprintf("line %d: shipment line is listed more than once", i+1))
+			}
+			if lineRequest.PackageNo != 0 && !packageNos[lineRequest.PackageNo] {
+				return exception.NewError(fmt.Sprintf("line %d: package %d does not exist", i+1, lineRequest.PackageNo))
+			}
+			assigned[lineRequest.LineID] = true
+			shipment.Lines[index].PackageNo = lineRequest.PackageNo
+		}
+
+		if err := service.ShipmentRepository.ReplacePackages(ctx, tx, shipment.ID, packages); err != nil {
+			return err
+		}
+		return service.ShipmentRepository.UpdateLinePackages(ctx, tx, shipment.Lines)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *ShipmentServiceImpl) Pick(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*logistics.ShipmentResponse, error) {
+	return service.changeStatus(ctx, id, userID, domain.ShipmentStatusPicked, "Items picked from warehouse", func(tx *gorm.DB, shipment *domain.Shipment, now time.Time) error {
+		shipment.PickedAt = &now
+		return nil
+	})
+}
+
+func (service *ShipmentServiceImpl) Ship(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentShipRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	carrierID, err := helper.ParseOptionalUUID(request.CarrierID, "carrier_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return service.changeStatus(ctx, id, userID, domain.ShipmentStatusShipped, "Shipment handed over to carrier", func(tx *gorm.DB, shipment *domain.Shipment, now time.Time) error {
+		if carrierID != nil {
+			if _, err := service.findActiveCarrier(ctx, tx, *carrierID); err != nil {
+				return err
+			}
+			shipment.CarrierID = carrierID
+		}
+		if shipment.CarrierID == nil {
+			return exception.NewError("carrier is required to ship the shipment")
+		}
+		if request.TrackingNumber != "" {
+			shipment.TrackingNumber = request.TrackingNumber
+		}
+		if request.Notes != "" {
+			shipment.Notes = request.Notes
+		}
+		shipment.ShippedAt = &now
+
+		if err := service.postStock(ctx, tx, *shipment, domain.StockMovementIssue, -1, userID, "Shipment "+shipment.Number); err != nil {
+			return err
+		}
+		return service.applyToSalesOrder(ctx, tx, *shipment, 1)
+	})
+}
+
+func (service *ShipmentServiceImpl) Deliver(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentDeliverRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	description := "Delivered, received by " + request.ReceivedBy
+	if request.Notes != "" {
+		description += ": " + request.Notes
+	}
+
+	return service.changeStatus(ctx, id, userID, domain.ShipmentStatusDelivered, description, func(tx *gorm.DB, shipment *domain.Shipment, now time.Time) error {
+		shipment.ReceivedBy = request.ReceivedBy
+		shipment.DeliveredAt = &now
+
+		if len(request.Attachments) == 0 {
+			return nil
+		}
+		return service.ShipmentRepository.CreateAttachments(ctx, tx, buildAttachments(shipment.ID, userID, request.Attachments))
+	})
+}
+
+func (service *ShipmentServiceImpl) Return(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentReturnRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	return service.changeStatus(ctx, id, userID, domain.ShipmentStatusReturned, "Returned: "+request.Reason, func(tx *gorm.DB, shipment *domain.Shipment, now time.Time) error {
+		shipment.ReturnReason = request.Reason
+		shipment.ReturnedAt = &now
+
+		if err := service.postStock(ctx, tx, *shipment, domain.StockMovementReceipt, 1, userID, "Return of shipment "+shipment.Number); err != nil {
+			return err
+		}
+		return service.applyToSalesOrder(ctx, tx, *shipment, -1)
+	})
+}
+
+func (service *ShipmentServiceImpl) AddTracking(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentTrackingRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	eventAt := time.Now()
+	if request.EventAt != "" {
+		parsed, err := time.Parse(time.RFC3339, request.EventAt)
+		if err != nil {
+			return nil, exception.NewError("invalid event time")
+		}
+		eventAt = parsed
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shipment, err := service.ShipmentRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("shipment not found")
+		}
+		if shipment.Status != domain.ShipmentStatusShipped {
+			return exception.NewError("tracking events can only be added to shipments in transit")
+		}
+
+		return service.ShipmentRepository.CreateEvent(ctx, tx, newShipmentEvent(shipment, userID, eventAt, request.Location, request.Description))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *ShipmentServiceImpl) AddAttachment(ctx context.Context, id uuid.UUID, userID uuid.UUID, request logistics.ShipmentAttachmentRequest) (*logistics.ShipmentResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shipment, err := service.ShipmentRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("shipment not found")
+		}
+		if shipment.Status == domain.ShipmentStatusPlanned || shipment.Status == domain.ShipmentStatusPicked {
+			return exception.NewError("proof of delivery can only be attached after the shipment is shipped")
+		}
+
+		return service.ShipmentRepository.CreateAttachments(ctx, tx, buildAttachments(shipment.ID, userID, []logistics.ShipmentAttachmentRequest{request}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// changeStatus mengubah status pengiriman sesuai shipmentTransitions dan mencatat event-nya.
+// apply dipakai untuk mengisi timestamp dan efek samping (mutasi stok, lampiran) sebelum disimpan.
+func (service *ShipmentServiceImpl) changeStatus(ctx context.Context, id uuid.UUID, userID uuid.UUID, to domain.ShipmentStatus, description string, apply func(tx *gorm.DB, shipment *domain.Shipment, now time.Time) error) (*logistics.ShipmentResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shipment, err := service.ShipmentRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("shipment not found")
+		}
+
+		if !isAllowedShipmentTransition(shipment.Status, to) {
+			return exception.NewError(fmt.Sprintf("shipment status cannot change from %s to %s", shipment.Status, to))
+		}
+
+		now := time.Now()
+		shipment.Status = to
+		if err := apply(tx, &shipment, now); err != nil {
+			return err
+		}
+		if err := service.ShipmentRepository.Update(ctx, tx, shipment); err != nil {
+			return err
+		}
+		return service.ShipmentRepository.CreateEvent(ctx, tx, newShipmentEvent(shipment, userID, now, "", description))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// postStock membukukan mutasi stok seluruh baris pengiriman; sign -1 untuk barang keluar
+// dan 1 untuk barang kembali ke gudang
+func (service *ShipmentServiceImpl) postStock(ctx context.Context, tx *gorm.DB, shipment domain.Shipment, movementType domain.StockMovementType, sign float64, userID uuid.UUID, notes string) error {
+	movements := make([]domain.StockMovement, 0, len(shipment.Lines))
+	for _, line := range shipment.Lines {
+		movements = append(movements, domain.StockMovement{
+			MovementType: movementType,
+			MovementDate: helper.Today(),
+			ItemID:       line.ItemID,
+			WarehouseID:  shipment.WarehouseID,
+			BinID:        line.BinID,
+			Quantity:     sign * line.Quantity,
+			Reference:    shipment.Number,
+			SourceType:   domain.StockSourceShipment,
+			SourceID:     &shipment.ID,
+			Notes:        notes,
+			CreatedBy:    userID,
+		})
+	}
+
+	_, err := service.InventoryService.PostMovements(ctx, tx, movements)
+	return err
+}
+
+// applyToSalesOrder memperbarui kuantitas terkirim sales order dari baris pengiriman; sign 1
+// saat barang dikirim dan -1 saat barang diretur
+func (service *ShipmentServiceImpl) applyToSalesOrder(ctx context.Context, tx *gorm.DB, shipment domain.Shipment, sign float64) error {
+	if shipment.SalesOrderID == nil {
+		return nil
+	}
+
+	shipped := make(map[uuid.UUID]float64, len(shipment.Lines))
+	for _, line := range shipment.Lines {
+		if line.SalesOrderLineID != nil {
+			shipped[*line.SalesOrderLineID] += sign * line.Quantity
+		}
+	}
+
+	_, err := service.SalesOrderService.ApplyShipment(ctx, tx, *shipment.SalesOrderID, shipped)
+	return err
+}
+
+// buildShipment mengisi header, baris dan koli pengiriman dari request. Penerima dan alamat
+// yang kosong diambil dari sales order atau customer; deskripsi dan satuan baris diambil dari item.
+func (service *ShipmentServiceImpl) buildShipment(ctx context.Context, tx *gorm.DB, shipment *domain.Shipment, request logistics.ShipmentRequest) error {
+	shipmentDate, err := helper.ParseDate(request.ShipmentDate)
+	if err != nil {
+		return exception.NewError("invalid shipment date")
+	}
+	carrierID, err := helper.ParseOptionalUUID(request.CarrierID, "carrier_id")
+	if err != nil {
+		return err
+	}
+	customerID, err := helper.ParseOptionalUUID(request.CustomerID, "customer_id")
+	if err != nil {
+		return err
+	}
+	salesOrderID, err := helper.ParseOptionalUUID(request.SalesOrderID, "sales_order_id")
+	if err != nil {
+		return err
+	}
+
+	warehouse, err := service.WarehouseRepository.FindById(ctx, tx, request.WarehouseID)
+	if err != nil {
+		return exception.NewNotFoundError("warehouse not found")
+	}
+	if !warehouse.IsActive {
+		return exception.NewError(fmt.Sprintf("warehouse %s is inactive", warehouse.Code))
+	}
+
+	if carrierID != nil {
+		if _, err := service.findActiveCarrier(ctx, tx, *carrierID); err != nil {
+			return err
+		}
+	}
+
+	recipientName := request.RecipientName
+	shippingAddress := request.ShippingAddress
+	recipientPhone := request.RecipientPhone
+
+	var orderLines map[uuid.UUID]domain.SalesOrderLine
+	if salesOrderID != nil {
+		order, err := service.SalesOrderService.FindOpenOrder(ctx, tx, *salesOrderID)
+		if err != nil {
+			return err
+		}
+		if customerID != nil && *customerID != order.CustomerID {
+			return exception.NewError(fmt.Sprintf("customer does not match sales order %s", order.Number))
+		}
+		customerID = &order.CustomerID
+		if shippingAddress == "" {
+			shippingAddress = order.ShippingAddress
+		}
+
+		orderLines = make(map[uuid.UUID]domain.SalesOrderLine, len(order.Lines))
+		for _, line := range order.Lines {
+			orderLines[line.ID] = line
+		}
+	}
+
+	if customerID != nil {
+		customer, err := service.CustomerRepository.FindById(ctx, tx, *customerID)
+		if err != nil {
+			return exception.NewNotFoundError("customer not found")
+		}
+		if !customer.IsActive {
+			return exception.NewError(fmt.Sprintf("customer %s is inactive", customer.Code))
+		}
+		if recipientName == "" {
+			recipientName = customer.Name
+		}
+		if shippingAddress == "" {
+			shippingAddress = customer.BillingAddress
+		}
+		if recipientPhone == "" {
+			recipientPhone = customer.Phone
+		}
+	}
+	if recipientName == "" {
+		return exception.NewError("recipient name is required")
+	}
+	if shippingAddress == "" {
+		return exception.NewError("shipping address is required")
+	}
+
+	packages, packageNos, err := buildPackages(shipment.ID, request.Packages)
+	if err != nil {
+		return err
+	}
+
+	itemIDs := make([]uuid.UUID, 0, len(request.Lines))
+	for _, line := range request.Lines {
+		itemIDs = append(itemIDs, line.ItemID)
+	}
+	items, err := service.ItemRepository.FindByIds(ctx, tx, itemIDs)
+	if err != nil {
+		return err
+	}
+	itemByID := make(map[uuid.UUID]domain.Item, len(items))
+	for _, item := range items {
+		itemByID[item.ID] = item
+	}
+
+	lines := make([]domain.ShipmentLine, 0, len(request.Lines))
+	for i, lineRequest := range request.Lines {
+		item, ok := itemByID[lineRequest.ItemID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("line %d: item not found", i+1))
+		}
+		if !item.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: item %s is inactive", i+1, item.Code))
+		}
+
+		binID, err := helper.ParseOptionalUUID(lineRequest.BinID, fmt.Sprintf("line %d bin_id", i+1))
+		if err != nil {
+			return err
+		}
+		if binID != nil && !hasActiveBin(warehouse, *binID) {
+			return exception.NewError(fmt.Sprintf("line %d: bin does not belong to warehouse %s or is inactive", i+1, warehouse.Code))
+		}
+		if lineRequest.PackageNo != 0 && !packageNos[lineRequest.PackageNo] {
+			return exception.NewError(fmt.Sprintf("line %d: package %d does not exist", i+1, lineRequest.PackageNo))
+		}
+
+		salesOrderLineID, err := helper.ParseOptionalUUID(lineRequest.SalesOrderLineID, fmt.Sprintf("line %d sales_order_line_id", i+1))
+		if err != nil {
+			return err
+		}
+		if err := checkSalesOrderLine(orderLines, salesOrderLineID, item, i+1); err != nil {
+			return err
+		}
+
+		description := lineRequest.Description
+		if description == "" {
+			description = item.Name
+		}
+		lines = append(lines, domain.ShipmentLine{
+			ID:               uuid.New(),
+			ShipmentID:       shipment.ID,
+			LineNo:           i + 1,
+			ItemID:           item.ID,
+			BinID:            binID,
+			SalesOrderLineID: salesOrderLineID,
+			Description:      description,
+			UOM:              item.UOM,
+			Quantity:         helper.RoundQuantity(lineRequest.Quantity),
+			PackageNo:        lineRequest.PackageNo,
+		})
+	}
+
+	shipment.ShipmentDate = shipmentDate
+	shipment.WarehouseID = warehouse.ID
+	shipment.CarrierID = carrierID
+	shipment.CustomerID = customerID
+	shipment.SalesOrderID = salesOrderID
+	shipment.RecipientName = recipientName
+	shipment.RecipientPhone = recipientPhone
+	shipment.ShippingAddress = shippingAddress
+	shipment.Reference = request.Reference
+	shipment.Notes = request.Notes
+	shipment.Lines = lines
+	shipment.Packages = packages
+	return nil
+}
+
+func (service *ShipmentServiceImpl) findActiveCarrier(ctx context.Context, tx *gorm.DB, id uuid.UUID) (domain.Carrier, error) {
+	carrier, err := service.CarrierRepository.FindById(ctx, tx, id)
+	if err != nil {
+		return domain.Carrier{}, exception.NewNotFoundError("carrier not found")
+	}
+	if !carrier.IsActive {
+		return domain.Carrier{}, exception.NewError(fmt.Sprintf("carrier %s is inactive", carrier.Code))
+	}
+	return carrier, nil
+}
+
+// checkSalesOrderLine memastikan baris pengiriman sesuai dengan sales order: bila pengiriman
+// terhubung ke order (orderLines tidak nil) setiap baris wajib menunjuk baris order dengan item
+// yang sama. Kelebihan kirim diperiksa saat pengiriman Shipped.
+func checkSalesOrderLine(orderLines map[uuid.UUID]domain.SalesOrderLine, salesOrderLineID *uuid.UUID, item domain.Item, lineNo int) error {
+	if orderLines == nil {
+		if salesOrderLineID != nil {
+			return exception.NewError(fmt.Sprintf("line %d: sales_order_line_id requires sales_order_id", lineNo))
+		}
+		return nil
+	}
+	if salesOrderLineID == nil {
+		return exception.NewError(fmt.Sprintf("line %d: sales_order_line_id is required for shipments of a sales order", lineNo))
+	}
+
+	orderLine, ok := orderLines[*salesOrderLineID]
+	if !ok {
+		return exception.NewError(fmt.Sprintf("line %d: line does not belong to the sales order", lineNo))
+	}
+	if orderLine.ItemID != item.ID {
+		return exception.NewError(fmt.Sprintf("line %d: item %s does not match sales order line %d", lineNo, item.Code, orderLine.LineNo))
+	}
+	return nil
+}
+
+// buildPackages mengembalikan koli beserta set nomor koli; nomor koli harus unik
+func buildPackages(shipmentID uuid.UUID, requests []logistics.ShipmentPackageRequest) ([]domain.ShipmentPackage, map[int]bool, error) {
+	packages := make([]domain.ShipmentPackage, 0, len(requests))
+	packageNos := make(map[int]bool, len(requests))
+	for i, packageRequest := range requests {
+		if packageNos[packageRequest.PackageNo] {
+			return nil, nil, exception.NewError(fmt.Sprintf("package %d: package number %d is used more than once", i+1, packageRequest.PackageNo))
+		}
+		packageNos[packageRequest.PackageNo] = true
+
+		packages = append(packages, domain.ShipmentPackage{
+			ID:          uuid.New(),
+			ShipmentID:  shipmentID,
+			PackageNo:   packageRequest.PackageNo,
+			PackageType: packageRequest.PackageType,
+			Weight:      helper.RoundQuantity(packageRequest.Weight),
+			Length:      helper.RoundMeasure(packageRequest.Length),
+			Width:       helper.RoundMeasure(packageRequest.Width),
+			Height:      helper.RoundMeasure(packageRequest.Height),
+			Notes:       packageRequest.Notes,
+		})
+	}
+	return packages, packageNos, nil
+}
+
+func buildAttachments(shipmentID uuid.UUID, userID uuid.UUID, requests []logistics.ShipmentAttachmentRequest) []domain.ShipmentAttachment {
+	attachments := make([]domain.ShipmentAttachment, 0, len(requests))
+	for _, attachmentRequest := range requests {
+		attachments = append(attachments, domain.ShipmentAttachment{
+			ID:          uuid.New(),
+			ShipmentID:  shipmentID,
+			FileName:    attachmentRequest.FileName,
+			ContentType: attachmentRequest.ContentType,
+			FileSize:    attachmentRequest.FileSize,
+			StorageURL:  attachmentRequest.StorageURL,
+			Description: attachmentRequest.Description,
+			UploadedBy:  userID,
+		})
+	}
+	return attachments
+}
+
+func newShipmentEvent(shipment domain.Shipment, userID uuid.UUID, eventAt time.Time, location string, description string) domain.ShipmentEvent {
+	return domain.ShipmentEvent{
+		ID:          uuid.New(),
+		ShipmentID:  shipment.ID,
+		Status:      shipment.Status,
+		EventAt:     eventAt,
+		Location:    location,
+		Description: description,
+		CreatedBy:   userID,
+	}
+}
+
+func hasActiveBin(warehouse domain.Warehouse, binID uuid.UUID) bool {
+	for _, bin := range warehouse.Bins {
+		if bin.ID == binID {
+			return bin.IsActive
+		}
+	}
+	return false
+}
+
+func isAllowedShipmentTransition(from, to domain.ShipmentStatus) bool {
+	for _, allowed := range shipmentTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package payable
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/payable"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// matchInvoice membandingkan baris invoice dengan purchase order (harga) dan kuantitas yang sudah
+// diterima lewat goods receipt. Kuantitas yang boleh ditagih adalah kuantitas diterima dikurangi
+// kuantitas pada invoice lain yang sudah Approved. Menagih kurang dari itu tidak dianggap selisih.
+// Pajak tidak ikut dibandingkan karena purchase order dicatat tanpa pajak.
+func matchInvoice(invoice domain.SupplierInvoice, order domain.PurchaseOrder, invoicedElsewhere map[uuid.UUID]float64, tolerance domain.MatchTolerance) payable.InvoiceMatchResponse {
+	result := payable.InvoiceMatchResponse{
+		InvoiceID:     invoice.ID,
+		InvoiceNumber: invoice.Number,
+		MatchStatus:   domain.InvoiceMatchStatusMatched,
+		Tolerance: payable.MatchToleranceResponse{
+			QuantityPercent: tolerance.QuantityPercent,
+			PricePercent:    tolerance.PricePercent,
+			AmountAbsolute:  tolerance.AmountAbsolute,
+		},
+		Lines: []payable.InvoiceMatchLineResponse{},
+	}
+
+	orderLineByID := make(map[uuid.UUID]domain.PurchaseOrderLine, len(order.Lines))
+	for _, line := range order.Lines {
+		orderLineByID[line.ID] = line
+	}
+
+	var expectedAmount decimal.Decimal
+	for _, line := range invoice.Lines {
+		lineResult := payable.InvoiceMatchLineResponse{
+			LineNo:              line.LineNo,
+			PurchaseOrderLineID: line.PurchaseOrderLineID,
+			Description:         line.Description,
+			InvoicedQuantity:    line.Quantity,
+			InvoiceUnitPrice:    line.UnitPrice,
+			Matched:             true,
+		}
+
+		orderLine, ok := orderLineByID[line.PurchaseOrderLineID]
+		if !ok {
+			lineResult.Matched = false
+			lineResult.Issues = append(lineResult.Issues, "purchase order line not found")
+			result.Lines = append(result.Lines, lineResult)
+			continue
+		}
+
+		billable := helper.RoundQuantity(orderLine.ReceivedQuantity - invoicedElsewhere[orderLine.ID])
+		if billable < 0 {
+			billable = 0
+		}
+		lineResult.OrderedQuantity = orderLine.Quantity
+		lineResult.ReceivedQuantity = orderLine.ReceivedQuantity
+		lineResult.PreviouslyInvoiced = helper.RoundQuantity(invoicedElsewhere[orderLine.ID])
+		lineResult.BillableQuantity = billable
+		lineResult.QuantityVariance = helper.RoundQuantity(line.Quantity - billable)
+		lineResult.OrderUnitPrice = orderLine.UnitPrice
+		lineResult.PriceVariance = helper.RoundAmount(line.UnitPrice.Sub(orderLine.UnitPrice))
+
+		allowedQuantity := helper.RoundQuantity(billable * tolerance.QuantityPercent / 100)
+		if lineResult.QuantityVariance > allowedQuantity {
+			lineResult.Matched = false
+			lineResult.Issues = append(lineResult.Issues, fmt.Sprintf("invoiced quantity %.4f exceeds billable quantity %.4f (received %.4f, previously invoiced %.4f)", line.Quantity, billable, orderLine.ReceivedQuantity, lineResult.PreviouslyInvoiced))
+		}
+
+		if lineResult.PriceVariance.IsPositive() {
+			allowedPrice := helper.PercentOfAmount(orderLine.UnitPrice, tolerance.PricePercent)
+			varianceValue := helper.MultiplyAmount(lineResult.PriceVariance, line.Quantity)
+			if lineResult.PriceVariance.GreaterThan(allowedPrice) && varianceValue.GreaterThan(tolerance.AmountAbsolute) {
+				lineResult.Matched = false
+				lineResult.Issues = append(lineResult.Issues, fmt.Sprintf("unit price %s exceeds order price %s by %s", line.UnitPrice.StringFixed(2), orderLine.UnitPrice.StringFixed(2), varianceValue.StringFixed(2)))
+			}
+		}
+
+		expectedAmount = expectedAmount.Add(orderLine.UnitPrice.Mul(decimal.NewFromFloat(line.Quantity)))
+		result.Lines = append(result.Lines, lineResult)
+	}
+
+	result.ExpectedAmount = helper.RoundAmount(expectedAmount)
+	result.InvoicedAmount = invoice.SubtotalAmount
+	result.AmountVariance = helper.RoundAmount(invoice.SubtotalAmount.Sub(result.ExpectedAmount))
+	for _, line := range result.Lines {
+		if !line.Matched {
+			result.MatchStatus = domain.InvoiceMatchStatusVariance
+		}
+	}
+	return result
+}
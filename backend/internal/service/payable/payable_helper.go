@@ -0,0 +1,188 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/payable"
+	repo "erpfinance/internal/repository/payable"
+	taxService "erpfinance/internal/service/tax"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// loadPayableSetting memastikan akun kontrol hutang sudah diatur sebelum jurnal otomatis dibuat
+func loadPayableSetting(ctx context.Context, tx *gorm.DB, repository repo.PayableSettingRepository) (domain.PayableSetting, error) {
+	setting, err := repository.Find(ctx, tx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.PayableSetting{}, exception.NewError("payable accounts are not configured")
+	}
+	if err != nil {
+		return domain.PayableSetting{}, err
+	}
+	if setting.PayableAccountID == nil {
+		return domain.PayableSetting{}, exception.NewError("payable account is not configured")
+	}
+	return setting, nil
+}
+
+// buildInvoiceTaxes menghitung nilai setiap baris pajak. Hasil kedua adalah total pengaruh pajak
+// terhadap tagihan (pajak potong bernilai negatif).
+func buildInvoiceTaxes(invoiceID uuid.UUID, resolved []taxService.ResolvedTaxLine) ([]domain.SupplierInvoiceTax, decimal.Decimal) {
+	taxes := make([]domain.SupplierInvoiceTax, 0, len(resolved))
+	var taxAmount decimal.Decimal
+	for i, line := range resolved {
+		tax := domain.SupplierInvoiceTax{
+			ID:                uuid.New(),
+			SupplierInvoiceID: invoiceID,
+			LineNo:            i + 1,
+			TaxCode:           line.TaxCode,
+			TaxType:           line.TaxType,
+			Description:       line.Description,
+			AccountID:         line.AccountID,
+			BaseAmount:        line.BaseAmount,
+			Rate:              line.Rate,
+			Amount:            line.Amount(),
+			IsWithholding:     line.IsWithholding,
+		}
+		taxAmount = taxAmount.Add(tax.SignedAmount())
+		taxes = append(taxes, tax)
+	}
+	return taxes, helper.RoundAmount(taxAmount)
+}
+
+// toTaxLineInputs menyalin baris pajak request ke input resolusi kode pajak
+func toTaxLineInputs(requests []payable.SupplierInvoiceTaxRequest) []taxService.TaxLineInput {
+	inputs := make([]taxService.TaxLineInput, 0, len(requests))
+	for _, request := range requests {
+		inputs = append(inputs, taxService.TaxLineInput{
+			TaxCode:       request.TaxCode,
+			Description:   request.Description,
+			AccountID:     request.AccountID,
+			Rate:          request.Rate,
+			BaseAmount:    request.BaseAmount,
+			IsWithholding: request.IsWithholding,
+		})
+	}
+	return inputs
+}
+
+// buildInvoiceJournal menyusun jurnal hutang saat invoice di-approve:
+// debit akun pembelian (subtotal) dan pajak masukan, kredit pajak potong dan hutang usaha.
+func buildInvoiceJournal(invoice domain.SupplierInvoice, payableAccountID uuid.UUID, purchaseAccountID uuid.UUID, userID uuid.UUID) domain.JournalEntry {
+	entry := domain.JournalEntry{
+		EntryDate:   invoice.InvoiceDate,
+		Description: fmt.Sprintf("Supplier invoice %s from %s", invoice.SupplierInvoiceNo, invoice.SupplierName),
+		Reference:   invoice.Number,
+		SourceType:  domain.JournalSourceSupplierInvoice,
+		SourceID:    &invoice.ID,
+		CreatedBy:   userID,
+	}
+
+	entry.Lines = append(entry.Lines, domain.JournalLine{
+		AccountID:   purchaseAccountID,
+		Description: "Purchases " + invoice.Number,
+		Debit:       invoice.SubtotalAmount,
+	})
+	for _, tax := range invoice.Taxes {
+		if helper.IsZeroAmount(tax.Amount) {
+			continue
+		}
+		line := domain.JournalLine{
+			AccountID:   tax.AccountID,
+			Description: fmt.Sprintf("%s %s", tax.TaxCode, invoice.Number),
+		}
+		if tax.IsWithholding {
+			line.Credit = tax.Amount
+		} else {
+			line.Debit = tax.Amount
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+	entry.Lines = append(entry.Lines, domain.JournalLine{
+		AccountID:   payableAccountID,
+		Description: "Payable " + invoice.Number,
+		Credit:      invoice.TotalAmount,
+	})
+	return entry
+}
+
+// buildAgingReport mengelompokkan sisa hutang per supplier dan mata uang ke bucket
+// berdasarkan jumlah hari lewat jatuh tempo pada tanggal asOf
+func buildAgingReport(asOf time.Time, rows []domain.PayableOutstanding) *payable.AgingReportResponse {
+	report := &payable.AgingReportResponse{
+		AsOf:      helper.FormatDate(asOf),
+		Suppliers: []payable.AgingSupplierResponse{},
+		Totals:    []payable.AgingTotalResponse{},
+	}
+
+	supplierIndex := make(map[string]int)
+	totalIndex := make(map[string]int)
+	for _, row := range rows {
+		outstanding := helper.RoundAmount(row.TotalAmount.Sub(row.PaidAmount))
+		if !outstanding.IsPositive() {
+			continue
+		}
+
+		daysOverdue := int(math.Floor(asOf.Sub(row.DueDate).Hours() / 24))
+		if daysOverdue < 0 {
+			daysOverdue = 0
+		}
+
+		key := row.SupplierID.String() + "|" + row.Currency
+		index, ok := supplierIndex[key]
+		if !ok {
+			report.Suppliers = append(report.Suppliers, payable.AgingSupplierResponse{
+				SupplierID:   row.SupplierID,
+				SupplierName: row.SupplierName,
+				Currency:     row.Currency,
+				Invoices:     []payable.AgingInvoiceResponse{},
+			})
+			index = len(report.Suppliers) - 1
+			supplierIndex[key] = index
+		}
+		supplier := &report.Suppliers[index]
+		supplier.Invoices = append(supplier.Invoices, payable.AgingInvoiceResponse{
+			InvoiceID:         row.InvoiceID,
+			Number:            row.Number,
+			SupplierInvoiceNo: row.SupplierInvoiceNo,
+			InvoiceDate:       helper.FormatDate(row.InvoiceDate),
+			DueDate:           helper.FormatDate(row.DueDate),
+			DaysOverdue:       daysOverdue,
+			OutstandingAmount: outstanding,
+		})
+		addToBucket(&supplier.Buckets, daysOverdue, outstanding)
+
+		totalPosition, ok := totalIndex[row.Currency]
+		if !ok {
+			report.Totals = append(report.Totals, payable.AgingTotalResponse{Currency: row.Currency})
+			totalPosition = len(report.Totals) - 1
+			totalIndex[row.Currency] = totalPosition
+		}
+		addToBucket(&report.Totals[totalPosition].Buckets, daysOverdue, outstanding)
+	}
+	return report
+}
+
+func addToBucket(buckets *payable.AgingBuckets, daysOverdue int, amount decimal.Decimal) {
+	switch {
+	case daysOverdue <= 0:
+		buckets.Current = helper.RoundAmount(buckets.Current.Add(amount))
+	case daysOverdue <= 30:
+		buckets.Days1To30 = helper.RoundAmount(buckets.Days1To30.Add(amount))
+	case daysOverdue <= 60:
+		buckets.Days31To60 = helper.RoundAmount(buckets.Days31To60.Add(amount))
+	case daysOverdue <= 90:
+		buckets.Days61To90 = helper.RoundAmount(buckets.Days61To90.Add(amount))
+	default:
+		buckets.Over90 = helper.RoundAmount(buckets.Over90.Add(amount))
+	}
+	buckets.Total = helper.RoundAmount(buckets.Total.Add(amount))
+}
@@ -0,0 +1,32 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+
+	"github.com/google/uuid"
+)
+
+type PayableService interface {
+	CreateInvoice(ctx context.Context, userID uuid.UUID, request payable.SupplierInvoiceRequest) (*payable.SupplierInvoiceResponse, error)
+	UpdateInvoice(ctx context.Context, id uuid.UUID, request payable.SupplierInvoiceRequest) (*payable.SupplierInvoiceResponse, error)
+	FindInvoiceById(ctx context.Context, id uuid.UUID) (*payable.SupplierInvoiceResponse, error)
+	FindAllInvoices(ctx context.Context, filter payable.SupplierInvoiceFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// MatchInvoice menghasilkan laporan selisih three-way match berdasarkan data terkini
+	MatchInvoice(ctx context.Context, id uuid.UUID) (*payable.InvoiceMatchResponse, error)
+
+	// ApproveInvoice menjalankan ulang three-way match, menolak approval bila ada selisih di luar
+	// toleransi, lalu memposting jurnal hutang (debit pembelian dan pajak, kredit hutang usaha)
+	ApproveInvoice(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*payable.SupplierInvoiceResponse, error)
+	CancelInvoice(ctx context.Context, id uuid.UUID) (*payable.SupplierInvoiceResponse, error)
+
+	GetTolerance(ctx context.Context) (*payable.MatchToleranceResponse, error)
+	UpdateTolerance(ctx context.Context, userID uuid.UUID, request payable.MatchToleranceRequest) (*payable.MatchToleranceResponse, error)
+	GetSetting(ctx context.Context) (*payable.PayableSettingResponse, error)
+	UpdateSetting(ctx context.Context, userID uuid.UUID, request payable.PayableSettingRequest) (*payable.PayableSettingResponse, error)
+
+	// AgingReport mengelompokkan sisa hutang per supplier ke bucket umur jatuh tempo
+	AgingReport(ctx context.Context, filter payable.AgingFilterRequest) (*payable.AgingReportResponse, error)
+}
@@ -0,0 +1,512 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+	repo "erpfinance/internal/repository/payable"
+	purchasingRepo "erpfinance/internal/repository/purchasing"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	supplierRepo "erpfinance/internal/repository/supplier"
+	currencyService "erpfinance/internal/service/currency"
+	ledgerService "erpfinance/internal/service/ledger"
+	taxService "erpfinance/internal/service/tax"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SupplierInvoiceNumberPrefix adalah prefix penomoran supplier invoice, contoh: SI-202507-00001
+const SupplierInvoiceNumberPrefix = "SI"
+
+type PayableServiceImpl struct {
+	SupplierInvoiceRepository repo.SupplierInvoiceRepository
+	MatchToleranceRepository  repo.MatchToleranceRepository
+	PayableSettingRepository  repo.PayableSettingRepository
+	PurchaseOrderRepository   purchasingRepo.PurchaseOrderRepository
+	SupplierRepository        supplierRepo.SupplierRepository
+	SequenceRepository        sequenceRepo.SequenceRepository
+	CurrencyService           currencyService.CurrencyService
+	LedgerService             ledgerService.LedgerService
+	TaxService                taxService.TaxService
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewPayableService(supplierInvoiceRepository repo.SupplierInvoiceRepository, matchToleranceRepository repo.MatchToleranceRepository, payableSettingRepository repo.PayableSettingRepository, purchaseOrderRepository purchasingRepo.PurchaseOrderRepository, supplierRepository supplierRepo.SupplierRepository, sequenceRepository sequenceRepo.SequenceRepository, currencyService currencyService.CurrencyService, ledgerService ledgerService.LedgerService, taxService taxService.TaxService, db *gorm.DB, validate *validator.Validate) PayableService {
+	return &PayableServiceImpl{
+		SupplierInvoiceRepository: supplierInvoiceRepository,
+		MatchToleranceRepository:  matchToleranceRepository,
+		PayableSettingRepository:  payableSettingRepository,
+		PurchaseOrderRepository:   purchaseOrderRepository,
+		SupplierRepository:        supplierRepository,
+		SequenceRepository:        sequenceRepository,
+		CurrencyService:           currencyService,
+		LedgerService:             ledgerService,
+		TaxService:                taxService,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *PayableServiceImpl) CreateInvoice(ctx context.Context, userID uuid.UUID, request payable.SupplierInvoiceRequest) (*payable.SupplierInvoiceResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	invoiceDate, err := helper.ParseDate(request.InvoiceDate)
+	if err != nil {
+		return nil, exception.NewError("invalid invoice date")
+	}
+
+	var invoiceID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice := domain.SupplierInvoice{
+			ID:        uuid.New(),
+			Status:    domain.SupplierInvoiceStatusDraft,
+			CreatedBy: userID,
+		}
+
+		order, err := service.applyRequest(ctx, tx, &invoice, request, invoiceDate)
+		if err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, SupplierInvoiceNumberPrefix, invoiceDate)
+		if err != nil {
+			return err
+		}
+		invoice.Number = number
+
+		if err := service.refreshMatchStatus(ctx, tx, &invoice, order); err != nil {
+			return err
+		}
+
+		created, err := service.SupplierInvoiceRepository.Create(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+		invoiceID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, invoiceID)
+}
+
+func (service *PayableServiceImpl) UpdateInvoice(ctx context.Context, id uuid.UUID, request payable.SupplierInvoiceRequest) (*payable.SupplierInvoiceResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	invoiceDate, err := helper.ParseDate(request.InvoiceDate)
+	if err != nil {
+		return nil, exception.NewError("invalid invoice date")
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SupplierInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("supplier invoice not found")
+		}
+
+		if invoice.Status != domain.SupplierInvoiceStatusDraft {
+			return exception.NewError("only draft supplier invoices can be updated")
+		}
+
+		order, err := service.applyRequest(ctx, tx, &invoice, request, invoiceDate)
+		if err != nil {
+			return err
+		}
+
+		if err := service.refreshMatchStatus(ctx, tx, &invoice, order); err != nil {
+			return err
+		}
+
+		if err := service.SupplierInvoiceRepository.Update(ctx, tx, invoice); err != nil {
+			return err
+		}
+
+		if err := service.SupplierInvoiceRepository.ReplaceLines(ctx, tx, invoice.ID, invoice.Lines); err != nil {
+			return err
+		}
+		return service.SupplierInvoiceRepository.ReplaceTaxes(ctx, tx, invoice.ID, invoice.Taxes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *PayableServiceImpl) FindInvoiceById(ctx context.Context, id uuid.UUID) (*payable.SupplierInvoiceResponse, error) {
+	invoice, err := service.SupplierInvoiceRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("supplier invoice not found")
+	}
+
+	return mapper.ToSupplierInvoiceResponse(invoice), nil
+}
+
+func (service *PayableServiceImpl) FindAllInvoices(ctx context.Context, filter payable.SupplierInvoiceFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	invoices, totalItems, err := service.SupplierInvoiceRepository.FindAllWithPagination(ctx, service.DB, filter.Status, filter.MatchStatus, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToSupplierInvoiceSummaryResponses(invoices)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PayableServiceImpl) MatchInvoice(ctx context.Context, id uuid.UUID) (*payable.InvoiceMatchResponse, error) {
+	invoice, err := service.SupplierInvoiceRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("supplier invoice not found")
+	}
+
+	order, err := service.PurchaseOrderRepository.FindById(ctx, service.DB, invoice.PurchaseOrderID)
+	if err != nil {
+		return nil, exception.NewNotFoundError("purchase order not found")
+	}
+
+	result, err := service.match(ctx, service.DB, invoice, order)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (service *PayableServiceImpl) ApproveInvoice(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*payable.SupplierInvoiceResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SupplierInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("supplier invoice not found")
+		}
+
+		if invoice.Status != domain.SupplierInvoiceStatusDraft {
+			return exception.NewError("only draft supplier invoices can be approved")
+		}
+
+		// Purchase order dikunci agar approval invoice lain dan goods receipt atas PO yang sama
+		// menunggu, sehingga kuantitas yang boleh ditagih tidak berubah sampai commit
+		order, err := service.PurchaseOrderRepository.FindByIdForUpdate(ctx, tx, invoice.PurchaseOrderID)
+		if err != nil {
+			return exception.NewNotFoundError("purchase order not found")
+		}
+
+		result, err := service.match(ctx, tx, invoice, order)
+		if err != nil {
+			return err
+		}
+		if result.MatchStatus != domain.InvoiceMatchStatusMatched {
+			return exception.NewError("supplier invoice does not match the purchase order and goods receipts within tolerance, see the variance report")
+		}
+
+		setting, err := loadPayableSetting(ctx, tx, service.PayableSettingRepository)
+		if err != nil {
+			return err
+		}
+		if setting.PurchaseAccountID == nil {
+			return exception.NewError("purchase account for payables is not configured")
+		}
+
+		// Kurs dikunci saat approval dan dipakai kembali saat pembayaran dan revaluasi
+		rate, err := service.CurrencyService.RateOn(ctx, tx, invoice.Currency, invoice.InvoiceDate)
+		if err != nil {
+			return err
+		}
+		journal, err := service.CurrencyService.ConvertEntry(ctx, tx, buildInvoiceJournal(invoice, *setting.PayableAccountID, *setting.PurchaseAccountID, userID), invoice.Currency, rate)
+		if err != nil {
+			return err
+		}
+		entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		invoice.Status = domain.SupplierInvoiceStatusApproved
+		invoice.MatchStatus = result.MatchStatus
+		invoice.ExchangeRate = rate
+		invoice.JournalEntryID = &entry.ID
+		invoice.ApprovedBy = &userID
+		invoice.ApprovedAt = &now
+		return service.SupplierInvoiceRepository.Update(ctx, tx, invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *PayableServiceImpl) CancelInvoice(ctx context.Context, id uuid.UUID) (*payable.SupplierInvoiceResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SupplierInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("supplier invoice not found")
+		}
+
+		if invoice.Status != domain.SupplierInvoiceStatusDraft {
+			return exception.NewError("only draft supplier invoices can be cancelled")
+		}
+
+		invoice.Status = domain.SupplierInvoiceStatusCancelled
+		return service.SupplierInvoiceRepository.Update(ctx, tx, invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *PayableServiceImpl) GetTolerance(ctx context.Context) (*payable.MatchToleranceResponse, error) {
+	tolerance, err := service.findTolerance(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToMatchToleranceResponse(tolerance), nil
+}
+
+func (service *PayableServiceImpl) UpdateTolerance(ctx context.Context, userID uuid.UUID, request payable.MatchToleranceRequest) (*payable.MatchToleranceResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	tolerance := domain.MatchTolerance{
+		QuantityPercent: helper.RoundMeasure(request.QuantityPercent),
+		PricePercent:    helper.RoundMeasure(request.PricePercent),
+		AmountAbsolute:  helper.RoundAmount(request.AmountAbsolute),
+		UpdatedBy:       &userID,
+	}
+	if err := service.MatchToleranceRepository.Save(ctx, service.DB, tolerance); err != nil {
+		return nil, err
+	}
+
+	return service.GetTolerance(ctx)
+}
+
+// applyRequest mengisi header dan baris invoice dari request setelah memastikan purchase order
+// bisa ditagih dan nomor invoice supplier belum pernah dipakai
+func (service *PayableServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, invoice *domain.SupplierInvoice, request payable.SupplierInvoiceRequest, invoiceDate time.Time) (domain.PurchaseOrder, error) {
+	order, err := service.PurchaseOrderRepository.FindById(ctx, tx, request.PurchaseOrderID)
+	if err != nil {
+		return domain.PurchaseOrder{}, exception.NewNotFoundError("purchase order not found")
+	}
+
+	switch order.Status {
+	case domain.PurchaseOrderStatusSent, domain.PurchaseOrderStatusPartiallyReceived, domain.PurchaseOrderStatusClosed:
+	default:
+		return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("purchase order with status %s cannot be invoiced", order.Status))
+	}
+
+	currency := strings.ToUpper(request.Currency)
+	if currency != order.Currency {
+		return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("invoice currency must match purchase order currency %s", order.Currency))
+	}
+
+	supplierInvoiceNo := strings.TrimSpace(request.SupplierInvoiceNo)
+	var excludeID *uuid.UUID
+	if invoice.Number != "" {
+		excludeID = &invoice.ID
+	}
+	exists, err := service.SupplierInvoiceRepository.ExistsSupplierInvoiceNo(ctx, tx, order.SupplierID, supplierInvoiceNo, excludeID)
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	if exists {
+		return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("supplier invoice number %s is already recorded for this supplier", supplierInvoiceNo))
+	}
+
+	orderLineByID := make(map[uuid.UUID]domain.PurchaseOrderLine, len(order.Lines))
+	for _, line := range order.Lines {
+		orderLineByID[line.ID] = line
+	}
+
+	lines := make([]domain.SupplierInvoiceLine, 0, len(request.Lines))
+	seen := make(map[uuid.UUID]bool, len(request.Lines))
+	var totalAmount decimal.Decimal
+	for i, line := range request.Lines {
+		orderLine, ok := orderLineByID[line.PurchaseOrderLineID]
+		if !ok {
+			return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("line %d: purchase order line does not belong to the purchase order", i+1))
+		}
+		if seen[orderLine.ID] {
+			return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("line %d: purchase order line is listed more than once", i+1))
+		}
+		seen[orderLine.ID] = true
+
+		description := line.Description
+		if description == "" {
+			description = orderLine.Description
+		}
+
+		invoiceLine := domain.SupplierInvoiceLine{
+			ID:                  uuid.New(),
+			SupplierInvoiceID:   invoice.ID,
+			PurchaseOrderLineID: orderLine.ID,
+			LineNo:              i + 1,
+			Description:         description,
+			Quantity:            helper.RoundQuantity(line.Quantity),
+			UnitPrice:           helper.RoundAmount(line.UnitPrice),
+		}
+		invoiceLine.LineTotal = helper.MultiplyAmount(invoiceLine.UnitPrice, invoiceLine.Quantity)
+		totalAmount = totalAmount.Add(invoiceLine.LineTotal)
+		lines = append(lines, invoiceLine)
+	}
+
+	subtotalAmount := helper.RoundAmount(totalAmount)
+	// Tarif PPh bergantung pada NPWP supplier, sehingga data supplier dibaca ulang dari master
+	supplier, err := service.SupplierRepository.FindById(ctx, tx, order.SupplierID)
+	if err != nil {
+		return domain.PurchaseOrder{}, exception.NewNotFoundError("supplier not found")
+	}
+	resolvedTaxes, err := service.TaxService.ResolveTaxLines(ctx, tx, taxService.TaxSidePurchase, subtotalAmount, toTaxLineInputs(request.Taxes), supplier.NPWP != "")
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	taxes, taxAmount := buildInvoiceTaxes(invoice.ID, resolvedTaxes)
+
+	// Jatuh tempo default mengikuti termin pembayaran purchase order
+	dueDate := invoiceDate.AddDate(0, 0, order.PaymentTermDays)
+	if request.DueDate != "" {
+		dueDate, err = helper.ParseDate(request.DueDate)
+		if err != nil {
+			return domain.PurchaseOrder{}, exception.NewError("invalid due date")
+		}
+		if dueDate.Before(invoiceDate) {
+			return domain.PurchaseOrder{}, exception.NewError("due date cannot be before invoice date")
+		}
+	}
+
+	invoice.SupplierInvoiceNo = supplierInvoiceNo
+	invoice.SupplierID = order.SupplierID
+	invoice.SupplierName = order.SupplierName
+	invoice.PurchaseOrderID = order.ID
+	invoice.InvoiceDate = invoiceDate
+	invoice.DueDate = dueDate
+	invoice.Currency = currency
+	invoice.Notes = request.Notes
+	invoice.SubtotalAmount = subtotalAmount
+	invoice.TaxAmount = taxAmount
+	invoice.TotalAmount = helper.RoundAmount(subtotalAmount.Add(taxAmount))
+	invoice.Lines = lines
+	invoice.Taxes = taxes
+
+	if !invoice.TotalAmount.IsPositive() {
+		return domain.PurchaseOrder{}, exception.NewError("invoice total after taxes must be greater than 0")
+	}
+	return order, nil
+}
+
+// refreshMatchStatus menyimpan hasil three-way match terkini pada header invoice
+func (service *PayableServiceImpl) refreshMatchStatus(ctx context.Context, tx *gorm.DB, invoice *domain.SupplierInvoice, order domain.PurchaseOrder) error {
+	result, err := service.match(ctx, tx, *invoice, order)
+	if err != nil {
+		return err
+	}
+	invoice.MatchStatus = result.MatchStatus
+	return nil
+}
+
+func (service *PayableServiceImpl) match(ctx context.Context, tx *gorm.DB, invoice domain.SupplierInvoice, order domain.PurchaseOrder) (payable.InvoiceMatchResponse, error) {
+	tolerance, err := service.findTolerance(ctx, tx)
+	if err != nil {
+		return payable.InvoiceMatchResponse{}, err
+	}
+
+	invoicedElsewhere, err := service.SupplierInvoiceRepository.SumApprovedQuantityByOrderLine(ctx, tx, order.ID, invoice.ID)
+	if err != nil {
+		return payable.InvoiceMatchResponse{}, err
+	}
+
+	return matchInvoice(invoice, order, invoicedElsewhere, tolerance), nil
+}
+
+// findTolerance mengembalikan toleransi nol (harus persis sama) jika belum pernah diatur
+func (service *PayableServiceImpl) findTolerance(ctx context.Context, tx *gorm.DB) (domain.MatchTolerance, error) {
+	tolerance, err := service.MatchToleranceRepository.Find(ctx, tx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.MatchTolerance{ID: domain.MatchToleranceID}, nil
+	}
+	if err != nil {
+		return domain.MatchTolerance{}, err
+	}
+	return tolerance, nil
+}
+
+func (service *PayableServiceImpl) GetSetting(ctx context.Context) (*payable.PayableSettingResponse, error) {
+	setting, err := service.PayableSettingRepository.Find(ctx, service.DB)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mapper.ToPayableSettingResponse(domain.PayableSetting{ID: domain.PayableSettingID}), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPayableSettingResponse(setting), nil
+}
+
+func (service *PayableServiceImpl) UpdateSetting(ctx context.Context, userID uuid.UUID, request payable.PayableSettingRequest) (*payable.PayableSettingResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.PayableAccountID, "payable account", domain.AccountTypeLiability); err != nil {
+			return err
+		}
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.PurchaseAccountID, "purchase account"); err != nil {
+			return err
+		}
+
+		return service.PayableSettingRepository.Save(ctx, tx, domain.PayableSetting{
+			PayableAccountID:  &request.PayableAccountID,
+			PurchaseAccountID: &request.PurchaseAccountID,
+			UpdatedBy:         &userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.GetSetting(ctx)
+}
+
+func (service *PayableServiceImpl) AgingReport(ctx context.Context, filter payable.AgingFilterRequest) (*payable.AgingReportResponse, error) {
+	asOf := helper.Today()
+	if filter.AsOf != "" {
+		parsed, err := helper.ParseDate(filter.AsOf)
+		if err != nil {
+			return nil, exception.NewError("as_of must be in format 2006-01-02")
+		}
+		asOf = parsed
+	}
+
+	supplierID, err := helper.ParseOptionalUUID(filter.SupplierID, "supplier_id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := service.SupplierInvoiceRepository.FindOutstandingAsOf(ctx, service.DB, asOf, supplierID, strings.ToUpper(filter.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAgingReport(asOf, rows), nil
+}
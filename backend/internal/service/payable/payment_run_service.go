@@ -0,0 +1,20 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+
+	"github.com/google/uuid"
+)
+
+type PaymentRunService interface {
+	// Create memilih invoice yang jatuh tempo dan menyusunnya menjadi batch pembayaran per supplier
+	Create(ctx context.Context, userID uuid.UUID, request payable.PaymentRunRequest) (*payable.PaymentRunResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*payable.PaymentRunResponse, error)
+	FindAll(ctx context.Context, filter payable.PaymentRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// Post memposting jurnal pembayaran setiap batch dan menambah nilai terbayar pada invoice
+	Post(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*payable.PaymentRunResponse, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*payable.PaymentRunResponse, error)
+}
@@ -0,0 +1,306 @@
+package payable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/payable"
+	repo "erpfinance/internal/repository/payable"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	supplierRepo "erpfinance/internal/repository/supplier"
+	currencyService "erpfinance/internal/service/currency"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PaymentRunNumberPrefix adalah prefix penomoran payment run, contoh: PY-202507-00001
+const PaymentRunNumberPrefix = "PY"
+
+type PaymentRunServiceImpl struct {
+	PaymentRunRepository      repo.PaymentRunRepository
+	SupplierInvoiceRepository repo.SupplierInvoiceRepository
+	PayableSettingRepository  repo.PayableSettingRepository
+	SupplierRepository        supplierRepo.SupplierRepository
+	SequenceRepository        sequenceRepo.SequenceRepository
+	CurrencyService           currencyService.CurrencyService
+	LedgerService             ledgerService.LedgerService
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewPaymentRunService(paymentRunRepository repo.PaymentRunRepository, supplierInvoiceRepository repo.SupplierInvoiceRepository, payableSettingRepository repo.PayableSettingRepository, supplierRepository supplierRepo.SupplierRepository, sequenceRepository sequenceRepo.SequenceRepository, currencyService currencyService.CurrencyService, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) PaymentRunService {
+	return &PaymentRunServiceImpl{
+		PaymentRunRepository:      paymentRunRepository,
+		SupplierInvoiceRepository: supplierInvoiceRepository,
+		PayableSettingRepository:  payableSettingRepository,
+		SupplierRepository:        supplierRepository,
+		SequenceRepository:        sequenceRepository,
+		CurrencyService:           currencyService,
+		LedgerService:             ledgerService,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *PaymentRunServiceImpl) Create(ctx context.Context, userID uuid.UUID, request payable.PaymentRunRequest) (*payable.PaymentRunResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	paymentDate, err := helper.ParseDate(request.PaymentDate)
+	if err != nil {
+		return nil, exception.NewError("invalid payment date")
+	}
+	dueUntil, err := helper.ParseDate(request.DueUntil)
+	if err != nil {
+		return nil, exception.NewError("invalid due until date")
+	}
+
+	var runID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		paymentAccount, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.PaymentAccountID, "payment account", domain.AccountTypeAsset)
+		if err != nil {
+			return err
+		}
+
+		invoices, err := service.SupplierInvoiceRepository.FindDueForPayment(ctx, tx, strings.ToUpper(request.Currency), dueUntil, request.SupplierIDs)
+		if err != nil {
+			return err
+		}
+		if len(invoices) == 0 {
+			return exception.NewError("no approved invoices are due for payment")
+		}
+
+		run := domain.PaymentRun{
+			ID:               uuid.New(),
+			PaymentDate:      paymentDate,
+			DueUntil:         dueUntil,
+			Currency:         strings.ToUpper(request.Currency),
+			PaymentAccountID: paymentAccount.ID,
+			Notes:            request.Notes,
+			Status:           domain.PaymentRunStatusDraft,
+			CreatedBy:        userID,
+		}
+
+		// Invoice sudah terurut per supplier sehingga batch cukup dibuat saat supplier berganti
+		var batch *domain.PaymentBatch
+		for _, invoice := range invoices {
+			if batch == nil || batch.SupplierID != invoice.SupplierID {
+				run.Batches = append(run.Batches, domain.PaymentBatch{
+					ID:           uuid.New(),
+					PaymentRunID: run.ID,
+					BatchNo:      len(run.Batches) + 1,
+					SupplierID:   invoice.SupplierID,
+					SupplierName: invoice.SupplierName,
+				})
+				batch = &run.Batches[len(run.Batches)-1]
+				if err := service.applyBankAccount(ctx, tx, batch); err != nil {
+					return err
+				}
+			}
+
+			amount := helper.RoundAmount(invoice.OutstandingAmount())
+			batch.Lines = append(batch.Lines, domain.PaymentBatchLine{
+				ID:                uuid.New(),
+				PaymentBatchID:    batch.ID,
+				SupplierInvoiceID: invoice.ID,
+				InvoiceNumber:     invoice.Number,
+				SupplierInvoiceNo: invoice.SupplierInvoiceNo,
+				DueDate:           invoice.DueDate,
+				Amount:            amount,
+			})
+			batch.TotalAmount = helper.RoundAmount(batch.TotalAmount.Add(amount))
+			run.TotalAmount = helper.RoundAmount(run.TotalAmount.Add(amount))
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, PaymentRunNumberPrefix, paymentDate)
+		if err != nil {
+			return err
+		}
+		run.Number = number
+
+		created, err := service.PaymentRunRepository.Create(ctx, tx, run)
+		if err != nil {
+			return err
+		}
+		runID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, runID)
+}
+
+func (service *PaymentRunServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*payable.PaymentRunResponse, error) {
+	run, err := service.PaymentRunRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("payment run not found")
+	}
+
+	return mapper.ToPaymentRunResponse(run), nil
+}
+
+func (service *PaymentRunServiceImpl) FindAll(ctx context.Context, filter payable.PaymentRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	runs, totalItems, err := service.PaymentRunRepository.FindAllWithPagination(ctx, service.DB, filter.Status, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToPaymentRunResponses(runs)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PaymentRunServiceImpl) Post(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*payable.PaymentRunResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		run, err := service.PaymentRunRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("payment run not found")
+		}
+
+		if run.Status != domain.PaymentRunStatusDraft {
+			return exception.NewError("only draft payment runs can be posted")
+		}
+
+		setting, err := loadPayableSetting(ctx, tx, service.PayableSettingRepository)
+		if err != nil {
+			return err
+		}
+
+		var invoiceIDs []uuid.UUID
+		for _, batch := range run.Batches {
+			for _, line := range batch.Lines {
+				invoiceIDs = append(invoiceIDs, line.SupplierInvoiceID)
+			}
+		}
+		invoices, err := service.SupplierInvoiceRepository.LockByIds(ctx, tx, invoiceIDs)
+		if err != nil {
+			return err
+		}
+		invoiceByID := make(map[uuid.UUID]domain.SupplierInvoice, len(invoices))
+		for _, invoice := range invoices {
+			invoiceByID[invoice.ID] = invoice
+		}
+
+		rate, err := service.CurrencyService.RateOn(ctx, tx, run.Currency, run.PaymentDate)
+		if err != nil {
+			return err
+		}
+
+		for _, batch := range run.Batches {
+			// Hutang dilunasi pada kurs invoice, pembayaran bank memakai kurs tanggal bayar
+			var payables []currencyService.RateAmount
+			for _, line := range batch.Lines {
+				invoice, ok := invoiceByID[line.SupplierInvoiceID]
+				if !ok || invoice.Status != domain.SupplierInvoiceStatusApproved {
+					return exception.NewError(fmt.Sprintf("invoice %s is no longer open for payment", line.InvoiceNumber))
+				}
+				if line.Amount.GreaterThan(helper.RoundAmount(invoice.OutstandingAmount())) {
+					return exception.NewError(fmt.Sprintf("payment for invoice %s exceeds its outstanding amount", line.InvoiceNumber))
+				}
+
+				payables = currencyService.AddRateAmount(payables, invoice.ExchangeRate, line.Amount)
+				invoice.PaidAmount = helper.RoundAmount(invoice.PaidAmount.Add(line.Amount))
+				if helper.IsZeroAmount(invoice.OutstandingAmount()) {
+					invoice.Status = domain.SupplierInvoiceStatusPaid
+				}
+				if err := service.SupplierInvoiceRepository.Update(ctx, tx, invoice); err != nil {
+					return err
+				}
+			}
+
+			journal := domain.JournalEntry{
+				EntryDate:   run.PaymentDate,
+				Description: fmt.Sprintf("Payment to %s", batch.SupplierName),
+				Reference:   run.Number,
+				SourceType:  domain.JournalSourcePaymentBatch,
+				SourceID:    &batch.ID,
+				CreatedBy:   userID,
+			}
+			for _, group := range payables {
+				journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: *setting.PayableAccountID, Description: "Payable " + batch.SupplierName, Debit: group.Amount, ExchangeRate: group.Rate})
+			}
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: run.PaymentAccountID, Description: "Payment " + run.Number, Credit: batch.TotalAmount})
+
+			journal, err = service.CurrencyService.ConvertEntry(ctx, tx, journal, run.Currency, rate)
+			if err != nil {
+				return err
+			}
+			entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+			if err != nil {
+				return err
+			}
+			if err := service.PaymentRunRepository.SetBatchJournal(ctx, tx, batch.ID, entry.ID); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		run.Status = domain.PaymentRunStatusPosted
+		run.ExchangeRate = rate
+		run.PostedBy = &userID
+		run.PostedAt = &now
+		return service.PaymentRunRepository.Update(ctx, tx, run)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *PaymentRunServiceImpl) Cancel(ctx context.Context, id uuid.UUID) (*payable.PaymentRunResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		run, err := service.PaymentRunRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("payment run not found")
+		}
+
+		if run.Status != domain.PaymentRunStatusDraft {
+			return exception.NewError("only draft payment runs can be cancelled")
+		}
+
+		run.Status = domain.PaymentRunStatusCancelled
+		return service.PaymentRunRepository.Update(ctx, tx, run)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// applyBankAccount menyalin rekening bank utama supplier (atau rekening pertama) ke batch
+func (service *PaymentRunServiceImpl) applyBankAccount(ctx context.Context, tx *gorm.DB, batch *domain.PaymentBatch) error {
+	supplier, err := service.SupplierRepository.FindById(ctx, tx, batch.SupplierID)
+	if err != nil {
+		return exception.NewNotFoundError("supplier not found")
+	}
+
+	if len(supplier.BankAccounts) == 0 {
+		return nil
+	}
+	account := supplier.BankAccounts[0]
+	for _, bankAccount := range supplier.BankAccounts {
+		if bankAccount.IsPrimary {
+			account = bankAccount
+			break
+		}
+	}
+
+	batch.BankName = account.BankName
+	batch.AccountNumber = account.AccountNumber
+	batch.AccountName = account.AccountName
+	return nil
+}
@@ -0,0 +1,17 @@
+package period
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PeriodCheckService dipakai oleh setiap proses yang menulis data keuangan untuk
+// memastikan tanggal transaksi berada di periode yang masih terbuka.
+type PeriodCheckService interface {
+	// EnsureOpen harus dipanggil di dalam DB.Transaction milik pemanggil. Row periode
+	// ditahan dengan FOR SHARE sampai transaksi selesai sehingga penutupan periode
+	// yang berjalan bersamaan akan menunggu.
+	EnsureOpen(ctx context.Context, tx *gorm.DB, date time.Time) error
+}
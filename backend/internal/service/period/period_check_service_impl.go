@@ -0,0 +1,39 @@
+package period
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	repo "erpfinance/internal/repository/period"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PeriodCheckServiceImpl struct {
+	PeriodRepository repo.PeriodRepository
+}
+
+func NewPeriodCheckService(periodRepository repo.PeriodRepository) PeriodCheckService {
+	return &PeriodCheckServiceImpl{
+		PeriodRepository: periodRepository,
+	}
+}
+
+func (service *PeriodCheckServiceImpl) EnsureOpen(ctx context.Context, tx *gorm.DB, date time.Time) error {
+	period, err := service.PeriodRepository.FindPeriodByDateForShare(ctx, tx, date)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.NewError(fmt.Sprintf("no accounting period is defined for %s", helper.FormatDate(date)))
+	}
+	if err != nil {
+		return err
+	}
+
+	if period.Status != domain.PeriodStatusOpen {
+		return exception.NewError(fmt.Sprintf("accounting period %s is %s, transactions dated %s cannot be posted", period.Name, period.Status, helper.FormatDate(date)))
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package period
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/period"
+
+	"github.com/google/uuid"
+)
+
+type PeriodService interface {
+	CreateFiscalYear(ctx context.Context, request period.FiscalYearCreateRequest) (*period.FiscalYearResponse, error)
+	FindFiscalYearById(ctx context.Context, id uuid.UUID) (*period.FiscalYearResponse, error)
+	FindAllFiscalYears(ctx context.Context, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	ChangeStatus(ctx context.Context, id uuid.UUID, userID uuid.UUID, request period.PeriodStatusRequest) (*period.PeriodResponse, error)
+	FindStatusHistory(ctx context.Context, id uuid.UUID) ([]period.PeriodStatusLogResponse, error)
+}
@@ -0,0 +1,198 @@
+package period
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/period"
+	repo "erpfinance/internal/repository/period"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// allowedTransitions berisi perubahan status periode yang diperbolehkan.
+// Periode yang sudah Locked tidak bisa dibuka kembali.
+var allowedTransitions = map[domain.PeriodStatus][]domain.PeriodStatus{
+	domain.PeriodStatusOpen:       {domain.PeriodStatusSoftClosed},
+	domain.PeriodStatusSoftClosed: {domain.PeriodStatusOpen, domain.PeriodStatusLocked},
+}
+
+type PeriodServiceImpl struct {
+	PeriodRepository repo.PeriodRepository
+	DB               *gorm.DB
+	Validate         *validator.Validate
+}
+
+func NewPeriodService(periodRepository repo.PeriodRepository, db *gorm.DB, validate *validator.Validate) PeriodService {
+	return &PeriodServiceImpl{
+		PeriodRepository: periodRepository,
+		DB:               db,
+		Validate:         validate,
+	}
+}
+
+func (service *PeriodServiceImpl) CreateFiscalYear(ctx context.Context, request period.FiscalYearCreateRequest) (*period.FiscalYearResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	startDate, err := helper.ParseDate(request.StartDate)
+	if err != nil {
+		return nil, exception.NewError("invalid start date")
+	}
+	if startDate.Day() != 1 {
+		return nil, exception.NewError("fiscal year must start on the first day of a month")
+	}
+	endDate := startDate.AddDate(1, 0, -1)
+
+	var fiscalYearID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.PeriodRepository.FindFiscalYearByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("fiscal year code already exists")
+		}
+
+		overlapping, err := service.PeriodRepository.CountOverlappingFiscalYears(ctx, tx, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return exception.NewError("fiscal year overlaps with an existing fiscal year")
+		}
+
+		fiscalYear := domain.FiscalYear{
+			ID:        uuid.New(),
+			Code:      request.Code,
+			StartDate: startDate,
+			EndDate:   endDate,
+		}
+
+		// Buat 12 periode bulanan, semuanya dalam status Open
+		for i := 0; i < 12; i++ {
+			periodStart := startDate.AddDate(0, i, 0)
+			fiscalYear.Periods = append(fiscalYear.Periods, domain.AccountingPeriod{
+				ID:           uuid.New(),
+				FiscalYearID: fiscalYear.ID,
+				PeriodNo:     i + 1,
+				Name:         periodStart.Format("2006-01"),
+				StartDate:    periodStart,
+				EndDate:      periodStart.AddDate(0, 1, -1),
+				Status:       domain.PeriodStatusOpen,
+			})
+		}
+
+		created, err := service.PeriodRepository.CreateFiscalYear(ctx, tx, fiscalYear)
+		if err != nil {
+			return err
+		}
+		fiscalYearID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindFiscalYearById(ctx, fiscalYearID)
+}
+
+func (service *PeriodServiceImpl) FindFiscalYearById(ctx context.Context, id uuid.UUID) (*period.FiscalYearResponse, error) {
+	fiscalYear, err := service.PeriodRepository.FindFiscalYearById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("fiscal year not found")
+	}
+
+	return mapper.ToFiscalYearResponse(fiscalYear), nil
+}
+
+func (service *PeriodServiceImpl) FindAllFiscalYears(ctx context.Context, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	fiscalYears, totalItems, err := service.PeriodRepository.FindAllFiscalYearsWithPagination(ctx, service.DB, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToFiscalYearResponses(fiscalYears)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PeriodServiceImpl) ChangeStatus(ctx context.Context, id uuid.UUID, userID uuid.UUID, request period.PeriodStatusRequest) (*period.PeriodResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var updated domain.AccountingPeriod
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := service.PeriodRepository.FindPeriodByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("accounting period not found")
+		}
+
+		if !isAllowedTransition(current.Status, request.Status) {
+			return exception.NewError(fmt.Sprintf("cannot change period status from %s to %s", current.Status, request.Status))
+		}
+
+		// Periode dikunci berurutan: semua periode sebelumnya harus sudah Locked
+		if request.Status == domain.PeriodStatusLocked {
+			earlier, err := service.PeriodRepository.CountEarlierNotLocked(ctx, tx, current.StartDate)
+			if err != nil {
+				return err
+			}
+			if earlier > 0 {
+				return exception.NewError("all earlier periods must be locked before locking this period")
+			}
+		}
+
+		if err := service.PeriodRepository.UpdatePeriodStatus(ctx, tx, current.ID, request.Status); err != nil {
+			return err
+		}
+
+		err = service.PeriodRepository.CreateStatusLog(ctx, tx, domain.PeriodStatusLog{
+			ID:         uuid.New(),
+			PeriodID:   current.ID,
+			FromStatus: current.Status,
+			ToStatus:   request.Status,
+			Reason:     request.Reason,
+			ChangedBy:  userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		updated, err = service.PeriodRepository.FindPeriodById(ctx, tx, current.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPeriodResponse(updated), nil
+}
+
+func (service *PeriodServiceImpl) FindStatusHistory(ctx context.Context, id uuid.UUID) ([]period.PeriodStatusLogResponse, error) {
+	if _, err := service.PeriodRepository.FindPeriodById(ctx, service.DB, id); err != nil {
+		return nil, exception.NewNotFoundError("accounting period not found")
+	}
+
+	logs, err := service.PeriodRepository.FindStatusLogsByPeriodId(ctx, service.DB, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPeriodStatusLogResponses(logs), nil
+}
+
+func isAllowedTransition(from, to domain.PeriodStatus) bool {
+	for _, allowed := range allowedTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package ppc
+
+import (
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// mrpEvent adalah satu kebutuhan (Quantity negatif) atau pasokan (Quantity positif) pada tanggal tertentu
+type mrpEvent struct {
+	Date     time.Time
+	Quantity float64
+}
+
+// mrpPlanner menghitung MRP untuk satu gudang. Semua data dimuat di awal run sehingga
+// perhitungannya murni di memori dan tidak menahan lock di database.
+type mrpPlanner struct {
+	runID        uuid.UUID
+	horizonStart time.Time
+	horizonEnd   time.Time
+	items        map[uuid.UUID]domain.Item
+	boms         map[uuid.UUID]domain.BillOfMaterial
+	onHand       map[uuid.UUID]float64
+	receipts     map[uuid.UUID][]mrpEvent
+	requirements map[uuid.UUID][]mrpEvent
+	lowLevelCode map[uuid.UUID]int
+
+	results     []domain.MRPItemResult
+	suggestions []domain.MRPSuggestion
+}
+
+func newMRPPlanner(run domain.MRPRun, items map[uuid.UUID]domain.Item, boms map[uuid.UUID]domain.BillOfMaterial) *mrpPlanner {
+	return &mrpPlanner{
+		runID:        run.ID,
+		horizonStart: run.HorizonStart,
+		horizonEnd:   run.HorizonEnd,
+		items:        items,
+		boms:         boms,
+		onHand:       make(map[uuid.UUID]float64),
+		receipts:     make(map[uuid.UUID][]mrpEvent),
+		requirements: make(map[uuid.UUID][]mrpEvent),
+		lowLevelCode: make(map[uuid.UUID]int),
+	}
+}
+
+// planningDate menggeser tanggal sebelum horizon ke awal horizon; ok false jika tanggal
+// berada setelah akhir horizon sehingga tidak ikut dihitung
+func (planner *mrpPlanner) planningDate(date time.Time) (time.Time, bool) {
+	if date.After(planner.horizonEnd) {
+		return time.Time{}, false
+	}
+	if date.Before(planner.horizonStart) {
+		return planner.horizonStart, true
+	}
+	return date, true
+}
+
+func (planner *mrpPlanner) addRequirement(itemID uuid.UUID, quantity float64, date time.Time) {
+	if quantity <= 0 {
+		return
+	}
+	if date, ok := planner.planningDate(date); ok {
+		planner.requirements[itemID] = append(planner.requirements[itemID], mrpEvent{Date: date, Quantity: -quantity})
+	}
+}
+
+func (planner *mrpPlanner) addReceipt(itemID uuid.UUID, quantity float64, date time.Time) {
+	if quantity <= 0 {
+		return
+	}
+	if date, ok := planner.planningDate(date); ok {
+		planner.receipts[itemID] = append(planner.receipts[itemID], mrpEvent{Date: date, Quantity: quantity})
+	}
+}
+
+// computeLowLevelCodes menghitung level terdalam setiap item di struktur BOM default.
+// Setiap iterasi menurunkan komponen minimal satu level di bawah induknya; jika masih ada
+// perubahan setelah iterasi sebanyak jumlah BOM berarti ada siklus.
+func (planner *mrpPlanner) computeLowLevelCodes() error {
+	for iteration := 0; ; iteration++ {
+		if iteration > len(planner.boms) {
+			return exception.NewError("default bills of material contain a cycle")
+		}
+
+		changed := false
+		for parentID, bom := range planner.boms {
+			level := planner.lowLevelCode[parentID] + 1
+			for _, line := range bom.Lines {
+				if planner.lowLevelCode[line.ComponentItemID] < level {
+					planner.lowLevelCode[line.ComponentItemID] = level
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+}
+
+// levels mengelompokkan item per low level code, diurutkan per kode item
+func (planner *mrpPlanner) levels() [][]uuid.UUID {
+	maxLevel := 0
+	for _, level := range planner.lowLevelCode {
+		if level > maxLevel {
+			maxLevel = level
+		}
+	}
+
+	levels := make([][]uuid.UUID, maxLevel+1)
+	for itemID := range planner.items {
+		level := planner.lowLevelCode[itemID]
+		levels[level] = append(levels[level], itemID)
+	}
+	for _, itemIDs := range levels {
+		sort.Slice(itemIDs, func(i, j int) bool {
+			return planner.items[itemIDs[i]].Code < planner.items[itemIDs[j]].Code
+		})
+	}
+	return levels
+}
+
+// planItem melakukan netting satu item: saldo awal adalah stok on-hand, lalu per tanggal
+// pasokan ditambahkan sebelum kebutuhan dikurangkan. Saat saldo negatif dibuat saran order
+// sebesar kekurangan (minimal min order qty item) yang jatuh tempo di tanggal tersebut.
+// Item dengan BOM default menghasilkan saran produksi dan kebutuhan komponennya diturunkan
+// ke tanggal release.
+func (planner *mrpPlanner) planItem(itemID uuid.UUID) {
+	requirements := planner.requirements[itemID]
+	if len(requirements) == 0 {
+		return
+	}
+	item := planner.items[itemID]
+	bom, hasBOM := planner.boms[itemID]
+
+	events := append(append([]mrpEvent{}, planner.receipts[itemID]...), requirements...)
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Date.Equal(events[j].Date) {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].Quantity > events[j].Quantity
+	})
+
+	result := domain.MRPItemResult{
+		ID:           uuid.New(),
+		MRPRunID:     planner.runID,
+		ItemID:       itemID,
+		LowLevelCode: planner.lowLevelCode[itemID],
+		OnHand:       helper.RoundQuantity(planner.onHand[itemID]),
+	}
+
+	projected := result.OnHand
+	for _, event := range events {
+		projected = helper.RoundQuantity(projected + event.Quantity)
+		if event.Quantity > 0 {
+			result.ScheduledReceipts = helper.RoundQuantity(result.ScheduledReceipts + event.Quantity)
+			continue
+		}
+		result.GrossRequirement = helper.RoundQuantity(result.GrossRequirement - event.Quantity)
+		if projected >= 0 {
+			continue
+		}
+
+		net := -projected
+		quantity := net
+		if quantity < item.MinOrderQty {
+			quantity = item.MinOrderQty
+		}
+		projected = helper.RoundQuantity(projected + quantity)
+		result.NetRequirement = helper.RoundQuantity(result.NetRequirement + net)
+		result.PlannedQuantity = helper.RoundQuantity(result.PlannedQuantity + quantity)
+
+		releaseDate := event.Date.AddDate(0, 0, -item.LeadTimeDays)
+		suggestion := domain.MRPSuggestion{
+			ID:             uuid.New(),
+			MRPRunID:       planner.runID,
+			ItemID:         itemID,
+			SuggestionType: domain.MRPSuggestionPurchase,
+			NetRequirement: net,
+			Quantity:       quantity,
+			ReleaseDate:    releaseDate,
+			DueDate:        event.Date,
+			IsPastDue:      releaseDate.Before(planner.horizonStart),
+			Status:         domain.MRPSuggestionStatusOpen,
+		}
+		if hasBOM {
+			suggestion.SuggestionType = domain.MRPSuggestionProduction
+			for _, line := range bom.Lines {
+				planner.addRequirement(line.ComponentItemID, helper.RoundQuantity(line.GrossQuantity(bom.Quantity, quantity)), releaseDate)
+			}
+		}
+		planner.suggestions = append(planner.suggestions, suggestion)
+	}
+
+	result.EndingBalance = projected
+	planner.results = append(planner.results, result)
+}
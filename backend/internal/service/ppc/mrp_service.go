@@ -0,0 +1,28 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+
+	"github.com/google/uuid"
+)
+
+type MRPService interface {
+	// StartRun menyimpan run berstatus Queued lalu menjalankan perhitungannya di background;
+	// status dan progress dipantau lewat FindRunById. Job hanya berjalan di goroutine proses ini,
+	// jadi run yang belum selesai saat server berhenti tidak dilanjutkan (lihat FailInterruptedRuns).
+	StartRun(ctx context.Context, userID uuid.UUID, request ppc.MRPRunRequest) (*ppc.MRPRunResponse, error)
+	// FailInterruptedRuns dipanggil saat server start untuk menandai run Queued/Running peninggalan
+	// proses sebelumnya sebagai Failed; run tersebut perlu dijalankan ulang oleh user. Mengasumsikan
+	// hanya satu instance server yang menjalankan MRP.
+	FailInterruptedRuns(ctx context.Context) error
+	FindRunById(ctx context.Context, id uuid.UUID) (*ppc.MRPRunResponse, error)
+	FindAllRuns(ctx context.Context, filter ppc.MRPRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	FindRunResults(ctx context.Context, id uuid.UUID) ([]ppc.MRPItemResultResponse, error)
+	FindRunSuggestions(ctx context.Context, id uuid.UUID, filter ppc.MRPSuggestionFilterRequest) ([]ppc.MRPSuggestionResponse, error)
+
+	// ConvertSuggestions membuat satu purchase requisition Draft untuk seluruh saran Purchase
+	// dan satu work order Draft per saran Production
+	ConvertSuggestions(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.MRPConvertRequest) (*ppc.MRPConvertResponse, error)
+}
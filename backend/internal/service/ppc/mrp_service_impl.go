@@ -0,0 +1,485 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/ppc"
+	purchasingRepo "erpfinance/internal/repository/purchasing"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	purchasingService "erpfinance/internal/service/purchasing"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MRPRunNumberPrefix adalah prefix penomoran run MRP, contoh: MRP-202507-00001
+const MRPRunNumberPrefix = "MRP"
+
+type MRPServiceImpl struct {
+	MRPRunRepository         repo.MRPRunRepository
+	BillOfMaterialRepository repo.BillOfMaterialRepository
+	WorkOrderRepository      repo.WorkOrderRepository
+	ItemRepository           inventoryRepo.ItemRepository
+	WarehouseRepository      inventoryRepo.WarehouseRepository
+	StockMovementRepository  inventoryRepo.StockMovementRepository
+	RequisitionRepository    purchasingRepo.RequisitionRepository
+	PurchaseOrderRepository  purchasingRepo.PurchaseOrderRepository
+	SequenceRepository       sequenceRepo.SequenceRepository
+	PurchasingService        purchasingService.PurchasingService
+	WorkOrderService         WorkOrderService
+	DB                       *gorm.DB
+	Validate                 *validator.Validate
+}
+
+func NewMRPService(mrpRunRepository repo.MRPRunRepository, billOfMaterialRepository repo.BillOfMaterialRepository, workOrderRepository repo.WorkOrderRepository, itemRepository inventoryRepo.ItemRepository, warehouseRepository inventoryRepo.WarehouseRepository, stockMovementRepository inventoryRepo.StockMovementRepository, requisitionRepository purchasingRepo.RequisitionRepository, purchaseOrderRepository purchasingRepo.PurchaseOrderRepository, sequenceRepository sequenceRepo.SequenceRepository, purchasingService purchasingService.PurchasingService, workOrderService WorkOrderService, db *gorm.DB, validate *validator.Validate) MRPService {
+	return &MRPServiceImpl{
+		MRPRunRepository:         mrpRunRepository,
+		BillOfMaterialRepository: billOfMaterialRepository,
+		WorkOrderRepository:      workOrderRepository,
+		ItemRepository:           itemRepository,
+		WarehouseRepository:      warehouseRepository,
+		StockMovementRepository:  stockMovementRepository,
+		RequisitionRepository:    requisitionRepository,
+		PurchaseOrderRepository:  purchaseOrderRepository,
+		SequenceRepository:       sequenceRepository,
+		PurchasingService:        purchasingService,
+		WorkOrderService:         workOrderService,
+		DB:                       db,
+		Validate:                 validate,
+	}
+}
+
+func (service *MRPServiceImpl) StartRun(ctx context.Context, userID uuid.UUID, request ppc.MRPRunRequest) (*ppc.MRPRunResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	horizonStart, err := helper.ParseDate(request.HorizonStart)
+	if err != nil {
+		return nil, exception.NewError("invalid horizon start")
+	}
+	horizonEnd, err := helper.ParseDate(request.HorizonEnd)
+	if err != nil {
+		return nil, exception.NewError("invalid horizon end")
+	}
+	if horizonEnd.Before(horizonStart) {
+		return nil, exception.NewError("horizon end cannot be before horizon start")
+	}
+
+	run := domain.MRPRun{
+		ID:           uuid.New(),
+		WarehouseID:  request.WarehouseID,
+		HorizonStart: horizonStart,
+		HorizonEnd:   horizonEnd,
+		Status:       domain.MRPRunStatusQueued,
+		Notes:        request.Notes,
+		CreatedBy:    userID,
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		warehouse, err := service.WarehouseRepository.FindById(ctx, tx, request.WarehouseID)
+		if err != nil {
+			return exception.NewNotFoundError("warehouse not found")
+		}
+		if !warehouse.IsActive {
+			return exception.NewError(fmt.Sprintf("warehouse %s is inactive", warehouse.Code))
+		}
+
+		for i, demandRequest := range request.Demands {
+			dueDate, err := helper.ParseDate(demandRequest.DueDate)
+			if err != nil {
+				return exception.NewError(fmt.Sprintf("demand %d: invalid due date", i+1))
+			}
+			if dueDate.Before(horizonStart) || dueDate.After(horizonEnd) {
+				return exception.NewError(fmt.Sprintf("demand %d: due date must be within the planning horizon", i+1))
+			}
+			if _, err := findActiveItem(ctx, tx, service.ItemRepository, demandRequest.ItemID); err != nil {
+				return err
+			}
+
+			run.Demands = append(run.Demands, domain.MRPDemand{
+				ID:        uuid.New(),
+				MRPRunID:  run.ID,
+				LineNo:    i + 1,
+				ItemID:    demandRequest.ItemID,
+				Quantity:  helper.RoundQuantity(demandRequest.Quantity),
+				DueDate:   dueDate,
+				Reference: demandRequest.Reference,
+			})
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, MRPRunNumberPrefix, helper.Today())
+		if err != nil {
+			return err
+		}
+		run.Number = number
+
+		_, err = service.MRPRunRepository.Create(ctx, tx, run)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Context request sudah selesai saat run berjalan, jadi job memakai context sendiri. Job tidak
+	// dipersistenkan; bila proses berhenti, run ditandai Failed oleh FailInterruptedRuns saat start.
+	go service.execute(run.ID)
+
+	return service.FindRunById(ctx, run.ID)
+}
+
+func (service *MRPServiceImpl) FailInterruptedRuns(ctx context.Context) error {
+	count, err := service.MRPRunRepository.FailUnfinished(ctx, service.DB, "MRP run was interrupted by a server restart, please start a new run")
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		log.Printf("WARNING: %d unfinished mrp run(s) marked as failed after restart", count)
+	}
+	return nil
+}
+
+func (service *MRPServiceImpl) FindRunById(ctx context.Context, id uuid.UUID) (*ppc.MRPRunResponse, error) {
+	run, err := service.MRPRunRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("mrp run not found")
+	}
+
+	return mapper.ToMRPRunResponse(run), nil
+}
+
+func (service *MRPServiceImpl) FindAllRuns(ctx context.Context, filter ppc.MRPRunFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	runs, totalItems, err := service.MRPRunRepository.FindAllWithPagination(ctx, service.DB, filter.Status, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToMRPRunResponses(runs)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *MRPServiceImpl) FindRunResults(ctx context.Context, id uuid.UUID) ([]ppc.MRPItemResultResponse, error) {
+	if _, err := service.MRPRunRepository.FindById(ctx, service.DB, id); err != nil {
+		return nil, exception.NewNotFoundError("mrp run not found")
+	}
+
+	results, err := service.MRPRunRepository.FindItemResults(ctx, service.DB, id)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToMRPItemResultResponses(results), nil
+}
+
+func (service *MRPServiceImpl) FindRunSuggestions(ctx context.Context, id uuid.UUID, filter ppc.MRPSuggestionFilterRequest) ([]ppc.MRPSuggestionResponse, error) {
+	if _, err := service.MRPRunRepository.FindById(ctx, service.DB, id); err != nil {
+		return nil, exception.NewNotFoundError("mrp run not found")
+	}
+
+	suggestions, err := service.MRPRunRepository.FindSuggestions(ctx, service.DB, id, filter.Type, filter.Status)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToMRPSuggestionResponses(suggestions), nil
+}
+
+func (service *MRPServiceImpl) ConvertSuggestions(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.MRPConvertRequest) (*ppc.MRPConvertResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	response := &ppc.MRPConvertResponse{WorkOrderNumbers: []string{}}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		run, err := service.MRPRunRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("mrp run not found")
+		}
+		if run.Status != domain.MRPRunStatusCompleted {
+			return exception.NewError("only suggestions of completed mrp runs can be converted")
+		}
+
+		suggestions, err := service.MRPRunRepository.LockOpenSuggestions(ctx, tx, run.ID, request.SuggestionIDs)
+		if err != nil {
+			return err
+		}
+		if len(suggestions) == 0 {
+			return exception.NewError("there are no open suggestions to convert")
+		}
+		if len(request.SuggestionIDs) > 0 && len(suggestions) != len(uniqueIDs(request.SuggestionIDs)) {
+			return exception.NewError("some suggestions are already converted or do not belong to this mrp run")
+		}
+
+		itemIDs := make([]uuid.UUID, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			itemIDs = append(itemIDs, suggestion.ItemID)
+		}
+		items, err := service.ItemRepository.FindByIds(ctx, tx, itemIDs)
+		if err != nil {
+			return err
+		}
+		itemByID := make(map[uuid.UUID]domain.Item, len(items))
+		for _, item := range items {
+			itemByID[item.ID] = item
+		}
+
+		today := helper.Today()
+		notes := fmt.Sprintf("Generated from MRP run %s", run.Number)
+
+		requisition := domain.PurchaseRequisition{
+			RequestDate: today,
+			Notes:       notes,
+			RequestedBy: userID,
+		}
+		var purchaseIndexes []int
+		for i := range suggestions {
+			suggestion := &suggestions[i]
+			item := itemByID[suggestion.ItemID]
+
+			if suggestion.SuggestionType == domain.MRPSuggestionPurchase {
+				if requisition.RequiredDate.IsZero() || suggestion.DueDate.Before(requisition.RequiredDate) {
+					requisition.RequiredDate = suggestion.DueDate
+				}
+				itemID := item.ID
+				requisition.Lines = append(requisition.Lines, domain.PurchaseRequisitionLine{
+					ItemID:             &itemID,
+					Description:        item.Code + " - " + item.Name,
+					Quantity:           suggestion.Quantity,
+					UOM:                item.UOM,
+					EstimatedUnitPrice: item.StandardCost,
+				})
+				purchaseIndexes = append(purchaseIndexes, i)
+				continue
+			}
+
+			// Saran yang sudah lewat release date tetap dibuat mulai hari ini
+			startDate := suggestion.ReleaseDate
+			if startDate.Before(today) {
+				startDate = today
+			}
+			endDate := suggestion.DueDate
+			if endDate.Before(startDate) {
+				endDate = startDate
+			}
+
+			workOrder, err := service.WorkOrderService.CreatePlanned(ctx, tx, domain.WorkOrder{
+				ItemID:           suggestion.ItemID,
+				WarehouseID:      run.WarehouseID,
+				PlannedQuantity:  suggestion.Quantity,
+				PlannedStartDate: startDate,
+				PlannedEndDate:   endDate,
+				Notes:            notes,
+				CreatedBy:        userID,
+			})
+			if err != nil {
+				return err
+			}
+			suggestion.DocumentID = &workOrder.ID
+			suggestion.DocumentNumber = workOrder.Number
+			response.WorkOrderNumbers = append(response.WorkOrderNumbers, workOrder.Number)
+		}
+
+		if len(requisition.Lines) > 0 {
+			if requisition.RequiredDate.Before(today) {
+				requisition.RequiredDate = today
+			}
+			created, err := service.PurchasingService.CreatePlannedRequisition(ctx, tx, requisition)
+			if err != nil {
+				return err
+			}
+			for _, index := range purchaseIndexes {
+				suggestions[index].DocumentID = &created.ID
+				suggestions[index].DocumentNumber = created.Number
+			}
+			response.PurchaseRequisitionID = &created.ID
+			response.PurchaseRequisitionNumber = created.Number
+		}
+
+		for _, suggestion := range suggestions {
+			suggestion.Status = domain.MRPSuggestionStatusConverted
+			if err := service.MRPRunRepository.UpdateSuggestion(ctx, tx, suggestion); err != nil {
+				return err
+			}
+		}
+		response.ConvertedCount = len(suggestions)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// execute menjalankan perhitungan run di background. Error maupun panic dicatat ke run
+// sebagai status Failed agar tidak ada run yang tertahan di status Running.
+func (service *MRPServiceImpl) execute(runID uuid.UUID) {
+	ctx := context.Background()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			service.finish(ctx, runID, fmt.Errorf("unexpected error: %v", recovered))
+		}
+	}()
+
+	service.finish(ctx, runID, service.plan(ctx, runID))
+}
+
+func (service *MRPServiceImpl) finish(ctx context.Context, runID uuid.UUID, planErr error) {
+	run, err := service.MRPRunRepository.FindById(ctx, service.DB, runID)
+	if err != nil {
+		log.Printf("ERROR: Failed to load mrp run %s: %v", runID, err)
+		return
+	}
+
+	now := time.Now()
+	run.FinishedAt = &now
+	if planErr != nil {
+		log.Printf("ERROR: MRP run %s failed: %v", run.Number, planErr)
+		run.Status = domain.MRPRunStatusFailed
+		run.Message = planErr.Error()
+	} else {
+		run.Status = domain.MRPRunStatusCompleted
+		run.Progress = 100
+		run.Message = "MRP run completed"
+	}
+
+	if err := service.MRPRunRepository.Update(ctx, service.DB, run); err != nil {
+		log.Printf("ERROR: Failed to update mrp run %s: %v", run.Number, err)
+	}
+}
+
+// plan memuat data perencanaan, menghitung netting per low level code dan menyimpan hasilnya.
+// Pasokan dari purchase order dan requisition tidak terikat gudang sehingga seluruhnya
+// dihitung sebagai pasokan gudang run.
+func (service *MRPServiceImpl) plan(ctx context.Context, runID uuid.UUID) error {
+	run, err := service.MRPRunRepository.FindById(ctx, service.DB, runID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	run.Status = domain.MRPRunStatusRunning
+	run.StartedAt = &now
+	run.Message = "Loading planning data"
+	if err := service.MRPRunRepository.Update(ctx, service.DB, run); err != nil {
+		return err
+	}
+
+	boms, err := service.BillOfMaterialRepository.FindAllDefault(ctx, service.DB)
+	if err != nil {
+		return err
+	}
+	workOrders, err := service.WorkOrderRepository.FindUnfinishedByWarehouse(ctx, service.DB, run.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	itemIDs := make([]uuid.UUID, 0)
+	for _, demand := range run.Demands {
+		itemIDs = append(itemIDs, demand.ItemID)
+	}
+	for itemID, bom := range boms {
+		itemIDs = append(itemIDs, itemID)
+		for _, line := range bom.Lines {
+			itemIDs = append(itemIDs, line.ComponentItemID)
+		}
+	}
+	for _, workOrder := range workOrders {
+		itemIDs = append(itemIDs, workOrder.ItemID)
+		for _, material := range workOrder.Materials {
+			itemIDs = append(itemIDs, material.ItemID)
+		}
+	}
+
+	items := make(map[uuid.UUID]domain.Item)
+	if ids := uniqueIDs(itemIDs); len(ids) > 0 {
+		found, err := service.ItemRepository.FindByIds(ctx, service.DB, ids)
+		if err != nil {
+			return err
+		}
+		for _, item := range found {
+			items[item.ID] = item
+		}
+	}
+
+	planner := newMRPPlanner(run, items, boms)
+
+	balances, err := service.StockMovementRepository.FindBalances(ctx, service.DB, nil, &run.WarehouseID)
+	if err != nil {
+		return err
+	}
+	for _, balance := range balances {
+		planner.onHand[balance.ItemID] += balance.Quantity
+	}
+
+	for _, demand := range run.Demands {
+		planner.addRequirement(demand.ItemID, demand.Quantity, demand.DueDate)
+	}
+	for _, workOrder := range workOrders {
+		planner.addReceipt(workOrder.ItemID, helper.RoundQuantity(workOrder.PlannedQuantity-workOrder.CompletedQuantity), workOrder.PlannedEndDate)
+		for _, material := range workOrder.Materials {
+			planner.addRequirement(material.ItemID, helper.RoundQuantity(material.RequiredQuantity-material.IssuedQuantity), workOrder.PlannedStartDate)
+		}
+	}
+
+	orderSupply, err := service.PurchaseOrderRepository.FindOpenSupply(ctx, service.DB)
+	if err != nil {
+		return err
+	}
+	requisitionSupply, err := service.RequisitionRepository.FindOpenSupply(ctx, service.DB)
+	if err != nil {
+		return err
+	}
+	for _, supply := range append(orderSupply, requisitionSupply...) {
+		planner.addReceipt(supply.ItemID, supply.Quantity, supply.Date)
+	}
+
+	if err := service.MRPRunRepository.UpdateProgress(ctx, service.DB, run.ID, 10, "Computing low level codes"); err != nil {
+		return err
+	}
+	if err := planner.computeLowLevelCodes(); err != nil {
+		return err
+	}
+
+	levels := planner.levels()
+	for level, itemIDs := range levels {
+		progress := 20 + 70*level/len(levels)
+		message := fmt.Sprintf("Netting level %d of %d", level+1, len(levels))
+		if err := service.MRPRunRepository.UpdateProgress(ctx, service.DB, run.ID, progress, message); err != nil {
+			return err
+		}
+		for _, itemID := range itemIDs {
+			planner.planItem(itemID)
+		}
+	}
+
+	if err := service.MRPRunRepository.UpdateProgress(ctx, service.DB, run.ID, 90, "Saving results"); err != nil {
+		return err
+	}
+	return service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return service.MRPRunRepository.SaveResults(ctx, tx, run.ID, planner.results, planner.suggestions)
+	})
+}
+
+// uniqueIDs membuang id duplikat dengan tetap menjaga urutan
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/ppc"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/ppc"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// findActiveItem memastikan item ada dan aktif
+func findActiveItem(ctx context.Context, tx *gorm.DB, itemRepository inventoryRepo.ItemRepository, id uuid.UUID) (domain.Item, error) {
+	item, err := itemRepository.FindById(ctx, tx, id)
+	if err != nil {
+		return domain.Item{}, exception.NewNotFoundError("item not found")
+	}
+	if !item.IsActive {
+		return domain.Item{}, exception.NewError(fmt.Sprintf("item %s is inactive", item.Code))
+	}
+	return item, nil
+}
+
+// bomExplosion menguraikan BOM bertingkat. Item dan BOM default di-cache karena sub-assembly
+// yang sama bisa muncul di beberapa cabang.
+type bomExplosion struct {
+	ctx                      context.Context
+	tx                       *gorm.DB
+	itemRepository           inventoryRepo.ItemRepository
+	billOfMaterialRepository repo.BillOfMaterialRepository
+	items                    map[uuid.UUID]domain.Item
+	boms                     map[uuid.UUID]*domain.BillOfMaterial
+}
+
+// explode mengembalikan komponen bom untuk quantity item induk beserta total biaya material.
+// Komponen yang punya BOM default diuraikan lagi dan biayanya di-roll-up dari komponennya;
+// komponen lain memakai standard cost item. path berisi item induk di cabang saat ini untuk
+// mendeteksi siklus dari data lama.
+func (explosion *bomExplosion) explode(bom domain.BillOfMaterial, quantity float64, level int, path map[uuid.UUID]bool) ([]ppc.BOMNodeResponse, decimal.Decimal, error) {
+	componentIDs := make([]uuid.UUID, 0, len(bom.Lines))
+	for _, line := range bom.Lines {
+		componentIDs = append(componentIDs, line.ComponentItemID)
+	}
+	if err := explosion.load(componentIDs); err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	var nodes []ppc.BOMNodeResponse
+	var totalCost decimal.Decimal
+	for _, line := range bom.Lines {
+		component := explosion.items[line.ComponentItemID]
+		node := ppc.BOMNodeResponse{
+			Level:    level,
+			ItemID:   component.ID,
+			ItemCode: component.Code,
+			ItemName: component.Name,
+			UOM:      component.UOM,
+			Quantity: helper.RoundQuantity(line.GrossQuantity(bom.Quantity, quantity)),
+			UnitCost: component.StandardCost,
+		}
+
+		if subBOM := explosion.boms[component.ID]; subBOM != nil {
+			if path[component.ID] {
+				return nil, decimal.Zero, exception.NewError(fmt.Sprintf("bill of material contains a cycle at item %s", component.Code))
+			}
+			path[component.ID] = true
+			children, cost, err := explosion.explode(*subBOM, node.Quantity, level+1, path)
+			delete(path, component.ID)
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+
+			node.BillOfMaterialID = &subBOM.ID
+			node.Components = children
+			node.TotalCost = cost
+			if node.Quantity > 0 {
+				node.UnitCost = helper.DivideAmount(cost, node.Quantity)
+			}
+		} else {
+			node.TotalCost = helper.MultiplyAmount(component.StandardCost, node.Quantity)
+		}
+
+		totalCost = totalCost.Add(node.TotalCost)
+		nodes = append(nodes, node)
+	}
+	return nodes, helper.RoundAmount(totalCost), nil
+}
+
+// load mengambil item dan BOM default yang belum ada di cache
+func (explosion *bomExplosion) load(itemIDs []uuid.UUID) error {
+	var missing []uuid.UUID
+	for _, id := range itemIDs {
+		if _, ok := explosion.items[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	items, err := explosion.itemRepository.FindByIds(explosion.ctx, explosion.tx, missing)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		explosion.items[item.ID] = item
+	}
+
+	defaults, err := explosion.billOfMaterialRepository.FindDefaultByItems(explosion.ctx, explosion.tx, missing)
+	if err != nil {
+		return err
+	}
+	for _, id := range missing {
+		if bom, ok := defaults[id]; ok {
+			explosion.boms[id] = &bom
+		}
+	}
+	return nil
+}
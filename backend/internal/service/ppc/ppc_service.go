@@ -0,0 +1,27 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+
+	"github.com/google/uuid"
+)
+
+type PPCService interface {
+	CreateWorkCenter(ctx context.Context, request ppc.WorkCenterCreateRequest) (*ppc.WorkCenterResponse, error)
+	UpdateWorkCenter(ctx context.Context, id uuid.UUID, request ppc.WorkCenterUpdateRequest) (*ppc.WorkCenterResponse, error)
+	FindWorkCenterById(ctx context.Context, id uuid.UUID) (*ppc.WorkCenterResponse, error)
+	FindAllWorkCenters(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	CreateBOM(ctx context.Context, request ppc.BillOfMaterialRequest) (*ppc.BillOfMaterialResponse, error)
+	UpdateBOM(ctx context.Context, id uuid.UUID, request ppc.BillOfMaterialRequest) (*ppc.BillOfMaterialResponse, error)
+	FindBOMById(ctx context.Context, id uuid.UUID) (*ppc.BillOfMaterialResponse, error)
+	FindAllBOMs(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	ExplodeBOM(ctx context.Context, id uuid.UUID, request ppc.BOMExplodeRequest) (*ppc.BOMExplodeResponse, error)
+
+	CreateRouting(ctx context.Context, request ppc.RoutingRequest) (*ppc.RoutingResponse, error)
+	UpdateRouting(ctx context.Context, id uuid.UUID, request ppc.RoutingRequest) (*ppc.RoutingResponse, error)
+	FindRoutingById(ctx context.Context, id uuid.UUID) (*ppc.RoutingResponse, error)
+	FindAllRoutings(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+}
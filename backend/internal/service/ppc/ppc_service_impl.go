@@ -0,0 +1,506 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/ppc"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PPCServiceImpl struct {
+	WorkCenterRepository     repo.WorkCenterRepository
+	BillOfMaterialRepository repo.BillOfMaterialRepository
+	RoutingRepository        repo.RoutingRepository
+	ItemRepository           inventoryRepo.ItemRepository
+	DB                       *gorm.DB
+	Validate                 *validator.Validate
+}
+
+func NewPPCService(workCenterRepository repo.WorkCenterRepository, billOfMaterialRepository repo.BillOfMaterialRepository, routingRepository repo.RoutingRepository, itemRepository inventoryRepo.ItemRepository, db *gorm.DB, validate *validator.Validate) PPCService {
+	return &PPCServiceImpl{
+		WorkCenterRepository:     workCenterRepository,
+		BillOfMaterialRepository: billOfMaterialRepository,
+		RoutingRepository:        routingRepository,
+		ItemRepository:           itemRepository,
+		DB:                       db,
+		Validate:                 validate,
+	}
+}
+
+func (service *PPCServiceImpl) CreateWorkCenter(ctx context.Context, request ppc.WorkCenterCreateRequest) (*ppc.WorkCenterResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var createdWorkCenter domain.WorkCenter
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.WorkCenterRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("work center code already exists")
+		}
+
+		workCenter := domain.WorkCenter{
+			ID:         uuid.New(),
+			Code:       request.Code,
+			Name:       request.Name,
+			HourlyRate: helper.RoundAmount(request.HourlyRate),
+			IsActive:   true,
+		}
+
+		createdWorkCenter, err = service.WorkCenterRepository.Create(ctx, tx, workCenter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToWorkCenterResponse(createdWorkCenter), nil
+}
+
+func (service *PPCServiceImpl) UpdateWorkCenter(ctx context.Context, id uuid.UUID, request ppc.WorkCenterUpdateRequest) (*ppc.WorkCenterResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var workCenter domain.WorkCenter
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		workCenter, err = service.WorkCenterRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work center not found")
+		}
+
+		workCenter.Name = request.Name
+		workCenter.HourlyRate = helper.RoundAmount(request.HourlyRate)
+		workCenter.IsActive = request.IsActive
+
+		return service.WorkCenterRepository.Update(ctx, tx, workCenter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToWorkCenterResponse(workCenter), nil
+}
+
+func (service *PPCServiceImpl) FindWorkCenterById(ctx context.Context, id uuid.UUID) (*ppc.WorkCenterResponse, error) {
+	workCenter, err := service.WorkCenterRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("work center not found")
+	}
+
+	return mapper.ToWorkCenterResponse(workCenter), nil
+}
+
+func (service *PPCServiceImpl) FindAllWorkCenters(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	workCenters, totalItems, err := service.WorkCenterRepository.FindAllWithPagination(ctx, service.DB, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToWorkCenterResponses(workCenters)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PPCServiceImpl) CreateBOM(ctx context.Context, request ppc.BillOfMaterialRequest) (*ppc.BillOfMaterialResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var bomID uuid.UUID
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		version, err := service.BillOfMaterialRepository.NextVersion(ctx, tx, request.ItemID)
+		if err != nil {
+			return err
+		}
+
+		bom := domain.BillOfMaterial{
+			ID:          uuid.New(),
+			ItemID:      request.ItemID,
+			Version:     version,
+			Description: request.Description,
+			Quantity:    helper.RoundQuantity(request.Quantity),
+			IsDefault:   request.IsDefault,
+			IsActive:    request.IsActive,
+		}
+		if err := service.buildBOMLines(ctx, tx, &bom, request.Lines); err != nil {
+			return err
+		}
+
+		created, err := service.BillOfMaterialRepository.Create(ctx, tx, bom)
+		if err != nil {
+			return err
+		}
+		if created.IsDefault {
+			if err := service.BillOfMaterialRepository.ClearDefault(ctx, tx, created.ItemID, created.ID); err != nil {
+				return err
+			}
+		}
+		bomID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindBOMById(ctx, bomID)
+}
+
+func (service *PPCServiceImpl) UpdateBOM(ctx context.Context, id uuid.UUID, request ppc.BillOfMaterialRequest) (*ppc.BillOfMaterialResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		bom, err := service.BillOfMaterialRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("bill of material not found")
+		}
+		if bom.ItemID != request.ItemID {
+			return exception.NewError("item of a bill of material cannot be changed")
+		}
+
+		bom.Description = request.Description
+		bom.Quantity = helper.RoundQuantity(request.Quantity)
+		bom.IsDefault = request.IsDefault
+		bom.IsActive = request.IsActive
+		if err := service.buildBOMLines(ctx, tx, &bom, request.Lines); err != nil {
+			return err
+		}
+
+		bom.Item = nil
+		if err := service.BillOfMaterialRepository.Update(ctx, tx, bom); err != nil {
+			return err
+		}
+		if err := service.BillOfMaterialRepository.ReplaceLines(ctx, tx, bom.ID, bom.Lines); err != nil {
+			return err
+		}
+		if bom.IsDefault {
+			return service.BillOfMaterialRepository.ClearDefault(ctx, tx, bom.ItemID, bom.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindBOMById(ctx, id)
+}
+
+func (service *PPCServiceImpl) FindBOMById(ctx context.Context, id uuid.UUID) (*ppc.BillOfMaterialResponse, error) {
+	bom, err := service.BillOfMaterialRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("bill of material not found")
+	}
+
+	return mapper.ToBillOfMaterialResponse(bom), nil
+}
+
+func (service *PPCServiceImpl) FindAllBOMs(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	itemID, err := helper.ParseOptionalUUID(filter.ItemID, "item_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	boms, totalItems, err := service.BillOfMaterialRepository.FindAllWithPagination(ctx, service.DB, filter.Search, itemID, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToBillOfMaterialResponses(boms)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PPCServiceImpl) ExplodeBOM(ctx context.Context, id uuid.UUID, request ppc.BOMExplodeRequest) (*ppc.BOMExplodeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	bom, err := service.BillOfMaterialRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("bill of material not found")
+	}
+
+	quantity := bom.Quantity
+	if request.Quantity > 0 {
+		quantity = helper.RoundQuantity(request.Quantity)
+	}
+
+	explosion := bomExplosion{
+		ctx:                      ctx,
+		tx:                       service.DB,
+		itemRepository:           service.ItemRepository,
+		billOfMaterialRepository: service.BillOfMaterialRepository,
+		items:                    make(map[uuid.UUID]domain.Item),
+		boms:                     make(map[uuid.UUID]*domain.BillOfMaterial),
+	}
+	components, materialCost, err := explosion.explode(bom, quantity, 1, map[uuid.UUID]bool{bom.ItemID: true})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ppc.BOMExplodeResponse{
+		BillOfMaterialID: bom.ID,
+		ItemID:           bom.ItemID,
+		Quantity:         quantity,
+		MaterialCost:     materialCost,
+		UnitCost:         helper.DivideAmount(materialCost, quantity),
+		Components:       components,
+	}
+	if bom.Item != nil {
+		response.ItemCode = bom.Item.Code
+		response.ItemName = bom.Item.Name
+	}
+	return response, nil
+}
+
+func (service *PPCServiceImpl) CreateRouting(ctx context.Context, request ppc.RoutingRequest) (*ppc.RoutingResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var routingID uuid.UUID
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := findActiveItem(ctx, tx, service.ItemRepository, request.ItemID); err != nil {
+			return err
+		}
+
+		version, err := service.RoutingRepository.NextVersion(ctx, tx, request.ItemID)
+		if err != nil {
+			return err
+		}
+
+		routing := domain.Routing{
+			ID:          uuid.New(),
+			ItemID:      request.ItemID,
+			Version:     version,
+			Description: request.Description,
+			IsDefault:   request.IsDefault,
+			IsActive:    request.IsActive,
+		}
+		if err := service.buildRoutingOperations(ctx, tx, &routing, request.Operations); err != nil {
+			return err
+		}
+
+		created, err := service.RoutingRepository.Create(ctx, tx, routing)
+		if err != nil {
+			return err
+		}
+		if created.IsDefault {
+			if err := service.RoutingRepository.ClearDefault(ctx, tx, created.ItemID, created.ID); err != nil {
+				return err
+			}
+		}
+		routingID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRoutingById(ctx, routingID)
+}
+
+func (service *PPCServiceImpl) UpdateRouting(ctx context.Context, id uuid.UUID, request ppc.RoutingRequest) (*ppc.RoutingResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		routing, err := service.RoutingRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("routing not found")
+		}
+		if routing.ItemID != request.ItemID {
+			return exception.NewError("item of a routing cannot be changed")
+		}
+
+		routing.Description = request.Description
+		routing.IsDefault = request.IsDefault
+		routing.IsActive = request.IsActive
+		if err := service.buildRoutingOperations(ctx, tx, &routing, request.Operations); err != nil {
+			return err
+		}
+
+		routing.Item = nil
+		if err := service.RoutingRepository.Update(ctx, tx, routing); err != nil {
+			return err
+		}
+		if err := service.RoutingRepository.ReplaceOperations(ctx, tx, routing.ID, routing.Operations); err != nil {
+			return err
+		}
+		if routing.IsDefault {
+			return service.RoutingRepository.ClearDefault(ctx, tx, routing.ItemID, routing.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRoutingById(ctx, id)
+}
+
+func (service *PPCServiceImpl) FindRoutingById(ctx context.Context, id uuid.UUID) (*ppc.RoutingResponse, error) {
+	routing, err := service.RoutingRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("routing not found")
+	}
+
+	return mapper.ToRoutingResponse(routing), nil
+}
+
+func (service *PPCServiceImpl) FindAllRoutings(ctx context.Context, filter ppc.PPCFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	itemID, err := helper.ParseOptionalUUID(filter.ItemID, "item_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	routings, totalItems, err := service.RoutingRepository.FindAllWithPagination(ctx, service.DB, filter.Search, itemID, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToRoutingResponses(routings)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+// buildBOMLines memvalidasi item induk dan komponen lalu mengisi lines BOM. Struktur BOM
+// tidak boleh membentuk siklus: item induk tidak boleh muncul lagi di bawah komponennya
+// melalui BOM default komponen tersebut.
+func (service *PPCServiceImpl) buildBOMLines(ctx context.Context, tx *gorm.DB, bom *domain.BillOfMaterial, requests []ppc.BillOfMaterialLineRequest) error {
+	parent, err := findActiveItem(ctx, tx, service.ItemRepository, bom.ItemID)
+	if err != nil {
+		return err
+	}
+
+	componentIDs := make([]uuid.UUID, 0, len(requests))
+	seen := make(map[uuid.UUID]bool, len(requests))
+	for i, line := range requests {
+		if line.ComponentItemID == bom.ItemID {
+			return exception.NewError(fmt.Sprintf("line %d: item cannot be a component of itself", i+1))
+		}
+		if seen[line.ComponentItemID] {
+			return exception.NewError(fmt.Sprintf("line %d: component is listed more than once", i+1))
+		}
+		seen[line.ComponentItemID] = true
+		componentIDs = append(componentIDs, line.ComponentItemID)
+	}
+
+	components, err := service.ItemRepository.FindByIds(ctx, tx, componentIDs)
+	if err != nil {
+		return err
+	}
+	componentByID := make(map[uuid.UUID]domain.Item, len(components))
+	for _, component := range components {
+		componentByID[component.ID] = component
+	}
+
+	bom.Lines = nil
+	for i, line := range requests {
+		component, ok := componentByID[line.ComponentItemID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("line %d: component item not found", i+1))
+		}
+		if !component.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: component item %s is inactive", i+1, component.Code))
+		}
+
+		bom.Lines = append(bom.Lines, domain.BillOfMaterialLine{
+			ID:               uuid.New(),
+			BillOfMaterialID: bom.ID,
+			LineNo:           i + 1,
+			ComponentItemID:  component.ID,
+			Quantity:         helper.RoundQuantity(line.Quantity),
+			ScrapPercent:     line.ScrapPercent,
+			Notes:            line.Notes,
+		})
+	}
+
+	// Telusuri BOM default komponen level demi level sampai tidak ada sub-assembly lagi
+	visited := make(map[uuid.UUID]bool)
+	level := componentIDs
+	for len(level) > 0 {
+		defaults, err := service.BillOfMaterialRepository.FindDefaultByItems(ctx, tx, level)
+		if err != nil {
+			return err
+		}
+
+		var next []uuid.UUID
+		for _, itemID := range level {
+			visited[itemID] = true
+			subBOM, ok := defaults[itemID]
+			if !ok || subBOM.ID == bom.ID {
+				continue
+			}
+			for _, line := range subBOM.Lines {
+				if line.ComponentItemID == bom.ItemID {
+					return exception.NewError(fmt.Sprintf("bill of material creates a cycle: item %s is used inside its own components", parent.Code))
+				}
+				if !visited[line.ComponentItemID] {
+					next = append(next, line.ComponentItemID)
+				}
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+// buildRoutingOperations memvalidasi work center dan urutan operasi lalu mengisi operasi routing
+func (service *PPCServiceImpl) buildRoutingOperations(ctx context.Context, tx *gorm.DB, routing *domain.Routing, requests []ppc.RoutingOperationRequest) error {
+	workCenterIDs := make([]uuid.UUID, 0, len(requests))
+	sequences := make(map[int]bool, len(requests))
+	for i, operation := range requests {
+		if sequences[operation.Sequence] {
+			return exception.NewError(fmt.Sprintf("operation %d: sequence %d is used more than once", i+1, operation.Sequence))
+		}
+		sequences[operation.Sequence] = true
+		workCenterIDs = append(workCenterIDs, operation.WorkCenterID)
+	}
+
+	workCenters, err := service.WorkCenterRepository.FindByIds(ctx, tx, workCenterIDs)
+	if err != nil {
+		return err
+	}
+	workCenterByID := make(map[uuid.UUID]domain.WorkCenter, len(workCenters))
+	for _, workCenter := range workCenters {
+		workCenterByID[workCenter.ID] = workCenter
+	}
+
+	routing.Operations = nil
+	for i, operation := range requests {
+		workCenter, ok := workCenterByID[operation.WorkCenterID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("operation %d: work center not found", i+1))
+		}
+		if !workCenter.IsActive {
+			return exception.NewError(fmt.Sprintf("operation %d: work center %s is inactive", i+1, workCenter.Code))
+		}
+
+		routing.Operations = append(routing.Operations, domain.RoutingOperation{
+			ID:                uuid.New(),
+			RoutingID:         routing.ID,
+			Sequence:          operation.Sequence,
+			WorkCenterID:      workCenter.ID,
+			Description:       operation.Description,
+			SetupMinutes:      operation.SetupMinutes,
+			RunMinutesPerUnit: operation.RunMinutesPerUnit,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WorkOrderService interface {
+	Create(ctx context.Context, userID uuid.UUID, request ppc.WorkOrderRequest) (*ppc.WorkOrderResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error)
+	FindAll(ctx context.Context, filter ppc.WorkOrderFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// Release mereservasi seluruh kebutuhan komponen dari stok gudang work order
+	Release(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error)
+	Issue(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.WorkOrderIssueRequest) (*ppc.WorkOrderResponse, error)
+	RecordOutput(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.WorkOrderOutputRequest) (*ppc.WorkOrderResponse, error)
+	Complete(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error)
+	Cost(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderCostResponse, error)
+
+	// CreatePlanned membuat work order Draft dengan BOM dan routing default item di dalam
+	// transaksi milik pemanggil. Dipakai untuk mengkonversi saran produksi MRP.
+	CreatePlanned(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) (domain.WorkOrder, error)
+}
@@ -0,0 +1,712 @@
+package ppc
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/ppc"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/ppc"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	inventoryService "erpfinance/internal/service/inventory"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// WorkOrderNumberPrefix adalah prefix penomoran work order, contoh: WO-202507-00001
+const WorkOrderNumberPrefix = "WO"
+
+type WorkOrderServiceImpl struct {
+	WorkOrderRepository      repo.WorkOrderRepository
+	BillOfMaterialRepository repo.BillOfMaterialRepository
+	RoutingRepository        repo.RoutingRepository
+	ItemRepository           inventoryRepo.ItemRepository
+	WarehouseRepository      inventoryRepo.WarehouseRepository
+	StockMovementRepository  inventoryRepo.StockMovementRepository
+	SequenceRepository       sequenceRepo.SequenceRepository
+	InventoryService         inventoryService.InventoryService
+	DB                       *gorm.DB
+	Validate                 *validator.Validate
+}
+
+func NewWorkOrderService(workOrderRepository repo.WorkOrderRepository, billOfMaterialRepository repo.BillOfMaterialRepository, routingRepository repo.RoutingRepository, itemRepository inventoryRepo.ItemRepository, warehouseRepository inventoryRepo.WarehouseRepository, stockMovementRepository inventoryRepo.StockMovementRepository, sequenceRepository sequenceRepo.SequenceRepository, inventoryService inventoryService.InventoryService, db *gorm.DB, validate *validator.Validate) WorkOrderService {
+	return &WorkOrderServiceImpl{
+		WorkOrderRepository:      workOrderRepository,
+		BillOfMaterialRepository: billOfMaterialRepository,
+		RoutingRepository:        routingRepository,
+		ItemRepository:           itemRepository,
+		WarehouseRepository:      warehouseRepository,
+		StockMovementRepository:  stockMovementRepository,
+		SequenceRepository:       sequenceRepository,
+		InventoryService:         inventoryService,
+		DB:                       db,
+		Validate:                 validate,
+	}
+}
+
+func (service *WorkOrderServiceImpl) Create(ctx context.Context, userID uuid.UUID, request ppc.WorkOrderRequest) (*ppc.WorkOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	startDate, err := helper.ParseDate(request.PlannedStartDate)
+	if err != nil {
+		return nil, exception.NewError("invalid planned start date")
+	}
+	endDate, err := helper.ParseDate(request.PlannedEndDate)
+	if err != nil {
+		return nil, exception.NewError("invalid planned end date")
+	}
+	if endDate.Before(startDate) {
+		return nil, exception.NewError("planned end date cannot be before planned start date")
+	}
+
+	bomID, err := helper.ParseOptionalUUID(request.BillOfMaterialID, "bill_of_material_id")
+	if err != nil {
+		return nil, err
+	}
+	routingID, err := helper.ParseOptionalUUID(request.RoutingID, "routing_id")
+	if err != nil {
+		return nil, err
+	}
+
+	var workOrderID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, err := service.create(ctx, tx, domain.WorkOrder{
+			ItemID:           request.ItemID,
+			WarehouseID:      request.WarehouseID,
+			PlannedQuantity:  request.PlannedQuantity,
+			PlannedStartDate: startDate,
+			PlannedEndDate:   endDate,
+			Notes:            request.Notes,
+			CreatedBy:        userID,
+		}, bomID, routingID)
+		if err != nil {
+			return err
+		}
+		workOrderID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, workOrderID)
+}
+
+func (service *WorkOrderServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error) {
+	workOrder, err := service.WorkOrderRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("work order not found")
+	}
+
+	return mapper.ToWorkOrderResponse(workOrder), nil
+}
+
+func (service *WorkOrderServiceImpl) FindAll(ctx context.Context, filter ppc.WorkOrderFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	itemID, err := helper.ParseOptionalUUID(filter.ItemID, "item_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	workOrders, totalItems, err := service.WorkOrderRepository.FindAllWithPagination(ctx, service.DB, filter.Status, itemID, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToWorkOrderResponses(workOrders)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *WorkOrderServiceImpl) Release(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		workOrder, err := service.WorkOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work order not found")
+		}
+
+		if workOrder.Status != domain.WorkOrderStatusDraft {
+			return exception.NewError("only draft work orders can be released")
+		}
+
+		available, err := service.availableStock(ctx, tx, workOrder)
+		if err != nil {
+			return err
+		}
+
+		var shortages []string
+		for i := range workOrder.Materials {
+			material := &workOrder.Materials[i]
+			if material.RequiredQuantity > available[material.ItemID] {
+				shortages = append(shortages, fmt.Sprintf("%s (required %.4f, available %.4f)", materialCode(*material), material.RequiredQuantity, available[material.ItemID]))
+				continue
+			}
+			available[material.ItemID] = helper.RoundQuantity(available[material.ItemID] - material.RequiredQuantity)
+			material.ReservedQuantity = material.RequiredQuantity
+		}
+		if len(shortages) > 0 {
+			return exception.NewError("insufficient stock to release work order: " + strings.Join(shortages, "; "))
+		}
+
+		if err := service.WorkOrderRepository.UpdateMaterials(ctx, tx, workOrder.Materials); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		workOrder.Status = domain.WorkOrderStatusReleased
+		workOrder.ReleasedAt = &now
+		return service.WorkOrderRepository.Update(ctx, tx, workOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *WorkOrderServiceImpl) Issue(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.WorkOrderIssueRequest) (*ppc.WorkOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	issueDate, err := helper.ParseDate(request.IssueDate)
+	if err != nil {
+		return nil, exception.NewError("invalid issue date")
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		workOrder, err := service.WorkOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work order not found")
+		}
+
+		if !workOrder.IsOpen() {
+			return exception.NewError("materials can only be issued to released or in-progress work orders")
+		}
+
+		materialIndex := make(map[uuid.UUID]int, len(workOrder.Materials))
+		for i, material := range workOrder.Materials {
+			materialIndex[material.ID] = i
+		}
+
+		// Pengeluaran melebihi sisa reservasi sendiri hanya boleh diambil dari stok yang
+		// tidak direservasi work order lain
+		available, err := service.availableStock(ctx, tx, workOrder)
+		if err != nil {
+			return err
+		}
+
+		movements := make([]domain.StockMovement, 0, len(request.Lines))
+		for i, line := range request.Lines {
+			index, ok := materialIndex[line.MaterialID]
+			if !ok {
+				return exception.NewError(fmt.Sprintf("line %d: material does not belong to this work order", i+1))
+			}
+			binID, err := helper.ParseOptionalUUID(line.BinID, fmt.Sprintf("line %d bin_id", i+1))
+			if err != nil {
+				return err
+			}
+
+			material := &workOrder.Materials[index]
+			quantity := helper.RoundQuantity(line.Quantity)
+			if extra := helper.RoundQuantity(quantity - material.OpenReservation()); extra > 0 {
+				if extra > available[material.ItemID] {
+					return exception.NewError(fmt.Sprintf("line %d: issue of %s exceeds its reservation and the free stock of %.4f", i+1, materialCode(*material), available[material.ItemID]))
+				}
+				available[material.ItemID] = helper.RoundQuantity(available[material.ItemID] - extra)
+			}
+			material.IssuedQuantity = helper.RoundQuantity(material.IssuedQuantity + quantity)
+
+			movements = append(movements, domain.StockMovement{
+				MovementType: domain.StockMovementIssue,
+				MovementDate: issueDate,
+				ItemID:       material.ItemID,
+				WarehouseID:  workOrder.WarehouseID,
+				BinID:        binID,
+				Quantity:     -quantity,
+				Reference:    workOrder.Number,
+				SourceType:   domain.StockSourceWorkOrder,
+				SourceID:     &workOrder.ID,
+				Notes:        request.Notes,
+				CreatedBy:    userID,
+			})
+		}
+
+		if _, err := service.InventoryService.PostMovements(ctx, tx, movements); err != nil {
+			return err
+		}
+		if err := service.WorkOrderRepository.UpdateMaterials(ctx, tx, workOrder.Materials); err != nil {
+			return err
+		}
+
+		workOrder.Status = domain.WorkOrderStatusInProgress
+		return service.WorkOrderRepository.Update(ctx, tx, workOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *WorkOrderServiceImpl) RecordOutput(ctx context.Context, id uuid.UUID, userID uuid.UUID, request ppc.WorkOrderOutputRequest) (*ppc.WorkOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	if request.GoodQuantity <= 0 && request.ScrapQuantity <= 0 {
+		return nil, exception.NewError("good quantity or scrap quantity must be greater than zero")
+	}
+
+	outputDate, err := helper.ParseDate(request.OutputDate)
+	if err != nil {
+		return nil, exception.NewError("invalid output date")
+	}
+	binID, err := helper.ParseOptionalUUID(request.BinID, "bin_id")
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		workOrder, err := service.WorkOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work order not found")
+		}
+
+		if !workOrder.IsOpen() {
+			return exception.NewError("output can only be recorded for released or in-progress work orders")
+		}
+
+		operationIndex := make(map[uuid.UUID]int, len(workOrder.Operations))
+		for i, operation := range workOrder.Operations {
+			operationIndex[operation.ID] = i
+		}
+		for i, timeRequest := range request.Operations {
+			index, ok := operationIndex[timeRequest.OperationID]
+			if !ok {
+				return exception.NewError(fmt.Sprintf("operation %d: operation does not belong to this work order", i+1))
+			}
+			operation := &workOrder.Operations[index]
+			operation.ActualMinutes = helper.RoundMeasure(operation.ActualMinutes + timeRequest.ActualMinutes)
+		}
+
+		output := domain.WorkOrderOutput{
+			ID:            uuid.New(),
+			WorkOrderID:   workOrder.ID,
+			OutputDate:    outputDate,
+			GoodQuantity:  helper.RoundQuantity(request.GoodQuantity),
+			ScrapQuantity: helper.RoundQuantity(request.ScrapQuantity),
+			Notes:         request.Notes,
+			CreatedBy:     userID,
+		}
+
+		if output.GoodQuantity > 0 {
+			movements, err := service.InventoryService.PostMovements(ctx, tx, []domain.StockMovement{{
+				MovementType: domain.StockMovementReceipt,
+				MovementDate: outputDate,
+				ItemID:       workOrder.ItemID,
+				WarehouseID:  workOrder.WarehouseID,
+				BinID:        binID,
+				Quantity:     output.GoodQuantity,
+				Reference:    workOrder.Number,
+				SourceType:   domain.StockSourceWorkOrder,
+				SourceID:     &workOrder.ID,
+				Notes:        request.Notes,
+				CreatedBy:    userID,
+			}})
+			if err != nil {
+				return err
+			}
+			output.MovementNumber = movements[0].Number
+		}
+
+		if err := service.WorkOrderRepository.CreateOutput(ctx, tx, output); err != nil {
+			return err
+		}
+		if err := service.WorkOrderRepository.UpdateOperations(ctx, tx, workOrder.Operations); err != nil {
+			return err
+		}
+
+		workOrder.CompletedQuantity = helper.RoundQuantity(workOrder.CompletedQuantity + output.GoodQuantity)
+		workOrder.ScrapQuantity = helper.RoundQuantity(workOrder.ScrapQuantity + output.ScrapQuantity)
+		workOrder.Status = domain.WorkOrderStatusInProgress
+		return service.WorkOrderRepository.Update(ctx, tx, workOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *WorkOrderServiceImpl) Complete(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		workOrder, err := service.WorkOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work order not found")
+		}
+
+		if !workOrder.IsOpen() {
+			return exception.NewError("only released or in-progress work orders can be completed")
+		}
+		if workOrder.CompletedQuantity <= 0 {
+			return exception.NewError("work order has no recorded output")
+		}
+
+		// Sisa reservasi yang tidak terpakai dilepas kembali ke stok bebas
+		for i := range workOrder.Materials {
+			if workOrder.Materials[i].ReservedQuantity > workOrder.Materials[i].IssuedQuantity {
+				workOrder.Materials[i].ReservedQuantity = workOrder.Materials[i].IssuedQuantity
+			}
+		}
+		if err := service.WorkOrderRepository.UpdateMaterials(ctx, tx, workOrder.Materials); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		workOrder.Status = domain.WorkOrderStatusCompleted
+		workOrder.CompletedAt = &now
+		return service.WorkOrderRepository.Update(ctx, tx, workOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *WorkOrderServiceImpl) Cancel(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		workOrder, err := service.WorkOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("work order not found")
+		}
+
+		if workOrder.Status != domain.WorkOrderStatusDraft && workOrder.Status != domain.WorkOrderStatusReleased {
+			return exception.NewError("only draft or released work orders can be cancelled")
+		}
+		if workOrder.CompletedQuantity > 0 || workOrder.ScrapQuantity > 0 {
+			return exception.NewError("work order with recorded output cannot be cancelled")
+		}
+
+		for i := range workOrder.Materials {
+			if workOrder.Materials[i].IssuedQuantity > 0 {
+				return exception.NewError("work order with issued materials cannot be cancelled")
+			}
+			workOrder.Materials[i].ReservedQuantity = 0
+		}
+		if err := service.WorkOrderRepository.UpdateMaterials(ctx, tx, workOrder.Materials); err != nil {
+			return err
+		}
+
+		workOrder.Status = domain.WorkOrderStatusCancelled
+		return service.WorkOrderRepository.Update(ctx, tx, workOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *WorkOrderServiceImpl) Cost(ctx context.Context, id uuid.UUID) (*ppc.WorkOrderCostResponse, error) {
+	workOrder, err := service.WorkOrderRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("work order not found")
+	}
+
+	return buildWorkOrderCost(workOrder), nil
+}
+
+func (service *WorkOrderServiceImpl) CreatePlanned(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) (domain.WorkOrder, error) {
+	if workOrder.PlannedQuantity <= 0 {
+		return domain.WorkOrder{}, exception.NewError("planned quantity must be greater than zero")
+	}
+	if workOrder.PlannedEndDate.Before(workOrder.PlannedStartDate) {
+		return domain.WorkOrder{}, exception.NewError("planned end date cannot be before planned start date")
+	}
+	return service.create(ctx, tx, workOrder, nil, nil)
+}
+
+// create menyimpan work order Draft: komponen disalin dari BOM dan operasi dari routing
+// (yang diminta atau default item) lalu nomor work order diambil dari sequence
+func (service *WorkOrderServiceImpl) create(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder, bomID *uuid.UUID, routingID *uuid.UUID) (domain.WorkOrder, error) {
+	item, err := findActiveItem(ctx, tx, service.ItemRepository, workOrder.ItemID)
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+
+	warehouse, err := service.WarehouseRepository.FindById(ctx, tx, workOrder.WarehouseID)
+	if err != nil {
+		return domain.WorkOrder{}, exception.NewNotFoundError("warehouse not found")
+	}
+	if !warehouse.IsActive {
+		return domain.WorkOrder{}, exception.NewError(fmt.Sprintf("warehouse %s is inactive", warehouse.Code))
+	}
+
+	bom, err := service.resolveBOM(ctx, tx, item, bomID)
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+	routing, err := service.resolveRouting(ctx, tx, item, routingID)
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+
+	workOrder.ID = uuid.New()
+	workOrder.BillOfMaterialID = bom.ID
+	workOrder.PlannedQuantity = helper.RoundQuantity(workOrder.PlannedQuantity)
+	workOrder.Status = domain.WorkOrderStatusDraft
+	if err := service.buildMaterials(ctx, tx, &workOrder, bom); err != nil {
+		return domain.WorkOrder{}, err
+	}
+	if routing != nil {
+		workOrder.RoutingID = &routing.ID
+		for _, operation := range routing.Operations {
+			workOrderOperation := domain.WorkOrderOperation{
+				ID:                uuid.New(),
+				WorkOrderID:       workOrder.ID,
+				Sequence:          operation.Sequence,
+				WorkCenterID:      operation.WorkCenterID,
+				Description:       operation.Description,
+				SetupMinutes:      operation.SetupMinutes,
+				RunMinutesPerUnit: operation.RunMinutesPerUnit,
+			}
+			if operation.WorkCenter != nil {
+				workOrderOperation.WorkCenterCode = operation.WorkCenter.Code
+				workOrderOperation.HourlyRate = operation.WorkCenter.HourlyRate
+			}
+			workOrder.Operations = append(workOrder.Operations, workOrderOperation)
+		}
+	}
+
+	number, err := service.SequenceRepository.Next(ctx, tx, WorkOrderNumberPrefix, workOrder.PlannedStartDate)
+	if err != nil {
+		return domain.WorkOrder{}, err
+	}
+	workOrder.Number = number
+
+	return service.WorkOrderRepository.Create(ctx, tx, workOrder)
+}
+
+// resolveBOM memakai BOM yang diminta (harus milik item dan aktif) atau BOM default item
+func (service *WorkOrderServiceImpl) resolveBOM(ctx context.Context, tx *gorm.DB, item domain.Item, bomID *uuid.UUID) (domain.BillOfMaterial, error) {
+	if bomID == nil {
+		defaults, err := service.BillOfMaterialRepository.FindDefaultByItems(ctx, tx, []uuid.UUID{item.ID})
+		if err != nil {
+			return domain.BillOfMaterial{}, err
+		}
+		bom, ok := defaults[item.ID]
+		if !ok {
+			return domain.BillOfMaterial{}, exception.NewError(fmt.Sprintf("item %s has no default bill of material", item.Code))
+		}
+		return bom, nil
+	}
+
+	bom, err := service.BillOfMaterialRepository.FindById(ctx, tx, *bomID)
+	if err != nil {
+		return domain.BillOfMaterial{}, exception.NewNotFoundError("bill of material not found")
+	}
+	if bom.ItemID != item.ID {
+		return domain.BillOfMaterial{}, exception.NewError("bill of material does not belong to the item")
+	}
+	if !bom.IsActive {
+		return domain.BillOfMaterial{}, exception.NewError("bill of material is inactive")
+	}
+	return bom, nil
+}
+
+// resolveRouting memakai routing yang diminta atau routing default item; item tanpa routing
+// tetap boleh diproduksi, hanya saja tanpa biaya tenaga kerja standar
+func (service *WorkOrderServiceImpl) resolveRouting(ctx context.Context, tx *gorm.DB, item domain.Item, routingID *uuid.UUID) (*domain.Routing, error) {
+	if routingID == nil {
+		routing, err := service.RoutingRepository.FindDefaultByItem(ctx, tx, item.ID)
+		if err != nil {
+			return nil, nil
+		}
+		return &routing, nil
+	}
+
+	routing, err := service.RoutingRepository.FindById(ctx, tx, *routingID)
+	if err != nil {
+		return nil, exception.NewNotFoundError("routing not found")
+	}
+	if routing.ItemID != item.ID {
+		return nil, exception.NewError("routing does not belong to the item")
+	}
+	if !routing.IsActive {
+		return nil, exception.NewError("routing is inactive")
+	}
+	return &routing, nil
+}
+
+// buildMaterials menghitung kebutuhan komponen satu tingkat BOM untuk planned quantity dan
+// menyalin standard cost komponen
+func (service *WorkOrderServiceImpl) buildMaterials(ctx context.Context, tx *gorm.DB, workOrder *domain.WorkOrder, bom domain.BillOfMaterial) error {
+	componentIDs := make([]uuid.UUID, 0, len(bom.Lines))
+	for _, line := range bom.Lines {
+		componentIDs = append(componentIDs, line.ComponentItemID)
+	}
+	components, err := service.ItemRepository.FindByIds(ctx, tx, componentIDs)
+	if err != nil {
+		return err
+	}
+	componentByID := make(map[uuid.UUID]domain.Item, len(components))
+	for _, component := range components {
+		componentByID[component.ID] = component
+	}
+
+	for i, line := range bom.Lines {
+		component, ok := componentByID[line.ComponentItemID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("bill of material line %d: component item not found", line.LineNo))
+		}
+		if !component.IsActive {
+			return exception.NewError(fmt.Sprintf("component item %s is inactive", component.Code))
+		}
+
+		workOrder.Materials = append(workOrder.Materials, domain.WorkOrderMaterial{
+			ID:               uuid.New(),
+			WorkOrderID:      workOrder.ID,
+			LineNo:           i + 1,
+			ItemID:           component.ID,
+			RequiredQuantity: helper.RoundQuantity(line.GrossQuantity(bom.Quantity, workOrder.PlannedQuantity)),
+			StandardUnitCost: component.StandardCost,
+		})
+	}
+	return nil
+}
+
+// availableStock mengunci item komponen lalu menghitung stok bebas per komponen di gudang
+// work order: saldo on-hand dikurangi sisa reservasi work order lain dan sisa reservasi
+// work order ini sendiri
+func (service *WorkOrderServiceImpl) availableStock(ctx context.Context, tx *gorm.DB, workOrder domain.WorkOrder) (map[uuid.UUID]float64, error) {
+	itemIDs := make([]uuid.UUID, 0, len(workOrder.Materials))
+	seen := make(map[uuid.UUID]bool, len(workOrder.Materials))
+	for _, material := range workOrder.Materials {
+		if !seen[material.ItemID] {
+			seen[material.ItemID] = true
+			itemIDs = append(itemIDs, material.ItemID)
+		}
+	}
+	if len(itemIDs) == 0 {
+		return map[uuid.UUID]float64{}, nil
+	}
+
+	if _, err := service.ItemRepository.LockByIds(ctx, tx, itemIDs); err != nil {
+		return nil, err
+	}
+
+	balances, err := service.StockMovementRepository.FindBalances(ctx, tx, nil, &workOrder.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := service.WorkOrderRepository.SumOpenReservations(ctx, tx, workOrder.WarehouseID, itemIDs, workOrder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[uuid.UUID]float64, len(itemIDs))
+	for _, balance := range balances {
+		if seen[balance.ItemID] {
+			available[balance.ItemID] += balance.Quantity
+		}
+	}
+	for _, material := range workOrder.Materials {
+		available[material.ItemID] -= material.OpenReservation()
+	}
+	for _, itemID := range itemIDs {
+		available[itemID] = helper.RoundQuantity(available[itemID] - reserved[itemID])
+		if available[itemID] < 0 {
+			available[itemID] = 0
+		}
+	}
+	return available, nil
+}
+
+// buildWorkOrderCost menghitung biaya standar (untuk planned quantity dan untuk hasil baik)
+// serta biaya aktual dari komponen yang dikeluarkan dan waktu operasi yang dilaporkan.
+// Varians positif berarti biaya aktual lebih besar dari standar (unfavourable).
+func buildWorkOrderCost(workOrder domain.WorkOrder) *ppc.WorkOrderCostResponse {
+	response := &ppc.WorkOrderCostResponse{
+		WorkOrderID:       workOrder.ID,
+		Number:            workOrder.Number,
+		Status:            string(workOrder.Status),
+		PlannedQuantity:   workOrder.PlannedQuantity,
+		CompletedQuantity: workOrder.CompletedQuantity,
+		ScrapQuantity:     workOrder.ScrapQuantity,
+	}
+	completedRatio := workOrder.CompletedQuantity / workOrder.PlannedQuantity
+
+	for _, material := range workOrder.Materials {
+		earnedQuantity := helper.RoundQuantity(material.RequiredQuantity * completedRatio)
+		cost := ppc.WorkOrderMaterialCost{
+			ItemID:           material.ItemID,
+			ItemCode:         materialCode(material),
+			StandardQuantity: earnedQuantity,
+			ActualQuantity:   material.IssuedQuantity,
+			StandardUnitCost: material.StandardUnitCost,
+			StandardCost:     helper.MultiplyAmount(material.StandardUnitCost, earnedQuantity),
+			ActualCost:       helper.MultiplyAmount(material.StandardUnitCost, material.IssuedQuantity),
+		}
+		response.StandardMaterialCost = response.StandardMaterialCost.Add(helper.MultiplyAmount(material.StandardUnitCost, material.RequiredQuantity))
+		response.ActualMaterialCost = response.ActualMaterialCost.Add(cost.ActualCost)
+		response.EarnedStandardCost = response.EarnedStandardCost.Add(cost.StandardCost)
+		response.Materials = append(response.Materials, cost)
+	}
+
+	for _, operation := range workOrder.Operations {
+		var earnedMinutes float64
+		if workOrder.CompletedQuantity > 0 {
+			earnedMinutes = operation.StandardMinutes(workOrder.CompletedQuantity)
+		}
+		cost := ppc.WorkOrderOperationCost{
+			Sequence:        operation.Sequence,
+			WorkCenterCode:  operation.WorkCenterCode,
+			StandardMinutes: helper.RoundMeasure(earnedMinutes),
+			ActualMinutes:   operation.ActualMinutes,
+			StandardCost:    laborCost(earnedMinutes, operation.HourlyRate),
+			ActualCost:      laborCost(operation.ActualMinutes, operation.HourlyRate),
+		}
+		response.StandardLaborCost = response.StandardLaborCost.Add(laborCost(operation.StandardMinutes(workOrder.PlannedQuantity), operation.HourlyRate))
+		response.ActualLaborCost = response.ActualLaborCost.Add(cost.ActualCost)
+		response.EarnedStandardCost = response.EarnedStandardCost.Add(cost.StandardCost)
+		response.Operations = append(response.Operations, cost)
+	}
+
+	response.StandardMaterialCost = helper.RoundAmount(response.StandardMaterialCost)
+	response.StandardLaborCost = helper.RoundAmount(response.StandardLaborCost)
+	response.StandardTotalCost = helper.RoundAmount(response.StandardMaterialCost.Add(response.StandardLaborCost))
+	response.StandardUnitCost = helper.DivideAmount(response.StandardTotalCost, workOrder.PlannedQuantity)
+	response.ActualMaterialCost = helper.RoundAmount(response.ActualMaterialCost)
+	response.ActualLaborCost = helper.RoundAmount(response.ActualLaborCost)
+	response.ActualTotalCost = helper.RoundAmount(response.ActualMaterialCost.Add(response.ActualLaborCost))
+	if workOrder.CompletedQuantity > 0 {
+		response.ActualUnitCost = helper.DivideAmount(response.ActualTotalCost, workOrder.CompletedQuantity)
+	}
+	response.EarnedStandardCost = helper.RoundAmount(response.EarnedStandardCost)
+	response.Variance = helper.RoundAmount(response.ActualTotalCost.Sub(response.EarnedStandardCost))
+	return response
+}
+
+// laborCost menghitung biaya tenaga kerja dari menit kerja dan tarif per jam work center
+func laborCost(minutes float64, hourlyRate decimal.Decimal) decimal.Decimal {
+	return helper.DivideAmount(hourlyRate.Mul(decimal.NewFromFloat(minutes)), 60)
+}
+
+// materialCode mengembalikan kode item komponen jika sudah di-preload
+func materialCode(material domain.WorkOrderMaterial) string {
+	if material.Item != nil {
+		return material.Item.Code
+	}
+	return material.ItemID.String()
+}
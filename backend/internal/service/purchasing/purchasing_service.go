@@ -0,0 +1,42 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/purchasing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PurchasingService interface {
+	CreateRequisition(ctx context.Context, userID uuid.UUID, request purchasing.RequisitionRequest) (*purchasing.RequisitionResponse, error)
+	UpdateRequisition(ctx context.Context, id uuid.UUID, request purchasing.RequisitionRequest) (*purchasing.RequisitionResponse, error)
+	FindRequisitionById(ctx context.Context, id uuid.UUID) (*purchasing.RequisitionResponse, error)
+	FindAllRequisitions(ctx context.Context, filter purchasing.PurchasingFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	SubmitRequisition(ctx context.Context, id uuid.UUID) (*purchasing.RequisitionResponse, error)
+	ApproveRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*purchasing.RequisitionResponse, error)
+	RejectRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID, request purchasing.RequisitionRejectRequest) (*purchasing.RequisitionResponse, error)
+
+	// ConvertRequisition membuat purchase order Draft dari requisition yang sudah Approved
+	ConvertRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID, request purchasing.RequisitionConvertRequest) (*purchasing.PurchaseOrderResponse, error)
+
+	CreatePurchaseOrder(ctx context.Context, userID uuid.UUID, request purchasing.PurchaseOrderRequest) (*purchasing.PurchaseOrderResponse, error)
+	UpdatePurchaseOrder(ctx context.Context, id uuid.UUID, request purchasing.PurchaseOrderRequest) (*purchasing.PurchaseOrderResponse, error)
+	FindPurchaseOrderById(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+	FindAllPurchaseOrders(ctx context.Context, filter purchasing.PurchasingFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	ApprovePurchaseOrder(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+	SendPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+	ClosePurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+	CancelPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+
+	// ReceiveOrderLines menambah kuantitas diterima per baris purchase order (key: id baris) di dalam
+	// transaksi milik pemanggil, lalu mengubah status menjadi PartiallyReceived atau Closed.
+	// Dipakai oleh goods receipt; purchase order yang dikembalikan sudah berisi kuantitas terbaru.
+	ReceiveOrderLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, received map[uuid.UUID]float64) (domain.PurchaseOrder, error)
+
+	// CreatePlannedRequisition menyimpan requisition Draft hasil perencanaan (MRP) di dalam
+	// transaksi milik pemanggil; nomor, status dan id baris diisi di sini
+	CreatePlannedRequisition(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) (domain.PurchaseRequisition, error)
+}
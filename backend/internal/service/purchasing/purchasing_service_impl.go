@@ -0,0 +1,739 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/purchasing"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/purchasing"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	approvalService "erpfinance/internal/service/approval"
+	supplierService "erpfinance/internal/service/supplier"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const (
+	// RequisitionNumberPrefix adalah prefix penomoran requisition, contoh: PR-202507-00001
+	RequisitionNumberPrefix = "PR"
+
+	// PurchaseOrderNumberPrefix adalah prefix penomoran purchase order, contoh: PO-202507-00001
+	PurchaseOrderNumberPrefix = "PO"
+)
+
+// orderTransitions berisi perubahan status purchase order yang dilakukan secara manual.
+// Status PartiallyReceived dan Closed karena penerimaan penuh diatur oleh ReceiveOrderLines.
+var orderTransitions = map[domain.PurchaseOrderStatus][]domain.PurchaseOrderStatus{
+	domain.PurchaseOrderStatusDraft:             {domain.PurchaseOrderStatusApproved, domain.PurchaseOrderStatusCancelled},
+	domain.PurchaseOrderStatusApproved:          {domain.PurchaseOrderStatusSent, domain.PurchaseOrderStatusCancelled},
+	domain.PurchaseOrderStatusPartiallyReceived: {domain.PurchaseOrderStatusClosed},
+}
+
+type PurchasingServiceImpl struct {
+	RequisitionRepository   repo.RequisitionRepository
+	PurchaseOrderRepository repo.PurchaseOrderRepository
+	SequenceRepository      sequenceRepo.SequenceRepository
+	SupplierCheckService    supplierService.SupplierCheckService
+	ItemRepository          inventoryRepo.ItemRepository
+	ApprovalService         approvalService.ApprovalService
+	DB                      *gorm.DB
+	Validate                *validator.Validate
+}
+
+func NewPurchasingService(requisitionRepository repo.RequisitionRepository, purchaseOrderRepository repo.PurchaseOrderRepository, sequenceRepository sequenceRepo.SequenceRepository, supplierCheckService supplierService.SupplierCheckService, itemRepository inventoryRepo.ItemRepository, approvalService approvalService.ApprovalService, db *gorm.DB, validate *validator.Validate) PurchasingService {
+	return &PurchasingServiceImpl{
+		RequisitionRepository:   requisitionRepository,
+		PurchaseOrderRepository: purchaseOrderRepository,
+		SequenceRepository:      sequenceRepository,
+		SupplierCheckService:    supplierCheckService,
+		ItemRepository:          itemRepository,
+		ApprovalService:         approvalService,
+		DB:                      db,
+		Validate:                validate,
+	}
+}
+
+func (service *PurchasingServiceImpl) CreateRequisition(ctx context.Context, userID uuid.UUID, request purchasing.RequisitionRequest) (*purchasing.RequisitionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	requestDate, requiredDate, err := parseDateRange(request.RequestDate, request.RequiredDate, "required date cannot be before request date")
+	if err != nil {
+		return nil, err
+	}
+
+	var requisitionID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition := domain.PurchaseRequisition{
+			ID:           uuid.New(),
+			RequestDate:  requestDate,
+			RequiredDate: requiredDate,
+			Notes:        request.Notes,
+			Status:       domain.RequisitionStatusDraft,
+			RequestedBy:  userID,
+		}
+		requisition.Lines = buildRequisitionLines(requisition.ID, request.Lines)
+
+		number, err := service.SequenceRepository.Next(ctx, tx, RequisitionNumberPrefix, requestDate)
+		if err != nil {
+			return err
+		}
+		requisition.Number = number
+
+		created, err := service.RequisitionRepository.Create(ctx, tx, requisition)
+		if err != nil {
+			return err
+		}
+		requisitionID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequisitionById(ctx, requisitionID)
+}
+
+func (service *PurchasingServiceImpl) UpdateRequisition(ctx context.Context, id uuid.UUID, request purchasing.RequisitionRequest) (*purchasing.RequisitionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	requestDate, requiredDate, err := parseDateRange(request.RequestDate, request.RequiredDate, "required date cannot be before request date")
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition, err := service.RequisitionRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase requisition not found")
+		}
+
+		if requisition.Status != domain.RequisitionStatusDraft {
+			return exception.NewError("only draft purchase requisitions can be updated")
+		}
+
+		requisition.RequestDate = requestDate
+		requisition.RequiredDate = requiredDate
+		requisition.Notes = request.Notes
+		if err := service.RequisitionRepository.Update(ctx, tx, requisition); err != nil {
+			return err
+		}
+
+		return service.RequisitionRepository.ReplaceLines(ctx, tx, requisition.ID, buildRequisitionLines(requisition.ID, request.Lines))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequisitionById(ctx, id)
+}
+
+func (service *PurchasingServiceImpl) FindRequisitionById(ctx context.Context, id uuid.UUID) (*purchasing.RequisitionResponse, error) {
+	requisition, err := service.RequisitionRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("purchase requisition not found")
+	}
+
+	return mapper.ToRequisitionResponse(requisition), nil
+}
+
+func (service *PurchasingServiceImpl) FindAllRequisitions(ctx context.Context, filter purchasing.PurchasingFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	requisitions, totalItems, err := service.RequisitionRepository.FindAllWithPagination(ctx, service.DB, filter.Status, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToRequisitionSummaryResponses(requisitions)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PurchasingServiceImpl) SubmitRequisition(ctx context.Context, id uuid.UUID) (*purchasing.RequisitionResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition, err := service.RequisitionRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase requisition not found")
+		}
+
+		if requisition.Status != domain.RequisitionStatusDraft {
+			return exception.NewError("only draft purchase requisitions can be submitted")
+		}
+
+		requisition.Status = domain.RequisitionStatusSubmitted
+
+		// Tanpa rule approval yang cocok requisition tetap Submitted dan disetujui manual; rule
+		// yang semua step-nya terlewati karena nominal kecil langsung menyetujui requisition
+		approvalRequest, err := service.ApprovalService.Submit(ctx, tx, domain.ApprovalSubmission{
+			DocumentType:   domain.ApprovalDocumentPurchaseRequisition,
+			DocumentID:     requisition.ID,
+			DocumentNumber: requisition.Number,
+			Amount:         requisitionAmount(requisition),
+			RequestedBy:    requisition.RequestedBy,
+		})
+		if err != nil {
+			return err
+		}
+		if approvalRequest.RuleID != nil && approvalRequest.Status == domain.ApprovalRequestStatusApproved {
+			requisition.Status = domain.RequisitionStatusApproved
+			requisition.ApprovedAt = approvalRequest.CompletedAt
+		}
+		return service.RequisitionRepository.Update(ctx, tx, requisition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequisitionById(ctx, id)
+}
+
+func (service *PurchasingServiceImpl) ApproveRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*purchasing.RequisitionResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition, err := service.RequisitionRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase requisition not found")
+		}
+
+		if requisition.Status != domain.RequisitionStatusSubmitted {
+			return exception.NewError("only submitted purchase requisitions can be approved")
+		}
+		if err := service.ensureManualApproval(ctx, tx, requisition.ID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		requisition.Status = domain.RequisitionStatusApproved
+		requisition.ApprovedBy = &userID
+		requisition.ApprovedAt = &now
+		return service.RequisitionRepository.Update(ctx, tx, requisition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequisitionById(ctx, id)
+}
+
+func (service *PurchasingServiceImpl) RejectRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID, request purchasing.RequisitionRejectRequest) (*purchasing.RequisitionResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition, err := service.RequisitionRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase requisition not found")
+		}
+
+		if requisition.Status != domain.RequisitionStatusSubmitted {
+			return exception.NewError("only submitted purchase requisitions can be rejected")
+		}
+		if err := service.ensureManualApproval(ctx, tx, requisition.ID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		requisition.Status = domain.RequisitionStatusRejected
+		requisition.ApprovedBy = &userID
+		requisition.ApprovedAt = &now
+		requisition.RejectReason = request.Reason
+		return service.RequisitionRepository.Update(ctx, tx, requisition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindRequisitionById(ctx, id)
+}
+
+func (service *PurchasingServiceImpl) ConvertRequisition(ctx context.Context, id uuid.UUID, userID uuid.UUID, request purchasing.RequisitionConvertRequest) (*purchasing.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	orderDate, expectedDate, err := parseDateRange(request.OrderDate, request.ExpectedDate, "expected date cannot be before order date")
+	if err != nil {
+		return nil, err
+	}
+
+	var orderID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		requisition, err := service.RequisitionRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase requisition not found")
+		}
+
+		if requisition.Status != domain.RequisitionStatusApproved {
+			return exception.NewError("only approved purchase requisitions can be converted")
+		}
+
+		// Harga dari request menggantikan estimasi harga pada baris requisition
+		priceByLineID := make(map[uuid.UUID]decimal.Decimal, len(request.Lines))
+		for _, price := range request.Lines {
+			priceByLineID[price.RequisitionLineID] = price.UnitPrice
+		}
+		lineRequests := make([]purchasing.PurchaseOrderLineRequest, 0, len(requisition.Lines))
+		for _, line := range requisition.Lines {
+			unitPrice, ok := priceByLineID[line.ID]
+			if !ok {
+				unitPrice = line.EstimatedUnitPrice
+			}
+			delete(priceByLineID, line.ID)
+			lineRequest := purchasing.PurchaseOrderLineRequest{
+				Description: line.Description,
+				Quantity:    line.Quantity,
+				UOM:         line.UOM,
+				UnitPrice:   unitPrice,
+			}
+			if line.ItemID != nil {
+				lineRequest.ItemID = line.ItemID.String()
+			}
+			lineRequests = append(lineRequests, lineRequest)
+		}
+		if len(priceByLineID) > 0 {
+			return exception.NewError("price lines must refer to lines of the purchase requisition")
+		}
+
+		supplier, err := service.SupplierCheckService.EnsureActive(ctx, tx, request.SupplierID)
+		if err != nil {
+			return err
+		}
+
+		order := domain.PurchaseOrder{
+			ID:            uuid.New(),
+			RequisitionID: &requisition.ID,
+			OrderDate:     orderDate,
+			ExpectedDate:  expectedDate,
+			Notes:         requisition.Notes,
+			Status:        domain.PurchaseOrderStatusDraft,
+			CreatedBy:     userID,
+		}
+		applySupplier(&order, supplier, request.Currency, request.PaymentTermDays)
+		order.Lines, order.TotalAmount, err = buildOrderLines(order.ID, lineRequests)
+		if err != nil {
+			return err
+		}
+		if err := service.ensureItems(ctx, tx, order.Lines); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, PurchaseOrderNumberPrefix, orderDate)
+		if err != nil {
+			return err
+		}
+		order.Number = number
+
+		created, err := service.PurchaseOrderRepository.Create(ctx, tx, order)
+		if err != nil {
+			return err
+		}
+		orderID = created.ID
+
+		requisition.Status = domain.RequisitionStatusConverted
+		requisition.PurchaseOrderID = &created.ID
+		return service.RequisitionRepository.Update(ctx, tx, requisition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindPurchaseOrderById(ctx, orderID)
+}
+
+func (service *PurchasingServiceImpl) CreatePurchaseOrder(ctx context.Context, userID uuid.UUID, request purchasing.PurchaseOrderRequest) (*purchasing.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	orderDate, expectedDate, err := parseDateRange(request.OrderDate, request.ExpectedDate, "expected date cannot be before order date")
+	if err != nil {
+		return nil, err
+	}
+
+	var orderID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		supplier, err := service.SupplierCheckService.EnsureActive(ctx, tx, request.SupplierID)
+		if err != nil {
+			return err
+		}
+
+		order := domain.PurchaseOrder{
+			ID:           uuid.New(),
+			OrderDate:    orderDate,
+			ExpectedDate: expectedDate,
+			Notes:        request.Notes,
+			Status:       domain.PurchaseOrderStatusDraft,
+			CreatedBy:    userID,
+		}
+		applySupplier(&order, supplier, request.Currency, request.PaymentTermDays)
+		order.Lines, order.TotalAmount, err = buildOrderLines(order.ID, request.Lines)
+		if err != nil {
+			return err
+		}
+		if err := service.ensureItems(ctx, tx, order.Lines); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, PurchaseOrderNumberPrefix, orderDate)
+		if err != nil {
+			return err
+		}
+		order.Number = number
+
+		created, err := service.PurchaseOrderRepository.Create(ctx, tx, order)
+		if err != nil {
+			return err
+		}
+		orderID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindPurchaseOrderById(ctx, orderID)
+}
+
+func (service *PurchasingServiceImpl) UpdatePurchaseOrder(ctx context.Context, id uuid.UUID, request purchasing.PurchaseOrderRequest) (*purchasing.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	orderDate, expectedDate, err := parseDateRange(request.OrderDate, request.ExpectedDate, "expected date cannot be before order date")
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.PurchaseOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase order not found")
+		}
+
+		if order.Status != domain.PurchaseOrderStatusDraft {
+			return exception.NewError("only draft purchase orders can be updated")
+		}
+
+		supplier, err := service.SupplierCheckService.EnsureActive(ctx, tx, request.SupplierID)
+		if err != nil {
+			return err
+		}
+
+		lines, totalAmount, err := buildOrderLines(order.ID, request.Lines)
+		if err != nil {
+			return err
+		}
+		if err := service.ensureItems(ctx, tx, lines); err != nil {
+			return err
+		}
+
+		applySupplier(&order, supplier, request.Currency, request.PaymentTermDays)
+		order.OrderDate = orderDate
+		order.ExpectedDate = expectedDate
+		order.Notes = request.Notes
+		order.TotalAmount = totalAmount
+		if err := service.PurchaseOrderRepository.Update(ctx, tx, order); err != nil {
+			return err
+		}
+
+		return service.PurchaseOrderRepository.ReplaceLines(ctx, tx, order.ID, lines)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindPurchaseOrderById(ctx, id)
+}
+
+func (service *PurchasingServiceImpl) FindPurchaseOrderById(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	order, err := service.PurchaseOrderRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("purchase order not found")
+	}
+
+	return mapper.ToPurchaseOrderResponse(order), nil
+}
+
+func (service *PurchasingServiceImpl) FindAllPurchaseOrders(ctx context.Context, filter purchasing.PurchasingFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	orders, totalItems, err := service.PurchaseOrderRepository.FindAllWithPagination(ctx, service.DB, filter.Status, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToPurchaseOrderResponses(orders)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *PurchasingServiceImpl) ApprovePurchaseOrder(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	return service.changeOrderStatus(ctx, id, domain.PurchaseOrderStatusApproved, func(order *domain.PurchaseOrder, now time.Time) {
+		order.ApprovedBy = &userID
+		order.ApprovedAt = &now
+	})
+}
+
+func (service *PurchasingServiceImpl) SendPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	return service.changeOrderStatus(ctx, id, domain.PurchaseOrderStatusSent, func(order *domain.PurchaseOrder, now time.Time) {
+		order.SentAt = &now
+	})
+}
+
+func (service *PurchasingServiceImpl) ClosePurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	return service.changeOrderStatus(ctx, id, domain.PurchaseOrderStatusClosed, func(order *domain.PurchaseOrder, now time.Time) {
+		order.ClosedAt = &now
+	})
+}
+
+func (service *PurchasingServiceImpl) CancelPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	return service.changeOrderStatus(ctx, id, domain.PurchaseOrderStatusCancelled, nil)
+}
+
+func (service *PurchasingServiceImpl) ReceiveOrderLines(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, received map[uuid.UUID]float64) (domain.PurchaseOrder, error) {
+	order, err := service.PurchaseOrderRepository.FindByIdForUpdate(ctx, tx, orderID)
+	if err != nil {
+		return domain.PurchaseOrder{}, exception.NewNotFoundError("purchase order not found")
+	}
+
+	if order.Status != domain.PurchaseOrderStatusSent && order.Status != domain.PurchaseOrderStatusPartiallyReceived {
+		return domain.PurchaseOrder{}, exception.NewError("only sent or partially received purchase orders can be received")
+	}
+
+	matched := 0
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		quantity, ok := received[line.ID]
+		if !ok {
+			continue
+		}
+		matched++
+
+		quantity = helper.RoundQuantity(quantity)
+		if quantity > helper.RoundQuantity(line.OutstandingQuantity()) {
+			return domain.PurchaseOrder{}, exception.NewError(fmt.Sprintf("line %d: received quantity exceeds outstanding quantity", line.LineNo))
+		}
+
+		line.ReceivedQuantity = helper.RoundQuantity(line.ReceivedQuantity + quantity)
+		if err := service.PurchaseOrderRepository.UpdateLineReceivedQuantity(ctx, tx, line.ID, line.ReceivedQuantity); err != nil {
+			return domain.PurchaseOrder{}, err
+		}
+	}
+	if matched != len(received) {
+		return domain.PurchaseOrder{}, exception.NewError("received line does not belong to the purchase order")
+	}
+
+	// Purchase order otomatis Closed jika semua baris sudah diterima penuh
+	order.Status = domain.PurchaseOrderStatusClosed
+	for _, line := range order.Lines {
+		if helper.RoundQuantity(line.OutstandingQuantity()) > 0 {
+			order.Status = domain.PurchaseOrderStatusPartiallyReceived
+			break
+		}
+	}
+	if order.Status == domain.PurchaseOrderStatusClosed {
+		now := time.Now()
+		order.ClosedAt = &now
+	}
+
+	if err := service.PurchaseOrderRepository.Update(ctx, tx, order); err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+	return order, nil
+}
+
+func (service *PurchasingServiceImpl) CreatePlannedRequisition(ctx context.Context, tx *gorm.DB, requisition domain.PurchaseRequisition) (domain.PurchaseRequisition, error) {
+	if len(requisition.Lines) == 0 {
+		return domain.PurchaseRequisition{}, exception.NewError("purchase requisition must have at least one line")
+	}
+
+	requisition.ID = uuid.New()
+	requisition.Status = domain.RequisitionStatusDraft
+	for i := range requisition.Lines {
+		requisition.Lines[i].ID = uuid.New()
+		requisition.Lines[i].RequisitionID = requisition.ID
+		requisition.Lines[i].LineNo = i + 1
+	}
+
+	number, err := service.SequenceRepository.Next(ctx, tx, RequisitionNumberPrefix, requisition.RequestDate)
+	if err != nil {
+		return domain.PurchaseRequisition{}, err
+	}
+	requisition.Number = number
+
+	return service.RequisitionRepository.Create(ctx, tx, requisition)
+}
+
+// changeOrderStatus mengubah status purchase order sesuai orderTransitions.
+// apply dipakai untuk mengisi kolom tambahan (approved_by, sent_at, dst.) sebelum disimpan.
+func (service *PurchasingServiceImpl) changeOrderStatus(ctx context.Context, id uuid.UUID, to domain.PurchaseOrderStatus, apply func(order *domain.PurchaseOrder, now time.Time)) (*purchasing.PurchaseOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.PurchaseOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("purchase order not found")
+		}
+
+		if !isAllowedOrderTransition(order.Status, to) {
+			return exception.NewError(fmt.Sprintf("purchase order status cannot change from %s to %s", order.Status, to))
+		}
+
+		order.Status = to
+		if apply != nil {
+			apply(&order, time.Now())
+		}
+		return service.PurchaseOrderRepository.Update(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindPurchaseOrderById(ctx, id)
+}
+
+func isAllowedOrderTransition(from, to domain.PurchaseOrderStatus) bool {
+	for _, allowed := range orderTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDateRange mengubah pasangan tanggal dokumen dan memastikan tanggal akhir tidak mendahului tanggal awal
+func parseDateRange(start, end string, orderMessage string) (time.Time, time.Time, error) {
+	startDate, err := helper.ParseDate(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, exception.NewError("dates must be in format 2006-01-02")
+	}
+	endDate, err := helper.ParseDate(end)
+	if err != nil {
+		return time.Time{}, time.Time{}, exception.NewError("dates must be in format 2006-01-02")
+	}
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, exception.NewError(orderMessage)
+	}
+	return startDate, endDate, nil
+}
+
+// applySupplier mengisi data supplier pada purchase order. Nama supplier disimpan sebagai
+// snapshot, currency dan termin pembayaran memakai default supplier jika tidak diisi.
+func applySupplier(order *domain.PurchaseOrder, supplier domain.Supplier, currency string, paymentTermDays *int) {
+	order.SupplierID = supplier.ID
+	order.SupplierName = supplier.Name
+
+	order.Currency = supplier.Currency
+	if currency != "" {
+		order.Currency = strings.ToUpper(currency)
+	}
+
+	order.PaymentTermDays = supplier.PaymentTermDays
+	if paymentTermDays != nil {
+		order.PaymentTermDays = *paymentTermDays
+	}
+}
+
+func buildRequisitionLines(requisitionID uuid.UUID, requests []purchasing.RequisitionLineRequest) []domain.PurchaseRequisitionLine {
+	lines := make([]domain.PurchaseRequisitionLine, 0, len(requests))
+	for i, line := range requests {
+		requisitionLine := domain.PurchaseRequisitionLine{
+			ID:                 uuid.New(),
+			RequisitionID:      requisitionID,
+			LineNo:             i + 1,
+			Description:        line.Description,
+			Quantity:           helper.RoundQuantity(line.Quantity),
+			UOM:                line.UOM,
+			EstimatedUnitPrice: helper.RoundAmount(line.EstimatedUnitPrice),
+		}
+		if line.ItemID != "" {
+			itemID := uuid.MustParse(line.ItemID)
+			requisitionLine.ItemID = &itemID
+		}
+		lines = append(lines, requisitionLine)
+	}
+	return lines
+}
+
+// ensureItems memastikan item yang direferensikan baris purchase order ada dan masih aktif
+func (service *PurchasingServiceImpl) ensureItems(ctx context.Context, tx *gorm.DB, lines []domain.PurchaseOrderLine) error {
+	for _, line := range lines {
+		if line.ItemID == nil {
+			continue
+		}
+		item, err := service.ItemRepository.FindById(ctx, tx, *line.ItemID)
+		if err != nil {
+			return exception.NewError(fmt.Sprintf("line %d: item not found", line.LineNo))
+		}
+		if !item.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: item %s is inactive", line.LineNo, item.Code))
+		}
+	}
+	return nil
+}
+
+// buildOrderLines menyusun baris purchase order beserta total nilainya
+func buildOrderLines(orderID uuid.UUID, requests []purchasing.PurchaseOrderLineRequest) ([]domain.PurchaseOrderLine, decimal.Decimal, error) {
+	lines := make([]domain.PurchaseOrderLine, 0, len(requests))
+	var totalAmount decimal.Decimal
+	for i, line := range requests {
+		orderLine := domain.PurchaseOrderLine{
+			ID:              uuid.New(),
+			PurchaseOrderID: orderID,
+			LineNo:          i + 1,
+			Description:     line.Description,
+			Quantity:        helper.RoundQuantity(line.Quantity),
+			UOM:             line.UOM,
+			UnitPrice:       helper.RoundAmount(line.UnitPrice),
+		}
+		orderLine.LineTotal = helper.MultiplyAmount(orderLine.UnitPrice, orderLine.Quantity)
+
+		if line.ItemID != "" {
+			itemID := uuid.MustParse(line.ItemID)
+			orderLine.ItemID = &itemID
+		}
+
+		if line.ExpectedDate != "" {
+			expectedDate, err := helper.ParseDate(line.ExpectedDate)
+			if err != nil {
+				return nil, decimal.Zero, exception.NewError(fmt.Sprintf("line %d: invalid expected date", i+1))
+			}
+			orderLine.ExpectedDate = &expectedDate
+		}
+
+		totalAmount = totalAmount.Add(orderLine.LineTotal)
+		lines = append(lines, orderLine)
+	}
+	return lines, helper.RoundAmount(totalAmount), nil
+}
+
+// ensureManualApproval menolak approve/reject manual selama requisition masih diproses oleh
+// workflow approval
+func (service *PurchasingServiceImpl) ensureManualApproval(ctx context.Context, tx *gorm.DB, requisitionID uuid.UUID) error {
+	pending, err := service.ApprovalService.HasPendingRequest(ctx, tx, domain.ApprovalDocumentPurchaseRequisition, requisitionID)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return exception.NewError("purchase requisition is in an approval workflow, decide it from the approval inbox")
+	}
+	return nil
+}
+
+// requisitionAmount menghitung estimasi nilai requisition untuk pemilihan rule approval
+func requisitionAmount(requisition domain.PurchaseRequisition) decimal.Decimal {
+	var amount decimal.Decimal
+	for _, line := range requisition.Lines {
+		amount = amount.Add(line.EstimatedUnitPrice.Mul(decimal.NewFromFloat(line.Quantity)))
+	}
+	return helper.RoundAmount(amount)
+}
@@ -0,0 +1,46 @@
+package purchasing
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/model/domain"
+	repo "erpfinance/internal/repository/purchasing"
+
+	"gorm.io/gorm"
+)
+
+// RequisitionApprovalListener menerapkan hasil workflow approval ke purchase requisition
+type RequisitionApprovalListener struct {
+	RequisitionRepository repo.RequisitionRepository
+}
+
+func NewRequisitionApprovalListener(requisitionRepository repo.RequisitionRepository) *RequisitionApprovalListener {
+	return &RequisitionApprovalListener{
+		RequisitionRepository: requisitionRepository,
+	}
+}
+
+func (listener *RequisitionApprovalListener) DocumentType() string {
+	return domain.ApprovalDocumentPurchaseRequisition
+}
+
+func (listener *RequisitionApprovalListener) OnApprovalCompleted(ctx context.Context, tx *gorm.DB, request domain.ApprovalRequest) error {
+	requisition, err := listener.RequisitionRepository.FindByIdForUpdate(ctx, tx, request.DocumentID)
+	if err != nil {
+		return exception.NewNotFoundError("purchase requisition not found")
+	}
+
+	if requisition.Status != domain.RequisitionStatusSubmitted {
+		return exception.NewError("purchase requisition is no longer waiting for approval")
+	}
+
+	requisition.ApprovedBy = request.DecidedBy
+	requisition.ApprovedAt = request.CompletedAt
+	if request.Status == domain.ApprovalRequestStatusRejected {
+		requisition.Status = domain.RequisitionStatusRejected
+		requisition.RejectReason = request.Comment
+	} else {
+		requisition.Status = domain.RequisitionStatusApproved
+	}
+	return listener.RequisitionRepository.Update(ctx, tx, requisition)
+}
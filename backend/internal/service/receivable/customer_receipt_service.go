@@ -0,0 +1,23 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+
+	"github.com/google/uuid"
+)
+
+type CustomerReceiptService interface {
+	Create(ctx context.Context, userID uuid.UUID, request receivable.CustomerReceiptRequest) (*receivable.CustomerReceiptResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*receivable.CustomerReceiptResponse, error)
+	FindAll(ctx context.Context, filter receivable.CustomerReceiptFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// Post memposting jurnal penerimaan (debit kas/bank, kredit piutang usaha sebesar seluruh
+	// penerimaan) dan melunasi invoice sesuai alokasi
+	Post(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*receivable.CustomerReceiptResponse, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*receivable.CustomerReceiptResponse, error)
+
+	// Allocate mengalokasikan kredit penerimaan yang sudah diposting ke invoice lain tanpa jurnal baru
+	Allocate(ctx context.Context, id uuid.UUID, userID uuid.UUID, request receivable.ReceiptAllocateRequest) (*receivable.CustomerReceiptResponse, error)
+}
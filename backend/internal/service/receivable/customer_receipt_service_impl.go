@@ -0,0 +1,368 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	repo "erpfinance/internal/repository/receivable"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	currencyService "erpfinance/internal/service/currency"
+	ledgerService "erpfinance/internal/service/ledger"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// CustomerReceiptNumberPrefix adalah prefix penomoran penerimaan customer, contoh: RC-202507-00001
+const CustomerReceiptNumberPrefix = "RC"
+
+type CustomerReceiptServiceImpl struct {
+	CustomerReceiptRepository   repo.CustomerReceiptRepository
+	SalesInvoiceRepository      repo.SalesInvoiceRepository
+	CustomerRepository          repo.CustomerRepository
+	ReceivableSettingRepository repo.ReceivableSettingRepository
+	SequenceRepository          sequenceRepo.SequenceRepository
+	CurrencyService             currencyService.CurrencyService
+	LedgerService               ledgerService.LedgerService
+	DB                          *gorm.DB
+	Validate                    *validator.Validate
+}
+
+func NewCustomerReceiptService(customerReceiptRepository repo.CustomerReceiptRepository, salesInvoiceRepository repo.SalesInvoiceRepository, customerRepository repo.CustomerRepository, receivableSettingRepository repo.ReceivableSettingRepository, sequenceRepository sequenceRepo.SequenceRepository, currencyService currencyService.CurrencyService, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) CustomerReceiptService {
+	return &CustomerReceiptServiceImpl{
+		CustomerReceiptRepository:   customerReceiptRepository,
+		SalesInvoiceRepository:      salesInvoiceRepository,
+		CustomerRepository:          customerRepository,
+		ReceivableSettingRepository: receivableSettingRepository,
+		SequenceRepository:          sequenceRepository,
+		CurrencyService:             currencyService,
+		LedgerService:               ledgerService,
+		DB:                          db,
+		Validate:                    validate,
+	}
+}
+
+func (service *CustomerReceiptServiceImpl) Create(ctx context.Context, userID uuid.UUID, request receivable.CustomerReceiptRequest) (*receivable.CustomerReceiptResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	receiptDate, err := helper.ParseDate(request.ReceiptDate)
+	if err != nil {
+		return nil, exception.NewError("invalid receipt date")
+	}
+
+	var receiptID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		customer, err := findActiveCustomer(ctx, tx, service.CustomerRepository, request.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		depositAccount, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.DepositAccountID, "deposit account", domain.AccountTypeAsset)
+		if err != nil {
+			return err
+		}
+
+		receipt := domain.CustomerReceipt{
+			ID:               uuid.New(),
+			CustomerID:       customer.ID,
+			CustomerName:     customer.Name,
+			ReceiptDate:      receiptDate,
+			Currency:         customer.Currency,
+			Amount:           helper.RoundAmount(request.Amount),
+			DepositAccountID: depositAccount.ID,
+			Reference:        request.Reference,
+			Notes:            request.Notes,
+			Status:           domain.CustomerReceiptStatusDraft,
+			CreatedBy:        userID,
+		}
+
+		if len(request.Allocations) > 0 {
+			invoices, err := service.SalesInvoiceRepository.LockByIds(ctx, tx, allocationInvoiceIDs(request.Allocations))
+			if err != nil {
+				return err
+			}
+			allocations, allocatedAmount, err := buildAllocations(receipt, invoices, request.Allocations, receiptDate, userID)
+			if err != nil {
+				return err
+			}
+			if allocatedAmount.GreaterThan(receipt.Amount) {
+				return exception.NewError("total allocations cannot exceed the receipt amount")
+			}
+			// Sisa piutang diperiksa ulang saat posting karena invoice bisa dilunasi penerimaan lain
+			if _, err := applyAllocations(invoices, allocations); err != nil {
+				return err
+			}
+			receipt.Allocations = allocations
+			receipt.AllocatedAmount = allocatedAmount
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, CustomerReceiptNumberPrefix, receiptDate)
+		if err != nil {
+			return err
+		}
+		receipt.Number = number
+
+		created, err := service.CustomerReceiptRepository.Create(ctx, tx, receipt)
+		if err != nil {
+			return err
+		}
+		receiptID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, receiptID)
+}
+
+func (service *CustomerReceiptServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*receivable.CustomerReceiptResponse, error) {
+	receipt, err := service.CustomerReceiptRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("customer receipt not found")
+	}
+
+	return mapper.ToCustomerReceiptResponse(receipt), nil
+}
+
+func (service *CustomerReceiptServiceImpl) FindAll(ctx context.Context, filter receivable.CustomerReceiptFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	customerID, err := helper.ParseOptionalUUID(filter.CustomerID, "customer_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	receipts, totalItems, err := service.CustomerReceiptRepository.FindAllWithPagination(ctx, service.DB, filter.Status, customerID, filter.Unapplied, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToCustomerReceiptResponses(receipts)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *CustomerReceiptServiceImpl) Post(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*receivable.CustomerReceiptResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		receipt, err := service.CustomerReceiptRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("customer receipt not found")
+		}
+
+		if receipt.Status != domain.CustomerReceiptStatusDraft {
+			return exception.NewError("only draft customer receipts can be posted")
+		}
+
+		setting, err := loadReceivableSetting(ctx, tx, service.ReceivableSettingRepository)
+		if err != nil {
+			return err
+		}
+
+		rate, err := service.CurrencyService.RateOn(ctx, tx, receipt.Currency, receipt.ReceiptDate)
+		if err != nil {
+			return err
+		}
+
+		// Piutang dilunasi pada kurs invoice, sisa kredit customer dibukukan pada kurs penerimaan
+		var receivables []currencyService.RateAmount
+		if len(receipt.Allocations) > 0 {
+			invoiceIDs := make([]uuid.UUID, 0, len(receipt.Allocations))
+			for _, allocation := range receipt.Allocations {
+				invoiceIDs = append(invoiceIDs, allocation.SalesInvoiceID)
+			}
+			invoices, err := service.SalesInvoiceRepository.LockByIds(ctx, tx, invoiceIDs)
+			if err != nil {
+				return err
+			}
+			receivables = groupAllocationsByRate(receivables, invoices, receipt.Allocations)
+			if err := service.settleInvoices(ctx, tx, invoices, receipt.Allocations); err != nil {
+				return err
+			}
+		}
+		if unapplied := helper.RoundAmount(receipt.UnappliedAmount()); !helper.IsZeroAmount(unapplied) {
+			receivables = currencyService.AddRateAmount(receivables, rate, unapplied)
+		}
+
+		journal := domain.JournalEntry{
+			EntryDate:   receipt.ReceiptDate,
+			Description: fmt.Sprintf("Receipt from %s", receipt.CustomerName),
+			Reference:   receipt.Number,
+			SourceType:  domain.JournalSourceCustomerReceipt,
+			SourceID:    &receipt.ID,
+			CreatedBy:   userID,
+			Lines: []domain.JournalLine{
+				{AccountID: receipt.DepositAccountID, Description: "Receipt " + receipt.Number, Debit: receipt.Amount},
+			},
+		}
+		for _, group := range receivables {
+			journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: *setting.ReceivableAccountID, Description: "Receivable " + receipt.CustomerName, Credit: group.Amount, ExchangeRate: group.Rate})
+		}
+
+		journal, err = service.CurrencyService.ConvertEntry(ctx, tx, journal, receipt.Currency, rate)
+		if err != nil {
+			return err
+		}
+		entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		receipt.Status = domain.CustomerReceiptStatusPosted
+		receipt.ExchangeRate = rate
+		receipt.JournalEntryID = &entry.ID
+		receipt.PostedBy = &userID
+		receipt.PostedAt = &now
+		return service.CustomerReceiptRepository.Update(ctx, tx, receipt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *CustomerReceiptServiceImpl) Cancel(ctx context.Context, id uuid.UUID) (*receivable.CustomerReceiptResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		receipt, err := service.CustomerReceiptRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("customer receipt not found")
+		}
+
+		if receipt.Status != domain.CustomerReceiptStatusDraft {
+			return exception.NewError("only draft customer receipts can be cancelled")
+		}
+
+		receipt.Status = domain.CustomerReceiptStatusCancelled
+		return service.CustomerReceiptRepository.Update(ctx, tx, receipt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *CustomerReceiptServiceImpl) Allocate(ctx context.Context, id uuid.UUID, userID uuid.UUID, request receivable.ReceiptAllocateRequest) (*receivable.CustomerReceiptResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	allocationDate := helper.Today()
+	if request.AllocationDate != "" {
+		parsed, err := helper.ParseDate(request.AllocationDate)
+		if err != nil {
+			return nil, exception.NewError("invalid allocation date")
+		}
+		allocationDate = parsed
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		receipt, err := service.CustomerReceiptRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("customer receipt not found")
+		}
+
+		if receipt.Status != domain.CustomerReceiptStatusPosted {
+			return exception.NewError("only posted customer receipts can be allocated, add allocations to a draft receipt before posting")
+		}
+		if allocationDate.Before(receipt.ReceiptDate) {
+			return exception.NewError("allocation date cannot be before receipt date")
+		}
+
+		invoices, err := service.SalesInvoiceRepository.LockByIds(ctx, tx, allocationInvoiceIDs(request.Allocations))
+		if err != nil {
+			return err
+		}
+		allocations, allocatedAmount, err := buildAllocations(receipt, invoices, request.Allocations, allocationDate, userID)
+		if err != nil {
+			return err
+		}
+		if allocatedAmount.GreaterThan(helper.RoundAmount(receipt.UnappliedAmount())) {
+			return exception.NewError(fmt.Sprintf("total allocations exceed the unapplied amount %s", receipt.UnappliedAmount().StringFixed(2)))
+		}
+
+		if err := service.postAllocationDifference(ctx, tx, receipt, invoices, allocations, allocationDate, userID); err != nil {
+			return err
+		}
+		if err := service.settleInvoices(ctx, tx, invoices, allocations); err != nil {
+			return err
+		}
+		if err := service.CustomerReceiptRepository.CreateAllocations(ctx, tx, allocations); err != nil {
+			return err
+		}
+
+		receipt.AllocatedAmount = helper.RoundAmount(receipt.AllocatedAmount.Add(allocatedAmount))
+		return service.CustomerReceiptRepository.Update(ctx, tx, receipt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// settleInvoices menyimpan PaidAmount dan status terbaru invoice yang sudah dikunci pemanggil
+func (service *CustomerReceiptServiceImpl) settleInvoices(ctx context.Context, tx *gorm.DB, invoices []domain.SalesInvoice, allocations []domain.ReceiptAllocation) error {
+	settled, err := applyAllocations(invoices, allocations)
+	if err != nil {
+		return err
+	}
+	for _, invoice := range settled {
+		if err := service.SalesInvoiceRepository.Update(ctx, tx, invoice); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postAllocationDifference menjurnal selisih kurs saat kredit customer valas (dibukukan pada kurs
+// penerimaan) dialokasikan ke invoice yang dibukukan pada kurs lain. Tidak ada jurnal bila kursnya sama.
+func (service *CustomerReceiptServiceImpl) postAllocationDifference(ctx context.Context, tx *gorm.DB, receipt domain.CustomerReceipt, invoices []domain.SalesInvoice, allocations []domain.ReceiptAllocation, allocationDate time.Time, userID uuid.UUID) error {
+	if receipt.Currency == domain.BaseCurrency {
+		return nil
+	}
+
+	receivables := groupAllocationsByRate(nil, invoices, allocations)
+	if len(receivables) == 0 || (len(receivables) == 1 && receivables[0].Rate == receipt.ExchangeRate) {
+		return nil
+	}
+
+	setting, err := loadReceivableSetting(ctx, tx, service.ReceivableSettingRepository)
+	if err != nil {
+		return err
+	}
+
+	journal := domain.JournalEntry{
+		EntryDate:   allocationDate,
+		Description: fmt.Sprintf("Exchange difference on allocation of %s", receipt.Number),
+		Reference:   receipt.Number,
+		SourceType:  domain.JournalSourceReceiptAllocation,
+		SourceID:    &receipt.ID,
+		CreatedBy:   userID,
+	}
+	var allocatedAmount decimal.Decimal
+	for _, group := range receivables {
+		allocatedAmount = allocatedAmount.Add(group.Amount)
+		journal.Lines = append(journal.Lines, domain.JournalLine{AccountID: *setting.ReceivableAccountID, Description: "Receivable " + receipt.CustomerName, Credit: group.Amount, ExchangeRate: group.Rate})
+	}
+	journal.Lines = append([]domain.JournalLine{
+		{AccountID: *setting.ReceivableAccountID, Description: "Customer credit " + receipt.Number, Debit: helper.RoundAmount(allocatedAmount), ExchangeRate: receipt.ExchangeRate},
+	}, journal.Lines...)
+
+	journal, err = service.CurrencyService.ConvertEntry(ctx, tx, journal, receipt.Currency, receipt.ExchangeRate)
+	if err != nil {
+		return err
+	}
+	_, err = service.LedgerService.PostEntry(ctx, tx, journal)
+	return err
+}
@@ -0,0 +1,19 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+
+	"github.com/google/uuid"
+)
+
+type CustomerService interface {
+	Create(ctx context.Context, request receivable.CustomerRequest) (*receivable.CustomerResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request receivable.CustomerRequest) (*receivable.CustomerResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*receivable.CustomerResponse, error)
+	FindAll(ctx context.Context, filter receivable.CustomerFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// Statement menyusun rekening koran customer untuk rentang tanggal tertentu
+	Statement(ctx context.Context, id uuid.UUID, request receivable.CustomerStatementRequest) (*receivable.CustomerStatementResponse, error)
+}
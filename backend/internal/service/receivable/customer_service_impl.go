@@ -0,0 +1,298 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	repo "erpfinance/internal/repository/receivable"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	statementDocumentInvoice = "SalesInvoice"
+	statementDocumentReceipt = "CustomerReceipt"
+)
+
+type CustomerServiceImpl struct {
+	CustomerRepository        repo.CustomerRepository
+	SalesInvoiceRepository    repo.SalesInvoiceRepository
+	CustomerReceiptRepository repo.CustomerReceiptRepository
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewCustomerService(customerRepository repo.CustomerRepository, salesInvoiceRepository repo.SalesInvoiceRepository, customerReceiptRepository repo.CustomerReceiptRepository, db *gorm.DB, validate *validator.Validate) CustomerService {
+	return &CustomerServiceImpl{
+		CustomerRepository:        customerRepository,
+		SalesInvoiceRepository:    salesInvoiceRepository,
+		CustomerReceiptRepository: customerReceiptRepository,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *CustomerServiceImpl) Create(ctx context.Context, request receivable.CustomerRequest) (*receivable.CustomerResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	npwp, err := normalizeNPWP(request.NPWP)
+	if err != nil {
+		return nil, err
+	}
+
+	var customerID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.CustomerRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("customer code already exists")
+		}
+
+		newCustomer := domain.Customer{
+			ID:              uuid.New(),
+			Code:            request.Code,
+			Name:            request.Name,
+			NPWP:            npwp,
+			Email:           request.Email,
+			Phone:           request.Phone,
+			BillingAddress:  request.BillingAddress,
+			PaymentTermDays: request.PaymentTermDays,
+			Currency:        strings.ToUpper(request.Currency),
+			CreditLimit:     helper.RoundAmount(request.CreditLimit),
+			IsActive:        true,
+			Notes:           request.Notes,
+		}
+		if request.IsActive != nil {
+			newCustomer.IsActive = *request.IsActive
+		}
+
+		created, err := service.CustomerRepository.Create(ctx, tx, newCustomer)
+		if err != nil {
+			return err
+		}
+		customerID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, customerID)
+}
+
+func (service *CustomerServiceImpl) Update(ctx context.Context, id uuid.UUID, request receivable.CustomerRequest) (*receivable.CustomerResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	npwp, err := normalizeNPWP(request.NPWP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := service.CustomerRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("customer not found")
+		}
+
+		existing, err := service.CustomerRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != current.ID {
+			return exception.NewError("customer code already exists")
+		}
+
+		// Mata uang dipakai sebagai mata uang invoice dan penerimaan, sehingga tidak boleh
+		// diganti setelah customer punya transaksi
+		currency := strings.ToUpper(request.Currency)
+		if currency != current.Currency {
+			exists, err := service.SalesInvoiceRepository.ExistsByCustomer(ctx, tx, current.ID)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return exception.NewError("currency cannot be changed after the customer has sales invoices")
+			}
+		}
+
+		current.Code = request.Code
+		current.Name = request.Name
+		current.NPWP = npwp
+		current.Email = request.Email
+		current.Phone = request.Phone
+		current.BillingAddress = request.BillingAddress
+		current.PaymentTermDays = request.PaymentTermDays
+		current.Currency = currency
+		current.CreditLimit = helper.RoundAmount(request.CreditLimit)
+		current.Notes = request.Notes
+		if request.IsActive != nil {
+			current.IsActive = *request.IsActive
+		}
+		return service.CustomerRepository.Update(ctx, tx, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *CustomerServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*receivable.CustomerResponse, error) {
+	result, err := service.CustomerRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("customer not found")
+	}
+
+	return mapper.ToCustomerResponse(result), nil
+}
+
+func (service *CustomerServiceImpl) FindAll(ctx context.Context, filter receivable.CustomerFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	var isActive *bool
+	if filter.IsActive != "" {
+		parsed, err := strconv.ParseBool(filter.IsActive)
+		if err != nil {
+			return dto.PaginationResponse{}, exception.NewError("is_active must be true or false")
+		}
+		isActive = &parsed
+	}
+
+	customers, totalItems, err := service.CustomerRepository.FindAllWithPagination(ctx, service.DB, filter.Search, isActive, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToCustomerResponses(customers)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *CustomerServiceImpl) Statement(ctx context.Context, id uuid.UUID, request receivable.CustomerStatementRequest) (*receivable.CustomerStatementResponse, error) {
+	customer, err := service.CustomerRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("customer not found")
+	}
+
+	dateTo := helper.Today()
+	if request.DateTo != "" {
+		dateTo, err = helper.ParseDate(request.DateTo)
+		if err != nil {
+			return nil, exception.NewError("date_to must be in format 2006-01-02")
+		}
+	}
+	dateFrom := time.Date(dateTo.Year(), dateTo.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if request.DateFrom != "" {
+		dateFrom, err = helper.ParseDate(request.DateFrom)
+		if err != nil {
+			return nil, exception.NewError("date_from must be in format 2006-01-02")
+		}
+	}
+	if dateFrom.After(dateTo) {
+		return nil, exception.NewError("date_from cannot be after date_to")
+	}
+
+	invoicedBefore, err := service.SalesInvoiceRepository.SumPostedByCustomerBefore(ctx, service.DB, customer.ID, dateFrom)
+	if err != nil {
+		return nil, err
+	}
+	receivedBefore, err := service.CustomerReceiptRepository.SumPostedByCustomerBefore(ctx, service.DB, customer.ID, dateFrom)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := service.SalesInvoiceRepository.FindPostedByCustomer(ctx, service.DB, customer.ID, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := service.CustomerReceiptRepository.FindPostedByCustomer(ctx, service.DB, customer.ID, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &receivable.CustomerStatementResponse{
+		CustomerID:     customer.ID,
+		CustomerCode:   customer.Code,
+		CustomerName:   customer.Name,
+		Currency:       customer.Currency,
+		DateFrom:       helper.FormatDate(dateFrom),
+		DateTo:         helper.FormatDate(dateTo),
+		OpeningBalance: helper.RoundAmount(invoicedBefore.Sub(receivedBefore)),
+		Lines:          []receivable.StatementLineResponse{},
+		OpenInvoices:   []receivable.AgingInvoiceResponse{},
+	}
+
+	type statementEntry struct {
+		date time.Time
+		line receivable.StatementLineResponse
+	}
+	entries := make([]statementEntry, 0, len(invoices)+len(receipts))
+	for _, invoice := range invoices {
+		entries = append(entries, statementEntry{date: invoice.InvoiceDate, line: receivable.StatementLineResponse{
+			Date:         helper.FormatDate(invoice.InvoiceDate),
+			DocumentType: statementDocumentInvoice,
+			DocumentID:   invoice.ID,
+			Number:       invoice.Number,
+			Reference:    invoice.Reference,
+			DueDate:      helper.FormatDate(invoice.DueDate),
+			Debit:        invoice.TotalAmount,
+		}})
+		statement.TotalInvoiced = statement.TotalInvoiced.Add(invoice.TotalAmount)
+	}
+	for _, receipt := range receipts {
+		entries = append(entries, statementEntry{date: receipt.ReceiptDate, line: receivable.StatementLineResponse{
+			Date:         helper.FormatDate(receipt.ReceiptDate),
+			DocumentType: statementDocumentReceipt,
+			DocumentID:   receipt.ID,
+			Number:       receipt.Number,
+			Reference:    receipt.Reference,
+			Credit:       receipt.Amount,
+		}})
+		statement.TotalReceived = statement.TotalReceived.Add(receipt.Amount)
+	}
+
+	// Invoice dan penerimaan pada tanggal yang sama tetap berurutan invoice lebih dulu
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].date.Before(entries[j].date)
+	})
+
+	balance := statement.OpeningBalance
+	for _, entry := range entries {
+		balance = helper.RoundAmount(balance.Add(entry.line.Debit).Sub(entry.line.Credit))
+		entry.line.Balance = balance
+		statement.Lines = append(statement.Lines, entry.line)
+	}
+	statement.TotalInvoiced = helper.RoundAmount(statement.TotalInvoiced)
+	statement.TotalReceived = helper.RoundAmount(statement.TotalReceived)
+	statement.ClosingBalance = balance
+
+	outstanding, err := service.SalesInvoiceRepository.FindOutstandingAsOf(ctx, service.DB, dateTo, &customer.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range outstanding {
+		amount := helper.RoundAmount(row.TotalAmount.Sub(row.PaidAmount))
+		if amount.IsPositive() {
+			statement.OpenInvoices = append(statement.OpenInvoices, toAgingInvoice(dateTo, row, amount))
+		}
+	}
+
+	credits, err := service.CustomerReceiptRepository.FindUnappliedAsOf(ctx, service.DB, dateTo, &customer.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, credit := range credits {
+		if unapplied := helper.RoundAmount(credit.Amount.Sub(credit.AllocatedAmount)); unapplied.IsPositive() {
+			statement.UnappliedCredit = helper.RoundAmount(statement.UnappliedCredit.Add(unapplied))
+		}
+	}
+
+	return statement, nil
+}
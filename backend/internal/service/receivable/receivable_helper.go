@@ -0,0 +1,364 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/receivable"
+	repo "erpfinance/internal/repository/receivable"
+	currencyService "erpfinance/internal/service/currency"
+	taxService "erpfinance/internal/service/tax"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// loadReceivableSetting memastikan akun kontrol piutang sudah diatur sebelum jurnal otomatis dibuat
+func loadReceivableSetting(ctx context.Context, tx *gorm.DB, repository repo.ReceivableSettingRepository) (domain.ReceivableSetting, error) {
+	setting, err := repository.Find(ctx, tx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ReceivableSetting{}, exception.NewError("receivable accounts are not configured")
+	}
+	if err != nil {
+		return domain.ReceivableSetting{}, err
+	}
+	if setting.ReceivableAccountID == nil {
+		return domain.ReceivableSetting{}, exception.NewError("receivable account is not configured")
+	}
+	return setting, nil
+}
+
+// findActiveCustomer menolak customer yang tidak ada atau sudah dinonaktifkan
+func findActiveCustomer(ctx context.Context, tx *gorm.DB, repository repo.CustomerRepository, id uuid.UUID) (domain.Customer, error) {
+	customer, err := repository.FindById(ctx, tx, id)
+	if err != nil {
+		return domain.Customer{}, exception.NewNotFoundError("customer not found")
+	}
+	if !customer.IsActive {
+		return domain.Customer{}, exception.NewError(fmt.Sprintf("customer %s is inactive", customer.Code))
+	}
+	return customer, nil
+}
+
+// normalizeNPWP membersihkan format NPWP; NPWP boleh kosong untuk customer perorangan atau luar negeri
+func normalizeNPWP(npwp string) (string, error) {
+	if strings.TrimSpace(npwp) == "" {
+		return "", nil
+	}
+	normalized := helper.NormalizeNPWP(npwp)
+	if !helper.IsValidNPWP(normalized) {
+		return "", exception.NewError("npwp must contain 15 or 16 digits")
+	}
+	return normalized, nil
+}
+
+// buildInvoiceTaxes menghitung nilai setiap baris pajak. Hasil kedua adalah total pengaruh pajak
+// terhadap piutang (pajak yang dipotong customer bernilai negatif).
+func buildInvoiceTaxes(invoiceID uuid.UUID, resolved []taxService.ResolvedTaxLine) ([]domain.SalesInvoiceTax, decimal.Decimal) {
+	taxes := make([]domain.SalesInvoiceTax, 0, len(resolved))
+	var taxAmount decimal.Decimal
+	for i, line := range resolved {
+		tax := domain.SalesInvoiceTax{
+			ID:             uuid.New(),
+			SalesInvoiceID: invoiceID,
+			LineNo:         i + 1,
+			TaxCode:        line.TaxCode,
+			TaxType:        line.TaxType,
+			Description:    line.Description,
+			AccountID:      line.AccountID,
+			BaseAmount:     line.BaseAmount,
+			Rate:           line.Rate,
+			Amount:         line.Amount(),
+			IsWithholding:  line.IsWithholding,
+		}
+		taxAmount = taxAmount.Add(tax.SignedAmount())
+		taxes = append(taxes, tax)
+	}
+	return taxes, helper.RoundAmount(taxAmount)
+}
+
+// requiresTaxInvoice menandakan invoice memiliki PPN keluaran sehingga perlu nomor faktur pajak
+func requiresTaxInvoice(invoice domain.SalesInvoice) bool {
+	for _, tax := range invoice.Taxes {
+		if tax.TaxType == domain.TaxTypePPN && !tax.IsWithholding && tax.Amount.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+// toTaxLineInputs menyalin baris pajak request ke input resolusi kode pajak
+func toTaxLineInputs(requests []receivable.SalesInvoiceTaxRequest) []taxService.TaxLineInput {
+	inputs := make([]taxService.TaxLineInput, 0, len(requests))
+	for _, request := range requests {
+		inputs = append(inputs, taxService.TaxLineInput{
+			TaxCode:       request.TaxCode,
+			Description:   request.Description,
+			AccountID:     request.AccountID,
+			Rate:          request.Rate,
+			BaseAmount:    request.BaseAmount,
+			IsWithholding: request.IsWithholding,
+		})
+	}
+	return inputs
+}
+
+// buildInvoiceJournal menyusun jurnal piutang saat invoice diposting: debit piutang usaha (total)
+// dan pajak yang dipotong customer, kredit pendapatan per akun dan pajak keluaran.
+func buildInvoiceJournal(invoice domain.SalesInvoice, setting domain.ReceivableSetting, userID uuid.UUID) (domain.JournalEntry, error) {
+	entry := domain.JournalEntry{
+		EntryDate:   invoice.InvoiceDate,
+		Description: fmt.Sprintf("Sales invoice %s to %s", invoice.Number, invoice.CustomerName),
+		Reference:   invoice.Number,
+		SourceType:  domain.JournalSourceSalesInvoice,
+		SourceID:    &invoice.ID,
+		CreatedBy:   userID,
+	}
+
+	entry.Lines = append(entry.Lines, domain.JournalLine{
+		AccountID:   *setting.ReceivableAccountID,
+		Description: "Receivable " + invoice.Number,
+		Debit:       invoice.TotalAmount,
+	})
+
+	// Pendapatan digabung per akun dengan urutan kemunculan baris pertama
+	var revenueAccounts []uuid.UUID
+	revenueByAccount := make(map[uuid.UUID]decimal.Decimal)
+	for _, line := range invoice.Lines {
+		accountID := setting.RevenueAccountID
+		if line.RevenueAccountID != nil {
+			accountID = line.RevenueAccountID
+		}
+		if accountID == nil {
+			return domain.JournalEntry{}, exception.NewError(fmt.Sprintf("line %d: revenue account is not set and no default revenue account is configured", line.LineNo))
+		}
+		if _, ok := revenueByAccount[*accountID]; !ok {
+			revenueAccounts = append(revenueAccounts, *accountID)
+		}
+		revenueByAccount[*accountID] = revenueByAccount[*accountID].Add(line.LineTotal)
+	}
+	for _, accountID := range revenueAccounts {
+		amount := helper.RoundAmount(revenueByAccount[accountID])
+		if helper.IsZeroAmount(amount) {
+			continue
+		}
+		entry.Lines = append(entry.Lines, domain.JournalLine{
+			AccountID:   accountID,
+			Description: "Revenue " + invoice.Number,
+			Credit:      amount,
+		})
+	}
+
+	for _, tax := range invoice.Taxes {
+		if helper.IsZeroAmount(tax.Amount) {
+			continue
+		}
+		line := domain.JournalLine{
+			AccountID:   tax.AccountID,
+			Description: fmt.Sprintf("%s %s", tax.TaxCode, invoice.Number),
+		}
+		if tax.IsWithholding {
+			line.Debit = tax.Amount
+		} else {
+			line.Credit = tax.Amount
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+	return entry, nil
+}
+
+// buildAllocations menyusun alokasi penerimaan ke invoice milik customer yang sama. Invoice harus
+// sudah diposting dan bertanggal paling lambat allocationDate; sisa piutang diperiksa oleh
+// applyAllocations. Hasil kedua adalah total alokasi.
+func buildAllocations(receipt domain.CustomerReceipt, invoices []domain.SalesInvoice, requests []receivable.ReceiptAllocationRequest, allocationDate time.Time, userID uuid.UUID) ([]domain.ReceiptAllocation, decimal.Decimal, error) {
+	invoiceByID := make(map[uuid.UUID]domain.SalesInvoice, len(invoices))
+	for _, invoice := range invoices {
+		invoiceByID[invoice.ID] = invoice
+	}
+
+	allocations := make([]domain.ReceiptAllocation, 0, len(requests))
+	seen := make(map[uuid.UUID]bool, len(requests))
+	var totalAmount decimal.Decimal
+	for i, request := range requests {
+		invoice, ok := invoiceByID[request.SalesInvoiceID]
+		if !ok {
+			return nil, decimal.Zero, exception.NewError(fmt.Sprintf("allocation %d: sales invoice not found", i+1))
+		}
+		if seen[invoice.ID] {
+			return nil, decimal.Zero, exception.NewError(fmt.Sprintf("allocation %d: sales invoice %s is listed more than once", i+1, invoice.Number))
+		}
+		seen[invoice.ID] = true
+
+		if invoice.CustomerID != receipt.CustomerID {
+			return nil, decimal.Zero, exception.NewError(fmt.Sprintf("allocation %d: sales invoice %s belongs to another customer", i+1, invoice.Number))
+		}
+		if invoice.InvoiceDate.After(allocationDate) {
+			return nil, decimal.Zero, exception.NewError(fmt.Sprintf("allocation %d: sales invoice %s is dated after the allocation date", i+1, invoice.Number))
+		}
+
+		allocation := domain.ReceiptAllocation{
+			ID:                uuid.New(),
+			CustomerReceiptID: receipt.ID,
+			SalesInvoiceID:    invoice.ID,
+			InvoiceNumber:     invoice.Number,
+			AllocationDate:    allocationDate,
+			Amount:            helper.RoundAmount(request.Amount),
+			CreatedBy:         userID,
+		}
+		totalAmount = totalAmount.Add(allocation.Amount)
+		allocations = append(allocations, allocation)
+	}
+	return allocations, helper.RoundAmount(totalAmount), nil
+}
+
+// applyAllocations menambah PaidAmount invoice sesuai alokasi dan menandai invoice yang lunas.
+// Alokasi ditolak bila invoice tidak lagi terbuka atau nilainya melebihi sisa piutang.
+func applyAllocations(invoices []domain.SalesInvoice, allocations []domain.ReceiptAllocation) ([]domain.SalesInvoice, error) {
+	invoiceByID := make(map[uuid.UUID]*domain.SalesInvoice, len(invoices))
+	for i := range invoices {
+		invoiceByID[invoices[i].ID] = &invoices[i]
+	}
+
+	for _, allocation := range allocations {
+		invoice, ok := invoiceByID[allocation.SalesInvoiceID]
+		if !ok || invoice.Status != domain.SalesInvoiceStatusPosted {
+			return nil, exception.NewError(fmt.Sprintf("sales invoice %s is not open for allocation", allocation.InvoiceNumber))
+		}
+		if allocation.Amount.GreaterThan(helper.RoundAmount(invoice.OutstandingAmount())) {
+			return nil, exception.NewError(fmt.Sprintf("allocation to sales invoice %s exceeds its outstanding amount", allocation.InvoiceNumber))
+		}
+
+		invoice.PaidAmount = helper.RoundAmount(invoice.PaidAmount.Add(allocation.Amount))
+		if helper.IsZeroAmount(invoice.OutstandingAmount()) {
+			invoice.Status = domain.SalesInvoiceStatusPaid
+		}
+	}
+	return invoices, nil
+}
+
+// groupAllocationsByRate mengelompokkan nominal alokasi per kurs invoice yang dilunasi
+func groupAllocationsByRate(groups []currencyService.RateAmount, invoices []domain.SalesInvoice, allocations []domain.ReceiptAllocation) []currencyService.RateAmount {
+	rateByInvoice := make(map[uuid.UUID]float64, len(invoices))
+	for _, invoice := range invoices {
+		rateByInvoice[invoice.ID] = invoice.ExchangeRate
+	}
+	for _, allocation := range allocations {
+		groups = currencyService.AddRateAmount(groups, rateByInvoice[allocation.SalesInvoiceID], allocation.Amount)
+	}
+	return groups
+}
+
+// allocationInvoiceIDs mengambil id invoice dari daftar alokasi
+func allocationInvoiceIDs(requests []receivable.ReceiptAllocationRequest) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.SalesInvoiceID)
+	}
+	return ids
+}
+
+// buildAgingReport mengelompokkan sisa piutang per customer dan mata uang ke bucket berdasarkan
+// jumlah hari lewat jatuh tempo pada tanggal asOf, lalu mengurangkan kredit yang belum dialokasikan
+func buildAgingReport(asOf time.Time, rows []domain.ReceivableOutstanding, credits []domain.ReceivableCredit) *receivable.AgingReportResponse {
+	report := &receivable.AgingReportResponse{
+		AsOf:      helper.FormatDate(asOf),
+		Customers: []receivable.AgingCustomerResponse{},
+		Totals:    []receivable.AgingTotalResponse{},
+	}
+
+	customerIndex := make(map[string]int)
+	totalIndex := make(map[string]int)
+	customerEntry := func(customerID uuid.UUID, customerName string, currency string) (*receivable.AgingCustomerResponse, *receivable.AgingTotalResponse) {
+		key := customerID.String() + "|" + currency
+		index, ok := customerIndex[key]
+		if !ok {
+			report.Customers = append(report.Customers, receivable.AgingCustomerResponse{
+				CustomerID:   customerID,
+				CustomerName: customerName,
+				Currency:     currency,
+				Invoices:     []receivable.AgingInvoiceResponse{},
+			})
+			index = len(report.Customers) - 1
+			customerIndex[key] = index
+		}
+
+		totalPosition, ok := totalIndex[currency]
+		if !ok {
+			report.Totals = append(report.Totals, receivable.AgingTotalResponse{Currency: currency})
+			totalPosition = len(report.Totals) - 1
+			totalIndex[currency] = totalPosition
+		}
+		return &report.Customers[index], &report.Totals[totalPosition]
+	}
+
+	for _, row := range rows {
+		outstanding := helper.RoundAmount(row.TotalAmount.Sub(row.PaidAmount))
+		if !outstanding.IsPositive() {
+			continue
+		}
+
+		customer, total := customerEntry(row.CustomerID, row.CustomerName, row.Currency)
+		invoice := toAgingInvoice(asOf, row, outstanding)
+		customer.Invoices = append(customer.Invoices, invoice)
+		addToBucket(&customer.Buckets, invoice.DaysOverdue, outstanding)
+		addToBucket(&total.Buckets, invoice.DaysOverdue, outstanding)
+	}
+
+	for _, credit := range credits {
+		unapplied := helper.RoundAmount(credit.Amount.Sub(credit.AllocatedAmount))
+		if !unapplied.IsPositive() {
+			continue
+		}
+
+		customer, total := customerEntry(credit.CustomerID, credit.CustomerName, credit.Currency)
+		customer.UnappliedCredit = helper.RoundAmount(customer.UnappliedCredit.Add(unapplied))
+		total.UnappliedCredit = helper.RoundAmount(total.UnappliedCredit.Add(unapplied))
+	}
+
+	for i := range report.Customers {
+		report.Customers[i].NetBalance = helper.RoundAmount(report.Customers[i].Buckets.Total.Sub(report.Customers[i].UnappliedCredit))
+	}
+	for i := range report.Totals {
+		report.Totals[i].NetBalance = helper.RoundAmount(report.Totals[i].Buckets.Total.Sub(report.Totals[i].UnappliedCredit))
+	}
+	return report
+}
+
+func toAgingInvoice(asOf time.Time, row domain.ReceivableOutstanding, outstanding decimal.Decimal) receivable.AgingInvoiceResponse {
+	daysOverdue := int(math.Floor(asOf.Sub(row.DueDate).Hours() / 24))
+	if daysOverdue < 0 {
+		daysOverdue = 0
+	}
+
+	return receivable.AgingInvoiceResponse{
+		InvoiceID:         row.InvoiceID,
+		Number:            row.Number,
+		InvoiceDate:       helper.FormatDate(row.InvoiceDate),
+		DueDate:           helper.FormatDate(row.DueDate),
+		DaysOverdue:       daysOverdue,
+		OutstandingAmount: outstanding,
+	}
+}
+
+func addToBucket(buckets *receivable.AgingBuckets, daysOverdue int, amount decimal.Decimal) {
+	switch {
+	case daysOverdue <= 0:
+		buckets.Current = helper.RoundAmount(buckets.Current.Add(amount))
+	case daysOverdue <= 30:
+		buckets.Days1To30 = helper.RoundAmount(buckets.Days1To30.Add(amount))
+	case daysOverdue <= 60:
+		buckets.Days31To60 = helper.RoundAmount(buckets.Days31To60.Add(amount))
+	case daysOverdue <= 90:
+		buckets.Days61To90 = helper.RoundAmount(buckets.Days61To90.Add(amount))
+	default:
+		buckets.Over90 = helper.RoundAmount(buckets.Over90.Add(amount))
+	}
+	buckets.Total = helper.RoundAmount(buckets.Total.Add(amount))
+}
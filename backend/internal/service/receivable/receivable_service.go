@@ -0,0 +1,26 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+
+	"github.com/google/uuid"
+)
+
+type ReceivableService interface {
+	CreateInvoice(ctx context.Context, userID uuid.UUID, request receivable.SalesInvoiceRequest) (*receivable.SalesInvoiceResponse, error)
+	UpdateInvoice(ctx context.Context, id uuid.UUID, request receivable.SalesInvoiceRequest) (*receivable.SalesInvoiceResponse, error)
+	FindInvoiceById(ctx context.Context, id uuid.UUID) (*receivable.SalesInvoiceResponse, error)
+	FindAllInvoices(ctx context.Context, filter receivable.SalesInvoiceFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// PostInvoice memposting jurnal piutang (debit piutang usaha, kredit pendapatan dan pajak keluaran)
+	PostInvoice(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*receivable.SalesInvoiceResponse, error)
+	CancelInvoice(ctx context.Context, id uuid.UUID) (*receivable.SalesInvoiceResponse, error)
+
+	GetSetting(ctx context.Context) (*receivable.ReceivableSettingResponse, error)
+	UpdateSetting(ctx context.Context, userID uuid.UUID, request receivable.ReceivableSettingRequest) (*receivable.ReceivableSettingResponse, error)
+
+	// AgingReport mengelompokkan sisa piutang per customer ke bucket umur jatuh tempo
+	AgingReport(ctx context.Context, filter receivable.AgingFilterRequest) (*receivable.AgingReportResponse, error)
+}
@@ -0,0 +1,404 @@
+package receivable
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/receivable"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/receivable"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	currencyService "erpfinance/internal/service/currency"
+	ledgerService "erpfinance/internal/service/ledger"
+	taxService "erpfinance/internal/service/tax"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SalesInvoiceNumberPrefix adalah prefix penomoran sales invoice, contoh: INV-202507-00001
+const SalesInvoiceNumberPrefix = "INV"
+
+type ReceivableServiceImpl struct {
+	SalesInvoiceRepository      repo.SalesInvoiceRepository
+	CustomerReceiptRepository   repo.CustomerReceiptRepository
+	CustomerRepository          repo.CustomerRepository
+	ReceivableSettingRepository repo.ReceivableSettingRepository
+	ItemRepository              inventoryRepo.ItemRepository
+	SequenceRepository          sequenceRepo.SequenceRepository
+	CurrencyService             currencyService.CurrencyService
+	LedgerService               ledgerService.LedgerService
+	TaxService                  taxService.TaxService
+	DB                          *gorm.DB
+	Validate                    *validator.Validate
+}
+
+func NewReceivableService(salesInvoiceRepository repo.SalesInvoiceRepository, customerReceiptRepository repo.CustomerReceiptRepository, customerRepository repo.CustomerRepository, receivableSettingRepository repo.ReceivableSettingRepository, itemRepository inventoryRepo.ItemRepository, sequenceRepository sequenceRepo.SequenceRepository, currencyService currencyService.CurrencyService, ledgerService ledgerService.LedgerService, taxService taxService.TaxService, db *gorm.DB, validate *validator.Validate) ReceivableService {
+	return &ReceivableServiceImpl{
+		SalesInvoiceRepository:      salesInvoiceRepository,
+		CustomerReceiptRepository:   customerReceiptRepository,
+		CustomerRepository:          customerRepository,
+		ReceivableSettingRepository: receivableSettingRepository,
+		ItemRepository:              itemRepository,
+		SequenceRepository:          sequenceRepository,
+		CurrencyService:             currencyService,
+		LedgerService:               ledgerService,
+		TaxService:                  taxService,
+		DB:                          db,
+		Validate:                    validate,
+	}
+}
+
+func (service *ReceivableServiceImpl) CreateInvoice(ctx context.Context, userID uuid.UUID, request receivable.SalesInvoiceRequest) (*receivable.SalesInvoiceResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	invoiceDate, err := helper.ParseDate(request.InvoiceDate)
+	if err != nil {
+		return nil, exception.NewError("invalid invoice date")
+	}
+
+	var invoiceID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice := domain.SalesInvoice{
+			ID:        uuid.New(),
+			Status:    domain.SalesInvoiceStatusDraft,
+			CreatedBy: userID,
+		}
+
+		if err := service.applyRequest(ctx, tx, &invoice, request, invoiceDate); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, SalesInvoiceNumberPrefix, invoiceDate)
+		if err != nil {
+			return err
+		}
+		invoice.Number = number
+
+		created, err := service.SalesInvoiceRepository.Create(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+		invoiceID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, invoiceID)
+}
+
+func (service *ReceivableServiceImpl) UpdateInvoice(ctx context.Context, id uuid.UUID, request receivable.SalesInvoiceRequest) (*receivable.SalesInvoiceResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	invoiceDate, err := helper.ParseDate(request.InvoiceDate)
+	if err != nil {
+		return nil, exception.NewError("invalid invoice date")
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SalesInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales invoice not found")
+		}
+
+		if invoice.Status != domain.SalesInvoiceStatusDraft {
+			return exception.NewError("only draft sales invoices can be updated")
+		}
+
+		if err := service.applyRequest(ctx, tx, &invoice, request, invoiceDate); err != nil {
+			return err
+		}
+
+		if err := service.SalesInvoiceRepository.Update(ctx, tx, invoice); err != nil {
+			return err
+		}
+
+		if err := service.SalesInvoiceRepository.ReplaceLines(ctx, tx, invoice.ID, invoice.Lines); err != nil {
+			return err
+		}
+		return service.SalesInvoiceRepository.ReplaceTaxes(ctx, tx, invoice.ID, invoice.Taxes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *ReceivableServiceImpl) FindInvoiceById(ctx context.Context, id uuid.UUID) (*receivable.SalesInvoiceResponse, error) {
+	invoice, err := service.SalesInvoiceRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("sales invoice not found")
+	}
+
+	return mapper.ToSalesInvoiceResponse(invoice), nil
+}
+
+func (service *ReceivableServiceImpl) FindAllInvoices(ctx context.Context, filter receivable.SalesInvoiceFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	customerID, err := helper.ParseOptionalUUID(filter.CustomerID, "customer_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	invoices, totalItems, err := service.SalesInvoiceRepository.FindAllWithPagination(ctx, service.DB, filter.Status, customerID, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToSalesInvoiceSummaryResponses(invoices)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *ReceivableServiceImpl) PostInvoice(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*receivable.SalesInvoiceResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SalesInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales invoice not found")
+		}
+
+		if invoice.Status != domain.SalesInvoiceStatusDraft {
+			return exception.NewError("only draft sales invoices can be posted")
+		}
+
+		if _, err := findActiveCustomer(ctx, tx, service.CustomerRepository, invoice.CustomerID); err != nil {
+			return err
+		}
+
+		setting, err := loadReceivableSetting(ctx, tx, service.ReceivableSettingRepository)
+		if err != nil {
+			return err
+		}
+
+		journal, err := buildInvoiceJournal(invoice, setting, userID)
+		if err != nil {
+			return err
+		}
+
+		// Kurs dikunci saat posting dan dipakai kembali saat pelunasan dan revaluasi
+		rate, err := service.CurrencyService.RateOn(ctx, tx, invoice.Currency, invoice.InvoiceDate)
+		if err != nil {
+			return err
+		}
+		journal, err = service.CurrencyService.ConvertEntry(ctx, tx, journal, invoice.Currency, rate)
+		if err != nil {
+			return err
+		}
+		entry, err := service.LedgerService.PostEntry(ctx, tx, journal)
+		if err != nil {
+			return err
+		}
+
+		// Faktur pajak keluaran diberi nomor NSFP saat invoice ber-PPN diposting
+		if requiresTaxInvoice(invoice) && invoice.TaxInvoiceNo == "" {
+			invoice.TaxInvoiceNo, err = service.TaxService.AssignTaxInvoiceNumber(ctx, tx, invoice.InvoiceDate)
+			if err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		invoice.Status = domain.SalesInvoiceStatusPosted
+		invoice.ExchangeRate = rate
+		invoice.JournalEntryID = &entry.ID
+		invoice.PostedBy = &userID
+		invoice.PostedAt = &now
+		return service.SalesInvoiceRepository.Update(ctx, tx, invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *ReceivableServiceImpl) CancelInvoice(ctx context.Context, id uuid.UUID) (*receivable.SalesInvoiceResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invoice, err := service.SalesInvoiceRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales invoice not found")
+		}
+
+		if invoice.Status != domain.SalesInvoiceStatusDraft {
+			return exception.NewError("only draft sales invoices can be cancelled")
+		}
+
+		invoice.Status = domain.SalesInvoiceStatusCancelled
+		return service.SalesInvoiceRepository.Update(ctx, tx, invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindInvoiceById(ctx, id)
+}
+
+func (service *ReceivableServiceImpl) GetSetting(ctx context.Context) (*receivable.ReceivableSettingResponse, error) {
+	setting, err := service.ReceivableSettingRepository.Find(ctx, service.DB)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mapper.ToReceivableSettingResponse(domain.ReceivableSetting{ID: domain.ReceivableSettingID}), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToReceivableSettingResponse(setting), nil
+}
+
+func (service *ReceivableServiceImpl) UpdateSetting(ctx context.Context, userID uuid.UUID, request receivable.ReceivableSettingRequest) (*receivable.ReceivableSettingResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.ReceivableAccountID, "receivable account", domain.AccountTypeAsset); err != nil {
+			return err
+		}
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, request.RevenueAccountID, "revenue account", domain.AccountTypeRevenue); err != nil {
+			return err
+		}
+
+		return service.ReceivableSettingRepository.Save(ctx, tx, domain.ReceivableSetting{
+			ReceivableAccountID: &request.ReceivableAccountID,
+			RevenueAccountID:    &request.RevenueAccountID,
+			UpdatedBy:           &userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.GetSetting(ctx)
+}
+
+func (service *ReceivableServiceImpl) AgingReport(ctx context.Context, filter receivable.AgingFilterRequest) (*receivable.AgingReportResponse, error) {
+	asOf := helper.Today()
+	if filter.AsOf != "" {
+		parsed, err := helper.ParseDate(filter.AsOf)
+		if err != nil {
+			return nil, exception.NewError("as_of must be in format 2006-01-02")
+		}
+		asOf = parsed
+	}
+
+	customerID, err := helper.ParseOptionalUUID(filter.CustomerID, "customer_id")
+	if err != nil {
+		return nil, err
+	}
+	currency := strings.ToUpper(filter.Currency)
+
+	rows, err := service.SalesInvoiceRepository.FindOutstandingAsOf(ctx, service.DB, asOf, customerID, currency)
+	if err != nil {
+		return nil, err
+	}
+	credits, err := service.CustomerReceiptRepository.FindUnappliedAsOf(ctx, service.DB, asOf, customerID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAgingReport(asOf, rows, credits), nil
+}
+
+// applyRequest mengisi header, baris dan pajak invoice dari request setelah memastikan customer
+// aktif serta item dan akun pendapatan per baris valid
+func (service *ReceivableServiceImpl) applyRequest(ctx context.Context, tx *gorm.DB, invoice *domain.SalesInvoice, request receivable.SalesInvoiceRequest, invoiceDate time.Time) error {
+	customer, err := findActiveCustomer(ctx, tx, service.CustomerRepository, request.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	lines := make([]domain.SalesInvoiceLine, 0, len(request.Lines))
+	var totalAmount decimal.Decimal
+	for i, line := range request.Lines {
+		itemID, err := helper.ParseOptionalUUID(line.ItemID, fmt.Sprintf("line %d item_id", i+1))
+		if err != nil {
+			return err
+		}
+		if itemID != nil {
+			item, err := service.ItemRepository.FindById(ctx, tx, *itemID)
+			if err != nil {
+				return exception.NewError(fmt.Sprintf("line %d: item not found", i+1))
+			}
+			if !item.IsActive {
+				return exception.NewError(fmt.Sprintf("line %d: item %s is inactive", i+1, item.Code))
+			}
+		}
+
+		revenueAccountID, err := helper.ParseOptionalUUID(line.RevenueAccountID, fmt.Sprintf("line %d revenue_account_id", i+1))
+		if err != nil {
+			return err
+		}
+		if revenueAccountID != nil {
+			if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, *revenueAccountID, fmt.Sprintf("line %d: revenue account", i+1), domain.AccountTypeRevenue); err != nil {
+				return err
+			}
+		}
+
+		invoiceLine := domain.SalesInvoiceLine{
+			ID:               uuid.New(),
+			SalesInvoiceID:   invoice.ID,
+			LineNo:           i + 1,
+			ItemID:           itemID,
+			Description:      line.Description,
+			Quantity:         helper.RoundQuantity(line.Quantity),
+			UOM:              line.UOM,
+			UnitPrice:        helper.RoundAmount(line.UnitPrice),
+			RevenueAccountID: revenueAccountID,
+		}
+		invoiceLine.LineTotal = helper.MultiplyAmount(invoiceLine.UnitPrice, invoiceLine.Quantity)
+		totalAmount = totalAmount.Add(invoiceLine.LineTotal)
+		lines = append(lines, invoiceLine)
+	}
+
+	subtotalAmount := helper.RoundAmount(totalAmount)
+	resolvedTaxes, err := service.TaxService.ResolveTaxLines(ctx, tx, taxService.TaxSideSales, subtotalAmount, toTaxLineInputs(request.Taxes), customer.NPWP != "")
+	if err != nil {
+		return err
+	}
+	taxes, taxAmount := buildInvoiceTaxes(invoice.ID, resolvedTaxes)
+
+	// Jatuh tempo default mengikuti termin pembayaran customer
+	dueDate := invoiceDate.AddDate(0, 0, customer.PaymentTermDays)
+	if request.DueDate != "" {
+		dueDate, err = helper.ParseDate(request.DueDate)
+		if err != nil {
+			return exception.NewError("invalid due date")
+		}
+		if dueDate.Before(invoiceDate) {
+			return exception.NewError("due date cannot be before invoice date")
+		}
+	}
+
+	invoice.CustomerID = customer.ID
+	invoice.CustomerName = customer.Name
+	invoice.InvoiceDate = invoiceDate
+	invoice.DueDate = dueDate
+	invoice.Currency = customer.Currency
+	invoice.Reference = request.Reference
+	invoice.Notes = request.Notes
+	invoice.SubtotalAmount = subtotalAmount
+	invoice.TaxAmount = taxAmount
+	invoice.TotalAmount = helper.RoundAmount(subtotalAmount.Add(taxAmount))
+	invoice.Lines = lines
+	invoice.Taxes = taxes
+
+	if !invoice.TotalAmount.IsPositive() {
+		return exception.NewError("invoice total after taxes must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package receiving
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/purchasing"
+	"erpfinance/internal/model/dto/receiving"
+
+	"github.com/google/uuid"
+)
+
+type GoodsReceiptService interface {
+	// Create mencatat GRN, menambah kuantitas diterima di purchase order dan
+	// membukukan stok masuk untuk baris yang terhubung ke item
+	Create(ctx context.Context, userID uuid.UUID, request receiving.GoodsReceiptRequest) (*receiving.GoodsReceiptResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*receiving.GoodsReceiptResponse, error)
+	FindAll(ctx context.Context, filter receiving.GoodsReceiptFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// ReceivePurchaseOrder mencatat goods receipt dari request endpoint receive purchase order lama
+	// dan mengembalikan purchase order dengan kuantitas diterima terbaru
+	ReceivePurchaseOrder(ctx context.Context, userID uuid.UUID, orderID uuid.UUID, request purchasing.PurchaseOrderReceiveRequest) (*purchasing.PurchaseOrderResponse, error)
+
+	// FindPurchaseOrder menampilkan purchase order beserta sisa kuantitas yang belum diterima
+	FindPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error)
+}
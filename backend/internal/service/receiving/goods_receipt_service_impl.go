@@ -0,0 +1,233 @@
+package receiving
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/purchasing"
+	"erpfinance/internal/model/dto/receiving"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/receiving"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	inventoryService "erpfinance/internal/service/inventory"
+	purchasingService "erpfinance/internal/service/purchasing"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GoodsReceiptNumberPrefix adalah prefix penomoran goods receipt, contoh: GR-202507-00001
+const GoodsReceiptNumberPrefix = "GR"
+
+type GoodsReceiptServiceImpl struct {
+	GoodsReceiptRepository repo.GoodsReceiptRepository
+	WarehouseRepository    inventoryRepo.WarehouseRepository
+	SequenceRepository     sequenceRepo.SequenceRepository
+	PurchasingService      purchasingService.PurchasingService
+	InventoryService       inventoryService.InventoryService
+	DB                     *gorm.DB
+	Validate               *validator.Validate
+}
+
+func NewGoodsReceiptService(goodsReceiptRepository repo.GoodsReceiptRepository, warehouseRepository inventoryRepo.WarehouseRepository, sequenceRepository sequenceRepo.SequenceRepository, purchasingService purchasingService.PurchasingService, inventoryService inventoryService.InventoryService, db *gorm.DB, validate *validator.Validate) GoodsReceiptService {
+	return &GoodsReceiptServiceImpl{
+		GoodsReceiptRepository: goodsReceiptRepository,
+		WarehouseRepository:    warehouseRepository,
+		SequenceRepository:     sequenceRepository,
+		PurchasingService:      purchasingService,
+		InventoryService:       inventoryService,
+		DB:                     db,
+		Validate:               validate,
+	}
+}
+
+func (service *GoodsReceiptServiceImpl) Create(ctx context.Context, userID uuid.UUID, request receiving.GoodsReceiptRequest) (*receiving.GoodsReceiptResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	receiptDate, err := helper.ParseDate(request.ReceiptDate)
+	if err != nil {
+		return nil, exception.NewError("invalid receipt date")
+	}
+
+	received := make(map[uuid.UUID]float64, len(request.Lines))
+	for i, line := range request.Lines {
+		if _, ok := received[line.PurchaseOrderLineID]; ok {
+			return nil, exception.NewError(fmt.Sprintf("line %d: purchase order line is listed more than once", i+1))
+		}
+		received[line.PurchaseOrderLineID] = line.Quantity
+	}
+
+	var receiptID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		warehouse, err := service.WarehouseRepository.FindById(ctx, tx, request.WarehouseID)
+		if err != nil {
+			return exception.NewNotFoundError("warehouse not found")
+		}
+		if !warehouse.IsActive {
+			return exception.NewError(fmt.Sprintf("warehouse %s is inactive", warehouse.Code))
+		}
+
+		order, err := service.PurchasingService.ReceiveOrderLines(ctx, tx, request.PurchaseOrderID, received)
+		if err != nil {
+			return err
+		}
+		if receiptDate.Before(order.OrderDate) {
+			return exception.NewError("receipt date cannot be before order date")
+		}
+
+		orderLineByID := make(map[uuid.UUID]domain.PurchaseOrderLine, len(order.Lines))
+		for _, line := range order.Lines {
+			orderLineByID[line.ID] = line
+		}
+
+		receipt := domain.GoodsReceipt{
+			ID:              uuid.New(),
+			PurchaseOrderID: order.ID,
+			SupplierID:      order.SupplierID,
+			WarehouseID:     warehouse.ID,
+			ReceiptDate:     receiptDate,
+			DeliveryNote:    request.DeliveryNote,
+			Notes:           request.Notes,
+			ReceivedBy:      userID,
+		}
+
+		var movements []domain.StockMovement
+		for i, line := range request.Lines {
+			orderLine := orderLineByID[line.PurchaseOrderLineID]
+			quantity := helper.RoundQuantity(line.Quantity)
+
+			binID, err := helper.ParseOptionalUUID(line.BinID, "bin_id")
+			if err != nil {
+				return err
+			}
+			if binID != nil && orderLine.ItemID == nil {
+				return exception.NewError(fmt.Sprintf("line %d: bin can only be set for stock items", i+1))
+			}
+
+			receipt.Lines = append(receipt.Lines, domain.GoodsReceiptLine{
+				ID:                  uuid.New(),
+				GoodsReceiptID:      receipt.ID,
+				PurchaseOrderLineID: orderLine.ID,
+				LineNo:              i + 1,
+				ItemID:              orderLine.ItemID,
+				BinID:               binID,
+				Description:         orderLine.Description,
+				UOM:                 orderLine.UOM,
+				OrderedQuantity:     orderLine.Quantity,
+				PreviouslyReceived:  helper.RoundQuantity(orderLine.ReceivedQuantity - quantity),
+				ReceivedQuantity:    quantity,
+			})
+
+			// Baris jasa/non-stok tidak menggerakkan persediaan
+			if orderLine.ItemID == nil {
+				continue
+			}
+			movements = append(movements, domain.StockMovement{
+				MovementType: domain.StockMovementReceipt,
+				MovementDate: receiptDate,
+				ItemID:       *orderLine.ItemID,
+				WarehouseID:  warehouse.ID,
+				BinID:        binID,
+				Quantity:     quantity,
+				SourceType:   domain.StockSourceGoodsReceipt,
+				SourceID:     &receipt.ID,
+				CreatedBy:    userID,
+			})
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, GoodsReceiptNumberPrefix, receiptDate)
+		if err != nil {
+			return err
+		}
+		receipt.Number = number
+
+		for i := range movements {
+			movements[i].Reference = number
+			movements[i].Notes = "Goods receipt for " + order.Number
+		}
+
+		created, err := service.GoodsReceiptRepository.Create(ctx, tx, receipt)
+		if err != nil {
+			return err
+		}
+		receiptID = created.ID
+
+		if len(movements) > 0 {
+			if _, err := service.InventoryService.PostMovements(ctx, tx, movements); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, receiptID)
+}
+
+func (service *GoodsReceiptServiceImpl) ReceivePurchaseOrder(ctx context.Context, userID uuid.UUID, orderID uuid.UUID, request purchasing.PurchaseOrderReceiveRequest) (*purchasing.PurchaseOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	receiptDate := request.ReceiptDate
+	if receiptDate == "" {
+		receiptDate = helper.FormatDate(time.Now())
+	}
+
+	receiptRequest := receiving.GoodsReceiptRequest{
+		PurchaseOrderID: orderID,
+		WarehouseID:     request.WarehouseID,
+		ReceiptDate:     receiptDate,
+		DeliveryNote:    request.DeliveryNote,
+	}
+	for _, line := range request.Lines {
+		receiptRequest.Lines = append(receiptRequest.Lines, receiving.GoodsReceiptLineRequest{
+			PurchaseOrderLineID: line.LineID,
+			Quantity:            line.Quantity,
+		})
+	}
+
+	if _, err := service.Create(ctx, userID, receiptRequest); err != nil {
+		return nil, err
+	}
+	return service.PurchasingService.FindPurchaseOrderById(ctx, orderID)
+}
+
+func (service *GoodsReceiptServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*receiving.GoodsReceiptResponse, error) {
+	receipt, err := service.GoodsReceiptRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("goods receipt not found")
+	}
+
+	return mapper.ToGoodsReceiptResponse(receipt), nil
+}
+
+func (service *GoodsReceiptServiceImpl) FindAll(ctx context.Context, filter receiving.GoodsReceiptFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	purchaseOrderID, err := helper.ParseOptionalUUID(filter.PurchaseOrderID, "purchase_order_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	receipts, totalItems, err := service.GoodsReceiptRepository.FindAllWithPagination(ctx, service.DB, purchaseOrderID, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToGoodsReceiptResponses(receipts)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *GoodsReceiptServiceImpl) FindPurchaseOrder(ctx context.Context, id uuid.UUID) (*purchasing.PurchaseOrderResponse, error) {
+	return service.PurchasingService.FindPurchaseOrderById(ctx, id)
+}
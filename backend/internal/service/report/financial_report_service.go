@@ -0,0 +1,15 @@
+package report
+
+import (
+	"context"
+	"erpfinance/internal/model/dto/report"
+)
+
+// FinancialReportService menyusun laporan keuangan dari jurnal yang sudah diposting
+type FinancialReportService interface {
+	TrialBalance(ctx context.Context, request report.FinancialReportRequest) (*report.TrialBalanceResponse, error)
+	BalanceSheet(ctx context.Context, request report.BalanceSheetRequest) (*report.BalanceSheetResponse, error)
+	IncomeStatement(ctx context.Context, request report.FinancialReportRequest) (*report.IncomeStatementResponse, error)
+	// CashFlow menyusun laporan arus kas metode tidak langsung
+	CashFlow(ctx context.Context, request report.FinancialReportRequest) (*report.CashFlowResponse, error)
+}
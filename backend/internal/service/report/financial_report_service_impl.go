@@ -0,0 +1,323 @@
+package report
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/report"
+	ledgerRepo "erpfinance/internal/repository/ledger"
+	repo "erpfinance/internal/repository/report"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type FinancialReportServiceImpl struct {
+	FinancialReportRepository repo.FinancialReportRepository
+	AccountRepository         ledgerRepo.AccountRepository
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewFinancialReportService(financialReportRepository repo.FinancialReportRepository, accountRepository ledgerRepo.AccountRepository, db *gorm.DB, validate *validator.Validate) FinancialReportService {
+	return &FinancialReportServiceImpl{
+		FinancialReportRepository: financialReportRepository,
+		AccountRepository:         accountRepository,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *FinancialReportServiceImpl) TrialBalance(ctx context.Context, request report.FinancialReportRequest) (*report.TrialBalanceResponse, error) {
+	periods, err := service.parsePeriods(request)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := service.AccountRepository.FindAll(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	openings := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	movements := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	response := &report.TrialBalanceResponse{Columns: toColumns(periods)}
+	for i, period := range periods {
+		if openings[i], err = service.findMovements(ctx, nil, dayBefore(*period.From)); err != nil {
+			return nil, err
+		}
+		if movements[i], err = service.findMovements(ctx, period.From, period.To); err != nil {
+			return nil, err
+		}
+
+		var total report.TrialBalanceTotalResponse
+		for _, account := range accounts {
+			movement := movements[i][account.ID]
+			closing := helper.RoundAmount(openings[i][account.ID].Balance().Add(movement.Balance()))
+			total.Debit = total.Debit.Add(movement.Debit)
+			total.Credit = total.Credit.Add(movement.Credit)
+			if closing.IsPositive() {
+				total.ClosingDebit = total.ClosingDebit.Add(closing)
+			} else {
+				total.ClosingCredit = total.ClosingCredit.Sub(closing)
+			}
+		}
+		total.Debit = helper.RoundAmount(total.Debit)
+		total.Credit = helper.RoundAmount(total.Credit)
+		total.ClosingDebit = helper.RoundAmount(total.ClosingDebit)
+		total.ClosingCredit = helper.RoundAmount(total.ClosingCredit)
+		total.IsBalanced = helper.IsZeroAmount(total.Debit.Sub(total.Credit)) && helper.IsZeroAmount(total.ClosingDebit.Sub(total.ClosingCredit))
+		response.Totals = append(response.Totals, total)
+	}
+
+	// Setiap kolom berisi 4 nilai: saldo awal, debit, kredit dan saldo akhir
+	own := func(account domain.Account) []decimal.Decimal {
+		amounts := make([]decimal.Decimal, 0, len(periods)*4)
+		for i := range periods {
+			opening := openings[i][account.ID].Balance()
+			movement := movements[i][account.ID]
+			amounts = append(amounts, opening, movement.Debit, movement.Credit, opening.Add(movement.Balance()))
+		}
+		return amounts
+	}
+	lines, _ := buildTreeLines(accounts, own, len(periods)*4, request.MaxLevel, request.IncludeZero)
+
+	response.Lines = make([]report.TrialBalanceLineResponse, 0, len(lines))
+	for _, line := range lines {
+		item := report.TrialBalanceLineResponse{
+			AccountID: line.Account.ID,
+			Code:      line.Account.Code,
+			Name:      line.Account.Name,
+			Type:      line.Account.Type,
+			Level:     line.Level,
+			IsHeader:  !line.Account.IsPostable,
+		}
+		for i := 0; i < len(line.Amounts); i += 4 {
+			item.Amounts = append(item.Amounts, report.TrialBalanceAmountResponse{
+				OpeningBalance: line.Amounts[i],
+				Debit:          line.Amounts[i+1],
+				Credit:         line.Amounts[i+2],
+				ClosingBalance: line.Amounts[i+3],
+			})
+		}
+		response.Lines = append(response.Lines, item)
+	}
+	return response, nil
+}
+
+func (service *FinancialReportServiceImpl) BalanceSheet(ctx context.Context, request report.BalanceSheetRequest) (*report.BalanceSheetResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	asOf, err := helper.ParseDate(request.AsOf)
+	if err != nil {
+		return nil, exception.NewError("invalid as_of")
+	}
+	periods := []reportPeriod{{Label: "current", To: asOf}}
+	if request.CompareAsOf != "" {
+		compareAsOf, err := helper.ParseDate(request.CompareAsOf)
+		if err != nil {
+			return nil, exception.NewError("invalid compare_as_of")
+		}
+		periods = append(periods, reportPeriod{Label: "comparative", To: compareAsOf})
+	}
+
+	accounts, err := service.AccountRepository.FindAll(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	for i, period := range periods {
+		if balances[i], err = service.findMovements(ctx, nil, period.To); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &report.BalanceSheetResponse{
+		Columns:     toColumns(periods),
+		Assets:      buildSection("Assets", accounts, ofType(domain.AccountTypeAsset), balances, 1, request.MaxLevel, request.IncludeZero),
+		Liabilities: buildSection("Liabilities", accounts, ofType(domain.AccountTypeLiability), balances, -1, request.MaxLevel, request.IncludeZero),
+		Equity:      buildSection("Equity", accounts, ofType(domain.AccountTypeEquity), balances, -1, request.MaxLevel, request.IncludeZero),
+	}
+
+	// Laba rugi yang belum ditutup ke laba ditahan tetap menjadi bagian ekuitas
+	earnings := netIncome(accounts, balances)
+	if !isZeroVector(earnings) || request.IncludeZero {
+		response.Equity.Lines = append(response.Equity.Lines, computedLine("Unclosed profit and loss", earnings))
+		addVector(response.Equity.Totals, earnings)
+		roundVector(response.Equity.Totals)
+	}
+
+	response.TotalLiabilitiesAndEquity = make([]decimal.Decimal, len(periods))
+	copy(response.TotalLiabilitiesAndEquity, response.Liabilities.Totals)
+	addVector(response.TotalLiabilitiesAndEquity, response.Equity.Totals)
+	roundVector(response.TotalLiabilitiesAndEquity)
+	for i := range periods {
+		response.IsBalanced = append(response.IsBalanced, helper.IsZeroAmount(response.Assets.Totals[i].Sub(response.TotalLiabilitiesAndEquity[i])))
+	}
+	return response, nil
+}
+
+func (service *FinancialReportServiceImpl) IncomeStatement(ctx context.Context, request report.FinancialReportRequest) (*report.IncomeStatementResponse, error) {
+	periods, err := service.parsePeriods(request)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := service.AccountRepository.FindAll(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	for i, period := range periods {
+		if balances[i], err = service.findMovements(ctx, period.From, period.To); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &report.IncomeStatementResponse{
+		Columns:  toColumns(periods),
+		Revenue:  buildSection("Revenue", accounts, ofType(domain.AccountTypeRevenue), balances, -1, request.MaxLevel, request.IncludeZero),
+		Expenses: buildSection("Expenses", accounts, ofType(domain.AccountTypeExpense), balances, 1, request.MaxLevel, request.IncludeZero),
+	}
+	response.NetIncome = subtractVector(response.Revenue.Totals, response.Expenses.Totals)
+	return response, nil
+}
+
+func (service *FinancialReportServiceImpl) CashFlow(ctx context.Context, request report.FinancialReportRequest) (*report.CashFlowResponse, error) {
+	periods, err := service.parsePeriods(request)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := service.AccountRepository.FindAll(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Akun kas adalah akun buku besar rekening bank/kas ditambah akun berkategori CASH
+	bankAccountIDs, err := service.FinancialReportRepository.FindBankGLAccountIDs(ctx, service.DB)
+	if err != nil {
+		return nil, err
+	}
+	cashAccounts := make(map[uuid.UUID]bool, len(bankAccountIDs))
+	for _, id := range bankAccountIDs {
+		cashAccounts[id] = true
+	}
+	for _, account := range accounts {
+		if account.EffectiveCashFlowCategory() == domain.CashFlowCategoryCash {
+			cashAccounts[account.ID] = true
+		}
+	}
+	if len(cashAccounts) == 0 {
+		return nil, exception.NewError("no cash accounts configured; register bank accounts or set cash_flow_category CASH on the cash GL accounts")
+	}
+
+	openings := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	movements := make([]map[uuid.UUID]domain.AccountMovement, len(periods))
+	for i, period := range periods {
+		if openings[i], err = service.findMovements(ctx, nil, dayBefore(*period.From)); err != nil {
+			return nil, err
+		}
+		if movements[i], err = service.findMovements(ctx, period.From, period.To); err != nil {
+			return nil, err
+		}
+	}
+
+	// Perubahan akun neraca non-kas dihitung kredit dikurangi debit: kenaikan aset mengurangi
+	// kas, kenaikan kewajiban dan ekuitas menambah kas
+	inCategory := func(category domain.CashFlowCategory) func(domain.Account) bool {
+		return func(account domain.Account) bool {
+			return !cashAccounts[account.ID] && account.EffectiveCashFlowCategory() == category
+		}
+	}
+	response := &report.CashFlowResponse{
+		Columns:   toColumns(periods),
+		Operating: buildSection("Operating activities", accounts, inCategory(domain.CashFlowCategoryOperating), movements, -1, request.MaxLevel, request.IncludeZero),
+		Investing: buildSection("Investing activities", accounts, inCategory(domain.CashFlowCategoryInvesting), movements, -1, request.MaxLevel, request.IncludeZero),
+		Financing: buildSection("Financing activities", accounts, inCategory(domain.CashFlowCategoryFinancing), movements, -1, request.MaxLevel, request.IncludeZero),
+	}
+
+	income := netIncome(accounts, movements)
+	response.Operating.Lines = append([]report.StatementLineResponse{computedLine("Net income", income)}, response.Operating.Lines...)
+	addVector(response.Operating.Totals, income)
+	roundVector(response.Operating.Totals)
+
+	for i := range periods {
+		var opening, change decimal.Decimal
+		for id := range cashAccounts {
+			opening = opening.Add(openings[i][id].Balance())
+			change = change.Add(movements[i][id].Balance())
+		}
+		response.OpeningCash = append(response.OpeningCash, helper.RoundAmount(opening))
+		response.ClosingCash = append(response.ClosingCash, helper.RoundAmount(opening.Add(change)))
+		response.NetChangeInCash = append(response.NetChangeInCash, helper.RoundAmount(response.Operating.Totals[i].Add(response.Investing.Totals[i]).Add(response.Financing.Totals[i])))
+	}
+	return response, nil
+}
+
+// parsePeriods memvalidasi request dan mengembalikan periode utama serta periode pembanding
+func (service *FinancialReportServiceImpl) parsePeriods(request report.FinancialReportRequest) ([]reportPeriod, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	current, err := parsePeriod("current", request.DateFrom, request.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	periods := []reportPeriod{current}
+	if request.CompareDateFrom != "" {
+		comparative, err := parsePeriod("comparative", request.CompareDateFrom, request.CompareDateTo)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, comparative)
+	}
+	return periods, nil
+}
+
+func parsePeriod(label, from, to string) (reportPeriod, error) {
+	dateFrom, err := helper.ParseDate(from)
+	if err != nil {
+		return reportPeriod{}, exception.NewError("invalid " + label + " period start date")
+	}
+	dateTo, err := helper.ParseDate(to)
+	if err != nil {
+		return reportPeriod{}, exception.NewError("invalid " + label + " period end date")
+	}
+	if dateTo.Before(dateFrom) {
+		return reportPeriod{}, exception.NewError(label + " period end date cannot be before its start date")
+	}
+	return reportPeriod{Label: label, From: &dateFrom, To: dateTo}, nil
+}
+
+func (service *FinancialReportServiceImpl) findMovements(ctx context.Context, dateFrom *time.Time, dateTo time.Time) (map[uuid.UUID]domain.AccountMovement, error) {
+	movements, err := service.FinancialReportRepository.FindAccountMovements(ctx, service.DB, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	return movementMap(movements), nil
+}
+
+// netIncome menjumlahkan akun pendapatan dan beban (kredit dikurangi debit) per kolom
+func netIncome(accounts []domain.Account, balances []map[uuid.UUID]domain.AccountMovement) []decimal.Decimal {
+	result := make([]decimal.Decimal, len(balances))
+	for _, account := range accounts {
+		if account.IsBalanceSheet() {
+			continue
+		}
+		for i, balance := range balances {
+			result[i] = result[i].Sub(balance[account.ID].Balance())
+		}
+	}
+	roundVector(result)
+	return result
+}
@@ -0,0 +1,190 @@
+package report
+
+import (
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto/report"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// reportPeriod adalah satu kolom laporan. From kosong berarti sejak awal pembukuan (neraca).
+type reportPeriod struct {
+	Label string
+	From  *time.Time
+	To    time.Time
+}
+
+func (p reportPeriod) column() report.ReportColumnResponse {
+	column := report.ReportColumnResponse{Label: p.Label, DateTo: helper.FormatDate(p.To)}
+	if p.From != nil {
+		column.DateFrom = helper.FormatDate(*p.From)
+	}
+	return column
+}
+
+// dayBefore mengembalikan tanggal sehari sebelum date, batas akhir saldo awal suatu periode
+func dayBefore(date time.Time) time.Time {
+	return date.AddDate(0, 0, -1)
+}
+
+func toColumns(periods []reportPeriod) []report.ReportColumnResponse {
+	columns := make([]report.ReportColumnResponse, 0, len(periods))
+	for _, period := range periods {
+		columns = append(columns, period.column())
+	}
+	return columns
+}
+
+func movementMap(movements []domain.AccountMovement) map[uuid.UUID]domain.AccountMovement {
+	result := make(map[uuid.UUID]domain.AccountMovement, len(movements))
+	for _, movement := range movements {
+		result[movement.AccountID] = movement
+	}
+	return result
+}
+
+// treeLine adalah satu akun pada laporan berhirarki dengan nilai yang sudah di-roll-up
+type treeLine struct {
+	Account domain.Account
+	Level   int
+	Amounts []decimal.Decimal
+}
+
+// buildTreeLines menyusun akun menjadi hirarki (akun induk di luar daftar diabaikan sehingga
+// anaknya menjadi akar) lalu menjumlahkan nilai setiap akun ke seluruh induknya. own
+// mengembalikan nilai akun itu sendiri sepanjang width. Akun tanpa nilai di seluruh
+// sub-pohonnya dilewati kecuali includeZero; akun di bawah maxLevel tidak ditampilkan tetapi
+// tetap terakumulasi ke induknya. Nilai kembalian kedua adalah total seluruh akar.
+func buildTreeLines(accounts []domain.Account, own func(domain.Account) []decimal.Decimal, width int, maxLevel int, includeZero bool) ([]treeLine, []decimal.Decimal) {
+	inSet := make(map[uuid.UUID]bool, len(accounts))
+	for _, account := range accounts {
+		inSet[account.ID] = true
+	}
+	childrenOf := make(map[uuid.UUID][]domain.Account)
+	var roots []domain.Account
+	for _, account := range accounts {
+		if account.ParentID != nil && inSet[*account.ParentID] {
+			childrenOf[*account.ParentID] = append(childrenOf[*account.ParentID], account)
+			continue
+		}
+		roots = append(roots, account)
+	}
+
+	var walk func(account domain.Account, level int) ([]decimal.Decimal, bool, []treeLine)
+	walk = func(account domain.Account, level int) ([]decimal.Decimal, bool, []treeLine) {
+		total := make([]decimal.Decimal, width)
+		copy(total, own(account))
+		active := !isZeroVector(total)
+
+		var childLines []treeLine
+		for _, child := range childrenOf[account.ID] {
+			amounts, childActive, lines := walk(child, level+1)
+			addVector(total, amounts)
+			active = active || childActive
+			childLines = append(childLines, lines...)
+		}
+		roundVector(total)
+
+		if (!active && !includeZero) || (maxLevel > 0 && level >= maxLevel) {
+			return total, active, nil
+		}
+		lines := append([]treeLine{{Account: account, Level: level, Amounts: total}}, childLines...)
+		return total, active, lines
+	}
+
+	totals := make([]decimal.Decimal, width)
+	var lines []treeLine
+	for _, root := range roots {
+		amounts, _, rootLines := walk(root, 0)
+		addVector(totals, amounts)
+		lines = append(lines, rootLines...)
+	}
+	roundVector(totals)
+	return lines, totals
+}
+
+// buildSection membuat satu bagian laporan dari akun-akun yang lolos filter. sign 1 untuk
+// saldo normal debit dan -1 untuk saldo normal kredit.
+func buildSection(name string, accounts []domain.Account, include func(domain.Account) bool, balances []map[uuid.UUID]domain.AccountMovement, sign float64, maxLevel int, includeZero bool) report.StatementSectionResponse {
+	var selected []domain.Account
+	for _, account := range accounts {
+		if include(account) {
+			selected = append(selected, account)
+		}
+	}
+
+	own := func(account domain.Account) []decimal.Decimal {
+		amounts := make([]decimal.Decimal, len(balances))
+		for i, balance := range balances {
+			amounts[i] = helper.MultiplyAmount(balance[account.ID].Balance(), sign)
+		}
+		return amounts
+	}
+	lines, totals := buildTreeLines(selected, own, len(balances), maxLevel, includeZero)
+
+	section := report.StatementSectionResponse{Name: name, Lines: make([]report.StatementLineResponse, 0, len(lines)), Totals: totals}
+	for _, line := range lines {
+		section.Lines = append(section.Lines, toStatementLine(line))
+	}
+	return section
+}
+
+func toStatementLine(line treeLine) report.StatementLineResponse {
+	accountID := line.Account.ID
+	return report.StatementLineResponse{
+		AccountID: &accountID,
+		Code:      line.Account.Code,
+		Name:      line.Account.Name,
+		Level:     line.Level,
+		IsHeader:  !line.Account.IsPostable,
+		Amounts:   line.Amounts,
+	}
+}
+
+// computedLine membuat baris hasil perhitungan yang tidak terkait akun tertentu
+func computedLine(name string, amounts []decimal.Decimal) report.StatementLineResponse {
+	return report.StatementLineResponse{Name: name, Amounts: amounts}
+}
+
+func ofType(types ...domain.AccountType) func(domain.Account) bool {
+	return func(account domain.Account) bool {
+		for _, accountType := range types {
+			if account.Type == accountType {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func addVector(target, values []decimal.Decimal) {
+	for i := range target {
+		target[i] = target[i].Add(values[i])
+	}
+}
+
+func subtractVector(a, b []decimal.Decimal) []decimal.Decimal {
+	result := make([]decimal.Decimal, len(a))
+	for i := range a {
+		result[i] = helper.RoundAmount(a[i].Sub(b[i]))
+	}
+	return result
+}
+
+func roundVector(values []decimal.Decimal) {
+	for i := range values {
+		values[i] = helper.RoundAmount(values[i])
+	}
+}
+
+func isZeroVector(values []decimal.Decimal) bool {
+	for _, value := range values {
+		if !helper.IsZeroAmount(value) {
+			return false
+		}
+	}
+	return true
+}
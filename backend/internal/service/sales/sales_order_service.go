@@ -0,0 +1,37 @@
+package sales
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/sales"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SalesOrderService interface {
+	Create(ctx context.Context, userID uuid.UUID, request sales.SalesOrderRequest) (*sales.SalesOrderResponse, error)
+	// Update hanya untuk order Draft atau CreditHold; order CreditHold kembali menjadi Draft
+	Update(ctx context.Context, id uuid.UUID, request sales.SalesOrderRequest) (*sales.SalesOrderResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*sales.SalesOrderResponse, error)
+	FindAll(ctx context.Context, filter sales.SalesOrderFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// Confirm memeriksa plafon kredit customer. Order yang melebihi plafon tidak dianggap error
+	// melainkan disimpan dengan status CreditHold menunggu OverrideCredit.
+	Confirm(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*sales.SalesOrderResponse, error)
+	// OverrideCredit mengonfirmasi order CreditHold atas persetujuan approver
+	OverrideCredit(ctx context.Context, id uuid.UUID, userID uuid.UUID, request sales.CreditOverrideRequest) (*sales.SalesOrderResponse, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*sales.SalesOrderResponse, error)
+
+	CustomerCredit(ctx context.Context, customerID uuid.UUID) (*sales.CustomerCreditResponse, error)
+
+	// FindOpenOrder mengambil order yang sudah dikonfirmasi dan belum selesai dikirim beserta
+	// barisnya di dalam transaksi milik pemanggil. Dipakai saat menyusun pengiriman.
+	FindOpenOrder(ctx context.Context, tx *gorm.DB, orderID uuid.UUID) (domain.SalesOrder, error)
+
+	// ApplyShipment menambah kuantitas terkirim per baris (key: id baris) di dalam transaksi milik
+	// pemanggil, lalu memperbarui status pemenuhan baris dan order. Kuantitas negatif dipakai
+	// saat barang diretur.
+	ApplyShipment(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, shipped map[uuid.UUID]float64) (domain.SalesOrder, error)
+}
@@ -0,0 +1,501 @@
+package sales
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/sales"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	receivableRepo "erpfinance/internal/repository/receivable"
+	repo "erpfinance/internal/repository/sales"
+	sequenceRepo "erpfinance/internal/repository/sequence"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SalesOrderNumberPrefix adalah prefix penomoran sales order, contoh: SO-202507-00001
+const SalesOrderNumberPrefix = "SO"
+
+type SalesOrderServiceImpl struct {
+	SalesOrderRepository      repo.SalesOrderRepository
+	CustomerRepository        receivableRepo.CustomerRepository
+	SalesInvoiceRepository    receivableRepo.SalesInvoiceRepository
+	CustomerReceiptRepository receivableRepo.CustomerReceiptRepository
+	ItemRepository            inventoryRepo.ItemRepository
+	SequenceRepository        sequenceRepo.SequenceRepository
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewSalesOrderService(salesOrderRepository repo.SalesOrderRepository, customerRepository receivableRepo.CustomerRepository, salesInvoiceRepository receivableRepo.SalesInvoiceRepository, customerReceiptRepository receivableRepo.CustomerReceiptRepository, itemRepository inventoryRepo.ItemRepository, sequenceRepository sequenceRepo.SequenceRepository, db *gorm.DB, validate *validator.Validate) SalesOrderService {
+	return &SalesOrderServiceImpl{
+		SalesOrderRepository:      salesOrderRepository,
+		CustomerRepository:        customerRepository,
+		SalesInvoiceRepository:    salesInvoiceRepository,
+		CustomerReceiptRepository: customerReceiptRepository,
+		ItemRepository:            itemRepository,
+		SequenceRepository:        sequenceRepository,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *SalesOrderServiceImpl) Create(ctx context.Context, userID uuid.UUID, request sales.SalesOrderRequest) (*sales.SalesOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	order := domain.SalesOrder{
+		ID:        uuid.New(),
+		Status:    domain.SalesOrderStatusDraft,
+		CreatedBy: userID,
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.buildOrder(ctx, tx, &order, request); err != nil {
+			return err
+		}
+
+		number, err := service.SequenceRepository.Next(ctx, tx, SalesOrderNumberPrefix, order.OrderDate)
+		if err != nil {
+			return err
+		}
+		order.Number = number
+
+		_, err = service.SalesOrderRepository.Create(ctx, tx, order)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, order.ID)
+}
+
+func (service *SalesOrderServiceImpl) Update(ctx context.Context, id uuid.UUID, request sales.SalesOrderRequest) (*sales.SalesOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.SalesOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales order not found")
+		}
+		if order.Status != domain.SalesOrderStatusDraft && order.Status != domain.SalesOrderStatusCreditHold {
+			return exception.NewError("only draft or credit hold sales orders can be updated")
+		}
+
+		if err := service.buildOrder(ctx, tx, &order, request); err != nil {
+			return err
+		}
+
+		// Isi order berubah sehingga hasil pemeriksaan kredit sebelumnya tidak berlaku lagi
+		order.Status = domain.SalesOrderStatusDraft
+		order.CreditLimit = decimal.Zero
+		order.CreditExposure = decimal.Zero
+
+		if err := service.SalesOrderRepository.Update(ctx, tx, order); err != nil {
+			return err
+		}
+		return service.SalesOrderRepository.ReplaceLines(ctx, tx, order.ID, order.Lines)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *SalesOrderServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*sales.SalesOrderResponse, error) {
+	order, err := service.SalesOrderRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("sales order not found")
+	}
+
+	return mapper.ToSalesOrderResponse(order), nil
+}
+
+func (service *SalesOrderServiceImpl) FindAll(ctx context.Context, filter sales.SalesOrderFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	customerID, err := helper.ParseOptionalUUID(filter.CustomerID, "customer_id")
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	orders, totalItems, err := service.SalesOrderRepository.FindAllWithPagination(ctx, service.DB, filter.Status, customerID, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToSalesOrderResponses(orders)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *SalesOrderServiceImpl) Confirm(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*sales.SalesOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.SalesOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales order not found")
+		}
+		if order.Status != domain.SalesOrderStatusDraft && order.Status != domain.SalesOrderStatusCreditHold {
+			return exception.NewError("only draft or credit hold sales orders can be confirmed")
+		}
+
+		// Row customer dikunci agar dua konfirmasi bersamaan tidak sama-sama lolos plafon
+		customer, err := service.CustomerRepository.FindByIdForUpdate(ctx, tx, order.CustomerID)
+		if err != nil {
+			return exception.NewNotFoundError("customer not found")
+		}
+		if !customer.IsActive {
+			return exception.NewError(fmt.Sprintf("customer %s is inactive", customer.Code))
+		}
+
+		openReceivable, openOrders, err := service.creditPosition(ctx, tx, customer.ID, &order.ID)
+		if err != nil {
+			return err
+		}
+
+		order.CreditLimit = customer.CreditLimit
+		order.CreditExposure = helper.RoundAmount(openReceivable.Add(openOrders).Add(order.TotalAmount))
+		if isOverCreditLimit(order.CreditLimit, order.CreditExposure) {
+			order.Status = domain.SalesOrderStatusCreditHold
+			return service.SalesOrderRepository.Update(ctx, tx, order)
+		}
+
+		now := time.Now()
+		order.Status = domain.SalesOrderStatusConfirmed
+		order.ConfirmedBy = &userID
+		order.ConfirmedAt = &now
+		return service.SalesOrderRepository.Update(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *SalesOrderServiceImpl) OverrideCredit(ctx context.Context, id uuid.UUID, userID uuid.UUID, request sales.CreditOverrideRequest) (*sales.SalesOrderResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.SalesOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales order not found")
+		}
+		if order.Status != domain.SalesOrderStatusCreditHold {
+			return exception.NewError("only sales orders on credit hold can be overridden")
+		}
+
+		now := time.Now()
+		order.Status = domain.SalesOrderStatusConfirmed
+		order.CreditOverrideBy = &userID
+		order.CreditOverrideAt = &now
+		order.CreditOverrideReason = request.Reason
+		order.ConfirmedBy = &userID
+		order.ConfirmedAt = &now
+		return service.SalesOrderRepository.Update(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *SalesOrderServiceImpl) Cancel(ctx context.Context, id uuid.UUID) (*sales.SalesOrderResponse, error) {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := service.SalesOrderRepository.FindByIdForUpdate(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("sales order not found")
+		}
+
+		// Order yang sudah mulai dikirim tidak bisa dibatalkan
+		switch order.Status {
+		case domain.SalesOrderStatusDraft, domain.SalesOrderStatusCreditHold, domain.SalesOrderStatusConfirmed:
+		default:
+			return exception.NewError(fmt.Sprintf("sales order with status %s cannot be cancelled", order.Status))
+		}
+
+		now := time.Now()
+		order.Status = domain.SalesOrderStatusCancelled
+		order.CancelledAt = &now
+		return service.SalesOrderRepository.Update(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *SalesOrderServiceImpl) CustomerCredit(ctx context.Context, customerID uuid.UUID) (*sales.CustomerCreditResponse, error) {
+	customer, err := service.CustomerRepository.FindById(ctx, service.DB, customerID)
+	if err != nil {
+		return nil, exception.NewNotFoundError("customer not found")
+	}
+
+	openReceivable, openOrders, err := service.creditPosition(ctx, service.DB, customer.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &sales.CustomerCreditResponse{
+		CustomerID:     customer.ID,
+		CustomerCode:   customer.Code,
+		CustomerName:   customer.Name,
+		Currency:       customer.Currency,
+		CreditLimit:    customer.CreditLimit,
+		OpenReceivable: openReceivable,
+		OpenOrders:     openOrders,
+		Exposure:       helper.RoundAmount(openReceivable.Add(openOrders)),
+		IsUnlimited:    helper.IsZeroAmount(customer.CreditLimit),
+	}
+	if !response.IsUnlimited && response.CreditLimit.GreaterThan(response.Exposure) {
+		response.AvailableCredit = helper.RoundAmount(response.CreditLimit.Sub(response.Exposure))
+	}
+	return response, nil
+}
+
+func (service *SalesOrderServiceImpl) FindOpenOrder(ctx context.Context, tx *gorm.DB, orderID uuid.UUID) (domain.SalesOrder, error) {
+	order, err := service.SalesOrderRepository.FindById(ctx, tx, orderID)
+	if err != nil {
+		return domain.SalesOrder{}, exception.NewNotFoundError("sales order not found")
+	}
+	if !order.IsOpen() {
+		return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("sales order %s is not open for shipment", order.Number))
+	}
+	return order, nil
+}
+
+func (service *SalesOrderServiceImpl) ApplyShipment(ctx context.Context, tx *gorm.DB, orderID uuid.UUID, shipped map[uuid.UUID]float64) (domain.SalesOrder, error) {
+	order, err := service.SalesOrderRepository.FindByIdForUpdate(ctx, tx, orderID)
+	if err != nil {
+		return domain.SalesOrder{}, exception.NewNotFoundError("sales order not found")
+	}
+
+	// Retur tetap bisa diterima setelah order Fulfilled; pengiriman baru hanya untuk order terbuka
+	if !order.IsOpen() && order.Status != domain.SalesOrderStatusFulfilled {
+		return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("sales order %s is not open for shipment", order.Number))
+	}
+
+	matched := 0
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		quantity, ok := shipped[line.ID]
+		if !ok {
+			continue
+		}
+		matched++
+
+		quantity = helper.RoundQuantity(quantity)
+		if quantity > 0 && !order.IsOpen() {
+			return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("sales order %s is already fulfilled", order.Number))
+		}
+		if quantity > helper.RoundQuantity(line.OutstandingQuantity()) {
+			return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("sales order %s line %d: shipped quantity exceeds outstanding quantity", order.Number, line.LineNo))
+		}
+		if -quantity > line.ShippedQuantity {
+			return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("sales order %s line %d: returned quantity exceeds shipped quantity", order.Number, line.LineNo))
+		}
+
+		line.ShippedQuantity = helper.RoundQuantity(line.ShippedQuantity + quantity)
+		line.FulfilmentStatus = lineFulfilmentStatus(*line)
+	}
+	if matched != len(shipped) {
+		return domain.SalesOrder{}, exception.NewError(fmt.Sprintf("shipped line does not belong to sales order %s", order.Number))
+	}
+
+	if err := service.SalesOrderRepository.UpdateLineFulfilment(ctx, tx, order.Lines); err != nil {
+		return domain.SalesOrder{}, err
+	}
+
+	order.Status = orderFulfilmentStatus(order.Lines)
+	if err := service.SalesOrderRepository.Update(ctx, tx, order); err != nil {
+		return domain.SalesOrder{}, err
+	}
+	return order, nil
+}
+
+// creditPosition menghitung piutang terbuka bersih (sisa invoice dikurangi kredit yang belum
+// dialokasikan) dan nilai order terbuka yang belum dikirim, tanpa order excludeID
+func (service *SalesOrderServiceImpl) creditPosition(ctx context.Context, tx *gorm.DB, customerID uuid.UUID, excludeID *uuid.UUID) (decimal.Decimal, decimal.Decimal, error) {
+	outstanding, err := service.SalesInvoiceRepository.SumOutstandingByCustomer(ctx, tx, customerID)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	unapplied, err := service.CustomerReceiptRepository.SumUnappliedByCustomer(ctx, tx, customerID)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	openOrders, err := service.SalesOrderRepository.SumOpenOrderValue(ctx, tx, customerID, excludeID)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	return helper.RoundAmount(outstanding.Sub(unapplied)), helper.RoundAmount(openOrders), nil
+}
+
+// buildOrder mengisi header dan baris order dari request setelah memastikan customer dan item
+// aktif. Mata uang mengikuti customer; deskripsi dan satuan kosong diambil dari item.
+func (service *SalesOrderServiceImpl) buildOrder(ctx context.Context, tx *gorm.DB, order *domain.SalesOrder, request sales.SalesOrderRequest) error {
+	orderDate, err := helper.ParseDate(request.OrderDate)
+	if err != nil {
+		return exception.NewError("invalid order date")
+	}
+	var requestedDate *time.Time
+	if request.RequestedDate != "" {
+		parsed, err := helper.ParseDate(request.RequestedDate)
+		if err != nil {
+			return exception.NewError("invalid requested date")
+		}
+		if parsed.Before(orderDate) {
+			return exception.NewError("requested date cannot be before order date")
+		}
+		requestedDate = &parsed
+	}
+
+	customer, err := service.CustomerRepository.FindById(ctx, tx, request.CustomerID)
+	if err != nil {
+		return exception.NewNotFoundError("customer not found")
+	}
+	if !customer.IsActive {
+		return exception.NewError(fmt.Sprintf("customer %s is inactive", customer.Code))
+	}
+
+	itemIDs := make([]uuid.UUID, 0, len(request.Lines))
+	for _, line := range request.Lines {
+		itemIDs = append(itemIDs, line.ItemID)
+	}
+	items, err := service.ItemRepository.FindByIds(ctx, tx, itemIDs)
+	if err != nil {
+		return err
+	}
+	itemByID := make(map[uuid.UUID]domain.Item, len(items))
+	for _, item := range items {
+		itemByID[item.ID] = item
+	}
+
+	lines := make([]domain.SalesOrderLine, 0, len(request.Lines))
+	var subtotalAmount, discountAmount, taxAmount decimal.Decimal
+	for i, lineRequest := range request.Lines {
+		item, ok := itemByID[lineRequest.ItemID]
+		if !ok {
+			return exception.NewError(fmt.Sprintf("line %d: item not found", i+1))
+		}
+		if !item.IsActive {
+			return exception.NewError(fmt.Sprintf("line %d: item %s is inactive", i+1, item.Code))
+		}
+
+		description := lineRequest.Description
+		if description == "" {
+			description = item.Name
+		}
+		line := buildOrderLine(order.ID, i+1, item, description, lineRequest)
+
+		subtotalAmount = subtotalAmount.Add(helper.RoundAmount(line.NetAmount.Add(line.DiscountAmount)))
+		discountAmount = discountAmount.Add(line.DiscountAmount)
+		taxAmount = taxAmount.Add(line.TaxAmount)
+		lines = append(lines, line)
+	}
+
+	shippingAddress := request.ShippingAddress
+	if shippingAddress == "" {
+		shippingAddress = customer.BillingAddress
+	}
+
+	order.CustomerID = customer.ID
+	order.CustomerName = customer.Name
+	order.OrderDate = orderDate
+	order.RequestedDate = requestedDate
+	order.Currency = customer.Currency
+	order.Reference = request.Reference
+	order.ShippingAddress = shippingAddress
+	order.Notes = request.Notes
+	order.SubtotalAmount = helper.RoundAmount(subtotalAmount)
+	order.DiscountAmount = helper.RoundAmount(discountAmount)
+	order.TaxAmount = helper.RoundAmount(taxAmount)
+	order.TotalAmount = helper.RoundAmount(order.SubtotalAmount.Sub(order.DiscountAmount).Add(order.TaxAmount))
+	order.Lines = lines
+
+	if !order.TotalAmount.IsPositive() {
+		return exception.NewError("order total must be greater than 0")
+	}
+	return nil
+}
+
+// buildOrderLine menghitung diskon, pajak dan total baris. Diskon dihitung dari nilai bruto
+// (kuantitas x harga) dan pajak dihitung dari nilai setelah diskon.
+func buildOrderLine(orderID uuid.UUID, lineNo int, item domain.Item, description string, request sales.SalesOrderLineRequest) domain.SalesOrderLine {
+	line := domain.SalesOrderLine{
+		ID:               uuid.New(),
+		SalesOrderID:     orderID,
+		LineNo:           lineNo,
+		ItemID:           item.ID,
+		Description:      description,
+		UOM:              item.UOM,
+		Quantity:         helper.RoundQuantity(request.Quantity),
+		UnitPrice:        helper.RoundAmount(request.UnitPrice),
+		DiscountPercent:  request.DiscountPercent,
+		TaxPercent:       request.TaxPercent,
+		FulfilmentStatus: domain.SalesOrderLineUnfulfilled,
+	}
+
+	grossAmount := helper.MultiplyAmount(line.UnitPrice, line.Quantity)
+	line.DiscountAmount = helper.PercentOfAmount(grossAmount, line.DiscountPercent)
+	line.NetAmount = helper.RoundAmount(grossAmount.Sub(line.DiscountAmount))
+	line.TaxAmount = helper.PercentOfAmount(line.NetAmount, line.TaxPercent)
+	line.LineTotal = helper.RoundAmount(line.NetAmount.Add(line.TaxAmount))
+	return line
+}
+
+// isOverCreditLimit: plafon 0 berarti customer tidak dibatasi
+func isOverCreditLimit(creditLimit decimal.Decimal, exposure decimal.Decimal) bool {
+	if helper.IsZeroAmount(creditLimit) {
+		return false
+	}
+	return exposure.GreaterThan(creditLimit)
+}
+
+func lineFulfilmentStatus(line domain.SalesOrderLine) domain.SalesOrderLineStatus {
+	switch {
+	case line.ShippedQuantity <= 0:
+		return domain.SalesOrderLineUnfulfilled
+	case helper.RoundQuantity(line.OutstandingQuantity()) <= 0:
+		return domain.SalesOrderLineFulfilled
+	default:
+		return domain.SalesOrderLinePartiallyFulfilled
+	}
+}
+
+// orderFulfilmentStatus menurunkan status order dari status pemenuhan seluruh barisnya
+func orderFulfilmentStatus(lines []domain.SalesOrderLine) domain.SalesOrderStatus {
+	fulfilled, started := 0, false
+	for _, line := range lines {
+		switch line.FulfilmentStatus {
+		case domain.SalesOrderLineFulfilled:
+			fulfilled++
+			started = true
+		case domain.SalesOrderLinePartiallyFulfilled:
+			started = true
+		}
+	}
+
+	switch {
+	case fulfilled == len(lines):
+		return domain.SalesOrderStatusFulfilled
+	case started:
+		return domain.SalesOrderStatusPartiallyFulfilled
+	default:
+		return domain.SalesOrderStatusConfirmed
+	}
+}
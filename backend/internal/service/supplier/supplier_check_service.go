@@ -0,0 +1,17 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/model/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SupplierCheckService dipakai oleh setiap proses yang membuat dokumen pembelian untuk
+// memastikan supplier yang dipakai masih aktif.
+type SupplierCheckService interface {
+	// EnsureActive harus dipanggil di dalam DB.Transaction milik pemanggil. Row supplier
+	// ditahan dengan FOR SHARE sehingga pemblokiran yang berjalan bersamaan akan menunggu.
+	EnsureActive(ctx context.Context, tx *gorm.DB, supplierID uuid.UUID) (domain.Supplier, error)
+}
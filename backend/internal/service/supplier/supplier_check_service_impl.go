@@ -0,0 +1,38 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/model/domain"
+	repo "erpfinance/internal/repository/supplier"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SupplierCheckServiceImpl struct {
+	SupplierRepository repo.SupplierRepository
+}
+
+func NewSupplierCheckService(supplierRepository repo.SupplierRepository) SupplierCheckService {
+	return &SupplierCheckServiceImpl{
+		SupplierRepository: supplierRepository,
+	}
+}
+
+func (service *SupplierCheckServiceImpl) EnsureActive(ctx context.Context, tx *gorm.DB, supplierID uuid.UUID) (domain.Supplier, error) {
+	supplier, err := service.SupplierRepository.FindByIdForShare(ctx, tx, supplierID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Supplier{}, exception.NewError("supplier not found")
+	}
+	if err != nil {
+		return domain.Supplier{}, err
+	}
+
+	if supplier.Status != domain.SupplierStatusActive {
+		return domain.Supplier{}, exception.NewError(fmt.Sprintf("supplier %s is %s and cannot be used", supplier.Code, supplier.Status))
+	}
+	return supplier, nil
+}
@@ -0,0 +1,17 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/supplier"
+
+	"github.com/google/uuid"
+)
+
+type SupplierService interface {
+	Create(ctx context.Context, request supplier.SupplierRequest) (*supplier.SupplierResponse, error)
+	Update(ctx context.Context, id uuid.UUID, request supplier.SupplierRequest) (*supplier.SupplierResponse, error)
+	FindById(ctx context.Context, id uuid.UUID) (*supplier.SupplierResponse, error)
+	FindAll(ctx context.Context, filter supplier.SupplierFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+	ChangeStatus(ctx context.Context, id uuid.UUID, request supplier.SupplierStatusRequest) (*supplier.SupplierResponse, error)
+}
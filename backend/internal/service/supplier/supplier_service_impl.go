@@ -0,0 +1,225 @@
+package supplier
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/supplier"
+	repo "erpfinance/internal/repository/supplier"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SupplierServiceImpl struct {
+	SupplierRepository repo.SupplierRepository
+	DB                 *gorm.DB
+	Validate           *validator.Validate
+}
+
+func NewSupplierService(supplierRepository repo.SupplierRepository, db *gorm.DB, validate *validator.Validate) SupplierService {
+	return &SupplierServiceImpl{
+		SupplierRepository: supplierRepository,
+		DB:                 db,
+		Validate:           validate,
+	}
+}
+
+func (service *SupplierServiceImpl) Create(ctx context.Context, request supplier.SupplierRequest) (*supplier.SupplierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	npwp, err := normalizeNPWP(request.NPWP)
+	if err != nil {
+		return nil, err
+	}
+
+	var supplierID uuid.UUID
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := service.SupplierRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != uuid.Nil {
+			return exception.NewError("supplier code already exists")
+		}
+
+		newSupplier := domain.Supplier{
+			ID:              uuid.New(),
+			Code:            request.Code,
+			Name:            request.Name,
+			NPWP:            npwp,
+			Email:           request.Email,
+			Phone:           request.Phone,
+			PaymentTermDays: request.PaymentTermDays,
+			Currency:        strings.ToUpper(request.Currency),
+			Status:          domain.SupplierStatusActive,
+			Notes:           request.Notes,
+		}
+		buildSupplierDetails(&newSupplier, request)
+
+		created, err := service.SupplierRepository.Create(ctx, tx, newSupplier)
+		if err != nil {
+			return err
+		}
+		supplierID = created.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, supplierID)
+}
+
+func (service *SupplierServiceImpl) Update(ctx context.Context, id uuid.UUID, request supplier.SupplierRequest) (*supplier.SupplierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	npwp, err := normalizeNPWP(request.NPWP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := service.SupplierRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("supplier not found")
+		}
+
+		existing, err := service.SupplierRepository.FindByCode(ctx, tx, request.Code)
+		if err == nil && existing.ID != current.ID {
+			return exception.NewError("supplier code already exists")
+		}
+
+		current.Code = request.Code
+		current.Name = request.Name
+		current.NPWP = npwp
+		current.Email = request.Email
+		current.Phone = request.Phone
+		current.PaymentTermDays = request.PaymentTermDays
+		current.Currency = strings.ToUpper(request.Currency)
+		current.Notes = request.Notes
+		if err := service.SupplierRepository.Update(ctx, tx, current); err != nil {
+			return err
+		}
+
+		buildSupplierDetails(&current, request)
+		return service.SupplierRepository.ReplaceDetails(ctx, tx, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+func (service *SupplierServiceImpl) FindById(ctx context.Context, id uuid.UUID) (*supplier.SupplierResponse, error) {
+	result, err := service.SupplierRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("supplier not found")
+	}
+
+	return mapper.ToSupplierResponse(result), nil
+}
+
+func (service *SupplierServiceImpl) FindAll(ctx context.Context, filter supplier.SupplierFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	suppliers, totalItems, err := service.SupplierRepository.FindAllWithPagination(ctx, service.DB, filter.Search, filter.Status, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToSupplierResponses(suppliers)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *SupplierServiceImpl) ChangeStatus(ctx context.Context, id uuid.UUID, request supplier.SupplierStatusRequest) (*supplier.SupplierResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+	if request.Status == domain.SupplierStatusBlocked && strings.TrimSpace(request.Reason) == "" {
+		return nil, exception.NewError("reason is required when blocking a supplier")
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := service.SupplierRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("supplier not found")
+		}
+
+		if current.Status == request.Status {
+			return exception.NewError("supplier is already " + string(request.Status))
+		}
+
+		current.Status = request.Status
+		current.BlockReason = ""
+		if request.Status == domain.SupplierStatusBlocked {
+			current.BlockReason = request.Reason
+		}
+		return service.SupplierRepository.Update(ctx, tx, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindById(ctx, id)
+}
+
+// normalizeNPWP membersihkan format NPWP; NPWP boleh kosong untuk supplier luar negeri
+func normalizeNPWP(npwp string) (string, error) {
+	if strings.TrimSpace(npwp) == "" {
+		return "", nil
+	}
+	normalized := helper.NormalizeNPWP(npwp)
+	if !helper.IsValidNPWP(normalized) {
+		return "", exception.NewError("npwp must contain 15 or 16 digits")
+	}
+	return normalized, nil
+}
+
+// buildSupplierDetails mengisi alamat, kontak dan rekening bank supplier dari request
+func buildSupplierDetails(s *domain.Supplier, request supplier.SupplierRequest) {
+	s.Addresses = nil
+	for _, address := range request.Addresses {
+		s.Addresses = append(s.Addresses, domain.SupplierAddress{
+			ID:         uuid.New(),
+			SupplierID: s.ID,
+			Label:      address.Label,
+			Address:    address.Address,
+			City:       address.City,
+			Province:   address.Province,
+			PostalCode: address.PostalCode,
+			IsPrimary:  address.IsPrimary,
+		})
+	}
+
+	s.Contacts = nil
+	for _, contact := range request.Contacts {
+		s.Contacts = append(s.Contacts, domain.SupplierContact{
+			ID:         uuid.New(),
+			SupplierID: s.ID,
+			Name:       contact.Name,
+			Position:   contact.Position,
+			Email:      contact.Email,
+			Phone:      contact.Phone,
+			IsPrimary:  contact.IsPrimary,
+		})
+	}
+
+	s.BankAccounts = nil
+	for _, account := range request.BankAccounts {
+		s.BankAccounts = append(s.BankAccounts, domain.SupplierBankAccount{
+			ID:            uuid.New(),
+			SupplierID:    s.ID,
+			BankName:      account.BankName,
+			AccountNumber: account.AccountNumber,
+			AccountName:   account.AccountName,
+			IsPrimary:     account.IsPrimary,
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package tax
+
+import (
+	"bytes"
+	"encoding/csv"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/model/domain"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TaxSide string
+
+const (
+	TaxSideSales    TaxSide = "SALES"
+	TaxSidePurchase TaxSide = "PURCHASE"
+)
+
+// TaxLineInput adalah baris pajak dari request invoice sebelum dilengkapi master kode pajak
+type TaxLineInput struct {
+	TaxCode       string
+	Description   string
+	AccountID     *uuid.UUID
+	Rate          float64
+	BaseAmount    *decimal.Decimal
+	IsWithholding bool
+}
+
+// ResolvedTaxLine adalah baris pajak yang siap dihitung nilainya
+type ResolvedTaxLine struct {
+	TaxCode       string
+	TaxType       domain.TaxType
+	Description   string
+	AccountID     uuid.UUID
+	BaseAmount    decimal.Decimal
+	Rate          float64
+	IsWithholding bool
+}
+
+// Amount adalah nilai pajak baris (DPP x tarif)
+func (l ResolvedTaxLine) Amount() decimal.Decimal {
+	return helper.PercentOfAmount(l.BaseAmount, l.Rate)
+}
+
+// emptyNPWP dipakai e-Faktur untuk pembeli tanpa NPWP
+const emptyNPWP = "000000000000000"
+
+var eFakturHeaders = [][]string{
+	{"FK", "KD_JENIS_TRANSAKSI", "FG_PENGGANTI", "NOMOR_FAKTUR", "MASA_PAJAK", "TAHUN_PAJAK", "TANGGAL_FAKTUR", "NPWP", "NAMA", "ALAMAT_LENGKAP", "JUMLAH_DPP", "JUMLAH_PPN", "JUMLAH_PPNBM", "ID_KETERANGAN_TAMBAHAN", "FG_UANG_MUKA", "UANG_MUKA_DPP", "UANG_MUKA_PPN", "UANG_MUKA_PPNBM", "REFERENSI", "KODE_DOKUMEN_PENDUKUNG"},
+	{"LT", "NPWP", "NAMA", "JALAN", "BLOK", "NOMOR", "RT", "RW", "KECAMATAN", "KELURAHAN", "KABUPATEN", "PROPINSI", "KODE_POS", "NOMOR_TELEPON"},
+	{"OF", "KODE_OBJEK", "NAMA", "HARGA_SATUAN", "JUMLAH_BARANG", "HARGA_TOTAL", "DISKON", "DPP", "PPN", "TARIF_PPNBM", "PPNBM"},
+}
+
+// buildEFakturCSV menyusun file import e-Faktur: tiga baris header diikuti baris FK per faktur dan
+// baris OF per baris invoice. Nominal dikonversi ke rupiah dengan kurs invoice; DPP dan PPN faktur
+// dibulatkan ke bawah, lalu dibagi ke baris OF secara proporsional dengan sisa di baris terakhir.
+func buildEFakturCSV(invoices []domain.SalesInvoice, customerByID map[uuid.UUID]domain.Customer, itemCodeByID map[uuid.UUID]string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.WriteAll(eFakturHeaders); err != nil {
+		return nil, err
+	}
+
+	for _, invoice := range invoices {
+		vat, ok := findOutputVAT(invoice)
+		if !ok {
+			continue
+		}
+
+		rate := invoice.ExchangeRate
+		if rate <= 0 {
+			rate = 1
+		}
+		dpp := vat.BaseAmount.Mul(decimal.NewFromFloat(rate)).Floor()
+		ppn := vat.Amount.Mul(decimal.NewFromFloat(rate)).Floor()
+
+		customer := customerByID[invoice.CustomerID]
+		name := customer.Name
+		if name == "" {
+			name = invoice.CustomerName
+		}
+		npwp := helper.NormalizeNPWP(customer.NPWP)
+		if npwp == "" {
+			npwp = emptyNPWP
+		}
+
+		digits := digitsOnly(invoice.TaxInvoiceNo)
+		transactionCode, replacement, number := domain.TaxTransactionCodeNormal, "0", digits
+		if len(digits) == 16 {
+			transactionCode, replacement, number = digits[:2], digits[2:3], digits[3:]
+		}
+
+		if err := writer.Write([]string{
+			"FK", transactionCode, replacement, number,
+			strconv.Itoa(int(invoice.InvoiceDate.Month())), strconv.Itoa(invoice.InvoiceDate.Year()),
+			invoice.InvoiceDate.Format("02/01/2006"), npwp, name, singleLine(customer.BillingAddress),
+			formatInteger(dpp), formatInteger(ppn), "0", "", "0", "0", "0", "0", invoice.Number, "",
+		}); err != nil {
+			return nil, err
+		}
+
+		var allocatedDPP, allocatedPPN decimal.Decimal
+		for i, line := range invoice.Lines {
+			lineDPP := proportionalShare(dpp, line.LineTotal, invoice.SubtotalAmount).Floor()
+			linePPN := proportionalShare(ppn, line.LineTotal, invoice.SubtotalAmount).Floor()
+			if i == len(invoice.Lines)-1 {
+				lineDPP = dpp.Sub(allocatedDPP)
+				linePPN = ppn.Sub(allocatedPPN)
+			}
+			allocatedDPP = allocatedDPP.Add(lineDPP)
+			allocatedPPN = allocatedPPN.Add(linePPN)
+
+			itemCode := ""
+			if line.ItemID != nil {
+				itemCode = itemCodeByID[*line.ItemID]
+			}
+			if err := writer.Write([]string{
+				"OF", itemCode, singleLine(line.Description),
+				helper.MultiplyAmount(line.UnitPrice, rate).String(), formatDecimal(line.Quantity),
+				helper.MultiplyAmount(line.LineTotal, rate).String(), "0",
+				formatInteger(lineDPP), formatInteger(linePPN), "0", "0",
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// findOutputVAT mengambil baris PPN keluaran invoice (paling banyak satu per invoice)
+func findOutputVAT(invoice domain.SalesInvoice) (domain.SalesInvoiceTax, bool) {
+	for _, tax := range invoice.Taxes {
+		if tax.TaxType == domain.TaxTypePPN && !tax.IsWithholding {
+			return tax, true
+		}
+	}
+	return domain.SalesInvoiceTax{}, false
+}
+
+// proportionalShare membagi amount sesuai porsi part terhadap total, 0 bila totalnya nol
+func proportionalShare(amount, part, total decimal.Decimal) decimal.Decimal {
+	if total.IsZero() {
+		return decimal.Zero
+	}
+	return amount.Mul(part).Div(total)
+}
+
+func digitsOnly(value string) string {
+	var builder strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func formatInteger(value decimal.Decimal) string {
+	return value.StringFixed(0)
+}
+
+func formatDecimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
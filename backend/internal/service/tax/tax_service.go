@@ -0,0 +1,37 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/tax"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type TaxService interface {
+	CreateTaxCode(ctx context.Context, request tax.TaxCodeRequest) (*tax.TaxCodeResponse, error)
+	UpdateTaxCode(ctx context.Context, id uuid.UUID, request tax.TaxCodeUpdateRequest) (*tax.TaxCodeResponse, error)
+	FindTaxCodeById(ctx context.Context, id uuid.UUID) (*tax.TaxCodeResponse, error)
+	FindAllTaxCodes(ctx context.Context, filter tax.TaxCodeFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	CreateInvoiceRange(ctx context.Context, userID uuid.UUID, request tax.TaxInvoiceRangeRequest) (*tax.TaxInvoiceRangeResponse, error)
+	UpdateInvoiceRange(ctx context.Context, id uuid.UUID, request tax.TaxInvoiceRangeUpdateRequest) (*tax.TaxInvoiceRangeResponse, error)
+	FindAllInvoiceRanges(ctx context.Context, filter tax.TaxInvoiceRangeFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error)
+
+	// ExportEFaktur menghasilkan file CSV format import e-Faktur untuk faktur pajak keluaran
+	// pada rentang tanggal invoice. Hasil kedua adalah nama file.
+	ExportEFaktur(ctx context.Context, request tax.TaxPeriodRequest) ([]byte, string, error)
+	WithholdingReport(ctx context.Context, request tax.TaxPeriodRequest) (*tax.WithholdingReportResponse, error)
+
+	// ResolveTaxLines melengkapi baris pajak invoice dari master kode pajak: tarif, akun sesuai sisi
+	// transaksi, sifat potong dan DPP. Kode yang tidak terdaftar memakai account_id dan rate dari
+	// request. Tarif PPh pembelian dinaikkan sebesar surcharge bila supplier tidak memiliki NPWP.
+	ResolveTaxLines(ctx context.Context, tx *gorm.DB, side TaxSide, subtotalAmount decimal.Decimal, inputs []TaxLineInput, counterpartyHasNPWP bool) ([]ResolvedTaxLine, error)
+
+	// AssignTaxInvoiceNumber mengambil nomor faktur pajak berikutnya dari range aktif tahun pajak
+	// tanggal invoice. Harus dipanggil di dalam transaksi yang sama dengan posting invoice.
+	AssignTaxInvoiceNumber(ctx context.Context, tx *gorm.DB, invoiceDate time.Time) (string, error)
+}
@@ -0,0 +1,447 @@
+package tax
+
+import (
+	"context"
+	"erpfinance/internal/exception"
+	"erpfinance/internal/helper"
+	"erpfinance/internal/helper/mapper"
+	"erpfinance/internal/model/domain"
+	"erpfinance/internal/model/dto"
+	"erpfinance/internal/model/dto/tax"
+	inventoryRepo "erpfinance/internal/repository/inventory"
+	repo "erpfinance/internal/repository/tax"
+	ledgerService "erpfinance/internal/service/ledger"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type TaxServiceImpl struct {
+	TaxCodeRepository         repo.TaxCodeRepository
+	TaxInvoiceRangeRepository repo.TaxInvoiceRangeRepository
+	TaxReportRepository       repo.TaxReportRepository
+	ItemRepository            inventoryRepo.ItemRepository
+	LedgerService             ledgerService.LedgerService
+	DB                        *gorm.DB
+	Validate                  *validator.Validate
+}
+
+func NewTaxService(taxCodeRepository repo.TaxCodeRepository, taxInvoiceRangeRepository repo.TaxInvoiceRangeRepository, taxReportRepository repo.TaxReportRepository, itemRepository inventoryRepo.ItemRepository, ledgerService ledgerService.LedgerService, db *gorm.DB, validate *validator.Validate) TaxService {
+	return &TaxServiceImpl{
+		TaxCodeRepository:         taxCodeRepository,
+		TaxInvoiceRangeRepository: taxInvoiceRangeRepository,
+		TaxReportRepository:       taxReportRepository,
+		ItemRepository:            itemRepository,
+		LedgerService:             ledgerService,
+		DB:                        db,
+		Validate:                  validate,
+	}
+}
+
+func (service *TaxServiceImpl) CreateTaxCode(ctx context.Context, request tax.TaxCodeRequest) (*tax.TaxCodeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	taxCode := domain.TaxCode{ID: uuid.New(), IsActive: true}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := service.applyTaxCodeRequest(ctx, tx, &taxCode, request); err != nil {
+			return err
+		}
+
+		var err error
+		taxCode, err = service.TaxCodeRepository.Create(ctx, tx, taxCode)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindTaxCodeById(ctx, taxCode.ID)
+}
+
+func (service *TaxServiceImpl) UpdateTaxCode(ctx context.Context, id uuid.UUID, request tax.TaxCodeUpdateRequest) (*tax.TaxCodeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		taxCode, err := service.TaxCodeRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("tax code not found")
+		}
+
+		if err := service.applyTaxCodeRequest(ctx, tx, &taxCode, request.TaxCodeRequest); err != nil {
+			return err
+		}
+		taxCode.IsActive = request.IsActive
+		return service.TaxCodeRepository.Update(ctx, tx, taxCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.FindTaxCodeById(ctx, id)
+}
+
+func (service *TaxServiceImpl) FindTaxCodeById(ctx context.Context, id uuid.UUID) (*tax.TaxCodeResponse, error) {
+	taxCode, err := service.TaxCodeRepository.FindById(ctx, service.DB, id)
+	if err != nil {
+		return nil, exception.NewNotFoundError("tax code not found")
+	}
+
+	return mapper.ToTaxCodeResponse(taxCode), nil
+}
+
+func (service *TaxServiceImpl) FindAllTaxCodes(ctx context.Context, filter tax.TaxCodeFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	taxCodes, totalItems, err := service.TaxCodeRepository.FindAllWithPagination(ctx, service.DB, strings.ToUpper(filter.Type), filter.ActiveOnly, filter.Search, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToTaxCodeResponses(taxCodes)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *TaxServiceImpl) CreateInvoiceRange(ctx context.Context, userID uuid.UUID, request tax.TaxInvoiceRangeRequest) (*tax.TaxInvoiceRangeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	if request.EndSerial < request.StartSerial {
+		return nil, exception.NewError("end_serial cannot be less than start_serial")
+	}
+
+	var created domain.TaxInvoiceRange
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		overlap, err := service.TaxInvoiceRangeRepository.ExistsOverlap(ctx, tx, request.Year, request.Prefix, request.StartSerial, request.EndSerial, nil)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return exception.NewError("tax invoice range overlaps an existing range")
+		}
+
+		created, err = service.TaxInvoiceRangeRepository.Create(ctx, tx, domain.TaxInvoiceRange{
+			ID:          uuid.New(),
+			Year:        request.Year,
+			Prefix:      request.Prefix,
+			StartSerial: request.StartSerial,
+			EndSerial:   request.EndSerial,
+			NextSerial:  request.StartSerial,
+			IsActive:    true,
+			Notes:       request.Notes,
+			CreatedBy:   userID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToTaxInvoiceRangeResponse(created), nil
+}
+
+func (service *TaxServiceImpl) UpdateInvoiceRange(ctx context.Context, id uuid.UUID, request tax.TaxInvoiceRangeUpdateRequest) (*tax.TaxInvoiceRangeResponse, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return nil, helper.FormatValidationError(err)
+	}
+
+	var invoiceRange domain.TaxInvoiceRange
+
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		invoiceRange, err = service.TaxInvoiceRangeRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("tax invoice range not found")
+		}
+
+		invoiceRange.IsActive = request.IsActive
+		invoiceRange.Notes = request.Notes
+		return service.TaxInvoiceRangeRepository.Update(ctx, tx, invoiceRange)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToTaxInvoiceRangeResponse(invoiceRange), nil
+}
+
+func (service *TaxServiceImpl) FindAllInvoiceRanges(ctx context.Context, filter tax.TaxInvoiceRangeFilterRequest, pagination dto.PaginationRequest) (dto.PaginationResponse, error) {
+	ranges, totalItems, err := service.TaxInvoiceRangeRepository.FindAllWithPagination(ctx, service.DB, filter.Year, pagination.Page, pagination.Limit)
+	if err != nil {
+		return dto.PaginationResponse{}, err
+	}
+
+	responses := mapper.ToTaxInvoiceRangeResponses(ranges)
+	return dto.NewPaginationResponse(pagination.Page, pagination.Limit, totalItems, responses), nil
+}
+
+func (service *TaxServiceImpl) ExportEFaktur(ctx context.Context, request tax.TaxPeriodRequest) ([]byte, string, error) {
+	dateFrom, dateTo, err := service.parsePeriod(request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	invoices, err := service.TaxReportRepository.FindTaxInvoices(ctx, service.DB, dateFrom, dateTo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var customerIDs, itemIDs []uuid.UUID
+	for _, invoice := range invoices {
+		customerIDs = append(customerIDs, invoice.CustomerID)
+		for _, line := range invoice.Lines {
+			if line.ItemID != nil {
+				itemIDs = append(itemIDs, *line.ItemID)
+			}
+		}
+	}
+
+	customers, err := service.TaxReportRepository.FindCustomersByIds(ctx, service.DB, customerIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	customerByID := make(map[uuid.UUID]domain.Customer, len(customers))
+	for _, customer := range customers {
+		customerByID[customer.ID] = customer
+	}
+
+	itemCodeByID := make(map[uuid.UUID]string)
+	if len(itemIDs) > 0 {
+		items, err := service.ItemRepository.FindByIds(ctx, service.DB, itemIDs)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, item := range items {
+			itemCodeByID[item.ID] = item.Code
+		}
+	}
+
+	content, err := buildEFakturCSV(invoices, customerByID, itemCodeByID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filename := fmt.Sprintf("efaktur_%s_%s.csv", dateFrom.Format("20060102"), dateTo.Format("20060102"))
+	return content, filename, nil
+}
+
+func (service *TaxServiceImpl) WithholdingReport(ctx context.Context, request tax.TaxPeriodRequest) (*tax.WithholdingReportResponse, error) {
+	dateFrom, dateTo, err := service.parsePeriod(request)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := service.TaxReportRepository.FindWithholdings(ctx, service.DB, dateFrom, dateTo, request.TaxType)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &tax.WithholdingReportResponse{
+		DateFrom: helper.FormatDate(dateFrom),
+		DateTo:   helper.FormatDate(dateTo),
+		Lines:    []tax.WithholdingLineResponse{},
+		Summary:  []tax.WithholdingSummaryResponse{},
+	}
+	summaryIndex := make(map[domain.TaxType]int)
+	for _, row := range rows {
+		line := tax.WithholdingLineResponse{
+			SupplierInvoiceID: row.SupplierInvoiceID,
+			InvoiceNumber:     row.InvoiceNumber,
+			SupplierInvoiceNo: row.SupplierInvoiceNo,
+			InvoiceDate:       helper.FormatDate(row.InvoiceDate),
+			SupplierID:        row.SupplierID,
+			SupplierName:      row.SupplierName,
+			SupplierNPWP:      row.SupplierNPWP,
+			TaxCode:           row.TaxCode,
+			TaxType:           row.TaxType,
+			Currency:          row.Currency,
+			ExchangeRate:      row.ExchangeRate,
+			Rate:              row.Rate,
+			BaseAmount:        row.BaseAmount,
+			Amount:            row.Amount,
+			BaseAmountIDR:     helper.MultiplyAmount(row.BaseAmount, row.ExchangeRate),
+			AmountIDR:         helper.MultiplyAmount(row.Amount, row.ExchangeRate),
+		}
+		report.Lines = append(report.Lines, line)
+
+		index, ok := summaryIndex[row.TaxType]
+		if !ok {
+			index = len(report.Summary)
+			summaryIndex[row.TaxType] = index
+			report.Summary = append(report.Summary, tax.WithholdingSummaryResponse{TaxType: row.TaxType})
+		}
+		report.Summary[index].BaseAmountIDR = helper.RoundAmount(report.Summary[index].BaseAmountIDR.Add(line.BaseAmountIDR))
+		report.Summary[index].AmountIDR = helper.RoundAmount(report.Summary[index].AmountIDR.Add(line.AmountIDR))
+	}
+
+	return report, nil
+}
+
+func (service *TaxServiceImpl) ResolveTaxLines(ctx context.Context, tx *gorm.DB, side TaxSide, subtotalAmount decimal.Decimal, inputs []TaxLineInput, counterpartyHasNPWP bool) ([]ResolvedTaxLine, error) {
+	lines := make([]ResolvedTaxLine, 0, len(inputs))
+	vatLines := 0
+	for i, input := range inputs {
+		code := strings.ToUpper(strings.TrimSpace(input.TaxCode))
+		line := ResolvedTaxLine{
+			TaxCode:       code,
+			TaxType:       domain.TaxTypeOther,
+			Description:   input.Description,
+			Rate:          input.Rate,
+			IsWithholding: input.IsWithholding,
+		}
+		baseFactor := 1.0
+		accountID := input.AccountID
+
+		taxCode, err := service.TaxCodeRepository.FindByCode(ctx, tx, code)
+		switch {
+		case err == nil:
+			if !taxCode.IsActive {
+				return nil, exception.NewError(fmt.Sprintf("tax line %d: tax code %s is inactive", i+1, code))
+			}
+			line.TaxType = taxCode.Type
+			line.Rate = taxCode.Rate
+			line.IsWithholding = taxCode.IsWithholding()
+			baseFactor = taxCode.BaseFactor
+			if line.Description == "" {
+				line.Description = taxCode.Name
+			}
+
+			masterAccountID := taxCode.SalesAccountID
+			if side == TaxSidePurchase {
+				masterAccountID = taxCode.PurchaseAccountID
+			}
+			if masterAccountID != nil {
+				accountID = masterAccountID
+			}
+
+			// PPh atas penerima penghasilan tanpa NPWP dipotong dengan tarif lebih tinggi
+			if side == TaxSidePurchase && line.IsWithholding && !counterpartyHasNPWP && taxCode.NoNPWPSurchargePercent > 0 {
+				line.Rate = line.Rate * (100 + taxCode.NoNPWPSurchargePercent) / 100
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if input.Rate <= 0 {
+				return nil, exception.NewError(fmt.Sprintf("tax line %d: tax code %s is not registered, rate is required", i+1, code))
+			}
+		default:
+			return nil, err
+		}
+
+		if accountID == nil {
+			return nil, exception.NewError(fmt.Sprintf("tax line %d: account_id is required for tax code %s", i+1, code))
+		}
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, *accountID, fmt.Sprintf("tax line %d: tax account", i+1)); err != nil {
+			return nil, err
+		}
+		line.AccountID = *accountID
+
+		if line.TaxType == domain.TaxTypePPN && !line.IsWithholding {
+			vatLines++
+			if vatLines > 1 {
+				return nil, exception.NewError("only one PPN tax line is allowed per invoice")
+			}
+		}
+
+		line.BaseAmount = helper.MultiplyAmount(subtotalAmount, baseFactor)
+		if input.BaseAmount != nil {
+			line.BaseAmount = helper.RoundAmount(*input.BaseAmount)
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func (service *TaxServiceImpl) AssignTaxInvoiceNumber(ctx context.Context, tx *gorm.DB, invoiceDate time.Time) (string, error) {
+	invoiceRange, err := service.TaxInvoiceRangeRepository.LockAvailableForYear(ctx, tx, invoiceDate.Year())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", exception.NewError(fmt.Sprintf("no tax invoice number range is available for %d", invoiceDate.Year()))
+		}
+		return "", err
+	}
+
+	number := domain.FormatTaxInvoiceNumber(domain.TaxTransactionCodeNormal, false, invoiceRange.Prefix, invoiceRange.Year, invoiceRange.NextSerial)
+	invoiceRange.NextSerial++
+	if err := service.TaxInvoiceRangeRepository.Update(ctx, tx, invoiceRange); err != nil {
+		return "", err
+	}
+	return number, nil
+}
+
+// applyTaxCodeRequest mengisi kode pajak dari request setelah memastikan kode unik dan akun valid
+func (service *TaxServiceImpl) applyTaxCodeRequest(ctx context.Context, tx *gorm.DB, taxCode *domain.TaxCode, request tax.TaxCodeRequest) error {
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	var excludeID *uuid.UUID
+	if taxCode.Code != "" {
+		excludeID = &taxCode.ID
+	}
+	exists, err := service.TaxCodeRepository.ExistsByCode(ctx, tx, code, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return exception.NewError(fmt.Sprintf("tax code %s already exists", code))
+	}
+
+	taxType := domain.TaxType(request.Type)
+	if request.NoNPWPSurchargePercent > 0 && !taxType.IsWithholding() {
+		return exception.NewError("no_npwp_surcharge_percent only applies to withholding taxes")
+	}
+	if request.SalesAccountID == nil && request.PurchaseAccountID == nil {
+		return exception.NewError("at least one of sales_account_id or purchase_account_id is required")
+	}
+	if request.SalesAccountID != nil {
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, *request.SalesAccountID, "sales tax account"); err != nil {
+			return err
+		}
+	}
+	if request.PurchaseAccountID != nil {
+		if _, err := service.LedgerService.EnsurePostableAccount(ctx, tx, *request.PurchaseAccountID, "purchase tax account"); err != nil {
+			return err
+		}
+	}
+
+	baseFactor := request.BaseFactor
+	if baseFactor == 0 {
+		baseFactor = 1
+	}
+
+	taxCode.Code = code
+	taxCode.Name = request.Name
+	taxCode.Type = taxType
+	taxCode.Rate = request.Rate
+	taxCode.BaseFactor = baseFactor
+	taxCode.NoNPWPSurchargePercent = request.NoNPWPSurchargePercent
+	taxCode.SalesAccountID = request.SalesAccountID
+	taxCode.PurchaseAccountID = request.PurchaseAccountID
+	taxCode.Notes = request.Notes
+	return nil
+}
+
+func (service *TaxServiceImpl) parsePeriod(request tax.TaxPeriodRequest) (time.Time, time.Time, error) {
+	if err := service.Validate.Struct(request); err != nil {
+		return time.Time{}, time.Time{}, helper.FormatValidationError(err)
+	}
+
+	dateFrom, err := helper.ParseDate(request.DateFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, exception.NewError("date_from must be in format 2006-01-02")
+	}
+	dateTo, err := helper.ParseDate(request.DateTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, exception.NewError("date_to must be in format 2006-01-02")
+	}
+	if dateTo.Before(dateFrom) {
+		return time.Time{}, time.Time{}, exception.NewError("date_to cannot be before date_from")
+	}
+	return dateFrom, dateTo, nil
+}
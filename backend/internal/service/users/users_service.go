@@ -13,4 +13,7 @@ type UsersService interface {
 	FindById(ctx context.Context, id uuid.UUID) (*users.UsersResponse, error)
 	Update(ctx context.Context, id uuid.UUID, request users.UsersUpdateRequest) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// Deactivate memblokir login dan langsung menolak semua token user
+	Deactivate(ctx context.Context, id uuid.UUID, actorID uuid.UUID) error
+	Activate(ctx context.Context, id uuid.UUID) error
 }
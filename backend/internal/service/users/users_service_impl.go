@@ -7,7 +7,9 @@ import (
 	"erpfinance/internal/helper/mapper"
 	"erpfinance/internal/model/dto"
 	"erpfinance/internal/model/dto/users"
+	tokenRepo "erpfinance/internal/repository/token"
 	repo "erpfinance/internal/repository/users"
+	authService "erpfinance/internal/service/auth"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -15,16 +17,20 @@ import (
 )
 
 type UsersServiceImpl struct {
-	UsersRepository repo.UsersRepository
-	DB              *gorm.DB
-	Validate        *validator.Validate
+	UsersRepository        repo.UsersRepository
+	TokenRepository        tokenRepo.TokenRepository
+	TokenRevocationService authService.TokenRevocationService
+	DB                     *gorm.DB
+	Validate               *validator.Validate
 }
 
-func NewUsersService(usersRepository repo.UsersRepository, db *gorm.DB, validate *validator.Validate) UsersService {
+func NewUsersService(usersRepository repo.UsersRepository, tokenRepository tokenRepo.TokenRepository, tokenRevocationService authService.TokenRevocationService, db *gorm.DB, validate *validator.Validate) UsersService {
 	return &UsersServiceImpl{
-		UsersRepository: usersRepository,
-		DB:              db,
-		Validate:        validate,
+		UsersRepository:        usersRepository,
+		TokenRepository:        tokenRepository,
+		TokenRevocationService: tokenRevocationService,
+		DB:                     db,
+		Validate:               validate,
 	}
 }
 
@@ -80,6 +86,9 @@ func (service *UsersServiceImpl) Update(ctx context.Context, id uuid.UUID, reque
             }
         }
 
+        // Role tersimpan di access token, jadi token lama harus ditolak bila role berubah
+        roleChanged := user.Role != request.Role
+
         // 3. Update data user
         user.Name = request.Name
         user.Email = request.Email
@@ -89,6 +98,12 @@ func (service *UsersServiceImpl) Update(ctx context.Context, id uuid.UUID, reque
             return err 
         }
 
+        if roleChanged {
+            if err := service.TokenRevocationService.RevokeAllForUser(ctx, tx, user.ID); err != nil {
+                return err
+            }
+        }
+
         return nil
     })
     
@@ -102,6 +117,11 @@ func (service *UsersServiceImpl) Delete(ctx context.Context, id uuid.UUID) error
             return exception.NewError("user not found")
         }
 
+        // Token user yang dihapus langsung ditolak tanpa menunggu cache kedaluwarsa
+        if err := service.TokenRevocationService.RevokeAllForUser(ctx, tx, user.ID); err != nil {
+            return err
+        }
+
         if err := service.UsersRepository.Delete(ctx, tx, user.ID); err != nil {
             return err 
         }
@@ -111,3 +131,49 @@ func (service *UsersServiceImpl) Delete(ctx context.Context, id uuid.UUID) error
 
     return err
 }
+
+func (service *UsersServiceImpl) Deactivate(ctx context.Context, id uuid.UUID, actorID uuid.UUID) error {
+	if id == actorID {
+		return exception.NewError("you cannot deactivate your own account")
+	}
+
+	return service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.UsersRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if !user.IsActive {
+			return exception.NewError("user is already deactivated")
+		}
+
+		if err := service.UsersRepository.UpdateActive(ctx, tx, user.ID, false); err != nil {
+			return err
+		}
+
+		// Hapus semua sesi lalu tolak access token yang masih beredar
+		if err := service.TokenRepository.DeleteByUserID(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		return service.TokenRevocationService.RevokeAllForUser(ctx, tx, user.ID)
+	})
+}
+
+func (service *UsersServiceImpl) Activate(ctx context.Context, id uuid.UUID) error {
+	err := service.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := service.UsersRepository.FindById(ctx, tx, id)
+		if err != nil {
+			return exception.NewNotFoundError("user not found")
+		}
+		if user.IsActive {
+			return exception.NewError("user is already active")
+		}
+
+		return service.UsersRepository.UpdateActive(ctx, tx, user.ID, true)
+	})
+	if err != nil {
+		return err
+	}
+
+	service.TokenRevocationService.ForgetUser(id)
+	return nil
+}